@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -15,6 +16,7 @@ import (
 	"github.com/rs/cors"
 
 	"github.com/Shakkuuu/sekai-songs-mylist/config"
+	domainrepository "github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
 	proto_auth_connect "github.com/Shakkuuu/sekai-songs-mylist/internal/gen/auth/v1/authv1connect"
 	proto_master_connect "github.com/Shakkuuu/sekai-songs-mylist/internal/gen/master/masterconnect"
 	proto_my_list_connect "github.com/Shakkuuu/sekai-songs-mylist/internal/gen/mylist/v1/mylistv1connect"
@@ -24,6 +26,7 @@ import (
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/interface/handler"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/interface/repository"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/auth"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/circuitbreaker"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/googleoauth"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/usecase"
 )
@@ -74,24 +77,50 @@ func main() {
 		os.Exit(1)
 	}
 
-	redisMasterCacheRepository := repository.NewRedisMasterCacheRepository(rc)
+	var redisMasterCacheRepository domainrepository.RedisMasterCacheRepository = repository.NewRedisMasterCacheRepository(rc, cfg.MasterCacheTTL, cfg.MasterCacheTTLJitter)
+	// キャッシュ、メモリ上のキャッシュの破棄の通知、変更通知は同じRedisを使うので、つながらないことを1つのbreakerで判断する
+	redisBreaker := circuitbreaker.New(cfg.MasterCacheBreakerThreshold, cfg.MasterCacheBreakerCooldown)
+	redisMasterCacheRepository = repository.NewResilientMasterCacheRepository(redisMasterCacheRepository, rc, redisBreaker)
+	var localMasterCacheRepository domainrepository.LocalMasterCacheRepository
+	if cfg.MasterLocalCacheSize > 0 {
+		localMasterCacheRepository = repository.NewLocalMasterCacheRepository(redisMasterCacheRepository, rc, redisBreaker, cfg.MasterLocalCacheSize, cfg.MasterLocalCacheMaxAge)
+		redisMasterCacheRepository = localMasterCacheRepository
+	}
+	redisMasterEventRepository := repository.NewResilientMasterEventRepository(repository.NewRedisMasterEventRepository(rc), redisBreaker)
+	var redisLockRepository domainrepository.RedisLockRepository
+	if cfg.MasterCacheFillLock {
+		redisLockRepository = repository.NewRedisLockRepository(rc)
+	}
 	masterRepository := repository.NewMasterRepository(queries)
 	userRepository := repository.NewUserRepository(queries)
-	masterUsecase := usecase.NewMasterUsecase(masterRepository, redisMasterCacheRepository)
+	txManager := repository.NewTxManager(dbConn, queries)
+	masterUsecase := usecase.NewMasterUsecase(masterRepository, redisMasterCacheRepository, redisMasterEventRepository, redisLockRepository, txManager)
+	// 検索用カラムを後から追加したDBでは既存の行が空のままで検索に出ないので、受け付ける前に埋める
+	artistCount, songCount, err := masterUsecase.BackfillSearchText(context.Background(), true)
+	if err != nil {
+		log.Printf("Failed to backfill search_text: \n%+v\n", err)
+		os.Exit(1)
+	}
+	if artistCount > 0 || songCount > 0 {
+		log.Printf("backfilled search_text: %d artists, %d songs\n", artistCount, songCount)
+	}
 	userUsecase := usecase.NewUserUsecase(userRepository)
 	masterHandler := handler.NewMasterHandler(masterUsecase, userUsecase)
 	authUsecase := usecase.NewAuthUsecase(userRepository)
 	authHandler := handler.NewAuthHandler(authUsecase, userUsecase)
 	userHandler := handler.NewUserHandler(userUsecase)
 	myListRepository := repository.NewMyListRepository(queries)
-	myListUsecase := usecase.NewMyListUsecase(myListRepository, masterRepository, redisMasterCacheRepository)
+	myListUsecase := usecase.NewMyListUsecase(myListRepository, masterRepository, redisMasterCacheRepository, txManager)
 	myListHandler := handler.NewMyListHandler(myListUsecase)
 	strageHandler := handler.NewStorageHandler()
+	calendarHandler := handler.NewCalendarHandler(masterUsecase)
+	feedHandler := handler.NewFeedHandler(masterUsecase)
 
 	mux := http.NewServeMux()
 	mux.Handle(
 		proto_master_connect.NewMasterServiceHandler(
 			masterHandler,
+			connect.WithInterceptors(auth.OptionalAuthInterceptor()),
 		),
 	)
 	mux.Handle(
@@ -117,6 +146,12 @@ func main() {
 	mux.HandleFunc("/upload/thumbnail", strageHandler.UploadThumbnailHandler)
 	mux.HandleFunc("/upload/attachment", strageHandler.UploadAttachmentHandler)
 	mux.HandleFunc("/image", strageHandler.GetImageHandler)
+	mux.HandleFunc("/calendar.ics", calendarHandler.GetCalendarHandler)
+	mux.HandleFunc("/feeds/master.atom", feedHandler.GetMasterAtomHandler)
+	if localMasterCacheRepository != nil {
+		cacheHandler := handler.NewCacheHandler(localMasterCacheRepository)
+		mux.HandleFunc("/cache/master/stats", cacheHandler.GetMasterCacheStatsHandler)
+	}
 	mux.HandleFunc("/delete/attachment", strageHandler.DeleteAttachmentHandler)
 
 	corsHandler := cors.New(cors.Options{
@@ -127,10 +162,32 @@ func main() {
 	})
 	handler := corsHandler.Handler(mux)
 
+	// WatchMasterのストリームは終わらないので、シャットダウン時にリクエストのctxを終わらせる
+	baseCtx, cancelBaseCtx := context.WithCancel(context.Background())
 	server := &http.Server{
-		Addr:    ":" + strconv.Itoa(cfg.ServerPort),
-		Handler: handler,
+		Addr:        ":" + strconv.Itoa(cfg.ServerPort),
+		Handler:     handler,
+		BaseContext: func(net.Listener) context.Context { return baseCtx },
 	}
+	server.RegisterOnShutdown(cancelBaseCtx)
+
+	// 公開日時を過ぎた曲を差分に出すため、定期的に変更履歴に記録する
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			if count, err := masterUsecase.RecordSongReleases(baseCtx); err != nil {
+				log.Printf("Failed to record song releases: \n%+v\n", err)
+			} else if count > 0 {
+				log.Printf("recorded song releases: %d songs\n", count)
+			}
+			select {
+			case <-baseCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
 
 	log.Println("Starting server on :" + strconv.Itoa(cfg.ServerPort))
 
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/masterbundle"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/usecase"
+)
+
+// マスタをJSONのバンドル、またはシード用のSQLファイルに書き出す
+func exportMaster(ctx context.Context, masterUsecase usecase.MasterUsecase, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	out := fs.String("out", "", "JSONの出力先 (省略時は標準出力)")
+	seedDir := fs.String("seed-dir", "", "v999.999.999_n_*-data.up.sqlを書き出すディレクトリ")
+	if err := fs.Parse(args); err != nil {
+		return errors.WithStack(err)
+	}
+
+	bundle, err := masterUsecase.ExportMaster(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if *seedDir != "" {
+		files, err := masterbundle.SeedSQL(bundle)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for _, f := range files {
+			path := filepath.Join(*seedDir, f.Name)
+			if err := os.WriteFile(path, f.Data, 0o644); err != nil {
+				return errors.WithStack(err)
+			}
+			log.Printf("wrote %s\n", path)
+		}
+		return nil
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := masterbundle.EncodeJSON(w, bundle); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
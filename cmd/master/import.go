@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/masterbundle"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/usecase"
+)
+
+// バンドルを読み込んで差分を表示する
+// -applyを付けた場合のみDBに反映する
+func importMaster(ctx context.Context, masterUsecase usecase.MasterUsecase, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	file := fs.String("file", "", "JSONファイル、CSVのzip、またはCSVを置いたディレクトリ")
+	apply := fs.Bool("apply", false, "差分をDBに反映する")
+	if err := fs.Parse(args); err != nil {
+		return errors.WithStack(err)
+	}
+	if *file == "" {
+		fs.Usage()
+		return errors.New("-file is required")
+	}
+
+	bundle, err := readBundle(*file)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	changes, err := masterUsecase.ImportMaster(ctx, bundle, !*apply)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	printChanges(changes)
+	switch {
+	case len(changes) == 0:
+		log.Println("no changes")
+	case *apply:
+		log.Printf("applied %d changes\n", len(changes))
+	default:
+		log.Printf("dry run: %d changes (run with -apply to import)\n", len(changes))
+	}
+
+	return nil
+}
+
+func readBundle(path string) (*masterbundle.Bundle, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if info.IsDir() {
+		bundle, err := masterbundle.DecodeCSVDir(path)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return bundle, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		defer f.Close()
+		bundle, err := masterbundle.DecodeJSON(f)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return bundle, nil
+	case ".zip":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		bundle, err := masterbundle.DecodeCSVZip(data)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return bundle, nil
+	default:
+		return nil, errors.Newf("unsupported bundle file: %s", path)
+	}
+}
+
+func printChanges(changes []*entity.MasterChange) {
+	for _, c := range changes {
+		switch c.Operation {
+		case entity.MasterChangeOperationInsert:
+			fmt.Printf("+ %s %s\n", c.Kind, c.Key)
+		case entity.MasterChangeOperationUpdate:
+			fmt.Printf("~ %s %s (%s)\n", c.Kind, c.Key, strings.Join(c.Fields, ", "))
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/config"
+	domainrepository "github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/infrastructure/db"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/infrastructure/redis"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/interface/repository"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/circuitbreaker"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/usecase"
+)
+
+// マスタデータ管理用のコマンド
+// go run ./cmd/master <command> [flags]
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() < 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		log.Printf("error Failed to load config: \n%+v\n", err)
+		os.Exit(1)
+	}
+
+	dbConfig := db.DBConfig{
+		Host:     cfg.DBHost,
+		User:     cfg.DBUserName,
+		Password: cfg.DBUserPassword,
+		DBName:   cfg.DBName,
+		Port:     cfg.DBPort,
+	}
+	dbConn, queries, err := db.Init(dbConfig)
+	if err != nil {
+		log.Printf("Failed to initialize database: \n%+v\n", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := dbConn.Close(); err != nil {
+			log.Printf("failed to close db connection: %v", err)
+		}
+	}()
+
+	redisConfig := redis.RedisConfing{
+		Host: cfg.RedisHost,
+		Port: cfg.RedisPort,
+	}
+	rc := redis.Init(redisConfig)
+	defer func() {
+		if err := rc.Close(); err != nil {
+			log.Printf("failed to close redis connection: %v", err)
+		}
+	}()
+
+	// 書き換えたことをAPIサーバーのメモリ上のキャッシュに知らせるため、メモリ上のキャッシュを通す
+	var redisMasterCacheRepository domainrepository.RedisMasterCacheRepository = repository.NewRedisMasterCacheRepository(rc, cfg.MasterCacheTTL, cfg.MasterCacheTTLJitter)
+	if cfg.MasterLocalCacheSize > 0 {
+		redisMasterCacheRepository = repository.NewLocalMasterCacheRepository(redisMasterCacheRepository, rc, circuitbreaker.New(cfg.MasterCacheBreakerThreshold, cfg.MasterCacheBreakerCooldown), cfg.MasterLocalCacheSize, cfg.MasterLocalCacheMaxAge)
+	}
+	redisMasterEventRepository := repository.NewRedisMasterEventRepository(rc)
+	masterRepository := repository.NewMasterRepository(queries)
+	txManager := repository.NewTxManager(dbConn, queries)
+	masterUsecase := usecase.NewMasterUsecase(masterRepository, redisMasterCacheRepository, redisMasterEventRepository, nil, txManager)
+
+	ctx := context.Background()
+	args := flag.Args()[1:]
+	switch flag.Arg(0) {
+	case "backfill-search-text":
+		err = backfillSearchText(ctx, masterUsecase, args)
+	case "import":
+		err = importMaster(ctx, masterUsecase, args)
+	case "export":
+		err = exportMaster(ctx, masterUsecase, args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Printf("%+v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: master <command> [flags]
+
+commands:
+  backfill-search-text  既存の曲・アーティストの検索用カラムを作り直す
+  import                JSON・CSVのマスタデータを差分を確認して取り込む
+  export                マスタデータをJSON、またはシード用のSQLファイルに書き出す`)
+}
+
+func backfillSearchText(ctx context.Context, masterUsecase usecase.MasterUsecase, args []string) error {
+	fs := flag.NewFlagSet("backfill-search-text", flag.ExitOnError)
+	if err := fs.Parse(args); err != nil {
+		return errors.WithStack(err)
+	}
+
+	artistCount, songCount, err := masterUsecase.BackfillSearchText(ctx, false)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	log.Printf("backfilled search_text: %d artists, %d songs\n", artistCount, songCount)
+
+	return nil
+}
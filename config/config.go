@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/cockroachdb/errors"
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -15,6 +17,20 @@ type Config struct {
 	RedisHost      string `env:"REDIS_HOST"`
 	RedisPort      int    `env:"REDIS_PORT"`
 	FrontEndURL    string `env:"FRONT_END_URL"`
+	// マスタのキャッシュをメモリにも持つ件数 0ならRedisだけ
+	MasterLocalCacheSize int `env:"MASTER_LOCAL_CACHE_SIZE" env-default:"1024"`
+	// メモリ上のキャッシュを取り直すまでの時間 破棄の通知が届かなかった場合に古いままの時間の上限になる
+	MasterLocalCacheMaxAge time.Duration `env:"MASTER_LOCAL_CACHE_MAX_AGE" env-default:"1m"`
+	// Redisにつながらない取得・書き込みがこの回数続いたら、しばらくRedisを使わずDBから取る
+	MasterCacheBreakerThreshold int `env:"MASTER_CACHE_BREAKER_THRESHOLD" env-default:"5"`
+	// Redisを使わない時間 過ぎたら1回試してつながれば戻す
+	MasterCacheBreakerCooldown time.Duration `env:"MASTER_CACHE_BREAKER_COOLDOWN" env-default:"30s"`
+	// Redisのマスタのキャッシュの期限 0なら期限なし
+	MasterCacheTTL time.Duration `env:"MASTER_CACHE_TTL" env-default:"24h"`
+	// 一斉に切れないように期限に足す時間の上限
+	MasterCacheTTLJitter time.Duration `env:"MASTER_CACHE_TTL_JITTER" env-default:"1h"`
+	// キャッシュに無い時にDBから取る処理を、Redisのロックで全てのAPIサーバーの中で1つにする
+	MasterCacheFillLock bool `env:"MASTER_CACHE_FILL_LOCK" env-default:"false"`
 }
 
 func NewConfig() (*Config, error) {
@@ -5,11 +5,40 @@ SELECT * FROM artists WHERE id = $1;
 SELECT * FROM artists ORDER BY id;
 
 -- name: InsertArtist :one
-INSERT INTO artists (name, kana)
-VALUES ($1, $2)
+INSERT INTO artists (name, kana, search_text)
+VALUES ($1, $2, $3)
 RETURNING *;
 
 -- name: ExistsArtist :one
 SELECT EXISTS (
   SELECT 1 FROM artists WHERE id = $1
 ) AS exists;
+
+-- name: UpdateArtist :exec
+UPDATE artists
+SET name = $1,
+    kana = $2,
+    search_text = $3
+WHERE id = $4;
+
+-- name: DeleteArtist :exec
+DELETE
+FROM artists
+WHERE id = $1;
+
+-- name: ListArtistNamesWithoutSearchText :many
+SELECT id, name, kana FROM artists WHERE search_text = '' ORDER BY id;
+
+-- name: UpdateArtistSearchText :exec
+UPDATE artists
+SET search_text = $1
+WHERE id = $2;
+
+-- name: FindArtistByNameKey :one
+-- artists_name_keyと同じ式で比較する
+SELECT *
+FROM artists
+WHERE lower(btrim(regexp_replace(normalize(name, NFKC), '\s+', ' ', 'g')))
+    = lower(btrim(regexp_replace(normalize(sqlc.arg(name)::text, NFKC), '\s+', ' ', 'g')))
+ORDER BY id
+LIMIT 1;
@@ -1,117 +1,113 @@
--- name: GetChartWithSongWithArtistsByID :many
-SELECT
-    c.id AS id,
-
-    s.id AS song_id,
-    s.name AS song_name,
-    s.kana AS song_kana,
-
-    la.id AS lyrics_artist_id,
-    la.name AS lyrics_artist_name,
-    la.kana AS lyrics_artist_kana,
-
-    ma.id AS music_artist_id,
-    ma.name AS music_artist_name,
-    ma.kana AS music_artist_kana,
-
-    aa.id AS arrangement_artist_id,
-    aa.name AS arrangement_artist_name,
-    aa.kana AS arrangement_artist_kana,
-
-    s.thumbnail,
-    s.original_video,
-    s.release_time,
-    s.deleted,
-
-    vp.id AS vocal_pattern_id,
-    vp.name AS vocal_pattern_name,
-
-    vps.singer_id,
-    si.name AS singer_name,
-    vps.position AS singer_order,
-
-    su.unit_id,
-    u.name AS unit_name,
-
-    smvt.music_video_type AS music_video_type_id,
-
-    c.difficulty_type,
-    c.level,
-    c.chart_view_link
-
-FROM charts c
-LEFT JOIN songs s ON c.song_id = s.id
-LEFT JOIN artists la ON s.lyrics_id = la.id
-LEFT JOIN artists ma ON s.music_id = ma.id
-LEFT JOIN artists aa ON s.arrangement_id = aa.id
-LEFT JOIN vocal_patterns vp ON vp.song_id = s.id
-LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
-LEFT JOIN singers si ON vps.singer_id = si.id
-LEFT JOIN song_units su ON su.song_id = s.id
-LEFT JOIN units u ON su.unit_id = u.id
-LEFT JOIN song_music_video_types smvt ON smvt.song_id = s.id
-WHERE c.id = $1;
-
--- name: ListChartWithSongWithArtists :many
-SELECT
-    c.id AS id,
-
-    s.id AS song_id,
-    s.name AS song_name,
-    s.kana AS song_kana,
-
-    la.id AS lyrics_artist_id,
-    la.name AS lyrics_artist_name,
-    la.kana AS lyrics_artist_kana,
-
-    ma.id AS music_artist_id,
-    ma.name AS music_artist_name,
-    ma.kana AS music_artist_kana,
-
-    aa.id AS arrangement_artist_id,
-    aa.name AS arrangement_artist_name,
-    aa.kana AS arrangement_artist_kana,
-
-    s.thumbnail,
-    s.original_video,
-    s.release_time,
-    s.deleted,
-
-    vp.id AS vocal_pattern_id,
-    vp.name AS vocal_pattern_name,
-
-    vps.singer_id,
-    si.name AS singer_name,
-    vps.position AS singer_order,
-
-    su.unit_id,
-    u.name AS unit_name,
-
-    smvt.music_video_type AS music_video_type_id,
-
-    c.difficulty_type,
-    c.level,
-    c.chart_view_link
-
-FROM charts c
-LEFT JOIN songs s ON c.song_id = s.id
-LEFT JOIN artists la ON s.lyrics_id = la.id
-LEFT JOIN artists ma ON s.music_id = ma.id
-LEFT JOIN artists aa ON s.arrangement_id = aa.id
-LEFT JOIN vocal_patterns vp ON vp.song_id = s.id
-LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
-LEFT JOIN singers si ON vps.singer_id = si.id
-LEFT JOIN song_units su ON su.song_id = s.id
-LEFT JOIN units u ON su.unit_id = u.id
-LEFT JOIN song_music_video_types smvt ON smvt.song_id = s.id
-ORDER BY c.id;
+-- name: ListChartIDs :many
+SELECT id FROM charts ORDER BY id;
 
 -- name: InsertChart :one
-INSERT INTO charts (song_id, difficulty_type, level, chart_view_link)
-VALUES ($1, $2, $3, $4)
+INSERT INTO charts (song_id, difficulty_type, level, chart_view_link, note_count, bpm_min, bpm_max, duration_seconds, constant)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING *;
 
 -- name: ExistsChart :one
 SELECT EXISTS (
   SELECT 1 FROM charts WHERE id = $1
 ) AS exists;
+
+-- name: ListChartIDsBySongID :many
+SELECT id FROM charts WHERE song_id = $1 ORDER BY id;
+
+-- name: UpdateChart :exec
+UPDATE charts
+SET song_id = $1,
+    difficulty_type = $2,
+    level = $3,
+    chart_view_link = $4,
+    note_count = $5,
+    bpm_min = $6,
+    bpm_max = $7,
+    duration_seconds = $8,
+    constant = $9
+WHERE id = $10;
+
+-- name: DeleteChart :exec
+DELETE
+FROM charts
+WHERE id = $1;
+
+-- name: DeleteChartsBySongID :exec
+DELETE
+FROM charts
+WHERE song_id = $1;
+
+-- name: SearchChartIDs :many
+SELECT c.id
+FROM charts c
+JOIN songs s ON c.song_id = s.id
+WHERE c.id > sqlc.arg(after_id)::int
+  AND (
+    cardinality(sqlc.arg(difficulty_types)::int[]) = 0
+    OR c.difficulty_type = ANY(sqlc.arg(difficulty_types)::int[])
+  )
+  AND (sqlc.narg(min_level)::int IS NULL OR c.level >= sqlc.narg(min_level)::int)
+  AND (sqlc.narg(max_level)::int IS NULL OR c.level <= sqlc.narg(max_level)::int)
+  AND (
+    cardinality(sqlc.arg(unit_ids)::int[]) = 0
+    OR EXISTS (
+      SELECT 1 FROM song_units su
+      WHERE su.song_id = s.id AND su.unit_id = ANY(sqlc.arg(unit_ids)::int[])
+    )
+  )
+  AND (
+    cardinality(sqlc.arg(singer_ids)::int[]) = 0
+    OR EXISTS (
+      SELECT 1 FROM vocal_patterns vp
+      JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+      WHERE vp.song_id = s.id AND vps.singer_id = ANY(sqlc.arg(singer_ids)::int[])
+    )
+  )
+  AND (sqlc.narg(release_from)::timestamp IS NULL OR s.release_time >= sqlc.narg(release_from)::timestamp)
+  AND (sqlc.narg(release_to)::timestamp IS NULL OR s.release_time < sqlc.narg(release_to)::timestamp)
+  AND (sqlc.narg(query)::text IS NULL OR s.search_text LIKE '%' || sqlc.narg(query)::text || '%')
+  AND (sqlc.arg(include_deleted)::boolean OR NOT COALESCE(s.deleted, FALSE))
+ORDER BY c.id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: CountSearchCharts :one
+SELECT COUNT(*)
+FROM charts c
+JOIN songs s ON c.song_id = s.id
+WHERE TRUE
+  AND (
+    cardinality(sqlc.arg(difficulty_types)::int[]) = 0
+    OR c.difficulty_type = ANY(sqlc.arg(difficulty_types)::int[])
+  )
+  AND (sqlc.narg(min_level)::int IS NULL OR c.level >= sqlc.narg(min_level)::int)
+  AND (sqlc.narg(max_level)::int IS NULL OR c.level <= sqlc.narg(max_level)::int)
+  AND (
+    cardinality(sqlc.arg(unit_ids)::int[]) = 0
+    OR EXISTS (
+      SELECT 1 FROM song_units su
+      WHERE su.song_id = s.id AND su.unit_id = ANY(sqlc.arg(unit_ids)::int[])
+    )
+  )
+  AND (
+    cardinality(sqlc.arg(singer_ids)::int[]) = 0
+    OR EXISTS (
+      SELECT 1 FROM vocal_patterns vp
+      JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+      WHERE vp.song_id = s.id AND vps.singer_id = ANY(sqlc.arg(singer_ids)::int[])
+    )
+  )
+  AND (sqlc.narg(release_from)::timestamp IS NULL OR s.release_time >= sqlc.narg(release_from)::timestamp)
+  AND (sqlc.narg(release_to)::timestamp IS NULL OR s.release_time < sqlc.narg(release_to)::timestamp)
+  AND (sqlc.narg(query)::text IS NULL OR s.search_text LIKE '%' || sqlc.narg(query)::text || '%')
+  AND (sqlc.arg(include_deleted)::boolean OR NOT COALESCE(s.deleted, FALSE));
+
+-- name: ListChartsByIDs :many
+SELECT *
+FROM charts
+WHERE id = ANY(sqlc.arg(ids)::int[])
+ORDER BY id;
+
+-- name: FindChartIDBySongAndDifficulty :one
+SELECT id
+FROM charts
+WHERE song_id = $1 AND difficulty_type = $2;
@@ -0,0 +1,28 @@
+-- name: UpsertChartLevelHistory :exec
+INSERT INTO chart_level_history (chart_id, level, effective_from)
+VALUES ($1, $2, $3)
+ON CONFLICT (chart_id, effective_from)
+DO UPDATE SET level = EXCLUDED.level, created_at = CURRENT_TIMESTAMP;
+
+-- name: ListChartLevelHistory :many
+SELECT *
+FROM chart_level_history
+WHERE chart_id = $1
+ORDER BY effective_from;
+
+-- name: GetChartLevelAsOf :one
+SELECT level
+FROM chart_level_history
+WHERE chart_id = $1 AND effective_from <= $2
+ORDER BY effective_from DESC
+LIMIT 1;
+
+-- name: DeleteChartLevelHistoryByChartID :exec
+DELETE
+FROM chart_level_history
+WHERE chart_id = $1;
+
+-- name: DeleteChartLevelHistoryBySongID :exec
+DELETE
+FROM chart_level_history
+WHERE chart_id IN (SELECT id FROM charts WHERE song_id = $1);
@@ -0,0 +1,21 @@
+-- name: IncrementMasterRevision :one
+UPDATE master_revision SET revision = revision + 1 WHERE id = 1 RETURNING revision;
+
+-- name: GetMasterRevision :one
+SELECT revision FROM master_revision WHERE id = 1;
+
+-- name: InsertMasterChangeLog :exec
+INSERT INTO master_change_logs (revision, kind, entity_id, operation)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListLatestMasterChangeLogsSince :many
+SELECT DISTINCT ON (kind, entity_id) revision, kind, entity_id, operation
+FROM master_change_logs
+WHERE revision > $1
+ORDER BY kind, entity_id, revision DESC, id DESC;
+
+-- name: GetReleasesRecordedAtForUpdate :one
+SELECT releases_recorded_at FROM master_revision WHERE id = 1 FOR UPDATE;
+
+-- name: UpdateReleasesRecordedAt :exec
+UPDATE master_revision SET releases_recorded_at = $1 WHERE id = 1;
@@ -40,3 +40,16 @@ WHERE id = $1;
 DELETE
 FROM my_list_charts
 WHERE my_list_id = $1;
+
+-- name: ExistsMyListChartByChartID :one
+SELECT EXISTS (
+    SELECT 1 FROM my_list_charts WHERE chart_id = $1
+) AS exists;
+
+-- name: ExistsMyListChartBySongID :one
+SELECT EXISTS (
+    SELECT 1
+    FROM my_list_charts mlc
+    JOIN charts c ON mlc.chart_id = c.id
+    WHERE c.song_id = $1
+) AS exists;
@@ -13,3 +13,17 @@ RETURNING *;
 SELECT EXISTS (
   SELECT 1 FROM singers WHERE id = $1
 ) AS exists;
+
+-- name: UpdateSinger :exec
+UPDATE singers
+SET name = $1
+WHERE id = $2;
+
+-- name: FindSingerByNameKey :one
+-- singers_name_keyと同じ式で比較する
+SELECT *
+FROM singers
+WHERE lower(btrim(regexp_replace(normalize(name, NFKC), '\s+', ' ', 'g')))
+    = lower(btrim(regexp_replace(normalize(sqlc.arg(name)::text, NFKC), '\s+', ' ', 'g')))
+ORDER BY id
+LIMIT 1;
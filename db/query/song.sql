@@ -1,103 +1,118 @@
--- name: GetSongDetailsByID :many
-SELECT
-    s.id AS id,
-    s.name,
-    s.kana,
-
-    l.id AS lyrics_artist_id,
-    l.name AS lyrics_artist_name,
-    l.kana AS lyrics_artist_kana,
-
-    m.id AS music_artist_id,
-    m.name AS music_artist_name,
-    m.kana AS music_artist_kana,
+-- name: ListSongIDs :many
+SELECT id FROM songs ORDER BY id;
 
-    a.id AS arrangement_artist_id,
-    a.name AS arrangement_artist_name,
-    a.kana AS arrangement_artist_kana,
-
-    s.thumbnail,
-    s.original_video,
-    s.release_time,
-    s.deleted,
-
-    vp.id AS vocal_pattern_id,
-    vp.name AS vocal_pattern_name,
-
-    vps.singer_id,
-    si.name AS singer_name,
-    vps.position AS singer_order,
+-- name: InsertSong :one
+INSERT INTO songs (name, kana, thumbnail, original_video, release_time, deleted, search_text)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
 
-    su.unit_id,
-    u.name AS unit_name,
+-- name: ExistsSong :one
+SELECT EXISTS (
+  SELECT 1 FROM songs WHERE id = $1
+) AS exists;
 
-    smvt.music_video_type AS music_video_type_id
+-- name: ExistsSongByArtistID :one
+SELECT EXISTS (
+  SELECT 1 FROM song_credits WHERE artist_id = $1
+) AS exists;
 
+-- name: UpdateSong :exec
+UPDATE songs
+SET name = $1,
+    kana = $2,
+    thumbnail = $3,
+    original_video = $4,
+    release_time = $5,
+    deleted = $6,
+    search_text = $7
+WHERE id = $8;
+
+-- name: DeleteSong :exec
+DELETE
+FROM songs
+WHERE id = $1;
+
+-- name: SearchSongIDs :many
+SELECT s.id
 FROM songs s
-LEFT JOIN artists l ON s.lyrics_id = l.id
-LEFT JOIN artists m ON s.music_id = m.id
-LEFT JOIN artists a ON s.arrangement_id = a.id
-LEFT JOIN vocal_patterns vp ON vp.song_id = s.id
-LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
-LEFT JOIN singers si ON vps.singer_id = si.id
-LEFT JOIN song_units su ON su.song_id = s.id
-LEFT JOIN units u ON su.unit_id = u.id
-LEFT JOIN song_music_video_types smvt ON smvt.song_id = s.id
-WHERE s.id = $1;
-
--- name: ListSongWithArtists :many
+WHERE s.id > sqlc.arg(after_id)::int
+  AND (sqlc.narg(query)::text IS NULL OR s.search_text LIKE '%' || sqlc.narg(query)::text || '%')
+  AND (
+    sqlc.narg(lyrics_id)::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM song_credits sc
+      WHERE sc.song_id = s.id AND sc.role = 1 AND sc.artist_id = sqlc.narg(lyrics_id)::int
+    )
+  )
+  AND (
+    sqlc.narg(music_id)::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM song_credits sc
+      WHERE sc.song_id = s.id AND sc.role = 2 AND sc.artist_id = sqlc.narg(music_id)::int
+    )
+  )
+  AND (
+    sqlc.narg(arrangement_id)::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM song_credits sc
+      WHERE sc.song_id = s.id AND sc.role = 3 AND sc.artist_id = sqlc.narg(arrangement_id)::int
+    )
+  )
+  AND (
+    sqlc.narg(unit_id)::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM song_units su
+      WHERE su.song_id = s.id AND su.unit_id = sqlc.narg(unit_id)::int
+    )
+  )
+  AND (
+    sqlc.narg(singer_id)::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM vocal_patterns vp
+      JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+      WHERE vp.song_id = s.id AND vps.singer_id = sqlc.narg(singer_id)::int
+    )
+  )
+  AND (
+    sqlc.narg(music_video_type)::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM song_music_video_types smvt
+      WHERE smvt.song_id = s.id AND smvt.music_video_type = sqlc.narg(music_video_type)::int
+    )
+  )
+  AND (sqlc.narg(release_from)::timestamp IS NULL OR s.release_time >= sqlc.narg(release_from)::timestamp)
+  AND (sqlc.narg(release_to)::timestamp IS NULL OR s.release_time < sqlc.narg(release_to)::timestamp)
+  AND (sqlc.arg(include_deleted)::boolean OR NOT COALESCE(s.deleted, FALSE))
+ORDER BY s.id
+LIMIT sqlc.arg(page_limit)::int;
+
+-- name: ListSongsByIDs :many
 SELECT
-    s.id AS id,
+    s.id,
     s.name,
     s.kana,
-
-    l.id AS lyrics_artist_id,
-    l.name AS lyrics_artist_name,
-    l.kana AS lyrics_artist_kana,
-
-    m.id AS music_artist_id,
-    m.name AS music_artist_name,
-    m.kana AS music_artist_kana,
-
-    a.id AS arrangement_artist_id,
-    a.name AS arrangement_artist_name,
-    a.kana AS arrangement_artist_kana,
-
     s.thumbnail,
     s.original_video,
     s.release_time,
     s.deleted,
-
-    vp.id AS vocal_pattern_id,
-    vp.name AS vocal_pattern_name,
-
-    vps.singer_id,
-    si.name AS singer_name,
-    vps.position AS singer_order,
-
-    su.unit_id,
-    u.name AS unit_name,
-
-    smvt.music_video_type AS music_video_type_id
-
+    s.created_at
 FROM songs s
-LEFT JOIN artists l ON s.lyrics_id = l.id
-LEFT JOIN artists m ON s.music_id = m.id
-LEFT JOIN artists a ON s.arrangement_id = a.id
-LEFT JOIN vocal_patterns vp ON vp.song_id = s.id
-LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
-LEFT JOIN singers si ON vps.singer_id = si.id
-LEFT JOIN song_units su ON su.song_id = s.id
-LEFT JOIN units u ON su.unit_id = u.id
-LEFT JOIN song_music_video_types smvt ON smvt.song_id = s.id
+WHERE s.id = ANY(sqlc.arg(ids)::int[])
 ORDER BY s.id;
 
--- name: InsertSong :one
-INSERT INTO songs (name, kana, lyrics_id, music_id, arrangement_id, thumbnail, original_video, release_time, deleted)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-RETURNING *;
+-- name: ListSongNames :many
+SELECT id, name, kana FROM songs ORDER BY id;
 
--- name: ExistsSong :one
-SELECT EXISTS (
-  SELECT 1 FROM songs WHERE id = $1
-) AS exists;
+-- name: ListSongNamesWithoutSearchText :many
+SELECT id, name, kana FROM songs WHERE search_text = '' ORDER BY id;
+
+-- name: UpdateSongSearchText :exec
+UPDATE songs
+SET search_text = $1
+WHERE id = $2;
+
+-- name: ListSongIDsReleasedBetween :many
+SELECT id FROM songs
+WHERE release_time > sqlc.arg(released_after)::timestamp
+  AND release_time <= sqlc.arg(released_until)::timestamp
+ORDER BY id;
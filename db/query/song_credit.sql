@@ -0,0 +1,36 @@
+-- name: InsertSongCredit :one
+INSERT INTO song_credits (song_id, artist_id, role, position)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: DeleteSongCreditsBySongID :exec
+DELETE
+FROM song_credits
+WHERE song_id = $1;
+
+-- name: ListSongCreditsBySongIDs :many
+SELECT
+    sc.song_id,
+    sc.role,
+    sc.position,
+    a.id,
+    a.name,
+    a.kana
+FROM song_credits sc
+JOIN artists a ON sc.artist_id = a.id
+WHERE sc.song_id = ANY(sqlc.arg(song_ids)::int[])
+ORDER BY sc.song_id, sc.role, sc.position, sc.id;
+
+-- name: DeleteDuplicateSongCreditsForMerge :exec
+-- 統合先が同じ曲・同じ役割で既にクレジットされている行は付け替えずに消す
+DELETE FROM song_credits sc
+WHERE sc.artist_id = sqlc.arg(source_id)
+  AND EXISTS (
+    SELECT 1 FROM song_credits t
+    WHERE t.song_id = sc.song_id AND t.role = sc.role AND t.artist_id = sqlc.arg(target_id)
+  );
+
+-- name: ReassignSongCredits :exec
+UPDATE song_credits
+SET artist_id = sqlc.arg(target_id)
+WHERE artist_id = sqlc.arg(source_id);
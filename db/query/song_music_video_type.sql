@@ -2,3 +2,14 @@
 INSERT INTO song_music_video_types (song_id, music_video_type)
 VALUES ($1, $2)
 RETURNING *;
+
+-- name: DeleteSongMusicVideoTypesBySongID :exec
+DELETE
+FROM song_music_video_types
+WHERE song_id = $1;
+
+-- name: ListSongMusicVideoTypesBySongIDs :many
+SELECT *
+FROM song_music_video_types
+WHERE song_id = ANY(sqlc.arg(song_ids)::int[])
+ORDER BY id;
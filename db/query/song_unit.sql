@@ -2,3 +2,18 @@
 INSERT INTO song_units (song_id, unit_id)
 VALUES ($1, $2)
 RETURNING *;
+
+-- name: DeleteSongUnitsBySongID :exec
+DELETE
+FROM song_units
+WHERE song_id = $1;
+
+-- name: ListUnitsBySongIDs :many
+SELECT
+    su.song_id,
+    u.id,
+    u.name
+FROM song_units su
+JOIN units u ON su.unit_id = u.id
+WHERE su.song_id = ANY(sqlc.arg(song_ids)::int[])
+ORDER BY su.id;
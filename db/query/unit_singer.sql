@@ -0,0 +1,38 @@
+-- name: InsertUnitSinger :one
+INSERT INTO unit_singers (unit_id, singer_id, position)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: ExistsUnitSinger :one
+SELECT EXISTS (
+  SELECT 1 FROM unit_singers WHERE unit_id = $1 AND singer_id = $2
+) AS exists;
+
+-- name: UpdateUnitSinger :exec
+UPDATE unit_singers
+SET position = $1
+WHERE unit_id = $2 AND singer_id = $3;
+
+-- name: DeleteUnitSinger :exec
+DELETE
+FROM unit_singers
+WHERE unit_id = $1 AND singer_id = $2;
+
+-- name: DeleteUnitSingersByUnitID :exec
+DELETE
+FROM unit_singers
+WHERE unit_id = $1;
+
+-- name: ListUnitSingers :many
+SELECT
+    us.unit_id,
+    u.name AS unit_name,
+    us.singer_id,
+    si.name AS singer_name,
+    us.position
+FROM unit_singers us
+JOIN units u ON us.unit_id = u.id
+JOIN singers si ON us.singer_id = si.id
+WHERE (sqlc.narg(unit_id)::int IS NULL OR us.unit_id = sqlc.narg(unit_id)::int)
+  AND (sqlc.narg(singer_id)::int IS NULL OR us.singer_id = sqlc.narg(singer_id)::int)
+ORDER BY us.unit_id, us.position, us.id;
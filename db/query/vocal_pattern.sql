@@ -2,3 +2,65 @@
 INSERT INTO vocal_patterns (song_id, name)
 VALUES ($1, $2)
 RETURNING *;
+
+-- name: DeleteVocalPatternsBySongID :exec
+DELETE
+FROM vocal_patterns
+WHERE song_id = $1;
+
+-- name: ListVocalPatternsWithSingers :many
+SELECT
+    vp.id,
+    vp.song_id,
+    vp.name,
+    vps.singer_id,
+    si.name AS singer_name,
+    vps.position AS singer_position
+FROM vocal_patterns vp
+LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+LEFT JOIN singers si ON vps.singer_id = si.id
+WHERE sqlc.narg(song_id)::int IS NULL OR vp.song_id = sqlc.narg(song_id)::int
+ORDER BY vp.id, vps.position;
+
+-- name: GetVocalPatternWithSingersByID :many
+SELECT
+    vp.id,
+    vp.song_id,
+    vp.name,
+    vps.singer_id,
+    si.name AS singer_name,
+    vps.position AS singer_position
+FROM vocal_patterns vp
+LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+LEFT JOIN singers si ON vps.singer_id = si.id
+WHERE vp.id = $1
+ORDER BY vps.position;
+
+-- name: ExistsVocalPattern :one
+SELECT EXISTS (
+  SELECT 1 FROM vocal_patterns WHERE id = $1
+) AS exists;
+
+-- name: UpdateVocalPattern :exec
+UPDATE vocal_patterns
+SET name = $1
+WHERE id = $2;
+
+-- name: DeleteVocalPattern :exec
+DELETE
+FROM vocal_patterns
+WHERE id = $1;
+
+-- name: ListVocalPatternsWithSingersBySongIDs :many
+SELECT
+    vp.id,
+    vp.song_id,
+    vp.name,
+    vps.singer_id,
+    si.name AS singer_name,
+    vps.position AS singer_position
+FROM vocal_patterns vp
+LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+LEFT JOIN singers si ON vps.singer_id = si.id
+WHERE vp.song_id = ANY(sqlc.arg(song_ids)::int[])
+ORDER BY vp.id, vps.position;
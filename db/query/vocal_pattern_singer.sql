@@ -2,3 +2,15 @@
 INSERT INTO vocal_pattern_singers (vocal_pattern_id, singer_id, position)
 VALUES ($1, $2, $3)
 RETURNING *;
+
+-- name: DeleteVocalPatternSingersBySongID :exec
+DELETE
+FROM vocal_pattern_singers
+WHERE vocal_pattern_id IN (
+    SELECT id FROM vocal_patterns WHERE song_id = $1
+);
+
+-- name: DeleteVocalPatternSingersByVocalPatternID :exec
+DELETE
+FROM vocal_pattern_singers
+WHERE vocal_pattern_id = $1;
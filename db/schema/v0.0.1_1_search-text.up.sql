@@ -0,0 +1,4 @@
+-- 検索用に正規化した文字列 (internal/pkg/normalize)
+-- 既存の行はAPIサーバーの起動時に空のものを埋める (go run ./cmd/master backfill-search-text で全て作り直せる)
+ALTER TABLE artists ADD COLUMN search_text TEXT NOT NULL DEFAULT '';
+ALTER TABLE songs ADD COLUMN search_text TEXT NOT NULL DEFAULT '';
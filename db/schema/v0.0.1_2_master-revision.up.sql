@@ -0,0 +1,20 @@
+-- マスタの版数 マスタを書き換えるたびに1つ増やす
+-- 1行だけのテーブルを行ロック付きで更新するので、書き込みは版数の順にコミットされる
+CREATE TABLE master_revision (
+    id INT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    revision BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO master_revision (id, revision) VALUES (1, 0);
+
+-- 版数ごとに変更されたマスタ
+CREATE TABLE master_change_logs (
+    id BIGSERIAL PRIMARY KEY,
+    revision BIGINT NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    entity_id INT NOT NULL,
+    operation VARCHAR(16) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX master_change_logs_revision_idx ON master_change_logs (revision);
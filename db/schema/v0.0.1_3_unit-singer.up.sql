@@ -0,0 +1,10 @@
+-- ユニットに所属する歌手
+CREATE TABLE unit_singers (
+    id SERIAL PRIMARY KEY,
+    unit_id INT NOT NULL REFERENCES units(id),
+    singer_id INT NOT NULL REFERENCES singers(id),
+    position INT NOT NULL,
+    UNIQUE (unit_id, singer_id)
+);
+
+CREATE INDEX unit_singers_singer_id_idx ON unit_singers (singer_id);
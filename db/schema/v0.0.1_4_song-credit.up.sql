@@ -0,0 +1,25 @@
+-- 曲のクレジット 同じ役割の中ではpositionの順に並べる
+-- roleはenums.CreditRole (1: 作詞, 2: 作曲, 3: 編曲)
+CREATE TABLE song_credits (
+    id SERIAL PRIMARY KEY,
+    song_id INT NOT NULL REFERENCES songs(id),
+    artist_id INT NOT NULL REFERENCES artists(id),
+    role INT NOT NULL,
+    position INT NOT NULL,
+    UNIQUE (song_id, role, artist_id)
+);
+
+CREATE INDEX song_credits_artist_id_idx ON song_credits (artist_id);
+
+-- 既存の作詞・作曲・編曲を移す
+INSERT INTO song_credits (song_id, artist_id, role, position)
+SELECT id, lyrics_id, 1, 1 FROM songs WHERE lyrics_id IS NOT NULL
+UNION ALL
+SELECT id, music_id, 2, 1 FROM songs WHERE music_id IS NOT NULL
+UNION ALL
+SELECT id, arrangement_id, 3, 1 FROM songs WHERE arrangement_id IS NOT NULL;
+
+ALTER TABLE songs
+    DROP COLUMN lyrics_id,
+    DROP COLUMN music_id,
+    DROP COLUMN arrangement_id;
@@ -0,0 +1,8 @@
+-- 譜面の追加情報 どれも任意で、未設定はNULL
+-- constantは非公式の難易度定数
+ALTER TABLE charts
+    ADD COLUMN note_count INT,
+    ADD COLUMN bpm_min INT,
+    ADD COLUMN bpm_max INT,
+    ADD COLUMN duration_seconds INT,
+    ADD COLUMN constant DOUBLE PRECISION;
@@ -0,0 +1,18 @@
+-- 譜面のレベルの変更履歴 effective_fromからそのレベルが適用される
+-- 未来の日時は入れないので、charts.levelは常に最新の行と同じになる
+-- release_timeと同じくUTCで持つ DBのタイムゾーンがUTCでなくてもずれないようにUTCに直す
+CREATE TABLE chart_level_history (
+    id SERIAL PRIMARY KEY,
+    chart_id INT NOT NULL REFERENCES charts(id),
+    level INT NOT NULL,
+    effective_from TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    UNIQUE (chart_id, effective_from)
+);
+
+-- 既存の譜面は曲の公開日時から今のレベルだったものとする
+INSERT INTO chart_level_history (chart_id, level, effective_from)
+SELECT c.id, c.level, LEAST(COALESCE(s.release_time, CURRENT_TIMESTAMP AT TIME ZONE 'UTC'), CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+FROM charts c
+LEFT JOIN songs s ON c.song_id = s.id
+WHERE c.level IS NOT NULL;
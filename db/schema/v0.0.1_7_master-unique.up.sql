@@ -0,0 +1,8 @@
+-- マスタの重複を防ぐ一意制約
+-- アーティスト・歌手・ユニットは名前の全角・半角、大文字・小文字、空白の違いを同じとみなす
+-- 既に重複がある場合は作成に失敗するので、先にMergeArtistsなどで解消しておく
+CREATE UNIQUE INDEX artists_name_key ON artists ((lower(btrim(regexp_replace(normalize(name, NFKC), '\s+', ' ', 'g')))));
+CREATE UNIQUE INDEX singers_name_key ON singers ((lower(btrim(regexp_replace(normalize(name, NFKC), '\s+', ' ', 'g')))));
+CREATE UNIQUE INDEX units_name_key ON units ((lower(btrim(regexp_replace(normalize(name, NFKC), '\s+', ' ', 'g')))));
+
+ALTER TABLE charts ADD CONSTRAINT charts_song_id_difficulty_type_key UNIQUE (song_id, difficulty_type);
@@ -0,0 +1,34 @@
+-- マスタを追加した日時 release_timeと同じくUTCで持つ
+-- 既存の曲と譜面は追加日時が分からないので曲の公開日時(未来なら今)、それ以外は今で埋める
+ALTER TABLE artists ADD COLUMN created_at TIMESTAMP;
+ALTER TABLE singers ADD COLUMN created_at TIMESTAMP;
+ALTER TABLE units ADD COLUMN created_at TIMESTAMP;
+ALTER TABLE songs ADD COLUMN created_at TIMESTAMP;
+ALTER TABLE charts ADD COLUMN created_at TIMESTAMP;
+
+UPDATE artists SET created_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC';
+UPDATE singers SET created_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC';
+UPDATE units SET created_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC';
+UPDATE songs
+SET created_at = LEAST(COALESCE(release_time, CURRENT_TIMESTAMP AT TIME ZONE 'UTC'), CURRENT_TIMESTAMP AT TIME ZONE 'UTC');
+UPDATE charts c
+SET created_at = COALESCE(s.created_at, CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+FROM songs s
+WHERE c.song_id = s.id;
+UPDATE charts SET created_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC' WHERE created_at IS NULL;
+
+ALTER TABLE artists
+    ALTER COLUMN created_at SET DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    ALTER COLUMN created_at SET NOT NULL;
+ALTER TABLE singers
+    ALTER COLUMN created_at SET DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    ALTER COLUMN created_at SET NOT NULL;
+ALTER TABLE units
+    ALTER COLUMN created_at SET DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    ALTER COLUMN created_at SET NOT NULL;
+ALTER TABLE songs
+    ALTER COLUMN created_at SET DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    ALTER COLUMN created_at SET NOT NULL;
+ALTER TABLE charts
+    ALTER COLUMN created_at SET DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    ALTER COLUMN created_at SET NOT NULL;
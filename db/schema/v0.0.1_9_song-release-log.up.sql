@@ -0,0 +1,5 @@
+-- 公開日時を過ぎた曲の変更履歴をどこまで記録したか release_timeと同じくUTCで持つ
+-- 公開前の曲は管理者以外の差分に含めないので、公開された時に更新として記録して差分に出るようにする
+-- 既存の公開済みの曲は差分の全件取得に含まれるので、今から数え始める
+ALTER TABLE master_revision
+    ADD COLUMN releases_recorded_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC');
@@ -18,21 +18,40 @@ type Chart struct {
 	DifficultyType enums.DifficultyType
 	Level          int32
 	ChartViewLink  string
+	Metadata       ChartMetadata
+	CreatedAt      time.Time
+}
+
+// 譜面の追加情報 ゼロ値は未設定
+// Constantは非公式の難易度定数
+type ChartMetadata struct {
+	NoteCount       int32
+	BPMMin          int32
+	BPMMax          int32
+	DurationSeconds int32
+	Constant        float64
+}
+
+// 譜面のレベルの変更履歴 EffectiveFromからLevelが適用される
+type ChartLevelHistory struct {
+	ChartID       int32
+	Level         int32
+	EffectiveFrom time.Time
+	CreatedAt     time.Time
 }
 
 type Singer struct {
 	ID       int32
 	Name     string
 	Position int32
+	// 所属しているユニット 曲やボーカルパターンに含まれる場合は空
+	Units []*Unit
 }
 
 type Song struct {
 	ID              int32
 	Name            string
 	Kana            string
-	Lyrics          Artist
-	Music           Artist
-	Arrangement     Artist
 	Thumbnail       string
 	OriginalVideo   string
 	ReleaseTime     time.Time
@@ -40,6 +59,23 @@ type Song struct {
 	VocalPatterns   []*VocalPattern
 	Units           []*Unit
 	MusicVideoTypes []enums.MusicVideoType
+	// 役割ごとにPositionの順
+	Credits   []*SongCredit
+	CreatedAt time.Time
+}
+
+// 公開月ごとの曲 Songsは公開日時の順
+type SongReleaseMonth struct {
+	Year  int32
+	Month int32
+	Songs []*Song
+}
+
+// 曲の作詞・作曲・編曲者 同じ役割の中ではPositionの順に並べる
+type SongCredit struct {
+	Artist   Artist
+	Role     enums.CreditRole
+	Position int32
 }
 
 type SongMusicVideoType struct {
@@ -51,10 +87,13 @@ type SongMusicVideoType struct {
 type Unit struct {
 	ID   int32
 	Name string
+	// 所属している歌手 Positionの順 曲に含まれる場合は空
+	Members []*Singer
 }
 
 type VocalPattern struct {
 	ID      int32
+	SongID  int32
 	Name    string
 	Singers []*Singer
 }
@@ -66,8 +105,73 @@ type VocalPatternSinger struct {
 	Position       int32
 }
 
+type UnitSinger struct {
+	ID       int32
+	UnitID   int32
+	SingerID int32
+	Position int32
+}
+
 type SongUnit struct {
 	ID     int32
 	SongID int32
 	UnitID int32
 }
+
+// マスタデータ一括投入時の差分
+type MasterChange struct {
+	Kind      string
+	Key       string
+	Operation MasterChangeOperation
+	Fields    []string
+}
+
+type MasterChangeOperation string
+
+const (
+	MasterChangeOperationInsert MasterChangeOperation = "insert"
+	MasterChangeOperationUpdate MasterChangeOperation = "update"
+	MasterChangeOperationDelete MasterChangeOperation = "delete"
+)
+
+// マスタの種類
+const (
+	MasterKindArtist       = "artist"
+	MasterKindSinger       = "singer"
+	MasterKindUnit         = "unit"
+	MasterKindSong         = "song"
+	MasterKindVocalPattern = "vocal_pattern"
+	MasterKindChart        = "chart"
+)
+
+// 版数ごとのマスタの変更履歴
+type MasterChangeLog struct {
+	Revision  int64
+	Kind      string
+	EntityID  int32
+	Operation MasterChangeOperation
+}
+
+// ある版数以降に変更・削除されたマスタ
+type MasterDelta struct {
+	Revision         int64
+	Artists          []*Artist
+	Singers          []*Singer
+	Units            []*Unit
+	Songs            []*Song
+	Charts           []*Chart
+	RemovedArtistIDs []int32
+	RemovedSingerIDs []int32
+	RemovedUnitIDs   []int32
+	RemovedSongIDs   []int32
+	RemovedChartIDs  []int32
+}
+
+// 新しく追加されたマスタ SongかChartのどちらかが入る
+type MasterAddition struct {
+	// MasterKindSong か MasterKindChart
+	Kind      string
+	Song      *Song
+	Chart     *Chart
+	CreatedAt time.Time
+}
@@ -14,45 +14,151 @@ import (
 
 var (
 	ErrNotFound = errors.New("not found")
+	// 一意制約に反する作成・更新
+	ErrAlreadyExists = errors.New("already exists")
 )
 
 type MasterRepository interface {
 	// Artist
 	ListArtists(ctx context.Context) ([]*entity.Artist, error)
 	GetArtistByID(ctx context.Context, id int32) (*entity.Artist, error)
+	// 正規化した名前が同じアーティスト ない場合はErrNotFound
+	FindArtistByNameKey(ctx context.Context, name string) (*entity.Artist, error)
 	CreateArtist(ctx context.Context, name, kana string) (*entity.Artist, error)
 	ExistsArtist(ctx context.Context, id int32) (bool, error)
+	UpdateArtist(ctx context.Context, id int32, name, kana string) error
+	DeleteArtist(ctx context.Context, id int32) error
 	// Singer
 	ListSingers(ctx context.Context) ([]*entity.Singer, error)
 	GetSingerByID(ctx context.Context, id int32) (*entity.Singer, error)
+	FindSingerByNameKey(ctx context.Context, name string) (*entity.Singer, error)
 	CreateSinger(ctx context.Context, name string) (*entity.Singer, error)
 	ExistsSinger(ctx context.Context, id int32) (bool, error)
+	UpdateSinger(ctx context.Context, id int32, name string) error
 	// Unit
 	ListUnits(ctx context.Context) ([]*entity.Unit, error)
 	GetUnitByID(ctx context.Context, id int32) (*entity.Unit, error)
+	FindUnitByNameKey(ctx context.Context, name string) (*entity.Unit, error)
 	CreateUnit(ctx context.Context, name string) (*entity.Unit, error)
 	ExistsUnit(ctx context.Context, id int32) (bool, error)
+	UpdateUnit(ctx context.Context, id int32, name string) error
 	// VocalPattern
+	ListVocalPatterns(ctx context.Context, songID int32) ([]*entity.VocalPattern, error)
+	GetVocalPatternByID(ctx context.Context, id int32) (*entity.VocalPattern, error)
 	CreateVocalPattern(ctx context.Context, songID int32, name string) (*sqlcgen.VocalPattern, error)
+	ExistsVocalPattern(ctx context.Context, id int32) (bool, error)
+	UpdateVocalPattern(ctx context.Context, id int32, name string) error
+	DeleteVocalPattern(ctx context.Context, id int32) error
+	DeleteVocalPatternsBySongID(ctx context.Context, songID int32) error
 	// VocalPatternSinger
 	CreateVocalPatternSinger(ctx context.Context, vocalPatternID, singerID, position int32) (*sqlcgen.VocalPatternSinger, error)
+	DeleteVocalPatternSingersBySongID(ctx context.Context, songID int32) error
+	DeleteVocalPatternSingersByVocalPatternID(ctx context.Context, vocalPatternID int32) error
+	// UnitSinger
+	CreateUnitSinger(ctx context.Context, unitID, singerID, position int32) (*sqlcgen.UnitSinger, error)
+	ExistsUnitSinger(ctx context.Context, unitID, singerID int32) (bool, error)
+	UpdateUnitSinger(ctx context.Context, unitID, singerID, position int32) error
+	DeleteUnitSinger(ctx context.Context, unitID, singerID int32) error
+	DeleteUnitSingersByUnitID(ctx context.Context, unitID int32) error
 	// SongUnit
 	CreateSongUnit(ctx context.Context, songID, unitID int32) (*sqlcgen.SongUnit, error)
+	DeleteSongUnitsBySongID(ctx context.Context, songID int32) error
+	// SongCredit
+	CreateSongCredit(ctx context.Context, songID, artistID int32, role enums.CreditRole, position int32) (*sqlcgen.SongCredit, error)
+	DeleteSongCreditsBySongID(ctx context.Context, songID int32) error
+	// sourceのクレジットをtargetに付け替える 同じ曲・同じ役割で重複するものは消す
+	MergeSongCredits(ctx context.Context, sourceArtistID, targetArtistID int32) error
 	// SongMusicVideoType
 	CreateSongMusicVideoType(ctx context.Context, songID int32, musicVideoType enums.MusicVideoType) (*sqlcgen.SongMusicVideoType, error)
+	DeleteSongMusicVideoTypesBySongID(ctx context.Context, songID int32) error
 	// Song
 	ListSongs(ctx context.Context) ([]*entity.Song, error)
 	GetSongByID(ctx context.Context, id int32) (*entity.Song, error)
 	CreateSong(ctx context.Context,
 		name, kana string,
-		lyrics_id, music_id, arrangement_id int32,
 		thumbnail, originalVideo string,
 		releaseTime time.Time, deleted bool,
 	) (*sqlcgen.Song, error)
 	ExistsSong(ctx context.Context, id int32) (bool, error)
+	ExistsSongByArtistID(ctx context.Context, artistID int32) (bool, error)
+	UpdateSong(ctx context.Context,
+		id int32,
+		name, kana string,
+		thumbnail, originalVideo string,
+		releaseTime time.Time, deleted bool,
+	) error
+	DeleteSong(ctx context.Context, id int32) error
+	SearchSongIDs(ctx context.Context, cond SongSearchCondition, afterID, limit int32) ([]int32, error)
+	ListSongsByIDs(ctx context.Context, ids []int32) ([]*entity.Song, error)
+	// SearchText
+	// onlyMissingなら検索用カラムが空の行だけ埋める
+	BackfillSearchText(ctx context.Context, onlyMissing bool) (artistCount, songCount int, err error)
 	// Chart
 	ListCharts(ctx context.Context) ([]*entity.Chart, error)
 	GetChartByID(ctx context.Context, id int32) (*entity.Chart, error)
-	CreateChart(ctx context.Context, songID, difficultyType, level int32, chartViewLink string) (*sqlcgen.Chart, error)
+	CreateChart(ctx context.Context, songID, difficultyType, level int32, chartViewLink string, metadata entity.ChartMetadata) (*sqlcgen.Chart, error)
 	ExistsChart(ctx context.Context, id int32) (bool, error)
+	// 同じ曲・同じ難易度の譜面のID ない場合はErrNotFound
+	FindChartIDBySongAndDifficulty(ctx context.Context, songID, difficultyType int32) (int32, error)
+	ListChartIDsBySongID(ctx context.Context, songID int32) ([]int32, error)
+	UpdateChart(ctx context.Context, id, songID, difficultyType, level int32, chartViewLink string, metadata entity.ChartMetadata) error
+	DeleteChart(ctx context.Context, id int32) error
+	DeleteChartsBySongID(ctx context.Context, songID int32) error
+	ExistsMyListChartByChartID(ctx context.Context, chartID int32) (bool, error)
+	ExistsMyListChartBySongID(ctx context.Context, songID int32) (bool, error)
+	SearchChartIDs(ctx context.Context, cond ChartSearchCondition, afterID, limit int32) ([]int32, error)
+	CountSearchCharts(ctx context.Context, cond ChartSearchCondition) (int64, error)
+	ListChartsByIDs(ctx context.Context, ids []int32) ([]*entity.Chart, error)
+	// ChartLevelHistory
+	// 同じ譜面・同じ適用日時の履歴があればレベルを上書きする
+	UpsertChartLevelHistory(ctx context.Context, chartID, level int32, effectiveFrom time.Time) error
+	ListChartLevelHistory(ctx context.Context, chartID int32) ([]*entity.ChartLevelHistory, error)
+	// asOf時点のレベル まだ履歴がない場合はErrNotFound
+	GetChartLevelAsOf(ctx context.Context, chartID int32, asOf time.Time) (int32, error)
+	DeleteChartLevelHistoryByChartID(ctx context.Context, chartID int32) error
+	DeleteChartLevelHistoryBySongID(ctx context.Context, songID int32) error
+	// Revision
+	GetMasterRevision(ctx context.Context) (int64, error)
+	// 版数を1つ進めて変更履歴を記録する トランザクション内で呼ぶ
+	RecordMasterChanges(ctx context.Context, logs []*entity.MasterChangeLog) (int64, error)
+	// 指定した版数より後の変更を、マスタごとに最新のものだけ返す
+	ListLatestMasterChangeLogsSince(ctx context.Context, revision int64) ([]*entity.MasterChangeLog, error)
+	// 公開された曲の変更履歴をどこまで記録したか 他の記録と重ならないよう行ロックを取る トランザクション内で呼ぶ
+	GetReleasesRecordedAtForUpdate(ctx context.Context) (time.Time, error)
+	UpdateReleasesRecordedAt(ctx context.Context, recordedAt time.Time) error
+	// 公開日時がafterより後、until以前の曲のID
+	ListSongIDsReleasedBetween(ctx context.Context, after, until time.Time) ([]int32, error)
+}
+
+// 曲検索の条件 ゼロ値の項目は絞り込みに使わない
+type SongSearchCondition struct {
+	Query          string
+	LyricsID       int32
+	MusicID        int32
+	ArrangementID  int32
+	UnitID         int32
+	SingerID       int32
+	MusicVideoType enums.MusicVideoType
+	ReleaseFrom    time.Time
+	ReleaseTo      time.Time
+	// 削除済みの曲も含める
+	IncludeDeleted bool
+	// 公開前の曲も含める falseなら現在時刻より前に公開された曲だけ
+	IncludeUnreleased bool
+}
+
+// 譜面検索の条件 ゼロ値・空の項目は絞り込みに使わない
+type ChartSearchCondition struct {
+	Query           string
+	DifficultyTypes []enums.DifficultyType
+	MinLevel        int32
+	MaxLevel        int32
+	UnitIDs         []int32
+	SingerIDs       []int32
+	ReleaseFrom     time.Time
+	ReleaseTo       time.Time
+	// 削除済みの曲の譜面も含める
+	IncludeDeleted bool
+	// 公開前の曲の譜面も含める falseなら現在時刻より前に公開された曲の譜面だけ
+	IncludeUnreleased bool
 }
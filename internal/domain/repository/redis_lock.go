@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/$GOFILE -package=mock_$GOPACKAGE
+
+const LOCK_REDIS_KEY = "lock"
+
+// 複数のAPIサーバーの間で処理を1つにするためのロック
+type RedisLockRepository interface {
+	// 他が持っている時はokがfalse 取れた時は解放に使うtokenを返す
+	// ttlを過ぎると解放していなくても他が取れる
+	TryLock(ctx context.Context, key string, ttl time.Duration) (token string, ok bool, err error)
+	// tokenが一致する時だけ解放する ttlを過ぎて他が取り直したロックは消さない
+	Unlock(ctx context.Context, key, token string) error
+}
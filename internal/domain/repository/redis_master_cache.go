@@ -2,48 +2,97 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/cockroachdb/errors"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/$GOFILE -package=mock_$GOPACKAGE
 
 const (
-	ARTIST_REDIS_KEY = "artist"
-	SINGER_REDIS_KEY = "singer"
-	UNIT_REDIS_KEY   = "unit"
-	SONG_REDIS_KEY   = "song"
-	CHART_REDIS_KEY  = "chart"
+	// キャッシュに入れるエンティティの形を変えたら上げる
+	// キーが変わるので、古い形で入ったキャッシュを読んで項目が空のままになることがない
+	// v2: 曲のクレジット(Credits)、譜面のメタデータ(Metadata)、追加日時(CreatedAt)
+	MASTER_CACHE_VERSION = "v2"
+
+	ARTIST_REDIS_KEY = MASTER_CACHE_VERSION + ":artist"
+	SINGER_REDIS_KEY = MASTER_CACHE_VERSION + ":singer"
+	UNIT_REDIS_KEY   = MASTER_CACHE_VERSION + ":unit"
+	SONG_REDIS_KEY   = MASTER_CACHE_VERSION + ":song"
+	CHART_REDIS_KEY  = MASTER_CACHE_VERSION + ":chart"
+	// 個別のキャッシュの依存関係 deps:<キー>にそのキーのマスタを含むキーの集合を入れる
+	DEPENDENCY_REDIS_KEY = MASTER_CACHE_VERSION + ":deps"
+
+	MASTER_EVENT_REDIS_CHANNEL = "master:events"
+	// マスタのキャッシュを書き換えた時に、各サーバーのメモリ上のキャッシュを破棄させる
+	MASTER_CACHE_INVALIDATE_REDIS_CHANNEL = "master:cache:invalidate"
+)
+
+var (
+	// Redisにつながらずキャッシュを使えない キャッシュに無い時(redis.Nil)と同じくDBから取る
+	ErrMasterCacheUnavailable = errors.New("master cache unavailable")
 )
 
+// 個別のキャッシュ(Set<マスタ>)は含んでいる他のマスタを依存関係として記録する
+// Delete<マスタ>はそのキャッシュと、それを含むキャッシュ(曲を含む譜面など)をまとめて破棄する
 type RedisMasterCacheRepository interface {
 	// Artist
 	SetArtist(ctx context.Context, id int32, data *entity.Artist) error
 	SetArtists(ctx context.Context, data []*entity.Artist) error
 	GetArtistByID(ctx context.Context, id int32) (*entity.Artist, error)
 	GetArtists(ctx context.Context) ([]*entity.Artist, error)
+	DeleteArtist(ctx context.Context, id int32) error
 
 	// Singer
 	SetSinger(ctx context.Context, id int32, data *entity.Singer) error
 	SetSingers(ctx context.Context, data []*entity.Singer) error
 	GetSingerByID(ctx context.Context, id int32) (*entity.Singer, error)
 	GetSingers(ctx context.Context) ([]*entity.Singer, error)
+	DeleteSinger(ctx context.Context, id int32) error
 
 	// Unit
 	SetUnit(ctx context.Context, id int32, data *entity.Unit) error
 	SetUnits(ctx context.Context, data []*entity.Unit) error
 	GetUnitByID(ctx context.Context, id int32) (*entity.Unit, error)
 	GetUnits(ctx context.Context) ([]*entity.Unit, error)
+	DeleteUnit(ctx context.Context, id int32) error
 
 	// Song
 	SetSong(ctx context.Context, id int32, data *entity.Song) error
 	SetSongs(ctx context.Context, data []*entity.Song) error
 	GetSongByID(ctx context.Context, id int32) (*entity.Song, error)
 	GetSongs(ctx context.Context) ([]*entity.Song, error)
+	DeleteSong(ctx context.Context, id int32) error
+	// 公開済みの曲だけの一覧 expirationが0なら期限なし
+	SetReleasedSongs(ctx context.Context, data []*entity.Song, expiration time.Duration) error
+	GetReleasedSongs(ctx context.Context) ([]*entity.Song, error)
 
 	// Chart
 	SetChart(ctx context.Context, id int32, data *entity.Chart) error
+	// 個別のキャッシュにまとめて入れる
+	SetChartsByID(ctx context.Context, data []*entity.Chart) error
 	SetCharts(ctx context.Context, data []*entity.Chart) error
 	GetChartByID(ctx context.Context, id int32) (*entity.Chart, error)
+	// idsと同じ順に返す キャッシュに無いものはnil
+	GetChartsByIDs(ctx context.Context, ids []int32) ([]*entity.Chart, error)
 	GetCharts(ctx context.Context) ([]*entity.Chart, error)
+	DeleteChart(ctx context.Context, id int32) error
+	// 公開済みの曲の譜面だけの一覧 expirationが0なら期限なし
+	SetReleasedCharts(ctx context.Context, data []*entity.Chart, expiration time.Duration) error
+	GetReleasedCharts(ctx context.Context) ([]*entity.Chart, error)
+}
+
+// メモリ上のキャッシュの利用状況
+type MasterCacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+// Redisのキャッシュの前に置くサーバーごとのメモリ上のキャッシュ
+// 返す値は他のリクエストと共有しているので書き換えない
+type LocalMasterCacheRepository interface {
+	RedisMasterCacheRepository
+	Stats() MasterCacheStats
 }
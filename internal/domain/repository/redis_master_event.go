@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/$GOFILE -package=mock_$GOPACKAGE
+
+// マスタの変更通知 複数のAPIサーバーに届くようにRedisのpub/subを使う
+type RedisMasterEventRepository interface {
+	// 同じ版数の変更をまとめて通知する
+	PublishMasterChanges(ctx context.Context, logs []*entity.MasterChangeLog) error
+	// ctxが終わるか、受け取りが追いつかなくなるとチャネルを閉じる
+	SubscribeMasterChanges(ctx context.Context) <-chan []*entity.MasterChangeLog
+}
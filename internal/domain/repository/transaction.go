@@ -0,0 +1,15 @@
+package repository
+
+import "context"
+
+//go:generate mockgen -source=$GOFILE -destination=../../mock/$GOPACKAGE/$GOFILE -package=mock_$GOPACKAGE
+
+type TxManager interface {
+	// fnの中で同じctxを使ったリポジトリの操作を1つのトランザクションで実行する
+	// fnがエラーを返したらロールバックする
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	// トランザクションのコミット後にfnを実行する ロールバックした場合は実行しない
+	// トランザクション外で呼んだ場合はすぐに実行する
+	// 書き込みは済んでいるので、fnのエラーはログに出すだけでDoの結果にはしない
+	AfterCommit(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -136,6 +136,283 @@ func (MusicVideoType) EnumDescriptor() ([]byte, []int) {
 	return file_enums_master_proto_rawDescGZIP(), []int{1}
 }
 
+// CreditRoles
+type CreditRole int32
+
+const (
+	CreditRole_CREDIT_ROLE_UNSPECIFIED CreditRole = 0
+	CreditRole_CREDIT_ROLE_LYRICS      CreditRole = 1
+	CreditRole_CREDIT_ROLE_MUSIC       CreditRole = 2
+	CreditRole_CREDIT_ROLE_ARRANGEMENT CreditRole = 3
+)
+
+// Enum value maps for CreditRole.
+var (
+	CreditRole_name = map[int32]string{
+		0: "CREDIT_ROLE_UNSPECIFIED",
+		1: "CREDIT_ROLE_LYRICS",
+		2: "CREDIT_ROLE_MUSIC",
+		3: "CREDIT_ROLE_ARRANGEMENT",
+	}
+	CreditRole_value = map[string]int32{
+		"CREDIT_ROLE_UNSPECIFIED": 0,
+		"CREDIT_ROLE_LYRICS":      1,
+		"CREDIT_ROLE_MUSIC":       2,
+		"CREDIT_ROLE_ARRANGEMENT": 3,
+	}
+)
+
+func (x CreditRole) Enum() *CreditRole {
+	p := new(CreditRole)
+	*p = x
+	return p
+}
+
+func (x CreditRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreditRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_enums_master_proto_enumTypes[2].Descriptor()
+}
+
+func (CreditRole) Type() protoreflect.EnumType {
+	return &file_enums_master_proto_enumTypes[2]
+}
+
+func (x CreditRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreditRole.Descriptor instead.
+func (CreditRole) EnumDescriptor() ([]byte, []int) {
+	return file_enums_master_proto_rawDescGZIP(), []int{2}
+}
+
+// MasterBundleFormats
+type MasterBundleFormat int32
+
+const (
+	MasterBundleFormat_MASTER_BUNDLE_FORMAT_UNSPECIFIED MasterBundleFormat = 0
+	MasterBundleFormat_MASTER_BUNDLE_FORMAT_JSON        MasterBundleFormat = 1
+	MasterBundleFormat_MASTER_BUNDLE_FORMAT_CSV_ZIP     MasterBundleFormat = 2
+)
+
+// Enum value maps for MasterBundleFormat.
+var (
+	MasterBundleFormat_name = map[int32]string{
+		0: "MASTER_BUNDLE_FORMAT_UNSPECIFIED",
+		1: "MASTER_BUNDLE_FORMAT_JSON",
+		2: "MASTER_BUNDLE_FORMAT_CSV_ZIP",
+	}
+	MasterBundleFormat_value = map[string]int32{
+		"MASTER_BUNDLE_FORMAT_UNSPECIFIED": 0,
+		"MASTER_BUNDLE_FORMAT_JSON":        1,
+		"MASTER_BUNDLE_FORMAT_CSV_ZIP":     2,
+	}
+)
+
+func (x MasterBundleFormat) Enum() *MasterBundleFormat {
+	p := new(MasterBundleFormat)
+	*p = x
+	return p
+}
+
+func (x MasterBundleFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MasterBundleFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_enums_master_proto_enumTypes[3].Descriptor()
+}
+
+func (MasterBundleFormat) Type() protoreflect.EnumType {
+	return &file_enums_master_proto_enumTypes[3]
+}
+
+func (x MasterBundleFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MasterBundleFormat.Descriptor instead.
+func (MasterBundleFormat) EnumDescriptor() ([]byte, []int) {
+	return file_enums_master_proto_rawDescGZIP(), []int{3}
+}
+
+// MasterChangeOperations
+type MasterChangeOperation int32
+
+const (
+	MasterChangeOperation_MASTER_CHANGE_OPERATION_UNSPECIFIED MasterChangeOperation = 0
+	MasterChangeOperation_MASTER_CHANGE_OPERATION_INSERT      MasterChangeOperation = 1
+	MasterChangeOperation_MASTER_CHANGE_OPERATION_UPDATE      MasterChangeOperation = 2
+	MasterChangeOperation_MASTER_CHANGE_OPERATION_DELETE      MasterChangeOperation = 3
+)
+
+// Enum value maps for MasterChangeOperation.
+var (
+	MasterChangeOperation_name = map[int32]string{
+		0: "MASTER_CHANGE_OPERATION_UNSPECIFIED",
+		1: "MASTER_CHANGE_OPERATION_INSERT",
+		2: "MASTER_CHANGE_OPERATION_UPDATE",
+		3: "MASTER_CHANGE_OPERATION_DELETE",
+	}
+	MasterChangeOperation_value = map[string]int32{
+		"MASTER_CHANGE_OPERATION_UNSPECIFIED": 0,
+		"MASTER_CHANGE_OPERATION_INSERT":      1,
+		"MASTER_CHANGE_OPERATION_UPDATE":      2,
+		"MASTER_CHANGE_OPERATION_DELETE":      3,
+	}
+)
+
+func (x MasterChangeOperation) Enum() *MasterChangeOperation {
+	p := new(MasterChangeOperation)
+	*p = x
+	return p
+}
+
+func (x MasterChangeOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MasterChangeOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_enums_master_proto_enumTypes[4].Descriptor()
+}
+
+func (MasterChangeOperation) Type() protoreflect.EnumType {
+	return &file_enums_master_proto_enumTypes[4]
+}
+
+func (x MasterChangeOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MasterChangeOperation.Descriptor instead.
+func (MasterChangeOperation) EnumDescriptor() ([]byte, []int) {
+	return file_enums_master_proto_rawDescGZIP(), []int{4}
+}
+
+// MasterKinds
+type MasterKind int32
+
+const (
+	MasterKind_MASTER_KIND_UNSPECIFIED   MasterKind = 0
+	MasterKind_MASTER_KIND_ARTIST        MasterKind = 1
+	MasterKind_MASTER_KIND_SINGER        MasterKind = 2
+	MasterKind_MASTER_KIND_UNIT          MasterKind = 3
+	MasterKind_MASTER_KIND_SONG          MasterKind = 4
+	MasterKind_MASTER_KIND_VOCAL_PATTERN MasterKind = 5
+	MasterKind_MASTER_KIND_CHART         MasterKind = 6
+)
+
+// Enum value maps for MasterKind.
+var (
+	MasterKind_name = map[int32]string{
+		0: "MASTER_KIND_UNSPECIFIED",
+		1: "MASTER_KIND_ARTIST",
+		2: "MASTER_KIND_SINGER",
+		3: "MASTER_KIND_UNIT",
+		4: "MASTER_KIND_SONG",
+		5: "MASTER_KIND_VOCAL_PATTERN",
+		6: "MASTER_KIND_CHART",
+	}
+	MasterKind_value = map[string]int32{
+		"MASTER_KIND_UNSPECIFIED":   0,
+		"MASTER_KIND_ARTIST":        1,
+		"MASTER_KIND_SINGER":        2,
+		"MASTER_KIND_UNIT":          3,
+		"MASTER_KIND_SONG":          4,
+		"MASTER_KIND_VOCAL_PATTERN": 5,
+		"MASTER_KIND_CHART":         6,
+	}
+)
+
+func (x MasterKind) Enum() *MasterKind {
+	p := new(MasterKind)
+	*p = x
+	return p
+}
+
+func (x MasterKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MasterKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_enums_master_proto_enumTypes[5].Descriptor()
+}
+
+func (MasterKind) Type() protoreflect.EnumType {
+	return &file_enums_master_proto_enumTypes[5]
+}
+
+func (x MasterKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MasterKind.Descriptor instead.
+func (MasterKind) EnumDescriptor() ([]byte, []int) {
+	return file_enums_master_proto_rawDescGZIP(), []int{5}
+}
+
+// ChartSortKeys
+type ChartSortKey int32
+
+const (
+	ChartSortKey_CHART_SORT_KEY_UNSPECIFIED ChartSortKey = 0
+	ChartSortKey_CHART_SORT_KEY_LEVEL       ChartSortKey = 1
+	ChartSortKey_CHART_SORT_KEY_NOTE_COUNT  ChartSortKey = 2
+	ChartSortKey_CHART_SORT_KEY_BPM         ChartSortKey = 3
+	ChartSortKey_CHART_SORT_KEY_DURATION    ChartSortKey = 4
+	ChartSortKey_CHART_SORT_KEY_CONSTANT    ChartSortKey = 5
+)
+
+// Enum value maps for ChartSortKey.
+var (
+	ChartSortKey_name = map[int32]string{
+		0: "CHART_SORT_KEY_UNSPECIFIED",
+		1: "CHART_SORT_KEY_LEVEL",
+		2: "CHART_SORT_KEY_NOTE_COUNT",
+		3: "CHART_SORT_KEY_BPM",
+		4: "CHART_SORT_KEY_DURATION",
+		5: "CHART_SORT_KEY_CONSTANT",
+	}
+	ChartSortKey_value = map[string]int32{
+		"CHART_SORT_KEY_UNSPECIFIED": 0,
+		"CHART_SORT_KEY_LEVEL":       1,
+		"CHART_SORT_KEY_NOTE_COUNT":  2,
+		"CHART_SORT_KEY_BPM":         3,
+		"CHART_SORT_KEY_DURATION":    4,
+		"CHART_SORT_KEY_CONSTANT":    5,
+	}
+)
+
+func (x ChartSortKey) Enum() *ChartSortKey {
+	p := new(ChartSortKey)
+	*p = x
+	return p
+}
+
+func (x ChartSortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChartSortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_enums_master_proto_enumTypes[6].Descriptor()
+}
+
+func (ChartSortKey) Type() protoreflect.EnumType {
+	return &file_enums_master_proto_enumTypes[6]
+}
+
+func (x ChartSortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChartSortKey.Descriptor instead.
+func (ChartSortKey) EnumDescriptor() ([]byte, []int) {
+	return file_enums_master_proto_rawDescGZIP(), []int{6}
+}
+
 var File_enums_master_proto protoreflect.FileDescriptor
 
 var file_enums_master_proto_rawDesc = string([]byte{
@@ -162,11 +439,61 @@ var file_enums_master_proto_rawDesc = string([]byte{
 	0x01, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x55, 0x53, 0x49, 0x43, 0x5f, 0x56, 0x49, 0x44, 0x45, 0x4f,
 	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x32, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x55,
 	0x53, 0x49, 0x43, 0x5f, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f,
-	0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x68, 0x61, 0x6b, 0x6b, 0x75, 0x75, 0x75,
-	0x2f, 0x73, 0x65, 0x6b, 0x61, 0x69, 0x2d, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2d, 0x6d, 0x79, 0x6c,
-	0x69, 0x73, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0x75, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x52, 0x45, 0x44, 0x49,
+	0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x4c, 0x59, 0x52, 0x49, 0x43, 0x53, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x55, 0x53, 0x49,
+	0x43, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03,
+	0x2a, 0x7b, 0x0a, 0x12, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52,
+	0x5f, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19,
+	0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4d,
+	0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x5f, 0x5a, 0x49, 0x50, 0x10, 0x02, 0x2a, 0xac, 0x01,
+	0x0a, 0x15, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x23, 0x4d, 0x41, 0x53, 0x54, 0x45,
+	0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x45,
+	0x52, 0x54, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x41, 0x53, 0x54,
+	0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x2a, 0xbb, 0x01, 0x0a,
+	0x0a, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x17, 0x4d,
+	0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x41, 0x53, 0x54,
+	0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x53, 0x54, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x12, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x53, 0x49, 0x4e, 0x47, 0x45, 0x52, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x41, 0x53, 0x54,
+	0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x10, 0x03, 0x12, 0x14,
+	0x0a, 0x10, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x4f,
+	0x4e, 0x47, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x56, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x50, 0x41, 0x54, 0x54, 0x45, 0x52,
+	0x4e, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x54, 0x10, 0x06, 0x2a, 0xb9, 0x01, 0x0a, 0x0c, 0x43,
+	0x68, 0x61, 0x72, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x43,
+	0x48, 0x41, 0x52, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x43,
+	0x48, 0x41, 0x52, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x42, 0x50, 0x4d, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17,
+	0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x44,
+	0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41,
+	0x52, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x53,
+	0x54, 0x41, 0x4e, 0x54, 0x10, 0x05, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x68, 0x61, 0x6b, 0x6b, 0x75, 0x75, 0x75, 0x2f, 0x73, 0x65,
+	0x6b, 0x61, 0x69, 0x2d, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2d, 0x6d, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -181,10 +508,15 @@ func file_enums_master_proto_rawDescGZIP() []byte {
 	return file_enums_master_proto_rawDescData
 }
 
-var file_enums_master_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_enums_master_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
 var file_enums_master_proto_goTypes = []any{
-	(DifficultyType)(0), // 0: enums.DifficultyType
-	(MusicVideoType)(0), // 1: enums.MusicVideoType
+	(DifficultyType)(0),        // 0: enums.DifficultyType
+	(MusicVideoType)(0),        // 1: enums.MusicVideoType
+	(CreditRole)(0),            // 2: enums.CreditRole
+	(MasterBundleFormat)(0),    // 3: enums.MasterBundleFormat
+	(MasterChangeOperation)(0), // 4: enums.MasterChangeOperation
+	(MasterKind)(0),            // 5: enums.MasterKind
+	(ChartSortKey)(0),          // 6: enums.ChartSortKey
 }
 var file_enums_master_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -204,7 +536,7 @@ func file_enums_master_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_enums_master_proto_rawDesc), len(file_enums_master_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      7,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
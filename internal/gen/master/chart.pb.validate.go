@@ -97,6 +97,16 @@ func (m *Chart) validate(all bool) error {
 
 	// no validation rules for ChartViewLink
 
+	// no validation rules for NoteCount
+
+	// no validation rules for BpmMin
+
+	// no validation rules for BpmMax
+
+	// no validation rules for DurationSeconds
+
+	// no validation rules for Constant
+
 	if len(errors) > 0 {
 		return ChartMultiError(errors)
 	}
@@ -173,3 +183,165 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ChartValidationError{}
+
+// Validate checks the field values on ChartLevelHistory with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChartLevelHistory) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChartLevelHistory with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChartLevelHistoryMultiError, or nil if none found.
+func (m *ChartLevelHistory) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChartLevelHistory) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Level
+
+	if all {
+		switch v := interface{}(m.GetEffectiveFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChartLevelHistoryValidationError{
+					field:  "EffectiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChartLevelHistoryValidationError{
+					field:  "EffectiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEffectiveFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChartLevelHistoryValidationError{
+				field:  "EffectiveFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChartLevelHistoryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChartLevelHistoryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChartLevelHistoryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ChartLevelHistoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChartLevelHistoryMultiError is an error wrapping multiple validation errors
+// returned by ChartLevelHistory.ValidateAll() if the designated constraints
+// aren't met.
+type ChartLevelHistoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChartLevelHistoryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChartLevelHistoryMultiError) AllErrors() []error { return m }
+
+// ChartLevelHistoryValidationError is the validation error returned by
+// ChartLevelHistory.Validate if the designated constraints aren't met.
+type ChartLevelHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChartLevelHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChartLevelHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChartLevelHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChartLevelHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChartLevelHistoryValidationError) ErrorName() string {
+	return "ChartLevelHistoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChartLevelHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChartLevelHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChartLevelHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChartLevelHistoryValidationError{}
@@ -24,6 +24,68 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AlreadyExistsのエラーに付ける詳細 衝突した既存のマスタ
+// 譜面のnameは"曲名/難易度"
+type MasterConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          enums.MasterKind       `protobuf:"varint,1,opt,name=kind,proto3,enum=enums.MasterKind" json:"kind,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MasterConflict) Reset() {
+	*x = MasterConflict{}
+	mi := &file_master_master_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MasterConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MasterConflict) ProtoMessage() {}
+
+func (x *MasterConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MasterConflict.ProtoReflect.Descriptor instead.
+func (*MasterConflict) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MasterConflict) GetKind() enums.MasterKind {
+	if x != nil {
+		return x.Kind
+	}
+	return enums.MasterKind(0)
+}
+
+func (x *MasterConflict) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MasterConflict) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // Artist
 type GetArtistsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -33,7 +95,7 @@ type GetArtistsRequest struct {
 
 func (x *GetArtistsRequest) Reset() {
 	*x = GetArtistsRequest{}
-	mi := &file_master_master_proto_msgTypes[0]
+	mi := &file_master_master_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +107,7 @@ func (x *GetArtistsRequest) String() string {
 func (*GetArtistsRequest) ProtoMessage() {}
 
 func (x *GetArtistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[0]
+	mi := &file_master_master_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +120,7 @@ func (x *GetArtistsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArtistsRequest.ProtoReflect.Descriptor instead.
 func (*GetArtistsRequest) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{0}
+	return file_master_master_proto_rawDescGZIP(), []int{1}
 }
 
 type GetArtistsResponse struct {
@@ -70,7 +132,7 @@ type GetArtistsResponse struct {
 
 func (x *GetArtistsResponse) Reset() {
 	*x = GetArtistsResponse{}
-	mi := &file_master_master_proto_msgTypes[1]
+	mi := &file_master_master_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -82,7 +144,7 @@ func (x *GetArtistsResponse) String() string {
 func (*GetArtistsResponse) ProtoMessage() {}
 
 func (x *GetArtistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[1]
+	mi := &file_master_master_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -95,7 +157,7 @@ func (x *GetArtistsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArtistsResponse.ProtoReflect.Descriptor instead.
 func (*GetArtistsResponse) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{1}
+	return file_master_master_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetArtistsResponse) GetArtists() []*Artist {
@@ -114,7 +176,7 @@ type GetArtistRequest struct {
 
 func (x *GetArtistRequest) Reset() {
 	*x = GetArtistRequest{}
-	mi := &file_master_master_proto_msgTypes[2]
+	mi := &file_master_master_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -126,7 +188,7 @@ func (x *GetArtistRequest) String() string {
 func (*GetArtistRequest) ProtoMessage() {}
 
 func (x *GetArtistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[2]
+	mi := &file_master_master_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -139,7 +201,7 @@ func (x *GetArtistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArtistRequest.ProtoReflect.Descriptor instead.
 func (*GetArtistRequest) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{2}
+	return file_master_master_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetArtistRequest) GetId() int32 {
@@ -158,7 +220,7 @@ type GetArtistResponse struct {
 
 func (x *GetArtistResponse) Reset() {
 	*x = GetArtistResponse{}
-	mi := &file_master_master_proto_msgTypes[3]
+	mi := &file_master_master_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -170,7 +232,7 @@ func (x *GetArtistResponse) String() string {
 func (*GetArtistResponse) ProtoMessage() {}
 
 func (x *GetArtistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[3]
+	mi := &file_master_master_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -183,7 +245,7 @@ func (x *GetArtistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetArtistResponse.ProtoReflect.Descriptor instead.
 func (*GetArtistResponse) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{3}
+	return file_master_master_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetArtistResponse) GetArtist() *Artist {
@@ -203,7 +265,7 @@ type CreateArtistRequest struct {
 
 func (x *CreateArtistRequest) Reset() {
 	*x = CreateArtistRequest{}
-	mi := &file_master_master_proto_msgTypes[4]
+	mi := &file_master_master_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +277,7 @@ func (x *CreateArtistRequest) String() string {
 func (*CreateArtistRequest) ProtoMessage() {}
 
 func (x *CreateArtistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[4]
+	mi := &file_master_master_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +290,7 @@ func (x *CreateArtistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateArtistRequest.ProtoReflect.Descriptor instead.
 func (*CreateArtistRequest) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{4}
+	return file_master_master_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateArtistRequest) GetName() string {
@@ -253,7 +315,7 @@ type CreateArtistResponse struct {
 
 func (x *CreateArtistResponse) Reset() {
 	*x = CreateArtistResponse{}
-	mi := &file_master_master_proto_msgTypes[5]
+	mi := &file_master_master_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -265,7 +327,7 @@ func (x *CreateArtistResponse) String() string {
 func (*CreateArtistResponse) ProtoMessage() {}
 
 func (x *CreateArtistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[5]
+	mi := &file_master_master_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,31 +340,33 @@ func (x *CreateArtistResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateArtistResponse.ProtoReflect.Descriptor instead.
 func (*CreateArtistResponse) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{5}
+	return file_master_master_proto_rawDescGZIP(), []int{6}
 }
 
-// Singer
-type GetSingersRequest struct {
+type UpdateArtistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kana          string                 `protobuf:"bytes,3,opt,name=kana,proto3" json:"kana,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSingersRequest) Reset() {
-	*x = GetSingersRequest{}
-	mi := &file_master_master_proto_msgTypes[6]
+func (x *UpdateArtistRequest) Reset() {
+	*x = UpdateArtistRequest{}
+	mi := &file_master_master_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSingersRequest) String() string {
+func (x *UpdateArtistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSingersRequest) ProtoMessage() {}
+func (*UpdateArtistRequest) ProtoMessage() {}
 
-func (x *GetSingersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[6]
+func (x *UpdateArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,76 +377,52 @@ func (x *GetSingersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSingersRequest.ProtoReflect.Descriptor instead.
-func (*GetSingersRequest) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{6}
-}
-
-type GetSingersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Singers       []*Singer              `protobuf:"bytes,1,rep,name=singers,proto3" json:"singers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetSingersResponse) Reset() {
-	*x = GetSingersResponse{}
-	mi := &file_master_master_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetSingersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use UpdateArtistRequest.ProtoReflect.Descriptor instead.
+func (*UpdateArtistRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{7}
 }
 
-func (*GetSingersResponse) ProtoMessage() {}
-
-func (x *GetSingersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[7]
+func (x *UpdateArtistRequest) GetId() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Id
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetSingersResponse.ProtoReflect.Descriptor instead.
-func (*GetSingersResponse) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{7}
+func (x *UpdateArtistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetSingersResponse) GetSingers() []*Singer {
+func (x *UpdateArtistRequest) GetKana() string {
 	if x != nil {
-		return x.Singers
+		return x.Kana
 	}
-	return nil
+	return ""
 }
 
-type GetSingerRequest struct {
+type UpdateArtistResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSingerRequest) Reset() {
-	*x = GetSingerRequest{}
+func (x *UpdateArtistResponse) Reset() {
+	*x = UpdateArtistResponse{}
 	mi := &file_master_master_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSingerRequest) String() string {
+func (x *UpdateArtistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSingerRequest) ProtoMessage() {}
+func (*UpdateArtistResponse) ProtoMessage() {}
 
-func (x *GetSingerRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateArtistResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,39 +434,32 @@ func (x *GetSingerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSingerRequest.ProtoReflect.Descriptor instead.
-func (*GetSingerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateArtistResponse.ProtoReflect.Descriptor instead.
+func (*UpdateArtistResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetSingerRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type GetSingerResponse struct {
+type DeleteArtistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Singer        *Singer                `protobuf:"bytes,1,opt,name=singer,proto3" json:"singer,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSingerResponse) Reset() {
-	*x = GetSingerResponse{}
+func (x *DeleteArtistRequest) Reset() {
+	*x = DeleteArtistRequest{}
 	mi := &file_master_master_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSingerResponse) String() string {
+func (x *DeleteArtistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSingerResponse) ProtoMessage() {}
+func (*DeleteArtistRequest) ProtoMessage() {}
 
-func (x *GetSingerResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteArtistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -438,39 +471,38 @@ func (x *GetSingerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSingerResponse.ProtoReflect.Descriptor instead.
-func (*GetSingerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteArtistRequest.ProtoReflect.Descriptor instead.
+func (*DeleteArtistRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetSingerResponse) GetSinger() *Singer {
+func (x *DeleteArtistRequest) GetId() int32 {
 	if x != nil {
-		return x.Singer
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type CreateSingerRequest struct {
+type DeleteArtistResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSingerRequest) Reset() {
-	*x = CreateSingerRequest{}
+func (x *DeleteArtistResponse) Reset() {
+	*x = DeleteArtistResponse{}
 	mi := &file_master_master_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSingerRequest) String() string {
+func (x *DeleteArtistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSingerRequest) ProtoMessage() {}
+func (*DeleteArtistResponse) ProtoMessage() {}
 
-func (x *CreateSingerRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteArtistResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -482,38 +514,34 @@ func (x *CreateSingerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSingerRequest.ProtoReflect.Descriptor instead.
-func (*CreateSingerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteArtistResponse.ProtoReflect.Descriptor instead.
+func (*DeleteArtistResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateSingerRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type CreateSingerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// sourceの作詞・作曲・編曲をtargetに付け替えて、sourceを削除する
+type MergeArtistsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SourceArtistId int32                  `protobuf:"varint,1,opt,name=source_artist_id,json=sourceArtistId,proto3" json:"source_artist_id,omitempty"`
+	TargetArtistId int32                  `protobuf:"varint,2,opt,name=target_artist_id,json=targetArtistId,proto3" json:"target_artist_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateSingerResponse) Reset() {
-	*x = CreateSingerResponse{}
+func (x *MergeArtistsRequest) Reset() {
+	*x = MergeArtistsRequest{}
 	mi := &file_master_master_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSingerResponse) String() string {
+func (x *MergeArtistsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSingerResponse) ProtoMessage() {}
+func (*MergeArtistsRequest) ProtoMessage() {}
 
-func (x *CreateSingerResponse) ProtoReflect() protoreflect.Message {
+func (x *MergeArtistsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -525,32 +553,45 @@ func (x *CreateSingerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSingerResponse.ProtoReflect.Descriptor instead.
-func (*CreateSingerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeArtistsRequest.ProtoReflect.Descriptor instead.
+func (*MergeArtistsRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{11}
 }
 
-// Unit
-type GetUnitsRequest struct {
+func (x *MergeArtistsRequest) GetSourceArtistId() int32 {
+	if x != nil {
+		return x.SourceArtistId
+	}
+	return 0
+}
+
+func (x *MergeArtistsRequest) GetTargetArtistId() int32 {
+	if x != nil {
+		return x.TargetArtistId
+	}
+	return 0
+}
+
+type MergeArtistsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUnitsRequest) Reset() {
-	*x = GetUnitsRequest{}
+func (x *MergeArtistsResponse) Reset() {
+	*x = MergeArtistsResponse{}
 	mi := &file_master_master_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUnitsRequest) String() string {
+func (x *MergeArtistsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnitsRequest) ProtoMessage() {}
+func (*MergeArtistsResponse) ProtoMessage() {}
 
-func (x *GetUnitsRequest) ProtoReflect() protoreflect.Message {
+func (x *MergeArtistsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -562,32 +603,32 @@ func (x *GetUnitsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnitsRequest.ProtoReflect.Descriptor instead.
-func (*GetUnitsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeArtistsResponse.ProtoReflect.Descriptor instead.
+func (*MergeArtistsResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{12}
 }
 
-type GetUnitsResponse struct {
+// Singer
+type GetSingersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Units         []*Unit                `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUnitsResponse) Reset() {
-	*x = GetUnitsResponse{}
+func (x *GetSingersRequest) Reset() {
+	*x = GetSingersRequest{}
 	mi := &file_master_master_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUnitsResponse) String() string {
+func (x *GetSingersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnitsResponse) ProtoMessage() {}
+func (*GetSingersRequest) ProtoMessage() {}
 
-func (x *GetUnitsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSingersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -599,39 +640,32 @@ func (x *GetUnitsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnitsResponse.ProtoReflect.Descriptor instead.
-func (*GetUnitsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSingersRequest.ProtoReflect.Descriptor instead.
+func (*GetSingersRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetUnitsResponse) GetUnits() []*Unit {
-	if x != nil {
-		return x.Units
-	}
-	return nil
-}
-
-type GetUnitRequest struct {
+type GetSingersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Singers       []*Singer              `protobuf:"bytes,1,rep,name=singers,proto3" json:"singers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUnitRequest) Reset() {
-	*x = GetUnitRequest{}
+func (x *GetSingersResponse) Reset() {
+	*x = GetSingersResponse{}
 	mi := &file_master_master_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUnitRequest) String() string {
+func (x *GetSingersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnitRequest) ProtoMessage() {}
+func (*GetSingersResponse) ProtoMessage() {}
 
-func (x *GetUnitRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSingersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -643,39 +677,39 @@ func (x *GetUnitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnitRequest.ProtoReflect.Descriptor instead.
-func (*GetUnitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSingersResponse.ProtoReflect.Descriptor instead.
+func (*GetSingersResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetUnitRequest) GetId() int32 {
+func (x *GetSingersResponse) GetSingers() []*Singer {
 	if x != nil {
-		return x.Id
+		return x.Singers
 	}
-	return 0
+	return nil
 }
 
-type GetUnitResponse struct {
+type GetSingerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Unit          *Unit                  `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUnitResponse) Reset() {
-	*x = GetUnitResponse{}
+func (x *GetSingerRequest) Reset() {
+	*x = GetSingerRequest{}
 	mi := &file_master_master_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUnitResponse) String() string {
+func (x *GetSingerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnitResponse) ProtoMessage() {}
+func (*GetSingerRequest) ProtoMessage() {}
 
-func (x *GetUnitResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSingerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -687,39 +721,39 @@ func (x *GetUnitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnitResponse.ProtoReflect.Descriptor instead.
-func (*GetUnitResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSingerRequest.ProtoReflect.Descriptor instead.
+func (*GetSingerRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetUnitResponse) GetUnit() *Unit {
+func (x *GetSingerRequest) GetId() int32 {
 	if x != nil {
-		return x.Unit
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type CreateUnitRequest struct {
+type GetSingerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Singer        *Singer                `protobuf:"bytes,1,opt,name=singer,proto3" json:"singer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUnitRequest) Reset() {
-	*x = CreateUnitRequest{}
+func (x *GetSingerResponse) Reset() {
+	*x = GetSingerResponse{}
 	mi := &file_master_master_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUnitRequest) String() string {
+func (x *GetSingerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUnitRequest) ProtoMessage() {}
+func (*GetSingerResponse) ProtoMessage() {}
 
-func (x *CreateUnitRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSingerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -731,38 +765,39 @@ func (x *CreateUnitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUnitRequest.ProtoReflect.Descriptor instead.
-func (*CreateUnitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSingerResponse.ProtoReflect.Descriptor instead.
+func (*GetSingerResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateUnitRequest) GetName() string {
+func (x *GetSingerResponse) GetSinger() *Singer {
 	if x != nil {
-		return x.Name
+		return x.Singer
 	}
-	return ""
+	return nil
 }
 
-type CreateUnitResponse struct {
+type CreateSingerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUnitResponse) Reset() {
-	*x = CreateUnitResponse{}
+func (x *CreateSingerRequest) Reset() {
+	*x = CreateSingerRequest{}
 	mi := &file_master_master_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUnitResponse) String() string {
+func (x *CreateSingerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUnitResponse) ProtoMessage() {}
+func (*CreateSingerRequest) ProtoMessage() {}
 
-func (x *CreateUnitResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSingerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -774,49 +809,38 @@ func (x *CreateUnitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUnitResponse.ProtoReflect.Descriptor instead.
-func (*CreateUnitResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSingerRequest.ProtoReflect.Descriptor instead.
+func (*CreateSingerRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{17}
 }
 
-// VocalPattern
-// message GetVocalPatternsRequest {}
-//
-//	message GetVocalPatternsResponse {
-//	  repeated master.VocalPattern vocal_patterns = 1;
-//	}
-//
-//	message GetVocalPatternRequest {
-//	  int32 id = 1;
-//	}
-//
-//	message GetVocalPatternResponse {
-//	  master.VocalPattern vocal_pattern = 1;
-//	}
-type CreateVocalPatternRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	SongId          int32                  `protobuf:"varint,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	SingerIds       []int32                `protobuf:"varint,3,rep,packed,name=singer_ids,json=singerIds,proto3" json:"singer_ids,omitempty"`
-	SingerPositions []int32                `protobuf:"varint,4,rep,packed,name=singer_positions,json=singerPositions,proto3" json:"singer_positions,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *CreateSingerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *CreateVocalPatternRequest) Reset() {
-	*x = CreateVocalPatternRequest{}
+type CreateSingerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSingerResponse) Reset() {
+	*x = CreateSingerResponse{}
 	mi := &file_master_master_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVocalPatternRequest) String() string {
+func (x *CreateSingerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVocalPatternRequest) ProtoMessage() {}
+func (*CreateSingerResponse) ProtoMessage() {}
 
-func (x *CreateVocalPatternRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSingerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -828,59 +852,33 @@ func (x *CreateVocalPatternRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVocalPatternRequest.ProtoReflect.Descriptor instead.
-func (*CreateVocalPatternRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSingerResponse.ProtoReflect.Descriptor instead.
+func (*CreateSingerResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateVocalPatternRequest) GetSongId() int32 {
-	if x != nil {
-		return x.SongId
-	}
-	return 0
-}
-
-func (x *CreateVocalPatternRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateVocalPatternRequest) GetSingerIds() []int32 {
-	if x != nil {
-		return x.SingerIds
-	}
-	return nil
-}
-
-func (x *CreateVocalPatternRequest) GetSingerPositions() []int32 {
-	if x != nil {
-		return x.SingerPositions
-	}
-	return nil
-}
-
-type CreateVocalPatternResponse struct {
+type UpdateSingerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVocalPatternResponse) Reset() {
-	*x = CreateVocalPatternResponse{}
+func (x *UpdateSingerRequest) Reset() {
+	*x = UpdateSingerRequest{}
 	mi := &file_master_master_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVocalPatternResponse) String() string {
+func (x *UpdateSingerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVocalPatternResponse) ProtoMessage() {}
+func (*UpdateSingerRequest) ProtoMessage() {}
 
-func (x *CreateVocalPatternResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateSingerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -892,32 +890,45 @@ func (x *CreateVocalPatternResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVocalPatternResponse.ProtoReflect.Descriptor instead.
-func (*CreateVocalPatternResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSingerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSingerRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{19}
 }
 
-// Song
-type GetSongsRequest struct {
+func (x *UpdateSingerRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSingerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateSingerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSongsRequest) Reset() {
-	*x = GetSongsRequest{}
+func (x *UpdateSingerResponse) Reset() {
+	*x = UpdateSingerResponse{}
 	mi := &file_master_master_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSongsRequest) String() string {
+func (x *UpdateSingerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSongsRequest) ProtoMessage() {}
+func (*UpdateSingerResponse) ProtoMessage() {}
 
-func (x *GetSongsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateSingerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -929,32 +940,32 @@ func (x *GetSongsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSongsRequest.ProtoReflect.Descriptor instead.
-func (*GetSongsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSingerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSingerResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{20}
 }
 
-type GetSongsResponse struct {
+// Unit
+type GetUnitsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Songs         []*Song                `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSongsResponse) Reset() {
-	*x = GetSongsResponse{}
+func (x *GetUnitsRequest) Reset() {
+	*x = GetUnitsRequest{}
 	mi := &file_master_master_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSongsResponse) String() string {
+func (x *GetUnitsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSongsResponse) ProtoMessage() {}
+func (*GetUnitsRequest) ProtoMessage() {}
 
-func (x *GetSongsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUnitsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -966,39 +977,32 @@ func (x *GetSongsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSongsResponse.ProtoReflect.Descriptor instead.
-func (*GetSongsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUnitsRequest.ProtoReflect.Descriptor instead.
+func (*GetUnitsRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetSongsResponse) GetSongs() []*Song {
-	if x != nil {
-		return x.Songs
-	}
-	return nil
-}
-
-type GetSongRequest struct {
+type GetUnitsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Units         []*Unit                `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSongRequest) Reset() {
-	*x = GetSongRequest{}
+func (x *GetUnitsResponse) Reset() {
+	*x = GetUnitsResponse{}
 	mi := &file_master_master_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSongRequest) String() string {
+func (x *GetUnitsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSongRequest) ProtoMessage() {}
+func (*GetUnitsResponse) ProtoMessage() {}
 
-func (x *GetSongRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUnitsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1010,39 +1014,39 @@ func (x *GetSongRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSongRequest.ProtoReflect.Descriptor instead.
-func (*GetSongRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUnitsResponse.ProtoReflect.Descriptor instead.
+func (*GetUnitsResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetSongRequest) GetId() int32 {
+func (x *GetUnitsResponse) GetUnits() []*Unit {
 	if x != nil {
-		return x.Id
+		return x.Units
 	}
-	return 0
+	return nil
 }
 
-type GetSongResponse struct {
+type GetUnitRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Song          *Song                  `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSongResponse) Reset() {
-	*x = GetSongResponse{}
+func (x *GetUnitRequest) Reset() {
+	*x = GetUnitRequest{}
 	mi := &file_master_master_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSongResponse) String() string {
+func (x *GetUnitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSongResponse) ProtoMessage() {}
+func (*GetUnitRequest) ProtoMessage() {}
 
-func (x *GetSongResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUnitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1054,49 +1058,39 @@ func (x *GetSongResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSongResponse.ProtoReflect.Descriptor instead.
-func (*GetSongResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUnitRequest.ProtoReflect.Descriptor instead.
+func (*GetUnitRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetSongResponse) GetSong() *Song {
+func (x *GetUnitRequest) GetId() int32 {
 	if x != nil {
-		return x.Song
+		return x.Id
 	}
-	return nil
-}
-
-type CreateSongRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Kana            string                 `protobuf:"bytes,2,opt,name=kana,proto3" json:"kana,omitempty"`
-	LyricsId        int32                  `protobuf:"varint,3,opt,name=lyrics_id,json=lyricsId,proto3" json:"lyrics_id,omitempty"`
-	MusicId         int32                  `protobuf:"varint,4,opt,name=music_id,json=musicId,proto3" json:"music_id,omitempty"`
-	ArrangementId   int32                  `protobuf:"varint,5,opt,name=arrangement_id,json=arrangementId,proto3" json:"arrangement_id,omitempty"`
-	Thumbnail       string                 `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	OriginalVideo   string                 `protobuf:"bytes,7,opt,name=original_video,json=originalVideo,proto3" json:"original_video,omitempty"`
-	ReleaseTime     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
-	Deleted         bool                   `protobuf:"varint,9,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	UnitIds         []int32                `protobuf:"varint,10,rep,packed,name=unit_ids,json=unitIds,proto3" json:"unit_ids,omitempty"`
-	MusicVideoTypes []enums.MusicVideoType `protobuf:"varint,11,rep,packed,name=music_video_types,json=musicVideoTypes,proto3,enum=enums.MusicVideoType" json:"music_video_types,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	return 0
 }
 
-func (x *CreateSongRequest) Reset() {
-	*x = CreateSongRequest{}
+type GetUnitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unit          *Unit                  `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnitResponse) Reset() {
+	*x = GetUnitResponse{}
 	mi := &file_master_master_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSongRequest) String() string {
+func (x *GetUnitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSongRequest) ProtoMessage() {}
+func (*GetUnitResponse) ProtoMessage() {}
 
-func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUnitResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1108,108 +1102,39 @@ func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSongRequest.ProtoReflect.Descriptor instead.
-func (*CreateSongRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUnitResponse.ProtoReflect.Descriptor instead.
+func (*GetUnitResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateSongRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateSongRequest) GetKana() string {
-	if x != nil {
-		return x.Kana
-	}
-	return ""
-}
-
-func (x *CreateSongRequest) GetLyricsId() int32 {
-	if x != nil {
-		return x.LyricsId
-	}
-	return 0
-}
-
-func (x *CreateSongRequest) GetMusicId() int32 {
-	if x != nil {
-		return x.MusicId
-	}
-	return 0
-}
-
-func (x *CreateSongRequest) GetArrangementId() int32 {
-	if x != nil {
-		return x.ArrangementId
-	}
-	return 0
-}
-
-func (x *CreateSongRequest) GetThumbnail() string {
-	if x != nil {
-		return x.Thumbnail
-	}
-	return ""
-}
-
-func (x *CreateSongRequest) GetOriginalVideo() string {
-	if x != nil {
-		return x.OriginalVideo
-	}
-	return ""
-}
-
-func (x *CreateSongRequest) GetReleaseTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ReleaseTime
-	}
-	return nil
-}
-
-func (x *CreateSongRequest) GetDeleted() bool {
-	if x != nil {
-		return x.Deleted
-	}
-	return false
-}
-
-func (x *CreateSongRequest) GetUnitIds() []int32 {
-	if x != nil {
-		return x.UnitIds
-	}
-	return nil
-}
-
-func (x *CreateSongRequest) GetMusicVideoTypes() []enums.MusicVideoType {
+func (x *GetUnitResponse) GetUnit() *Unit {
 	if x != nil {
-		return x.MusicVideoTypes
+		return x.Unit
 	}
 	return nil
 }
 
-type CreateSongResponse struct {
+type CreateUnitRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSongResponse) Reset() {
-	*x = CreateSongResponse{}
+func (x *CreateUnitRequest) Reset() {
+	*x = CreateUnitRequest{}
 	mi := &file_master_master_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSongResponse) String() string {
+func (x *CreateUnitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSongResponse) ProtoMessage() {}
+func (*CreateUnitRequest) ProtoMessage() {}
 
-func (x *CreateSongResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateUnitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1221,32 +1146,38 @@ func (x *CreateSongResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSongResponse.ProtoReflect.Descriptor instead.
-func (*CreateSongResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateUnitRequest.ProtoReflect.Descriptor instead.
+func (*CreateUnitRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{25}
 }
 
-// Chart
-type GetChartsRequest struct {
+func (x *CreateUnitRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateUnitResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChartsRequest) Reset() {
-	*x = GetChartsRequest{}
+func (x *CreateUnitResponse) Reset() {
+	*x = CreateUnitResponse{}
 	mi := &file_master_master_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChartsRequest) String() string {
+func (x *CreateUnitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChartsRequest) ProtoMessage() {}
+func (*CreateUnitResponse) ProtoMessage() {}
 
-func (x *GetChartsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateUnitResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1258,32 +1189,33 @@ func (x *GetChartsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChartsRequest.ProtoReflect.Descriptor instead.
-func (*GetChartsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateUnitResponse.ProtoReflect.Descriptor instead.
+func (*CreateUnitResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{26}
 }
 
-type GetChartsResponse struct {
+type UpdateUnitRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Charts        []*Chart               `protobuf:"bytes,1,rep,name=charts,proto3" json:"charts,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChartsResponse) Reset() {
-	*x = GetChartsResponse{}
+func (x *UpdateUnitRequest) Reset() {
+	*x = UpdateUnitRequest{}
 	mi := &file_master_master_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChartsResponse) String() string {
+func (x *UpdateUnitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChartsResponse) ProtoMessage() {}
+func (*UpdateUnitRequest) ProtoMessage() {}
 
-func (x *GetChartsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateUnitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1295,39 +1227,45 @@ func (x *GetChartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChartsResponse.ProtoReflect.Descriptor instead.
-func (*GetChartsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUnitRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUnitRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetChartsResponse) GetCharts() []*Chart {
+func (x *UpdateUnitRequest) GetId() int32 {
 	if x != nil {
-		return x.Charts
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type GetChartRequest struct {
+func (x *UpdateUnitRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateUnitResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChartRequest) Reset() {
-	*x = GetChartRequest{}
+func (x *UpdateUnitResponse) Reset() {
+	*x = UpdateUnitResponse{}
 	mi := &file_master_master_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChartRequest) String() string {
+func (x *UpdateUnitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChartRequest) ProtoMessage() {}
+func (*UpdateUnitResponse) ProtoMessage() {}
 
-func (x *GetChartRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateUnitResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1339,39 +1277,35 @@ func (x *GetChartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChartRequest.ProtoReflect.Descriptor instead.
-func (*GetChartRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUnitResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUnitResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetChartRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type GetChartResponse struct {
+// UnitSinger
+type CreateUnitSingerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Chart         *Chart                 `protobuf:"bytes,1,opt,name=chart,proto3" json:"chart,omitempty"`
+	UnitId        int32                  `protobuf:"varint,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	SingerId      int32                  `protobuf:"varint,2,opt,name=singer_id,json=singerId,proto3" json:"singer_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChartResponse) Reset() {
-	*x = GetChartResponse{}
+func (x *CreateUnitSingerRequest) Reset() {
+	*x = CreateUnitSingerRequest{}
 	mi := &file_master_master_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChartResponse) String() string {
+func (x *CreateUnitSingerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChartResponse) ProtoMessage() {}
+func (*CreateUnitSingerRequest) ProtoMessage() {}
 
-func (x *GetChartResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateUnitSingerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1383,42 +1317,52 @@ func (x *GetChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChartResponse.ProtoReflect.Descriptor instead.
-func (*GetChartResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateUnitSingerRequest.ProtoReflect.Descriptor instead.
+func (*CreateUnitSingerRequest) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetChartResponse) GetChart() *Chart {
+func (x *CreateUnitSingerRequest) GetUnitId() int32 {
 	if x != nil {
-		return x.Chart
+		return x.UnitId
 	}
-	return nil
+	return 0
 }
 
-type CreateChartRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SongId         int32                  `protobuf:"varint,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
-	DifficultyType enums.DifficultyType   `protobuf:"varint,2,opt,name=difficulty_type,json=difficultyType,proto3,enum=enums.DifficultyType" json:"difficulty_type,omitempty"`
-	Level          int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
-	ChartViewLink  string                 `protobuf:"bytes,4,opt,name=chart_view_link,json=chartViewLink,proto3" json:"chart_view_link,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CreateUnitSingerRequest) GetSingerId() int32 {
+	if x != nil {
+		return x.SingerId
+	}
+	return 0
 }
 
-func (x *CreateChartRequest) Reset() {
-	*x = CreateChartRequest{}
+func (x *CreateUnitSingerRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type CreateUnitSingerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUnitSingerResponse) Reset() {
+	*x = CreateUnitSingerResponse{}
 	mi := &file_master_master_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateChartRequest) String() string {
+func (x *CreateUnitSingerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChartRequest) ProtoMessage() {}
+func (*CreateUnitSingerResponse) ProtoMessage() {}
 
-func (x *CreateChartRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateUnitSingerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_master_master_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1430,60 +1374,92 @@ func (x *CreateChartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChartRequest.ProtoReflect.Descriptor instead.
-func (*CreateChartRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateUnitSingerResponse.ProtoReflect.Descriptor instead.
+func (*CreateUnitSingerResponse) Descriptor() ([]byte, []int) {
 	return file_master_master_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CreateChartRequest) GetSongId() int32 {
+type UpdateUnitSingerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnitId        int32                  `protobuf:"varint,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	SingerId      int32                  `protobuf:"varint,2,opt,name=singer_id,json=singerId,proto3" json:"singer_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUnitSingerRequest) Reset() {
+	*x = UpdateUnitSingerRequest{}
+	mi := &file_master_master_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUnitSingerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUnitSingerRequest) ProtoMessage() {}
+
+func (x *UpdateUnitSingerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[31]
 	if x != nil {
-		return x.SongId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateChartRequest) GetDifficultyType() enums.DifficultyType {
+// Deprecated: Use UpdateUnitSingerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUnitSingerRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateUnitSingerRequest) GetUnitId() int32 {
 	if x != nil {
-		return x.DifficultyType
+		return x.UnitId
 	}
-	return enums.DifficultyType(0)
+	return 0
 }
 
-func (x *CreateChartRequest) GetLevel() int32 {
+func (x *UpdateUnitSingerRequest) GetSingerId() int32 {
 	if x != nil {
-		return x.Level
+		return x.SingerId
 	}
 	return 0
 }
 
-func (x *CreateChartRequest) GetChartViewLink() string {
+func (x *UpdateUnitSingerRequest) GetPosition() int32 {
 	if x != nil {
-		return x.ChartViewLink
+		return x.Position
 	}
-	return ""
+	return 0
 }
 
-type CreateChartResponse struct {
+type UpdateUnitSingerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateChartResponse) Reset() {
-	*x = CreateChartResponse{}
-	mi := &file_master_master_proto_msgTypes[31]
+func (x *UpdateUnitSingerResponse) Reset() {
+	*x = UpdateUnitSingerResponse{}
+	mi := &file_master_master_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateChartResponse) String() string {
+func (x *UpdateUnitSingerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChartResponse) ProtoMessage() {}
+func (*UpdateUnitSingerResponse) ProtoMessage() {}
 
-func (x *CreateChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_master_master_proto_msgTypes[31]
+func (x *UpdateUnitSingerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1494,9 +1470,3069 @@ func (x *CreateChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChartResponse.ProtoReflect.Descriptor instead.
+// Deprecated: Use UpdateUnitSingerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUnitSingerResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{32}
+}
+
+type DeleteUnitSingerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnitId        int32                  `protobuf:"varint,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	SingerId      int32                  `protobuf:"varint,2,opt,name=singer_id,json=singerId,proto3" json:"singer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUnitSingerRequest) Reset() {
+	*x = DeleteUnitSingerRequest{}
+	mi := &file_master_master_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUnitSingerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUnitSingerRequest) ProtoMessage() {}
+
+func (x *DeleteUnitSingerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUnitSingerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUnitSingerRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteUnitSingerRequest) GetUnitId() int32 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+func (x *DeleteUnitSingerRequest) GetSingerId() int32 {
+	if x != nil {
+		return x.SingerId
+	}
+	return 0
+}
+
+type DeleteUnitSingerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUnitSingerResponse) Reset() {
+	*x = DeleteUnitSingerResponse{}
+	mi := &file_master_master_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUnitSingerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUnitSingerResponse) ProtoMessage() {}
+
+func (x *DeleteUnitSingerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUnitSingerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUnitSingerResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{34}
+}
+
+// VocalPattern
+type GetVocalPatternsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0の場合は全件
+	SongId        int32 `protobuf:"varint,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVocalPatternsRequest) Reset() {
+	*x = GetVocalPatternsRequest{}
+	mi := &file_master_master_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVocalPatternsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVocalPatternsRequest) ProtoMessage() {}
+
+func (x *GetVocalPatternsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVocalPatternsRequest.ProtoReflect.Descriptor instead.
+func (*GetVocalPatternsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetVocalPatternsRequest) GetSongId() int32 {
+	if x != nil {
+		return x.SongId
+	}
+	return 0
+}
+
+type GetVocalPatternsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VocalPatterns []*VocalPattern        `protobuf:"bytes,1,rep,name=vocal_patterns,json=vocalPatterns,proto3" json:"vocal_patterns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVocalPatternsResponse) Reset() {
+	*x = GetVocalPatternsResponse{}
+	mi := &file_master_master_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVocalPatternsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVocalPatternsResponse) ProtoMessage() {}
+
+func (x *GetVocalPatternsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVocalPatternsResponse.ProtoReflect.Descriptor instead.
+func (*GetVocalPatternsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetVocalPatternsResponse) GetVocalPatterns() []*VocalPattern {
+	if x != nil {
+		return x.VocalPatterns
+	}
+	return nil
+}
+
+type GetVocalPatternRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVocalPatternRequest) Reset() {
+	*x = GetVocalPatternRequest{}
+	mi := &file_master_master_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVocalPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVocalPatternRequest) ProtoMessage() {}
+
+func (x *GetVocalPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVocalPatternRequest.ProtoReflect.Descriptor instead.
+func (*GetVocalPatternRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetVocalPatternRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetVocalPatternResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VocalPattern  *VocalPattern          `protobuf:"bytes,1,opt,name=vocal_pattern,json=vocalPattern,proto3" json:"vocal_pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVocalPatternResponse) Reset() {
+	*x = GetVocalPatternResponse{}
+	mi := &file_master_master_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVocalPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVocalPatternResponse) ProtoMessage() {}
+
+func (x *GetVocalPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVocalPatternResponse.ProtoReflect.Descriptor instead.
+func (*GetVocalPatternResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetVocalPatternResponse) GetVocalPattern() *VocalPattern {
+	if x != nil {
+		return x.VocalPattern
+	}
+	return nil
+}
+
+type CreateVocalPatternRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SongId          int32                  `protobuf:"varint,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SingerIds       []int32                `protobuf:"varint,3,rep,packed,name=singer_ids,json=singerIds,proto3" json:"singer_ids,omitempty"`
+	SingerPositions []int32                `protobuf:"varint,4,rep,packed,name=singer_positions,json=singerPositions,proto3" json:"singer_positions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateVocalPatternRequest) Reset() {
+	*x = CreateVocalPatternRequest{}
+	mi := &file_master_master_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVocalPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVocalPatternRequest) ProtoMessage() {}
+
+func (x *CreateVocalPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVocalPatternRequest.ProtoReflect.Descriptor instead.
+func (*CreateVocalPatternRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateVocalPatternRequest) GetSongId() int32 {
+	if x != nil {
+		return x.SongId
+	}
+	return 0
+}
+
+func (x *CreateVocalPatternRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVocalPatternRequest) GetSingerIds() []int32 {
+	if x != nil {
+		return x.SingerIds
+	}
+	return nil
+}
+
+func (x *CreateVocalPatternRequest) GetSingerPositions() []int32 {
+	if x != nil {
+		return x.SingerPositions
+	}
+	return nil
+}
+
+type CreateVocalPatternResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVocalPatternResponse) Reset() {
+	*x = CreateVocalPatternResponse{}
+	mi := &file_master_master_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVocalPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVocalPatternResponse) ProtoMessage() {}
+
+func (x *CreateVocalPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVocalPatternResponse.ProtoReflect.Descriptor instead.
+func (*CreateVocalPatternResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{40}
+}
+
+type UpdateVocalPatternRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SingerIds       []int32                `protobuf:"varint,3,rep,packed,name=singer_ids,json=singerIds,proto3" json:"singer_ids,omitempty"`
+	SingerPositions []int32                `protobuf:"varint,4,rep,packed,name=singer_positions,json=singerPositions,proto3" json:"singer_positions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateVocalPatternRequest) Reset() {
+	*x = UpdateVocalPatternRequest{}
+	mi := &file_master_master_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVocalPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVocalPatternRequest) ProtoMessage() {}
+
+func (x *UpdateVocalPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVocalPatternRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVocalPatternRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateVocalPatternRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateVocalPatternRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateVocalPatternRequest) GetSingerIds() []int32 {
+	if x != nil {
+		return x.SingerIds
+	}
+	return nil
+}
+
+func (x *UpdateVocalPatternRequest) GetSingerPositions() []int32 {
+	if x != nil {
+		return x.SingerPositions
+	}
+	return nil
+}
+
+type UpdateVocalPatternResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVocalPatternResponse) Reset() {
+	*x = UpdateVocalPatternResponse{}
+	mi := &file_master_master_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVocalPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVocalPatternResponse) ProtoMessage() {}
+
+func (x *UpdateVocalPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVocalPatternResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVocalPatternResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{42}
+}
+
+type DeleteVocalPatternRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVocalPatternRequest) Reset() {
+	*x = DeleteVocalPatternRequest{}
+	mi := &file_master_master_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVocalPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVocalPatternRequest) ProtoMessage() {}
+
+func (x *DeleteVocalPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVocalPatternRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVocalPatternRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteVocalPatternRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteVocalPatternResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVocalPatternResponse) Reset() {
+	*x = DeleteVocalPatternResponse{}
+	mi := &file_master_master_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVocalPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVocalPatternResponse) ProtoMessage() {}
+
+func (x *DeleteVocalPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVocalPatternResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVocalPatternResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{44}
+}
+
+// Song
+type GetSongsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 削除済みの曲も含める 管理者のみ
+	IncludeDeleted bool `protobuf:"varint,1,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSongsRequest) Reset() {
+	*x = GetSongsRequest{}
+	mi := &file_master_master_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongsRequest) ProtoMessage() {}
+
+func (x *GetSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongsRequest.ProtoReflect.Descriptor instead.
+func (*GetSongsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetSongsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetSongsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Songs         []*Song                `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSongsResponse) Reset() {
+	*x = GetSongsResponse{}
+	mi := &file_master_master_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongsResponse) ProtoMessage() {}
+
+func (x *GetSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongsResponse.ProtoReflect.Descriptor instead.
+func (*GetSongsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetSongsResponse) GetSongs() []*Song {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+type GetSongRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSongRequest) Reset() {
+	*x = GetSongRequest{}
+	mi := &file_master_master_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongRequest) ProtoMessage() {}
+
+func (x *GetSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongRequest.ProtoReflect.Descriptor instead.
+func (*GetSongRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSongRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSongResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Song          *Song                  `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSongResponse) Reset() {
+	*x = GetSongResponse{}
+	mi := &file_master_master_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongResponse) ProtoMessage() {}
+
+func (x *GetSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongResponse.ProtoReflect.Descriptor instead.
+func (*GetSongResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetSongResponse) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+// 同じ役割のクレジットは並べた順になる 作詞・作曲・編曲はそれぞれ1人以上必要
+type SongCreditInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtistId      int32                  `protobuf:"varint,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	Role          enums.CreditRole       `protobuf:"varint,2,opt,name=role,proto3,enum=enums.CreditRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SongCreditInput) Reset() {
+	*x = SongCreditInput{}
+	mi := &file_master_master_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongCreditInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongCreditInput) ProtoMessage() {}
+
+func (x *SongCreditInput) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongCreditInput.ProtoReflect.Descriptor instead.
+func (*SongCreditInput) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SongCreditInput) GetArtistId() int32 {
+	if x != nil {
+		return x.ArtistId
+	}
+	return 0
+}
+
+func (x *SongCreditInput) GetRole() enums.CreditRole {
+	if x != nil {
+		return x.Role
+	}
+	return enums.CreditRole(0)
+}
+
+type CreateSongRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kana            string                 `protobuf:"bytes,2,opt,name=kana,proto3" json:"kana,omitempty"`
+	Thumbnail       string                 `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	OriginalVideo   string                 `protobuf:"bytes,7,opt,name=original_video,json=originalVideo,proto3" json:"original_video,omitempty"`
+	ReleaseTime     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
+	Deleted         bool                   `protobuf:"varint,9,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	UnitIds         []int32                `protobuf:"varint,10,rep,packed,name=unit_ids,json=unitIds,proto3" json:"unit_ids,omitempty"`
+	MusicVideoTypes []enums.MusicVideoType `protobuf:"varint,11,rep,packed,name=music_video_types,json=musicVideoTypes,proto3,enum=enums.MusicVideoType" json:"music_video_types,omitempty"`
+	Credits         []*SongCreditInput     `protobuf:"bytes,12,rep,name=credits,proto3" json:"credits,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateSongRequest) Reset() {
+	*x = CreateSongRequest{}
+	mi := &file_master_master_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSongRequest) ProtoMessage() {}
+
+func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSongRequest.ProtoReflect.Descriptor instead.
+func (*CreateSongRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateSongRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSongRequest) GetKana() string {
+	if x != nil {
+		return x.Kana
+	}
+	return ""
+}
+
+func (x *CreateSongRequest) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
+func (x *CreateSongRequest) GetOriginalVideo() string {
+	if x != nil {
+		return x.OriginalVideo
+	}
+	return ""
+}
+
+func (x *CreateSongRequest) GetReleaseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseTime
+	}
+	return nil
+}
+
+func (x *CreateSongRequest) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *CreateSongRequest) GetUnitIds() []int32 {
+	if x != nil {
+		return x.UnitIds
+	}
+	return nil
+}
+
+func (x *CreateSongRequest) GetMusicVideoTypes() []enums.MusicVideoType {
+	if x != nil {
+		return x.MusicVideoTypes
+	}
+	return nil
+}
+
+func (x *CreateSongRequest) GetCredits() []*SongCreditInput {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+type CreateSongResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSongResponse) Reset() {
+	*x = CreateSongResponse{}
+	mi := &file_master_master_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSongResponse) ProtoMessage() {}
+
+func (x *CreateSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSongResponse.ProtoReflect.Descriptor instead.
+func (*CreateSongResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{51}
+}
+
+type UpdateSongRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kana            string                 `protobuf:"bytes,3,opt,name=kana,proto3" json:"kana,omitempty"`
+	Thumbnail       string                 `protobuf:"bytes,7,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	OriginalVideo   string                 `protobuf:"bytes,8,opt,name=original_video,json=originalVideo,proto3" json:"original_video,omitempty"`
+	ReleaseTime     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
+	Deleted         bool                   `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	UnitIds         []int32                `protobuf:"varint,11,rep,packed,name=unit_ids,json=unitIds,proto3" json:"unit_ids,omitempty"`
+	MusicVideoTypes []enums.MusicVideoType `protobuf:"varint,12,rep,packed,name=music_video_types,json=musicVideoTypes,proto3,enum=enums.MusicVideoType" json:"music_video_types,omitempty"`
+	Credits         []*SongCreditInput     `protobuf:"bytes,13,rep,name=credits,proto3" json:"credits,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateSongRequest) Reset() {
+	*x = UpdateSongRequest{}
+	mi := &file_master_master_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSongRequest) ProtoMessage() {}
+
+func (x *UpdateSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSongRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSongRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateSongRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSongRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSongRequest) GetKana() string {
+	if x != nil {
+		return x.Kana
+	}
+	return ""
+}
+
+func (x *UpdateSongRequest) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
+func (x *UpdateSongRequest) GetOriginalVideo() string {
+	if x != nil {
+		return x.OriginalVideo
+	}
+	return ""
+}
+
+func (x *UpdateSongRequest) GetReleaseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseTime
+	}
+	return nil
+}
+
+func (x *UpdateSongRequest) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *UpdateSongRequest) GetUnitIds() []int32 {
+	if x != nil {
+		return x.UnitIds
+	}
+	return nil
+}
+
+func (x *UpdateSongRequest) GetMusicVideoTypes() []enums.MusicVideoType {
+	if x != nil {
+		return x.MusicVideoTypes
+	}
+	return nil
+}
+
+func (x *UpdateSongRequest) GetCredits() []*SongCreditInput {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+type UpdateSongResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSongResponse) Reset() {
+	*x = UpdateSongResponse{}
+	mi := &file_master_master_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSongResponse) ProtoMessage() {}
+
+func (x *UpdateSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSongResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSongResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{53}
+}
+
+type DeleteSongRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSongRequest) Reset() {
+	*x = DeleteSongRequest{}
+	mi := &file_master_master_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSongRequest) ProtoMessage() {}
+
+func (x *DeleteSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSongRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSongRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteSongRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSongResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSongResponse) Reset() {
+	*x = DeleteSongResponse{}
+	mi := &file_master_master_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSongResponse) ProtoMessage() {}
+
+func (x *DeleteSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSongResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSongResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{55}
+}
+
+type SearchSongsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 曲名・読みの部分一致
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// 0の項目は絞り込みに使わない 作詞・作曲・編曲はクレジットのいずれかに含まれる曲
+	LyricsId       int32                  `protobuf:"varint,2,opt,name=lyrics_id,json=lyricsId,proto3" json:"lyrics_id,omitempty"`
+	MusicId        int32                  `protobuf:"varint,3,opt,name=music_id,json=musicId,proto3" json:"music_id,omitempty"`
+	ArrangementId  int32                  `protobuf:"varint,4,opt,name=arrangement_id,json=arrangementId,proto3" json:"arrangement_id,omitempty"`
+	UnitId         int32                  `protobuf:"varint,5,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	SingerId       int32                  `protobuf:"varint,6,opt,name=singer_id,json=singerId,proto3" json:"singer_id,omitempty"`
+	MusicVideoType enums.MusicVideoType   `protobuf:"varint,7,opt,name=music_video_type,json=musicVideoType,proto3,enum=enums.MusicVideoType" json:"music_video_type,omitempty"`
+	ReleaseFrom    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=release_from,json=releaseFrom,proto3" json:"release_from,omitempty"`
+	ReleaseTo      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=release_to,json=releaseTo,proto3" json:"release_to,omitempty"`
+	PageSize       int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// 削除済みの曲も含める 管理者のみ
+	IncludeDeleted bool `protobuf:"varint,12,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchSongsRequest) Reset() {
+	*x = SearchSongsRequest{}
+	mi := &file_master_master_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSongsRequest) ProtoMessage() {}
+
+func (x *SearchSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSongsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSongsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SearchSongsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchSongsRequest) GetLyricsId() int32 {
+	if x != nil {
+		return x.LyricsId
+	}
+	return 0
+}
+
+func (x *SearchSongsRequest) GetMusicId() int32 {
+	if x != nil {
+		return x.MusicId
+	}
+	return 0
+}
+
+func (x *SearchSongsRequest) GetArrangementId() int32 {
+	if x != nil {
+		return x.ArrangementId
+	}
+	return 0
+}
+
+func (x *SearchSongsRequest) GetUnitId() int32 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+func (x *SearchSongsRequest) GetSingerId() int32 {
+	if x != nil {
+		return x.SingerId
+	}
+	return 0
+}
+
+func (x *SearchSongsRequest) GetMusicVideoType() enums.MusicVideoType {
+	if x != nil {
+		return x.MusicVideoType
+	}
+	return enums.MusicVideoType(0)
+}
+
+func (x *SearchSongsRequest) GetReleaseFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseFrom
+	}
+	return nil
+}
+
+func (x *SearchSongsRequest) GetReleaseTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseTo
+	}
+	return nil
+}
+
+func (x *SearchSongsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchSongsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchSongsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type SearchSongsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Songs         []*Song                `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSongsResponse) Reset() {
+	*x = SearchSongsResponse{}
+	mi := &file_master_master_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSongsResponse) ProtoMessage() {}
+
+func (x *SearchSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSongsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSongsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SearchSongsResponse) GetSongs() []*Song {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+func (x *SearchSongsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListSongReleasesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0なら絞り込まない
+	UnitId        int32 `protobuf:"varint,1,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSongReleasesRequest) Reset() {
+	*x = ListSongReleasesRequest{}
+	mi := &file_master_master_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSongReleasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSongReleasesRequest) ProtoMessage() {}
+
+func (x *ListSongReleasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSongReleasesRequest.ProtoReflect.Descriptor instead.
+func (*ListSongReleasesRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListSongReleasesRequest) GetUnitId() int32 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+type ListSongReleasesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 古い月から順
+	Months        []*SongReleaseMonth `protobuf:"bytes,1,rep,name=months,proto3" json:"months,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSongReleasesResponse) Reset() {
+	*x = ListSongReleasesResponse{}
+	mi := &file_master_master_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSongReleasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSongReleasesResponse) ProtoMessage() {}
+
+func (x *ListSongReleasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSongReleasesResponse.ProtoReflect.Descriptor instead.
+func (*ListSongReleasesResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListSongReleasesResponse) GetMonths() []*SongReleaseMonth {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+// Chart
+type GetChartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 削除済みの曲の譜面も含める 管理者のみ
+	IncludeDeleted bool `protobuf:"varint,1,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// 0の項目は絞り込みに使わない 絞り込む項目が未設定の譜面は除く
+	MinNoteCount int32 `protobuf:"varint,2,opt,name=min_note_count,json=minNoteCount,proto3" json:"min_note_count,omitempty"`
+	MaxNoteCount int32 `protobuf:"varint,3,opt,name=max_note_count,json=maxNoteCount,proto3" json:"max_note_count,omitempty"`
+	// BPMの範囲が重なる譜面を返す
+	MinBpm             int32   `protobuf:"varint,4,opt,name=min_bpm,json=minBpm,proto3" json:"min_bpm,omitempty"`
+	MaxBpm             int32   `protobuf:"varint,5,opt,name=max_bpm,json=maxBpm,proto3" json:"max_bpm,omitempty"`
+	MinDurationSeconds int32   `protobuf:"varint,6,opt,name=min_duration_seconds,json=minDurationSeconds,proto3" json:"min_duration_seconds,omitempty"`
+	MaxDurationSeconds int32   `protobuf:"varint,7,opt,name=max_duration_seconds,json=maxDurationSeconds,proto3" json:"max_duration_seconds,omitempty"`
+	MinConstant        float64 `protobuf:"fixed64,8,opt,name=min_constant,json=minConstant,proto3" json:"min_constant,omitempty"`
+	MaxConstant        float64 `protobuf:"fixed64,9,opt,name=max_constant,json=maxConstant,proto3" json:"max_constant,omitempty"`
+	// 未指定はID順 BPMはbpm_maxで並べる 並べる項目が未設定の譜面は最後にする
+	SortKey       enums.ChartSortKey `protobuf:"varint,10,opt,name=sort_key,json=sortKey,proto3,enum=enums.ChartSortKey" json:"sort_key,omitempty"`
+	Descending    bool               `protobuf:"varint,11,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChartsRequest) Reset() {
+	*x = GetChartsRequest{}
+	mi := &file_master_master_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartsRequest) ProtoMessage() {}
+
+func (x *GetChartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartsRequest.ProtoReflect.Descriptor instead.
+func (*GetChartsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetChartsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetChartsRequest) GetMinNoteCount() int32 {
+	if x != nil {
+		return x.MinNoteCount
+	}
+	return 0
+}
+
+func (x *GetChartsRequest) GetMaxNoteCount() int32 {
+	if x != nil {
+		return x.MaxNoteCount
+	}
+	return 0
+}
+
+func (x *GetChartsRequest) GetMinBpm() int32 {
+	if x != nil {
+		return x.MinBpm
+	}
+	return 0
+}
+
+func (x *GetChartsRequest) GetMaxBpm() int32 {
+	if x != nil {
+		return x.MaxBpm
+	}
+	return 0
+}
+
+func (x *GetChartsRequest) GetMinDurationSeconds() int32 {
+	if x != nil {
+		return x.MinDurationSeconds
+	}
+	return 0
+}
+
+func (x *GetChartsRequest) GetMaxDurationSeconds() int32 {
+	if x != nil {
+		return x.MaxDurationSeconds
+	}
+	return 0
+}
+
+func (x *GetChartsRequest) GetMinConstant() float64 {
+	if x != nil {
+		return x.MinConstant
+	}
+	return 0
+}
+
+func (x *GetChartsRequest) GetMaxConstant() float64 {
+	if x != nil {
+		return x.MaxConstant
+	}
+	return 0
+}
+
+func (x *GetChartsRequest) GetSortKey() enums.ChartSortKey {
+	if x != nil {
+		return x.SortKey
+	}
+	return enums.ChartSortKey(0)
+}
+
+func (x *GetChartsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type GetChartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Charts        []*Chart               `protobuf:"bytes,1,rep,name=charts,proto3" json:"charts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChartsResponse) Reset() {
+	*x = GetChartsResponse{}
+	mi := &file_master_master_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartsResponse) ProtoMessage() {}
+
+func (x *GetChartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartsResponse.ProtoReflect.Descriptor instead.
+func (*GetChartsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetChartsResponse) GetCharts() []*Chart {
+	if x != nil {
+		return x.Charts
+	}
+	return nil
+}
+
+type GetChartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 指定した場合はlevelをその時点のものにする
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChartRequest) Reset() {
+	*x = GetChartRequest{}
+	mi := &file_master_master_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartRequest) ProtoMessage() {}
+
+func (x *GetChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartRequest.ProtoReflect.Descriptor instead.
+func (*GetChartRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetChartRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetChartRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type GetChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chart         *Chart                 `protobuf:"bytes,1,opt,name=chart,proto3" json:"chart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChartResponse) Reset() {
+	*x = GetChartResponse{}
+	mi := &file_master_master_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartResponse) ProtoMessage() {}
+
+func (x *GetChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartResponse.ProtoReflect.Descriptor instead.
+func (*GetChartResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetChartResponse) GetChart() *Chart {
+	if x != nil {
+		return x.Chart
+	}
+	return nil
+}
+
+type CreateChartRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SongId         int32                  `protobuf:"varint,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	DifficultyType enums.DifficultyType   `protobuf:"varint,2,opt,name=difficulty_type,json=difficultyType,proto3,enum=enums.DifficultyType" json:"difficulty_type,omitempty"`
+	Level          int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	ChartViewLink  string                 `protobuf:"bytes,4,opt,name=chart_view_link,json=chartViewLink,proto3" json:"chart_view_link,omitempty"`
+	// 以下は任意 0は未設定
+	NoteCount int32 `protobuf:"varint,5,opt,name=note_count,json=noteCount,proto3" json:"note_count,omitempty"`
+	// BPMが一定の場合はどちらか片方だけでもよい
+	BpmMin          int32   `protobuf:"varint,6,opt,name=bpm_min,json=bpmMin,proto3" json:"bpm_min,omitempty"`
+	BpmMax          int32   `protobuf:"varint,7,opt,name=bpm_max,json=bpmMax,proto3" json:"bpm_max,omitempty"`
+	DurationSeconds int32   `protobuf:"varint,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Constant        float64 `protobuf:"fixed64,9,opt,name=constant,proto3" json:"constant,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateChartRequest) Reset() {
+	*x = CreateChartRequest{}
+	mi := &file_master_master_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChartRequest) ProtoMessage() {}
+
+func (x *CreateChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChartRequest.ProtoReflect.Descriptor instead.
+func (*CreateChartRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateChartRequest) GetSongId() int32 {
+	if x != nil {
+		return x.SongId
+	}
+	return 0
+}
+
+func (x *CreateChartRequest) GetDifficultyType() enums.DifficultyType {
+	if x != nil {
+		return x.DifficultyType
+	}
+	return enums.DifficultyType(0)
+}
+
+func (x *CreateChartRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *CreateChartRequest) GetChartViewLink() string {
+	if x != nil {
+		return x.ChartViewLink
+	}
+	return ""
+}
+
+func (x *CreateChartRequest) GetNoteCount() int32 {
+	if x != nil {
+		return x.NoteCount
+	}
+	return 0
+}
+
+func (x *CreateChartRequest) GetBpmMin() int32 {
+	if x != nil {
+		return x.BpmMin
+	}
+	return 0
+}
+
+func (x *CreateChartRequest) GetBpmMax() int32 {
+	if x != nil {
+		return x.BpmMax
+	}
+	return 0
+}
+
+func (x *CreateChartRequest) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CreateChartRequest) GetConstant() float64 {
+	if x != nil {
+		return x.Constant
+	}
+	return 0
+}
+
+type CreateChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChartResponse) Reset() {
+	*x = CreateChartResponse{}
+	mi := &file_master_master_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChartResponse) ProtoMessage() {}
+
+func (x *CreateChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChartResponse.ProtoReflect.Descriptor instead.
 func (*CreateChartResponse) Descriptor() ([]byte, []int) {
-	return file_master_master_proto_rawDescGZIP(), []int{31}
+	return file_master_master_proto_rawDescGZIP(), []int{65}
+}
+
+type UpdateChartRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SongId         int32                  `protobuf:"varint,2,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	DifficultyType enums.DifficultyType   `protobuf:"varint,3,opt,name=difficulty_type,json=difficultyType,proto3,enum=enums.DifficultyType" json:"difficulty_type,omitempty"`
+	Level          int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	ChartViewLink  string                 `protobuf:"bytes,5,opt,name=chart_view_link,json=chartViewLink,proto3" json:"chart_view_link,omitempty"`
+	// 以下は任意 0は未設定
+	NoteCount int32 `protobuf:"varint,6,opt,name=note_count,json=noteCount,proto3" json:"note_count,omitempty"`
+	// BPMが一定の場合はどちらか片方だけでもよい
+	BpmMin          int32   `protobuf:"varint,7,opt,name=bpm_min,json=bpmMin,proto3" json:"bpm_min,omitempty"`
+	BpmMax          int32   `protobuf:"varint,8,opt,name=bpm_max,json=bpmMax,proto3" json:"bpm_max,omitempty"`
+	DurationSeconds int32   `protobuf:"varint,9,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Constant        float64 `protobuf:"fixed64,10,opt,name=constant,proto3" json:"constant,omitempty"`
+	// レベルの変更を適用する日時 未指定は現在時刻 未来の日時は指定できない
+	LevelEffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=level_effective_from,json=levelEffectiveFrom,proto3" json:"level_effective_from,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateChartRequest) Reset() {
+	*x = UpdateChartRequest{}
+	mi := &file_master_master_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChartRequest) ProtoMessage() {}
+
+func (x *UpdateChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChartRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChartRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateChartRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateChartRequest) GetSongId() int32 {
+	if x != nil {
+		return x.SongId
+	}
+	return 0
+}
+
+func (x *UpdateChartRequest) GetDifficultyType() enums.DifficultyType {
+	if x != nil {
+		return x.DifficultyType
+	}
+	return enums.DifficultyType(0)
+}
+
+func (x *UpdateChartRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *UpdateChartRequest) GetChartViewLink() string {
+	if x != nil {
+		return x.ChartViewLink
+	}
+	return ""
+}
+
+func (x *UpdateChartRequest) GetNoteCount() int32 {
+	if x != nil {
+		return x.NoteCount
+	}
+	return 0
+}
+
+func (x *UpdateChartRequest) GetBpmMin() int32 {
+	if x != nil {
+		return x.BpmMin
+	}
+	return 0
+}
+
+func (x *UpdateChartRequest) GetBpmMax() int32 {
+	if x != nil {
+		return x.BpmMax
+	}
+	return 0
+}
+
+func (x *UpdateChartRequest) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *UpdateChartRequest) GetConstant() float64 {
+	if x != nil {
+		return x.Constant
+	}
+	return 0
+}
+
+func (x *UpdateChartRequest) GetLevelEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LevelEffectiveFrom
+	}
+	return nil
+}
+
+type UpdateChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChartResponse) Reset() {
+	*x = UpdateChartResponse{}
+	mi := &file_master_master_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChartResponse) ProtoMessage() {}
+
+func (x *UpdateChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChartResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChartResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{67}
+}
+
+type DeleteChartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChartRequest) Reset() {
+	*x = DeleteChartRequest{}
+	mi := &file_master_master_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChartRequest) ProtoMessage() {}
+
+func (x *DeleteChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChartRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChartRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteChartRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChartResponse) Reset() {
+	*x = DeleteChartResponse{}
+	mi := &file_master_master_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChartResponse) ProtoMessage() {}
+
+func (x *DeleteChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChartResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChartResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{69}
+}
+
+type GetChartHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChartId       int32                  `protobuf:"varint,1,opt,name=chart_id,json=chartId,proto3" json:"chart_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChartHistoryRequest) Reset() {
+	*x = GetChartHistoryRequest{}
+	mi := &file_master_master_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChartHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartHistoryRequest) ProtoMessage() {}
+
+func (x *GetChartHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetChartHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetChartHistoryRequest) GetChartId() int32 {
+	if x != nil {
+		return x.ChartId
+	}
+	return 0
+}
+
+type GetChartHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// effective_fromの順
+	Histories     []*ChartLevelHistory `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChartHistoryResponse) Reset() {
+	*x = GetChartHistoryResponse{}
+	mi := &file_master_master_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChartHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartHistoryResponse) ProtoMessage() {}
+
+func (x *GetChartHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetChartHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetChartHistoryResponse) GetHistories() []*ChartLevelHistory {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+type SearchChartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 曲名・読みの部分一致
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// 空・0の項目は絞り込みに使わない
+	DifficultyTypes []enums.DifficultyType `protobuf:"varint,2,rep,packed,name=difficulty_types,json=difficultyTypes,proto3,enum=enums.DifficultyType" json:"difficulty_types,omitempty"`
+	MinLevel        int32                  `protobuf:"varint,3,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	MaxLevel        int32                  `protobuf:"varint,4,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	UnitIds         []int32                `protobuf:"varint,5,rep,packed,name=unit_ids,json=unitIds,proto3" json:"unit_ids,omitempty"`
+	SingerIds       []int32                `protobuf:"varint,6,rep,packed,name=singer_ids,json=singerIds,proto3" json:"singer_ids,omitempty"`
+	ReleaseFrom     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=release_from,json=releaseFrom,proto3" json:"release_from,omitempty"`
+	ReleaseTo       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=release_to,json=releaseTo,proto3" json:"release_to,omitempty"`
+	PageSize        int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// 削除済みの曲の譜面も含める 管理者のみ
+	IncludeDeleted bool `protobuf:"varint,11,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchChartsRequest) Reset() {
+	*x = SearchChartsRequest{}
+	mi := &file_master_master_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchChartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchChartsRequest) ProtoMessage() {}
+
+func (x *SearchChartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchChartsRequest.ProtoReflect.Descriptor instead.
+func (*SearchChartsRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SearchChartsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchChartsRequest) GetDifficultyTypes() []enums.DifficultyType {
+	if x != nil {
+		return x.DifficultyTypes
+	}
+	return nil
+}
+
+func (x *SearchChartsRequest) GetMinLevel() int32 {
+	if x != nil {
+		return x.MinLevel
+	}
+	return 0
+}
+
+func (x *SearchChartsRequest) GetMaxLevel() int32 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *SearchChartsRequest) GetUnitIds() []int32 {
+	if x != nil {
+		return x.UnitIds
+	}
+	return nil
+}
+
+func (x *SearchChartsRequest) GetSingerIds() []int32 {
+	if x != nil {
+		return x.SingerIds
+	}
+	return nil
+}
+
+func (x *SearchChartsRequest) GetReleaseFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseFrom
+	}
+	return nil
+}
+
+func (x *SearchChartsRequest) GetReleaseTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseTo
+	}
+	return nil
+}
+
+func (x *SearchChartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchChartsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchChartsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type SearchChartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Charts        []*Chart               `protobuf:"bytes,1,rep,name=charts,proto3" json:"charts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchChartsResponse) Reset() {
+	*x = SearchChartsResponse{}
+	mi := &file_master_master_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchChartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchChartsResponse) ProtoMessage() {}
+
+func (x *SearchChartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchChartsResponse.ProtoReflect.Descriptor instead.
+func (*SearchChartsResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SearchChartsResponse) GetCharts() []*Chart {
+	if x != nil {
+		return x.Charts
+	}
+	return nil
+}
+
+func (x *SearchChartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchChartsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// MasterImport
+type MasterChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// artist, singer, unit, song, vocal_pattern, chart
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 自然キー 曲に属するものは"曲名/名前"
+	Key       string                      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Operation enums.MasterChangeOperation `protobuf:"varint,3,opt,name=operation,proto3,enum=enums.MasterChangeOperation" json:"operation,omitempty"`
+	// 更新される項目
+	Fields        []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MasterChange) Reset() {
+	*x = MasterChange{}
+	mi := &file_master_master_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MasterChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MasterChange) ProtoMessage() {}
+
+func (x *MasterChange) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MasterChange.ProtoReflect.Descriptor instead.
+func (*MasterChange) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MasterChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MasterChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MasterChange) GetOperation() enums.MasterChangeOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return enums.MasterChangeOperation(0)
+}
+
+func (x *MasterChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ImportMasterRequest struct {
+	state  protoimpl.MessageState   `protogen:"open.v1"`
+	Format enums.MasterBundleFormat `protobuf:"varint,1,opt,name=format,proto3,enum=enums.MasterBundleFormat" json:"format,omitempty"`
+	Data   []byte                   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// trueの場合は差分を返すだけで反映しない
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMasterRequest) Reset() {
+	*x = ImportMasterRequest{}
+	mi := &file_master_master_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMasterRequest) ProtoMessage() {}
+
+func (x *ImportMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMasterRequest.ProtoReflect.Descriptor instead.
+func (*ImportMasterRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ImportMasterRequest) GetFormat() enums.MasterBundleFormat {
+	if x != nil {
+		return x.Format
+	}
+	return enums.MasterBundleFormat(0)
+}
+
+func (x *ImportMasterRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportMasterRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportMasterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*MasterChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Applied       bool                   `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMasterResponse) Reset() {
+	*x = ImportMasterResponse{}
+	mi := &file_master_master_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMasterResponse) ProtoMessage() {}
+
+func (x *ImportMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMasterResponse.ProtoReflect.Descriptor instead.
+func (*ImportMasterResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ImportMasterResponse) GetChanges() []*MasterChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ImportMasterResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type ExportMasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMasterRequest) Reset() {
+	*x = ExportMasterRequest{}
+	mi := &file_master_master_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMasterRequest) ProtoMessage() {}
+
+func (x *ExportMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMasterRequest.ProtoReflect.Descriptor instead.
+func (*ExportMasterRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{77}
+}
+
+type ExportMasterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// JSON形式のバンドル
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMasterResponse) Reset() {
+	*x = ExportMasterResponse{}
+	mi := &file_master_master_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMasterResponse) ProtoMessage() {}
+
+func (x *ExportMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMasterResponse.ProtoReflect.Descriptor instead.
+func (*ExportMasterResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ExportMasterResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// MasterRevision
+type GetMasterVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMasterVersionRequest) Reset() {
+	*x = GetMasterVersionRequest{}
+	mi := &file_master_master_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMasterVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMasterVersionRequest) ProtoMessage() {}
+
+func (x *GetMasterVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMasterVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetMasterVersionRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{79}
+}
+
+type GetMasterVersionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// マスタを書き換えるたびに増える版数
+	Revision      int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMasterVersionResponse) Reset() {
+	*x = GetMasterVersionResponse{}
+	mi := &file_master_master_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMasterVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMasterVersionResponse) ProtoMessage() {}
+
+func (x *GetMasterVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMasterVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetMasterVersionResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetMasterVersionResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetMasterDeltaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0の場合は全件
+	SinceRevision int64 `protobuf:"varint,1,opt,name=since_revision,json=sinceRevision,proto3" json:"since_revision,omitempty"`
+	// 削除済みの曲・譜面も含める 管理者のみ
+	IncludeDeleted bool `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMasterDeltaRequest) Reset() {
+	*x = GetMasterDeltaRequest{}
+	mi := &file_master_master_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMasterDeltaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMasterDeltaRequest) ProtoMessage() {}
+
+func (x *GetMasterDeltaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMasterDeltaRequest.ProtoReflect.Descriptor instead.
+func (*GetMasterDeltaRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetMasterDeltaRequest) GetSinceRevision() int64 {
+	if x != nil {
+		return x.SinceRevision
+	}
+	return 0
+}
+
+func (x *GetMasterDeltaRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetMasterDeltaResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Revision int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// 追加・更新されたマスタ
+	Artists []*Artist `protobuf:"bytes,2,rep,name=artists,proto3" json:"artists,omitempty"`
+	Singers []*Singer `protobuf:"bytes,3,rep,name=singers,proto3" json:"singers,omitempty"`
+	Units   []*Unit   `protobuf:"bytes,4,rep,name=units,proto3" json:"units,omitempty"`
+	Songs   []*Song   `protobuf:"bytes,5,rep,name=songs,proto3" json:"songs,omitempty"`
+	Charts  []*Chart  `protobuf:"bytes,6,rep,name=charts,proto3" json:"charts,omitempty"`
+	// 削除されたマスタ
+	RemovedArtistIds []int32 `protobuf:"varint,7,rep,packed,name=removed_artist_ids,json=removedArtistIds,proto3" json:"removed_artist_ids,omitempty"`
+	RemovedSingerIds []int32 `protobuf:"varint,8,rep,packed,name=removed_singer_ids,json=removedSingerIds,proto3" json:"removed_singer_ids,omitempty"`
+	RemovedUnitIds   []int32 `protobuf:"varint,9,rep,packed,name=removed_unit_ids,json=removedUnitIds,proto3" json:"removed_unit_ids,omitempty"`
+	RemovedSongIds   []int32 `protobuf:"varint,10,rep,packed,name=removed_song_ids,json=removedSongIds,proto3" json:"removed_song_ids,omitempty"`
+	RemovedChartIds  []int32 `protobuf:"varint,11,rep,packed,name=removed_chart_ids,json=removedChartIds,proto3" json:"removed_chart_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetMasterDeltaResponse) Reset() {
+	*x = GetMasterDeltaResponse{}
+	mi := &file_master_master_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMasterDeltaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMasterDeltaResponse) ProtoMessage() {}
+
+func (x *GetMasterDeltaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMasterDeltaResponse.ProtoReflect.Descriptor instead.
+func (*GetMasterDeltaResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetMasterDeltaResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetMasterDeltaResponse) GetArtists() []*Artist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *GetMasterDeltaResponse) GetSingers() []*Singer {
+	if x != nil {
+		return x.Singers
+	}
+	return nil
+}
+
+func (x *GetMasterDeltaResponse) GetUnits() []*Unit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *GetMasterDeltaResponse) GetSongs() []*Song {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+func (x *GetMasterDeltaResponse) GetCharts() []*Chart {
+	if x != nil {
+		return x.Charts
+	}
+	return nil
+}
+
+func (x *GetMasterDeltaResponse) GetRemovedArtistIds() []int32 {
+	if x != nil {
+		return x.RemovedArtistIds
+	}
+	return nil
+}
+
+func (x *GetMasterDeltaResponse) GetRemovedSingerIds() []int32 {
+	if x != nil {
+		return x.RemovedSingerIds
+	}
+	return nil
+}
+
+func (x *GetMasterDeltaResponse) GetRemovedUnitIds() []int32 {
+	if x != nil {
+		return x.RemovedUnitIds
+	}
+	return nil
+}
+
+func (x *GetMasterDeltaResponse) GetRemovedSongIds() []int32 {
+	if x != nil {
+		return x.RemovedSongIds
+	}
+	return nil
+}
+
+func (x *GetMasterDeltaResponse) GetRemovedChartIds() []int32 {
+	if x != nil {
+		return x.RemovedChartIds
+	}
+	return nil
+}
+
+// MasterEvent
+type MasterEvent struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Kind          enums.MasterKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=enums.MasterKind" json:"kind,omitempty"`
+	Id            int32                       `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Operation     enums.MasterChangeOperation `protobuf:"varint,3,opt,name=operation,proto3,enum=enums.MasterChangeOperation" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MasterEvent) Reset() {
+	*x = MasterEvent{}
+	mi := &file_master_master_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MasterEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MasterEvent) ProtoMessage() {}
+
+func (x *MasterEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MasterEvent.ProtoReflect.Descriptor instead.
+func (*MasterEvent) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *MasterEvent) GetKind() enums.MasterKind {
+	if x != nil {
+		return x.Kind
+	}
+	return enums.MasterKind(0)
+}
+
+func (x *MasterEvent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MasterEvent) GetOperation() enums.MasterChangeOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return enums.MasterChangeOperation(0)
+}
+
+type WatchMasterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 削除済みの曲・譜面の変更も送る 管理者のみ
+	IncludeDeleted bool `protobuf:"varint,1,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WatchMasterRequest) Reset() {
+	*x = WatchMasterRequest{}
+	mi := &file_master_master_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMasterRequest) ProtoMessage() {}
+
+func (x *WatchMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMasterRequest.ProtoReflect.Descriptor instead.
+func (*WatchMasterRequest) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *WatchMasterRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type WatchMasterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// この変更で進んだ後の版数
+	Revision      int64          `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Events        []*MasterEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchMasterResponse) Reset() {
+	*x = WatchMasterResponse{}
+	mi := &file_master_master_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMasterResponse) ProtoMessage() {}
+
+func (x *WatchMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_master_master_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMasterResponse.ProtoReflect.Descriptor instead.
+func (*WatchMasterResponse) Descriptor() ([]byte, []int) {
+	return file_master_master_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *WatchMasterResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *WatchMasterResponse) GetEvents() []*MasterEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
 }
 
 var File_master_master_proto protoreflect.FileDescriptor
@@ -1513,216 +4549,772 @@ var file_master_master_proto_rawDesc = string([]byte{
 	0x74, 0x65, 0x72, 0x2f, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x11, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x75, 0x6e, 0x69, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x72,
-	0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x73, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41,
-	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x61,
-	0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72,
-	0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04,
-	0x6b, 0x61, 0x6e, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72,
-	0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6b, 0x61, 0x6e, 0x61, 0x22, 0x16, 0x0a, 0x14,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x07, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x76,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5b, 0x0a, 0x0e, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x25, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0x22, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x55,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x6b, 0x61, 0x6e, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52,
+	0x04, 0x6b, 0x61, 0x6e, 0x61, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x04, 0x6b, 0x61, 0x6e, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6b, 0x61, 0x6e, 0x61, 0x22, 0x16, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x0a,
+	0x13, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x07, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x07,
+	0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x52, 0x07, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6e, 0x67,
-	0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x22, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x05, 0x75,
-	0x6e, 0x69, 0x74, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x6e, 0x69,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x33, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
-	0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x06,
-	0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x52, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x16, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff,
+	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x36, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55,
+	0x6e, 0x69, 0x74, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69,
+	0x74, 0x22, 0x33, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92,
-	0x01, 0x06, 0x22, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x49, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x10, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0c, 0xfa,
-	0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x69, 0x6e,
-	0x67, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1c, 0x0a, 0x1a,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65,
-	0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x05,
-	0x73, 0x6f, 0x6e, 0x67, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x6f,
-	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x22, 0x82, 0x04, 0x0a,
-	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x6b, 0x61, 0x6e, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6b, 0x61,
-	0x6e, 0x61, 0x12, 0x24, 0x0a, 0x09, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x08,
-	0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x08, 0x6d, 0x75, 0x73, 0x69,
-	0x63, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
-	0x02, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0e,
-	0x61, 0x72, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x0d, 0x61,
-	0x72, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x09,
-	0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e,
-	0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x12, 0x47, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52,
-	0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06,
-	0x22, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x73, 0x12,
-	0x50, 0x0a, 0x11, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x75,
-	0x6d, 0x73, 0x2e, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70,
-	0x65, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x92, 0x01, 0x07, 0x22, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
-	0x52, 0x0f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x68,
-	0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x25, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52,
-	0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x68,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
-	0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x05, 0x63, 0x68,
-	0x61, 0x72, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x6f,
-	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x1a, 0x02, 0x28, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x0f,
-	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x44, 0x69,
-	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
-	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x10, 0x64, 0x28, 0x01,
-	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x74,
-	0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x74,
-	0x56, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
-	0xd9, 0x08, 0x0a, 0x0d, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12,
-	0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x86, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x53,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07,
+	0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x24,
+	0x0a, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x08, 0x73, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x06, 0x75, 0x6e, 0x69,
+	0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52,
+	0x08, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x1a, 0x02, 0x28, 0x01, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a,
+	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x17, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52,
+	0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x28, 0x01, 0x52, 0x08, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1a, 0x0a,
+	0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x06,
+	0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x63,
+	0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x76, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x52, 0x0d, 0x76, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22,
+	0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x76, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x52, 0x0c, 0x76, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22,
+	0xc3, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x1a, 0x02, 0x28,
+	0x01, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x10,
+	0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04,
+	0x1a, 0x02, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f,
+	0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x73, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0c,
+	0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x09, 0x73, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x10, 0x73, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x05, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52,
+	0x0f, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
+	0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f,
+	0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x36,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52,
+	0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
+	0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x73,
+	0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x22, 0x6a, 0x0a,
+	0x0f, 0x53, 0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x24, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x08, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10,
+	0x01, 0x20, 0x00, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xfc, 0x03, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x04, 0x6b, 0x61, 0x6e, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6b, 0x61, 0x6e, 0x61, 0x12,
+	0x25, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x74, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x47, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02,
+	0x08, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0c, 0xfa, 0x42, 0x09,
+	0x92, 0x01, 0x06, 0x22, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x49,
+	0x64, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x5f, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x92, 0x01, 0x07, 0x22, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x0f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08,
+	0x05, 0x10, 0x06, 0x52, 0x09, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x69, 0x64, 0x52, 0x08,
+	0x6d, 0x75, 0x73, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x52, 0x0e, 0x61, 0x72, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95,
+	0x04, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x04, 0x6b, 0x61, 0x6e, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6b, 0x61, 0x6e, 0x61, 0x12, 0x25, 0x0a,
+	0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62,
+	0x6e, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x12, 0x47, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01,
+	0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01,
+	0x06, 0x22, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x73,
+	0x12, 0x50, 0x0a, 0x11, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x2e, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79,
+	0x70, 0x65, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0x92, 0x01, 0x07, 0x22, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x0f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x6e,
+	0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x92, 0x01, 0x02, 0x08, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x4a,
+	0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10,
+	0x07, 0x52, 0x09, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x69, 0x64, 0x52, 0x08, 0x6d, 0x75,
+	0x73, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x52, 0x0e, 0x61, 0x72, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xab, 0x04, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x09, 0x6c, 0x79, 0x72, 0x69, 0x63,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x28, 0x00, 0x52, 0x08, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x08, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x07, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x49,
+	0x64, 0x12, 0x2e, 0x0a, 0x0e, 0x61, 0x72, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02,
+	0x28, 0x00, 0x52, 0x0d, 0x61, 0x72, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x06, 0x75, 0x6e, 0x69,
+	0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52,
+	0x08, 0x73, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x6d, 0x75, 0x73,
+	0x69, 0x63, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x4d, 0x75, 0x73, 0x69,
+	0x63, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74,
+	0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x12, 0x26,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x61,
+	0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f,
+	0x6e, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x3b, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07,
+	0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x22, 0x4c,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x22, 0x93, 0x04, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x0e, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
+	0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4e,
+	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f,
+	0x62, 0x70, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02,
+	0x28, 0x00, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x42, 0x70, 0x6d, 0x12, 0x20, 0x0a, 0x07, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x70, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x1a, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x42, 0x70, 0x6d, 0x12, 0x39, 0x0a, 0x14,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x28, 0x00, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x12,
+	0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x31, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b,
+	0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x6f, 0x72, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x3a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x22, 0x52,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x61, 0x73,
+	0x4f, 0x66, 0x22, 0x37, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43,
+	0x68, 0x61, 0x72, 0x74, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x22, 0xb4, 0x03, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x06, 0x73, 0x6f,
+	0x6e, 0x67, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x0f, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e,
+	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa,
+	0x42, 0x06, 0x1a, 0x04, 0x10, 0x64, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x2f, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x74, 0x56, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x2a, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x1a, 0x06, 0x18, 0xa0, 0x8d, 0x06, 0x28,
+	0x00, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x07,
+	0x62, 0x70, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x06, 0x62, 0x70, 0x6d, 0x4d, 0x69,
+	0x6e, 0x12, 0x23, 0x0a, 0x07, 0x62, 0x70, 0x6d, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x06,
+	0x62, 0x70, 0x6d, 0x4d, 0x61, 0x78, 0x12, 0x35, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0x90, 0x1c, 0x28, 0x00, 0x52, 0x0f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x04, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x73, 0x6f, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x28, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x0f, 0x64,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x44, 0x69, 0x66,
+	0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x10, 0x64, 0x28, 0x01, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x5f,
+	0x76, 0x69, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x74, 0x56,
+	0x69, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2a, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xfa, 0x42, 0x08,
+	0x1a, 0x06, 0x18, 0xa0, 0x8d, 0x06, 0x28, 0x00, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x07, 0x62, 0x70, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00,
+	0x52, 0x06, 0x62, 0x70, 0x6d, 0x4d, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x07, 0x62, 0x70, 0x6d, 0x5f,
+	0x6d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05,
+	0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x06, 0x62, 0x70, 0x6d, 0x4d, 0x61, 0x78, 0x12, 0x35, 0x0a,
+	0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0x90,
+	0x1c, 0x28, 0x00, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x11, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x72, 0x74,
+	0x49, 0x64, 0x22, 0x52, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x96, 0x04, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x4f,
+	0x0a, 0x10, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42,
+	0x0d, 0xfa, 0x42, 0x0a, 0x92, 0x01, 0x07, 0x22, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0f,
+	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x63, 0x28, 0x00, 0x52, 0x08, 0x6d,
+	0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a,
+	0x04, 0x18, 0x63, 0x28, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x27, 0x0a, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x05, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52,
+	0x07, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0c, 0xfa, 0x42,
+	0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f,
+	0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x12,
+	0x26, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22,
+	0x86, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x3a, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01,
+	0x20, 0x00, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0x60, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x36, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x0e, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x28, 0x00, 0x52, 0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xd3, 0x03, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x73,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x07, 0x73, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e,
+	0x69, 0x74, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x6f, 0x6e,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x0a,
+	0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x06, 0x63, 0x68,
+	0x61, 0x72, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49,
+	0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x10,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x6f, 0x6e,
+	0x67, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x43, 0x68, 0x61, 0x72, 0x74, 0x49, 0x64, 0x73,
+	0x22, 0x80, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6e,
+	0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x6e, 0x75,
+	0x6d, 0x73, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x22, 0x5e, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x32, 0x94, 0x18, 0x0a, 0x0d, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
 	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
 	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x73, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69,
-	0x6e, 0x67, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53,
-	0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74,
-	0x73, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12,
-	0x16, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x43, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x19,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x49, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1b,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73,
+	0x12, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x16,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x19, 0x2e,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x2e,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
 	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e,
-	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
-	0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x61,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x6c,
-	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f,
-	0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x17,
-	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x6d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
-	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x6d, 0x61,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x12,
-	0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74,
-	0x12, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x72, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3c, 0x5a, 0x3a, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x68, 0x61, 0x6b, 0x6b, 0x75,
-	0x75, 0x75, 0x2f, 0x73, 0x65, 0x6b, 0x61, 0x69, 0x2d, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2d, 0x6d,
-	0x79, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67,
-	0x65, 0x6e, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x69, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x55, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x53,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x53, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x63, 0x61,
+	0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x21, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x21, 0x2e, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x63, 0x61,
+	0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
+	0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x63,
+	0x61, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x63, 0x61,
+	0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x17, 0x2e, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x19,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x6f, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x68, 0x61, 0x72,
+	0x74, 0x73, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43,
+	0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x49, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a,
+	0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x68, 0x61, 0x6b, 0x6b, 0x75, 0x75, 0x75,
+	0x2f, 0x73, 0x65, 0x6b, 0x61, 0x69, 0x2d, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2d, 0x6d, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1737,100 +5329,245 @@ func file_master_master_proto_rawDescGZIP() []byte {
 	return file_master_master_proto_rawDescData
 }
 
-var file_master_master_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_master_master_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_master_master_proto_goTypes = []any{
-	(*GetArtistsRequest)(nil),          // 0: master.GetArtistsRequest
-	(*GetArtistsResponse)(nil),         // 1: master.GetArtistsResponse
-	(*GetArtistRequest)(nil),           // 2: master.GetArtistRequest
-	(*GetArtistResponse)(nil),          // 3: master.GetArtistResponse
-	(*CreateArtistRequest)(nil),        // 4: master.CreateArtistRequest
-	(*CreateArtistResponse)(nil),       // 5: master.CreateArtistResponse
-	(*GetSingersRequest)(nil),          // 6: master.GetSingersRequest
-	(*GetSingersResponse)(nil),         // 7: master.GetSingersResponse
-	(*GetSingerRequest)(nil),           // 8: master.GetSingerRequest
-	(*GetSingerResponse)(nil),          // 9: master.GetSingerResponse
-	(*CreateSingerRequest)(nil),        // 10: master.CreateSingerRequest
-	(*CreateSingerResponse)(nil),       // 11: master.CreateSingerResponse
-	(*GetUnitsRequest)(nil),            // 12: master.GetUnitsRequest
-	(*GetUnitsResponse)(nil),           // 13: master.GetUnitsResponse
-	(*GetUnitRequest)(nil),             // 14: master.GetUnitRequest
-	(*GetUnitResponse)(nil),            // 15: master.GetUnitResponse
-	(*CreateUnitRequest)(nil),          // 16: master.CreateUnitRequest
-	(*CreateUnitResponse)(nil),         // 17: master.CreateUnitResponse
-	(*CreateVocalPatternRequest)(nil),  // 18: master.CreateVocalPatternRequest
-	(*CreateVocalPatternResponse)(nil), // 19: master.CreateVocalPatternResponse
-	(*GetSongsRequest)(nil),            // 20: master.GetSongsRequest
-	(*GetSongsResponse)(nil),           // 21: master.GetSongsResponse
-	(*GetSongRequest)(nil),             // 22: master.GetSongRequest
-	(*GetSongResponse)(nil),            // 23: master.GetSongResponse
-	(*CreateSongRequest)(nil),          // 24: master.CreateSongRequest
-	(*CreateSongResponse)(nil),         // 25: master.CreateSongResponse
-	(*GetChartsRequest)(nil),           // 26: master.GetChartsRequest
-	(*GetChartsResponse)(nil),          // 27: master.GetChartsResponse
-	(*GetChartRequest)(nil),            // 28: master.GetChartRequest
-	(*GetChartResponse)(nil),           // 29: master.GetChartResponse
-	(*CreateChartRequest)(nil),         // 30: master.CreateChartRequest
-	(*CreateChartResponse)(nil),        // 31: master.CreateChartResponse
-	(*Artist)(nil),                     // 32: master.Artist
-	(*Singer)(nil),                     // 33: master.Singer
-	(*Unit)(nil),                       // 34: master.Unit
-	(*Song)(nil),                       // 35: master.Song
-	(*timestamppb.Timestamp)(nil),      // 36: google.protobuf.Timestamp
-	(enums.MusicVideoType)(0),          // 37: enums.MusicVideoType
-	(*Chart)(nil),                      // 38: master.Chart
-	(enums.DifficultyType)(0),          // 39: enums.DifficultyType
+	(*MasterConflict)(nil),             // 0: master.MasterConflict
+	(*GetArtistsRequest)(nil),          // 1: master.GetArtistsRequest
+	(*GetArtistsResponse)(nil),         // 2: master.GetArtistsResponse
+	(*GetArtistRequest)(nil),           // 3: master.GetArtistRequest
+	(*GetArtistResponse)(nil),          // 4: master.GetArtistResponse
+	(*CreateArtistRequest)(nil),        // 5: master.CreateArtistRequest
+	(*CreateArtistResponse)(nil),       // 6: master.CreateArtistResponse
+	(*UpdateArtistRequest)(nil),        // 7: master.UpdateArtistRequest
+	(*UpdateArtistResponse)(nil),       // 8: master.UpdateArtistResponse
+	(*DeleteArtistRequest)(nil),        // 9: master.DeleteArtistRequest
+	(*DeleteArtistResponse)(nil),       // 10: master.DeleteArtistResponse
+	(*MergeArtistsRequest)(nil),        // 11: master.MergeArtistsRequest
+	(*MergeArtistsResponse)(nil),       // 12: master.MergeArtistsResponse
+	(*GetSingersRequest)(nil),          // 13: master.GetSingersRequest
+	(*GetSingersResponse)(nil),         // 14: master.GetSingersResponse
+	(*GetSingerRequest)(nil),           // 15: master.GetSingerRequest
+	(*GetSingerResponse)(nil),          // 16: master.GetSingerResponse
+	(*CreateSingerRequest)(nil),        // 17: master.CreateSingerRequest
+	(*CreateSingerResponse)(nil),       // 18: master.CreateSingerResponse
+	(*UpdateSingerRequest)(nil),        // 19: master.UpdateSingerRequest
+	(*UpdateSingerResponse)(nil),       // 20: master.UpdateSingerResponse
+	(*GetUnitsRequest)(nil),            // 21: master.GetUnitsRequest
+	(*GetUnitsResponse)(nil),           // 22: master.GetUnitsResponse
+	(*GetUnitRequest)(nil),             // 23: master.GetUnitRequest
+	(*GetUnitResponse)(nil),            // 24: master.GetUnitResponse
+	(*CreateUnitRequest)(nil),          // 25: master.CreateUnitRequest
+	(*CreateUnitResponse)(nil),         // 26: master.CreateUnitResponse
+	(*UpdateUnitRequest)(nil),          // 27: master.UpdateUnitRequest
+	(*UpdateUnitResponse)(nil),         // 28: master.UpdateUnitResponse
+	(*CreateUnitSingerRequest)(nil),    // 29: master.CreateUnitSingerRequest
+	(*CreateUnitSingerResponse)(nil),   // 30: master.CreateUnitSingerResponse
+	(*UpdateUnitSingerRequest)(nil),    // 31: master.UpdateUnitSingerRequest
+	(*UpdateUnitSingerResponse)(nil),   // 32: master.UpdateUnitSingerResponse
+	(*DeleteUnitSingerRequest)(nil),    // 33: master.DeleteUnitSingerRequest
+	(*DeleteUnitSingerResponse)(nil),   // 34: master.DeleteUnitSingerResponse
+	(*GetVocalPatternsRequest)(nil),    // 35: master.GetVocalPatternsRequest
+	(*GetVocalPatternsResponse)(nil),   // 36: master.GetVocalPatternsResponse
+	(*GetVocalPatternRequest)(nil),     // 37: master.GetVocalPatternRequest
+	(*GetVocalPatternResponse)(nil),    // 38: master.GetVocalPatternResponse
+	(*CreateVocalPatternRequest)(nil),  // 39: master.CreateVocalPatternRequest
+	(*CreateVocalPatternResponse)(nil), // 40: master.CreateVocalPatternResponse
+	(*UpdateVocalPatternRequest)(nil),  // 41: master.UpdateVocalPatternRequest
+	(*UpdateVocalPatternResponse)(nil), // 42: master.UpdateVocalPatternResponse
+	(*DeleteVocalPatternRequest)(nil),  // 43: master.DeleteVocalPatternRequest
+	(*DeleteVocalPatternResponse)(nil), // 44: master.DeleteVocalPatternResponse
+	(*GetSongsRequest)(nil),            // 45: master.GetSongsRequest
+	(*GetSongsResponse)(nil),           // 46: master.GetSongsResponse
+	(*GetSongRequest)(nil),             // 47: master.GetSongRequest
+	(*GetSongResponse)(nil),            // 48: master.GetSongResponse
+	(*SongCreditInput)(nil),            // 49: master.SongCreditInput
+	(*CreateSongRequest)(nil),          // 50: master.CreateSongRequest
+	(*CreateSongResponse)(nil),         // 51: master.CreateSongResponse
+	(*UpdateSongRequest)(nil),          // 52: master.UpdateSongRequest
+	(*UpdateSongResponse)(nil),         // 53: master.UpdateSongResponse
+	(*DeleteSongRequest)(nil),          // 54: master.DeleteSongRequest
+	(*DeleteSongResponse)(nil),         // 55: master.DeleteSongResponse
+	(*SearchSongsRequest)(nil),         // 56: master.SearchSongsRequest
+	(*SearchSongsResponse)(nil),        // 57: master.SearchSongsResponse
+	(*ListSongReleasesRequest)(nil),    // 58: master.ListSongReleasesRequest
+	(*ListSongReleasesResponse)(nil),   // 59: master.ListSongReleasesResponse
+	(*GetChartsRequest)(nil),           // 60: master.GetChartsRequest
+	(*GetChartsResponse)(nil),          // 61: master.GetChartsResponse
+	(*GetChartRequest)(nil),            // 62: master.GetChartRequest
+	(*GetChartResponse)(nil),           // 63: master.GetChartResponse
+	(*CreateChartRequest)(nil),         // 64: master.CreateChartRequest
+	(*CreateChartResponse)(nil),        // 65: master.CreateChartResponse
+	(*UpdateChartRequest)(nil),         // 66: master.UpdateChartRequest
+	(*UpdateChartResponse)(nil),        // 67: master.UpdateChartResponse
+	(*DeleteChartRequest)(nil),         // 68: master.DeleteChartRequest
+	(*DeleteChartResponse)(nil),        // 69: master.DeleteChartResponse
+	(*GetChartHistoryRequest)(nil),     // 70: master.GetChartHistoryRequest
+	(*GetChartHistoryResponse)(nil),    // 71: master.GetChartHistoryResponse
+	(*SearchChartsRequest)(nil),        // 72: master.SearchChartsRequest
+	(*SearchChartsResponse)(nil),       // 73: master.SearchChartsResponse
+	(*MasterChange)(nil),               // 74: master.MasterChange
+	(*ImportMasterRequest)(nil),        // 75: master.ImportMasterRequest
+	(*ImportMasterResponse)(nil),       // 76: master.ImportMasterResponse
+	(*ExportMasterRequest)(nil),        // 77: master.ExportMasterRequest
+	(*ExportMasterResponse)(nil),       // 78: master.ExportMasterResponse
+	(*GetMasterVersionRequest)(nil),    // 79: master.GetMasterVersionRequest
+	(*GetMasterVersionResponse)(nil),   // 80: master.GetMasterVersionResponse
+	(*GetMasterDeltaRequest)(nil),      // 81: master.GetMasterDeltaRequest
+	(*GetMasterDeltaResponse)(nil),     // 82: master.GetMasterDeltaResponse
+	(*MasterEvent)(nil),                // 83: master.MasterEvent
+	(*WatchMasterRequest)(nil),         // 84: master.WatchMasterRequest
+	(*WatchMasterResponse)(nil),        // 85: master.WatchMasterResponse
+	(enums.MasterKind)(0),              // 86: enums.MasterKind
+	(*Artist)(nil),                     // 87: master.Artist
+	(*Singer)(nil),                     // 88: master.Singer
+	(*Unit)(nil),                       // 89: master.Unit
+	(*VocalPattern)(nil),               // 90: master.VocalPattern
+	(*Song)(nil),                       // 91: master.Song
+	(enums.CreditRole)(0),              // 92: enums.CreditRole
+	(*timestamppb.Timestamp)(nil),      // 93: google.protobuf.Timestamp
+	(enums.MusicVideoType)(0),          // 94: enums.MusicVideoType
+	(*SongReleaseMonth)(nil),           // 95: master.SongReleaseMonth
+	(enums.ChartSortKey)(0),            // 96: enums.ChartSortKey
+	(*Chart)(nil),                      // 97: master.Chart
+	(enums.DifficultyType)(0),          // 98: enums.DifficultyType
+	(*ChartLevelHistory)(nil),          // 99: master.ChartLevelHistory
+	(enums.MasterChangeOperation)(0),   // 100: enums.MasterChangeOperation
+	(enums.MasterBundleFormat)(0),      // 101: enums.MasterBundleFormat
 }
 var file_master_master_proto_depIdxs = []int32{
-	32, // 0: master.GetArtistsResponse.artists:type_name -> master.Artist
-	32, // 1: master.GetArtistResponse.artist:type_name -> master.Artist
-	33, // 2: master.GetSingersResponse.singers:type_name -> master.Singer
-	33, // 3: master.GetSingerResponse.singer:type_name -> master.Singer
-	34, // 4: master.GetUnitsResponse.units:type_name -> master.Unit
-	34, // 5: master.GetUnitResponse.unit:type_name -> master.Unit
-	35, // 6: master.GetSongsResponse.songs:type_name -> master.Song
-	35, // 7: master.GetSongResponse.song:type_name -> master.Song
-	36, // 8: master.CreateSongRequest.release_time:type_name -> google.protobuf.Timestamp
-	37, // 9: master.CreateSongRequest.music_video_types:type_name -> enums.MusicVideoType
-	38, // 10: master.GetChartsResponse.charts:type_name -> master.Chart
-	38, // 11: master.GetChartResponse.chart:type_name -> master.Chart
-	39, // 12: master.CreateChartRequest.difficulty_type:type_name -> enums.DifficultyType
-	0,  // 13: master.MasterService.GetArtists:input_type -> master.GetArtistsRequest
-	2,  // 14: master.MasterService.GetArtist:input_type -> master.GetArtistRequest
-	4,  // 15: master.MasterService.CreateArtist:input_type -> master.CreateArtistRequest
-	6,  // 16: master.MasterService.GetSingers:input_type -> master.GetSingersRequest
-	8,  // 17: master.MasterService.GetSinger:input_type -> master.GetSingerRequest
-	10, // 18: master.MasterService.CreateSinger:input_type -> master.CreateSingerRequest
-	12, // 19: master.MasterService.GetUnits:input_type -> master.GetUnitsRequest
-	14, // 20: master.MasterService.GetUnit:input_type -> master.GetUnitRequest
-	16, // 21: master.MasterService.CreateUnit:input_type -> master.CreateUnitRequest
-	18, // 22: master.MasterService.CreateVocalPattern:input_type -> master.CreateVocalPatternRequest
-	20, // 23: master.MasterService.GetSongs:input_type -> master.GetSongsRequest
-	22, // 24: master.MasterService.GetSong:input_type -> master.GetSongRequest
-	24, // 25: master.MasterService.CreateSong:input_type -> master.CreateSongRequest
-	26, // 26: master.MasterService.GetCharts:input_type -> master.GetChartsRequest
-	28, // 27: master.MasterService.GetChart:input_type -> master.GetChartRequest
-	30, // 28: master.MasterService.CreateChart:input_type -> master.CreateChartRequest
-	1,  // 29: master.MasterService.GetArtists:output_type -> master.GetArtistsResponse
-	3,  // 30: master.MasterService.GetArtist:output_type -> master.GetArtistResponse
-	5,  // 31: master.MasterService.CreateArtist:output_type -> master.CreateArtistResponse
-	7,  // 32: master.MasterService.GetSingers:output_type -> master.GetSingersResponse
-	9,  // 33: master.MasterService.GetSinger:output_type -> master.GetSingerResponse
-	11, // 34: master.MasterService.CreateSinger:output_type -> master.CreateSingerResponse
-	13, // 35: master.MasterService.GetUnits:output_type -> master.GetUnitsResponse
-	15, // 36: master.MasterService.GetUnit:output_type -> master.GetUnitResponse
-	17, // 37: master.MasterService.CreateUnit:output_type -> master.CreateUnitResponse
-	19, // 38: master.MasterService.CreateVocalPattern:output_type -> master.CreateVocalPatternResponse
-	21, // 39: master.MasterService.GetSongs:output_type -> master.GetSongsResponse
-	23, // 40: master.MasterService.GetSong:output_type -> master.GetSongResponse
-	25, // 41: master.MasterService.CreateSong:output_type -> master.CreateSongResponse
-	27, // 42: master.MasterService.GetCharts:output_type -> master.GetChartsResponse
-	29, // 43: master.MasterService.GetChart:output_type -> master.GetChartResponse
-	31, // 44: master.MasterService.CreateChart:output_type -> master.CreateChartResponse
-	29, // [29:45] is the sub-list for method output_type
-	13, // [13:29] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	86,  // 0: master.MasterConflict.kind:type_name -> enums.MasterKind
+	87,  // 1: master.GetArtistsResponse.artists:type_name -> master.Artist
+	87,  // 2: master.GetArtistResponse.artist:type_name -> master.Artist
+	88,  // 3: master.GetSingersResponse.singers:type_name -> master.Singer
+	88,  // 4: master.GetSingerResponse.singer:type_name -> master.Singer
+	89,  // 5: master.GetUnitsResponse.units:type_name -> master.Unit
+	89,  // 6: master.GetUnitResponse.unit:type_name -> master.Unit
+	90,  // 7: master.GetVocalPatternsResponse.vocal_patterns:type_name -> master.VocalPattern
+	90,  // 8: master.GetVocalPatternResponse.vocal_pattern:type_name -> master.VocalPattern
+	91,  // 9: master.GetSongsResponse.songs:type_name -> master.Song
+	91,  // 10: master.GetSongResponse.song:type_name -> master.Song
+	92,  // 11: master.SongCreditInput.role:type_name -> enums.CreditRole
+	93,  // 12: master.CreateSongRequest.release_time:type_name -> google.protobuf.Timestamp
+	94,  // 13: master.CreateSongRequest.music_video_types:type_name -> enums.MusicVideoType
+	49,  // 14: master.CreateSongRequest.credits:type_name -> master.SongCreditInput
+	93,  // 15: master.UpdateSongRequest.release_time:type_name -> google.protobuf.Timestamp
+	94,  // 16: master.UpdateSongRequest.music_video_types:type_name -> enums.MusicVideoType
+	49,  // 17: master.UpdateSongRequest.credits:type_name -> master.SongCreditInput
+	94,  // 18: master.SearchSongsRequest.music_video_type:type_name -> enums.MusicVideoType
+	93,  // 19: master.SearchSongsRequest.release_from:type_name -> google.protobuf.Timestamp
+	93,  // 20: master.SearchSongsRequest.release_to:type_name -> google.protobuf.Timestamp
+	91,  // 21: master.SearchSongsResponse.songs:type_name -> master.Song
+	95,  // 22: master.ListSongReleasesResponse.months:type_name -> master.SongReleaseMonth
+	96,  // 23: master.GetChartsRequest.sort_key:type_name -> enums.ChartSortKey
+	97,  // 24: master.GetChartsResponse.charts:type_name -> master.Chart
+	93,  // 25: master.GetChartRequest.as_of:type_name -> google.protobuf.Timestamp
+	97,  // 26: master.GetChartResponse.chart:type_name -> master.Chart
+	98,  // 27: master.CreateChartRequest.difficulty_type:type_name -> enums.DifficultyType
+	98,  // 28: master.UpdateChartRequest.difficulty_type:type_name -> enums.DifficultyType
+	93,  // 29: master.UpdateChartRequest.level_effective_from:type_name -> google.protobuf.Timestamp
+	99,  // 30: master.GetChartHistoryResponse.histories:type_name -> master.ChartLevelHistory
+	98,  // 31: master.SearchChartsRequest.difficulty_types:type_name -> enums.DifficultyType
+	93,  // 32: master.SearchChartsRequest.release_from:type_name -> google.protobuf.Timestamp
+	93,  // 33: master.SearchChartsRequest.release_to:type_name -> google.protobuf.Timestamp
+	97,  // 34: master.SearchChartsResponse.charts:type_name -> master.Chart
+	100, // 35: master.MasterChange.operation:type_name -> enums.MasterChangeOperation
+	101, // 36: master.ImportMasterRequest.format:type_name -> enums.MasterBundleFormat
+	74,  // 37: master.ImportMasterResponse.changes:type_name -> master.MasterChange
+	87,  // 38: master.GetMasterDeltaResponse.artists:type_name -> master.Artist
+	88,  // 39: master.GetMasterDeltaResponse.singers:type_name -> master.Singer
+	89,  // 40: master.GetMasterDeltaResponse.units:type_name -> master.Unit
+	91,  // 41: master.GetMasterDeltaResponse.songs:type_name -> master.Song
+	97,  // 42: master.GetMasterDeltaResponse.charts:type_name -> master.Chart
+	86,  // 43: master.MasterEvent.kind:type_name -> enums.MasterKind
+	100, // 44: master.MasterEvent.operation:type_name -> enums.MasterChangeOperation
+	83,  // 45: master.WatchMasterResponse.events:type_name -> master.MasterEvent
+	1,   // 46: master.MasterService.GetArtists:input_type -> master.GetArtistsRequest
+	3,   // 47: master.MasterService.GetArtist:input_type -> master.GetArtistRequest
+	5,   // 48: master.MasterService.CreateArtist:input_type -> master.CreateArtistRequest
+	7,   // 49: master.MasterService.UpdateArtist:input_type -> master.UpdateArtistRequest
+	9,   // 50: master.MasterService.DeleteArtist:input_type -> master.DeleteArtistRequest
+	11,  // 51: master.MasterService.MergeArtists:input_type -> master.MergeArtistsRequest
+	13,  // 52: master.MasterService.GetSingers:input_type -> master.GetSingersRequest
+	15,  // 53: master.MasterService.GetSinger:input_type -> master.GetSingerRequest
+	17,  // 54: master.MasterService.CreateSinger:input_type -> master.CreateSingerRequest
+	19,  // 55: master.MasterService.UpdateSinger:input_type -> master.UpdateSingerRequest
+	21,  // 56: master.MasterService.GetUnits:input_type -> master.GetUnitsRequest
+	23,  // 57: master.MasterService.GetUnit:input_type -> master.GetUnitRequest
+	25,  // 58: master.MasterService.CreateUnit:input_type -> master.CreateUnitRequest
+	27,  // 59: master.MasterService.UpdateUnit:input_type -> master.UpdateUnitRequest
+	29,  // 60: master.MasterService.CreateUnitSinger:input_type -> master.CreateUnitSingerRequest
+	31,  // 61: master.MasterService.UpdateUnitSinger:input_type -> master.UpdateUnitSingerRequest
+	33,  // 62: master.MasterService.DeleteUnitSinger:input_type -> master.DeleteUnitSingerRequest
+	35,  // 63: master.MasterService.GetVocalPatterns:input_type -> master.GetVocalPatternsRequest
+	37,  // 64: master.MasterService.GetVocalPattern:input_type -> master.GetVocalPatternRequest
+	39,  // 65: master.MasterService.CreateVocalPattern:input_type -> master.CreateVocalPatternRequest
+	41,  // 66: master.MasterService.UpdateVocalPattern:input_type -> master.UpdateVocalPatternRequest
+	43,  // 67: master.MasterService.DeleteVocalPattern:input_type -> master.DeleteVocalPatternRequest
+	45,  // 68: master.MasterService.GetSongs:input_type -> master.GetSongsRequest
+	47,  // 69: master.MasterService.GetSong:input_type -> master.GetSongRequest
+	50,  // 70: master.MasterService.CreateSong:input_type -> master.CreateSongRequest
+	52,  // 71: master.MasterService.UpdateSong:input_type -> master.UpdateSongRequest
+	54,  // 72: master.MasterService.DeleteSong:input_type -> master.DeleteSongRequest
+	56,  // 73: master.MasterService.SearchSongs:input_type -> master.SearchSongsRequest
+	58,  // 74: master.MasterService.ListSongReleases:input_type -> master.ListSongReleasesRequest
+	60,  // 75: master.MasterService.GetCharts:input_type -> master.GetChartsRequest
+	62,  // 76: master.MasterService.GetChart:input_type -> master.GetChartRequest
+	64,  // 77: master.MasterService.CreateChart:input_type -> master.CreateChartRequest
+	66,  // 78: master.MasterService.UpdateChart:input_type -> master.UpdateChartRequest
+	68,  // 79: master.MasterService.DeleteChart:input_type -> master.DeleteChartRequest
+	72,  // 80: master.MasterService.SearchCharts:input_type -> master.SearchChartsRequest
+	70,  // 81: master.MasterService.GetChartHistory:input_type -> master.GetChartHistoryRequest
+	75,  // 82: master.MasterService.ImportMaster:input_type -> master.ImportMasterRequest
+	77,  // 83: master.MasterService.ExportMaster:input_type -> master.ExportMasterRequest
+	79,  // 84: master.MasterService.GetMasterVersion:input_type -> master.GetMasterVersionRequest
+	81,  // 85: master.MasterService.GetMasterDelta:input_type -> master.GetMasterDeltaRequest
+	84,  // 86: master.MasterService.WatchMaster:input_type -> master.WatchMasterRequest
+	2,   // 87: master.MasterService.GetArtists:output_type -> master.GetArtistsResponse
+	4,   // 88: master.MasterService.GetArtist:output_type -> master.GetArtistResponse
+	6,   // 89: master.MasterService.CreateArtist:output_type -> master.CreateArtistResponse
+	8,   // 90: master.MasterService.UpdateArtist:output_type -> master.UpdateArtistResponse
+	10,  // 91: master.MasterService.DeleteArtist:output_type -> master.DeleteArtistResponse
+	12,  // 92: master.MasterService.MergeArtists:output_type -> master.MergeArtistsResponse
+	14,  // 93: master.MasterService.GetSingers:output_type -> master.GetSingersResponse
+	16,  // 94: master.MasterService.GetSinger:output_type -> master.GetSingerResponse
+	18,  // 95: master.MasterService.CreateSinger:output_type -> master.CreateSingerResponse
+	20,  // 96: master.MasterService.UpdateSinger:output_type -> master.UpdateSingerResponse
+	22,  // 97: master.MasterService.GetUnits:output_type -> master.GetUnitsResponse
+	24,  // 98: master.MasterService.GetUnit:output_type -> master.GetUnitResponse
+	26,  // 99: master.MasterService.CreateUnit:output_type -> master.CreateUnitResponse
+	28,  // 100: master.MasterService.UpdateUnit:output_type -> master.UpdateUnitResponse
+	30,  // 101: master.MasterService.CreateUnitSinger:output_type -> master.CreateUnitSingerResponse
+	32,  // 102: master.MasterService.UpdateUnitSinger:output_type -> master.UpdateUnitSingerResponse
+	34,  // 103: master.MasterService.DeleteUnitSinger:output_type -> master.DeleteUnitSingerResponse
+	36,  // 104: master.MasterService.GetVocalPatterns:output_type -> master.GetVocalPatternsResponse
+	38,  // 105: master.MasterService.GetVocalPattern:output_type -> master.GetVocalPatternResponse
+	40,  // 106: master.MasterService.CreateVocalPattern:output_type -> master.CreateVocalPatternResponse
+	42,  // 107: master.MasterService.UpdateVocalPattern:output_type -> master.UpdateVocalPatternResponse
+	44,  // 108: master.MasterService.DeleteVocalPattern:output_type -> master.DeleteVocalPatternResponse
+	46,  // 109: master.MasterService.GetSongs:output_type -> master.GetSongsResponse
+	48,  // 110: master.MasterService.GetSong:output_type -> master.GetSongResponse
+	51,  // 111: master.MasterService.CreateSong:output_type -> master.CreateSongResponse
+	53,  // 112: master.MasterService.UpdateSong:output_type -> master.UpdateSongResponse
+	55,  // 113: master.MasterService.DeleteSong:output_type -> master.DeleteSongResponse
+	57,  // 114: master.MasterService.SearchSongs:output_type -> master.SearchSongsResponse
+	59,  // 115: master.MasterService.ListSongReleases:output_type -> master.ListSongReleasesResponse
+	61,  // 116: master.MasterService.GetCharts:output_type -> master.GetChartsResponse
+	63,  // 117: master.MasterService.GetChart:output_type -> master.GetChartResponse
+	65,  // 118: master.MasterService.CreateChart:output_type -> master.CreateChartResponse
+	67,  // 119: master.MasterService.UpdateChart:output_type -> master.UpdateChartResponse
+	69,  // 120: master.MasterService.DeleteChart:output_type -> master.DeleteChartResponse
+	73,  // 121: master.MasterService.SearchCharts:output_type -> master.SearchChartsResponse
+	71,  // 122: master.MasterService.GetChartHistory:output_type -> master.GetChartHistoryResponse
+	76,  // 123: master.MasterService.ImportMaster:output_type -> master.ImportMasterResponse
+	78,  // 124: master.MasterService.ExportMaster:output_type -> master.ExportMasterResponse
+	80,  // 125: master.MasterService.GetMasterVersion:output_type -> master.GetMasterVersionResponse
+	82,  // 126: master.MasterService.GetMasterDelta:output_type -> master.GetMasterDeltaResponse
+	85,  // 127: master.MasterService.WatchMaster:output_type -> master.WatchMasterResponse
+	87,  // [87:128] is the sub-list for method output_type
+	46,  // [46:87] is the sub-list for method input_type
+	46,  // [46:46] is the sub-list for extension type_name
+	46,  // [46:46] is the sub-list for extension extendee
+	0,   // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_master_master_proto_init() }
@@ -1843,13 +5580,14 @@ func file_master_master_proto_init() {
 	file_master_singer_proto_init()
 	file_master_song_proto_init()
 	file_master_unit_proto_init()
+	file_master_vocal_pattern_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_master_master_proto_rawDesc), len(file_master_master_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
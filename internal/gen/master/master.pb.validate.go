@@ -36,9 +36,115 @@ var (
 	_ = anypb.Any{}
 	_ = sort.Sort
 
-	_ = enums.MusicVideoType(0)
+	_ = enums.MasterKind(0)
 )
 
+// Validate checks the field values on MasterConflict with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MasterConflict) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MasterConflict with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MasterConflictMultiError,
+// or nil if none found.
+func (m *MasterConflict) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MasterConflict) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return MasterConflictMultiError(errors)
+	}
+
+	return nil
+}
+
+// MasterConflictMultiError is an error wrapping multiple validation errors
+// returned by MasterConflict.ValidateAll() if the designated constraints
+// aren't met.
+type MasterConflictMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MasterConflictMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MasterConflictMultiError) AllErrors() []error { return m }
+
+// MasterConflictValidationError is the validation error returned by
+// MasterConflict.Validate if the designated constraints aren't met.
+type MasterConflictValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MasterConflictValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MasterConflictValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MasterConflictValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MasterConflictValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MasterConflictValidationError) ErrorName() string { return "MasterConflictValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MasterConflictValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMasterConflict.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MasterConflictValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MasterConflictValidationError{}
+
 // Validate checks the field values on GetArtistsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -736,42 +842,75 @@ var _ interface {
 	ErrorName() string
 } = CreateArtistResponseValidationError{}
 
-// Validate checks the field values on GetSingersRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSingersRequest) Validate() error {
+// Validate checks the field values on UpdateArtistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateArtistRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSingersRequest with the rules
+// ValidateAll checks the field values on UpdateArtistRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSingersRequestMultiError, or nil if none found.
-func (m *GetSingersRequest) ValidateAll() error {
+// UpdateArtistRequestMultiError, or nil if none found.
+func (m *UpdateArtistRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSingersRequest) validate(all bool) error {
+func (m *UpdateArtistRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if m.GetId() < 1 {
+		err := UpdateArtistRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := UpdateArtistRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetKana()); l < 1 || l > 255 {
+		err := UpdateArtistRequestValidationError{
+			field:  "Kana",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetSingersRequestMultiError(errors)
+		return UpdateArtistRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSingersRequestMultiError is an error wrapping multiple validation errors
-// returned by GetSingersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetSingersRequestMultiError []error
+// UpdateArtistRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateArtistRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateArtistRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSingersRequestMultiError) Error() string {
+func (m UpdateArtistRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -780,11 +919,11 @@ func (m GetSingersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSingersRequestMultiError) AllErrors() []error { return m }
+func (m UpdateArtistRequestMultiError) AllErrors() []error { return m }
 
-// GetSingersRequestValidationError is the validation error returned by
-// GetSingersRequest.Validate if the designated constraints aren't met.
-type GetSingersRequestValidationError struct {
+// UpdateArtistRequestValidationError is the validation error returned by
+// UpdateArtistRequest.Validate if the designated constraints aren't met.
+type UpdateArtistRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -792,24 +931,24 @@ type GetSingersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSingersRequestValidationError) Field() string { return e.field }
+func (e UpdateArtistRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSingersRequestValidationError) Reason() string { return e.reason }
+func (e UpdateArtistRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSingersRequestValidationError) Cause() error { return e.cause }
+func (e UpdateArtistRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSingersRequestValidationError) Key() bool { return e.key }
+func (e UpdateArtistRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSingersRequestValidationError) ErrorName() string {
-	return "GetSingersRequestValidationError"
+func (e UpdateArtistRequestValidationError) ErrorName() string {
+	return "UpdateArtistRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSingersRequestValidationError) Error() string {
+func (e UpdateArtistRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -821,14 +960,14 @@ func (e GetSingersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSingersRequest.%s: %s%s",
+		"invalid %sUpdateArtistRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSingersRequestValidationError{}
+var _ error = UpdateArtistRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -836,78 +975,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSingersRequestValidationError{}
+} = UpdateArtistRequestValidationError{}
 
-// Validate checks the field values on GetSingersResponse with the rules
+// Validate checks the field values on UpdateArtistResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSingersResponse) Validate() error {
+func (m *UpdateArtistResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSingersResponse with the rules
+// ValidateAll checks the field values on UpdateArtistResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSingersResponseMultiError, or nil if none found.
-func (m *GetSingersResponse) ValidateAll() error {
+// UpdateArtistResponseMultiError, or nil if none found.
+func (m *UpdateArtistResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSingersResponse) validate(all bool) error {
+func (m *UpdateArtistResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSingers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetSingersResponseValidationError{
-						field:  fmt.Sprintf("Singers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetSingersResponseValidationError{
-						field:  fmt.Sprintf("Singers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetSingersResponseValidationError{
-					field:  fmt.Sprintf("Singers[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetSingersResponseMultiError(errors)
+		return UpdateArtistResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSingersResponseMultiError is an error wrapping multiple validation errors
-// returned by GetSingersResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetSingersResponseMultiError []error
+// UpdateArtistResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateArtistResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateArtistResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSingersResponseMultiError) Error() string {
+func (m UpdateArtistResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -916,11 +1021,11 @@ func (m GetSingersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSingersResponseMultiError) AllErrors() []error { return m }
+func (m UpdateArtistResponseMultiError) AllErrors() []error { return m }
 
-// GetSingersResponseValidationError is the validation error returned by
-// GetSingersResponse.Validate if the designated constraints aren't met.
-type GetSingersResponseValidationError struct {
+// UpdateArtistResponseValidationError is the validation error returned by
+// UpdateArtistResponse.Validate if the designated constraints aren't met.
+type UpdateArtistResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -928,24 +1033,24 @@ type GetSingersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSingersResponseValidationError) Field() string { return e.field }
+func (e UpdateArtistResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSingersResponseValidationError) Reason() string { return e.reason }
+func (e UpdateArtistResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSingersResponseValidationError) Cause() error { return e.cause }
+func (e UpdateArtistResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSingersResponseValidationError) Key() bool { return e.key }
+func (e UpdateArtistResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSingersResponseValidationError) ErrorName() string {
-	return "GetSingersResponseValidationError"
+func (e UpdateArtistResponseValidationError) ErrorName() string {
+	return "UpdateArtistResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSingersResponseValidationError) Error() string {
+func (e UpdateArtistResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -957,14 +1062,14 @@ func (e GetSingersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSingersResponse.%s: %s%s",
+		"invalid %sUpdateArtistResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSingersResponseValidationError{}
+var _ error = UpdateArtistResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -972,46 +1077,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSingersResponseValidationError{}
+} = UpdateArtistResponseValidationError{}
 
-// Validate checks the field values on GetSingerRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSingerRequest) Validate() error {
+// Validate checks the field values on DeleteArtistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteArtistRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSingerRequest with the rules
+// ValidateAll checks the field values on DeleteArtistRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSingerRequestMultiError, or nil if none found.
-func (m *GetSingerRequest) ValidateAll() error {
+// DeleteArtistRequestMultiError, or nil if none found.
+func (m *DeleteArtistRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSingerRequest) validate(all bool) error {
+func (m *DeleteArtistRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if m.GetId() < 1 {
+		err := DeleteArtistRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetSingerRequestMultiError(errors)
+		return DeleteArtistRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSingerRequestMultiError is an error wrapping multiple validation errors
-// returned by GetSingerRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetSingerRequestMultiError []error
+// DeleteArtistRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteArtistRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteArtistRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSingerRequestMultiError) Error() string {
+func (m DeleteArtistRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1020,11 +1134,11 @@ func (m GetSingerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSingerRequestMultiError) AllErrors() []error { return m }
+func (m DeleteArtistRequestMultiError) AllErrors() []error { return m }
 
-// GetSingerRequestValidationError is the validation error returned by
-// GetSingerRequest.Validate if the designated constraints aren't met.
-type GetSingerRequestValidationError struct {
+// DeleteArtistRequestValidationError is the validation error returned by
+// DeleteArtistRequest.Validate if the designated constraints aren't met.
+type DeleteArtistRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1032,22 +1146,24 @@ type GetSingerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSingerRequestValidationError) Field() string { return e.field }
+func (e DeleteArtistRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSingerRequestValidationError) Reason() string { return e.reason }
+func (e DeleteArtistRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSingerRequestValidationError) Cause() error { return e.cause }
+func (e DeleteArtistRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSingerRequestValidationError) Key() bool { return e.key }
+func (e DeleteArtistRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSingerRequestValidationError) ErrorName() string { return "GetSingerRequestValidationError" }
+func (e DeleteArtistRequestValidationError) ErrorName() string {
+	return "DeleteArtistRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetSingerRequestValidationError) Error() string {
+func (e DeleteArtistRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1059,14 +1175,14 @@ func (e GetSingerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSingerRequest.%s: %s%s",
+		"invalid %sDeleteArtistRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSingerRequestValidationError{}
+var _ error = DeleteArtistRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1074,73 +1190,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSingerRequestValidationError{}
+} = DeleteArtistRequestValidationError{}
 
-// Validate checks the field values on GetSingerResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetSingerResponse) Validate() error {
+// Validate checks the field values on DeleteArtistResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteArtistResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSingerResponse with the rules
+// ValidateAll checks the field values on DeleteArtistResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSingerResponseMultiError, or nil if none found.
-func (m *GetSingerResponse) ValidateAll() error {
+// DeleteArtistResponseMultiError, or nil if none found.
+func (m *DeleteArtistResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSingerResponse) validate(all bool) error {
+func (m *DeleteArtistResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSinger()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetSingerResponseValidationError{
-					field:  "Singer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetSingerResponseValidationError{
-					field:  "Singer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSinger()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetSingerResponseValidationError{
-				field:  "Singer",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetSingerResponseMultiError(errors)
+		return DeleteArtistResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSingerResponseMultiError is an error wrapping multiple validation errors
-// returned by GetSingerResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetSingerResponseMultiError []error
+// DeleteArtistResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteArtistResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteArtistResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSingerResponseMultiError) Error() string {
+func (m DeleteArtistResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1149,11 +1236,11 @@ func (m GetSingerResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSingerResponseMultiError) AllErrors() []error { return m }
+func (m DeleteArtistResponseMultiError) AllErrors() []error { return m }
 
-// GetSingerResponseValidationError is the validation error returned by
-// GetSingerResponse.Validate if the designated constraints aren't met.
-type GetSingerResponseValidationError struct {
+// DeleteArtistResponseValidationError is the validation error returned by
+// DeleteArtistResponse.Validate if the designated constraints aren't met.
+type DeleteArtistResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1161,24 +1248,24 @@ type GetSingerResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSingerResponseValidationError) Field() string { return e.field }
+func (e DeleteArtistResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSingerResponseValidationError) Reason() string { return e.reason }
+func (e DeleteArtistResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSingerResponseValidationError) Cause() error { return e.cause }
+func (e DeleteArtistResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSingerResponseValidationError) Key() bool { return e.key }
+func (e DeleteArtistResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSingerResponseValidationError) ErrorName() string {
-	return "GetSingerResponseValidationError"
+func (e DeleteArtistResponseValidationError) ErrorName() string {
+	return "DeleteArtistResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSingerResponseValidationError) Error() string {
+func (e DeleteArtistResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1190,14 +1277,14 @@ func (e GetSingerResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSingerResponse.%s: %s%s",
+		"invalid %sDeleteArtistResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSingerResponseValidationError{}
+var _ error = DeleteArtistResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1205,34 +1292,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSingerResponseValidationError{}
+} = DeleteArtistResponseValidationError{}
 
-// Validate checks the field values on CreateSingerRequest with the rules
+// Validate checks the field values on MergeArtistsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSingerRequest) Validate() error {
+func (m *MergeArtistsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSingerRequest with the rules
+// ValidateAll checks the field values on MergeArtistsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSingerRequestMultiError, or nil if none found.
-func (m *CreateSingerRequest) ValidateAll() error {
+// MergeArtistsRequestMultiError, or nil if none found.
+func (m *MergeArtistsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSingerRequest) validate(all bool) error {
+func (m *MergeArtistsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
-		err := CreateSingerRequestValidationError{
-			field:  "Name",
-			reason: "value length must be between 1 and 255 runes, inclusive",
+	if m.GetSourceArtistId() < 1 {
+		err := MergeArtistsRequestValidationError{
+			field:  "SourceArtistId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTargetArtistId() < 1 {
+		err := MergeArtistsRequestValidationError{
+			field:  "TargetArtistId",
+			reason: "value must be greater than or equal to 1",
 		}
 		if !all {
 			return err
@@ -1241,19 +1339,19 @@ func (m *CreateSingerRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateSingerRequestMultiError(errors)
+		return MergeArtistsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSingerRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateSingerRequest.ValidateAll() if the designated
+// MergeArtistsRequestMultiError is an error wrapping multiple validation
+// errors returned by MergeArtistsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateSingerRequestMultiError []error
+type MergeArtistsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSingerRequestMultiError) Error() string {
+func (m MergeArtistsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1262,11 +1360,11 @@ func (m CreateSingerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSingerRequestMultiError) AllErrors() []error { return m }
+func (m MergeArtistsRequestMultiError) AllErrors() []error { return m }
 
-// CreateSingerRequestValidationError is the validation error returned by
-// CreateSingerRequest.Validate if the designated constraints aren't met.
-type CreateSingerRequestValidationError struct {
+// MergeArtistsRequestValidationError is the validation error returned by
+// MergeArtistsRequest.Validate if the designated constraints aren't met.
+type MergeArtistsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1274,24 +1372,24 @@ type CreateSingerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSingerRequestValidationError) Field() string { return e.field }
+func (e MergeArtistsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSingerRequestValidationError) Reason() string { return e.reason }
+func (e MergeArtistsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSingerRequestValidationError) Cause() error { return e.cause }
+func (e MergeArtistsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSingerRequestValidationError) Key() bool { return e.key }
+func (e MergeArtistsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSingerRequestValidationError) ErrorName() string {
-	return "CreateSingerRequestValidationError"
+func (e MergeArtistsRequestValidationError) ErrorName() string {
+	return "MergeArtistsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSingerRequestValidationError) Error() string {
+func (e MergeArtistsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1303,14 +1401,14 @@ func (e CreateSingerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSingerRequest.%s: %s%s",
+		"invalid %sMergeArtistsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSingerRequestValidationError{}
+var _ error = MergeArtistsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1318,24 +1416,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSingerRequestValidationError{}
+} = MergeArtistsRequestValidationError{}
 
-// Validate checks the field values on CreateSingerResponse with the rules
+// Validate checks the field values on MergeArtistsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSingerResponse) Validate() error {
+func (m *MergeArtistsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSingerResponse with the rules
+// ValidateAll checks the field values on MergeArtistsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSingerResponseMultiError, or nil if none found.
-func (m *CreateSingerResponse) ValidateAll() error {
+// MergeArtistsResponseMultiError, or nil if none found.
+func (m *MergeArtistsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSingerResponse) validate(all bool) error {
+func (m *MergeArtistsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1343,19 +1441,19 @@ func (m *CreateSingerResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return CreateSingerResponseMultiError(errors)
+		return MergeArtistsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSingerResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateSingerResponse.ValidateAll() if the designated
+// MergeArtistsResponseMultiError is an error wrapping multiple validation
+// errors returned by MergeArtistsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateSingerResponseMultiError []error
+type MergeArtistsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSingerResponseMultiError) Error() string {
+func (m MergeArtistsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1364,11 +1462,11 @@ func (m CreateSingerResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSingerResponseMultiError) AllErrors() []error { return m }
+func (m MergeArtistsResponseMultiError) AllErrors() []error { return m }
 
-// CreateSingerResponseValidationError is the validation error returned by
-// CreateSingerResponse.Validate if the designated constraints aren't met.
-type CreateSingerResponseValidationError struct {
+// MergeArtistsResponseValidationError is the validation error returned by
+// MergeArtistsResponse.Validate if the designated constraints aren't met.
+type MergeArtistsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1376,24 +1474,24 @@ type CreateSingerResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSingerResponseValidationError) Field() string { return e.field }
+func (e MergeArtistsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSingerResponseValidationError) Reason() string { return e.reason }
+func (e MergeArtistsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSingerResponseValidationError) Cause() error { return e.cause }
+func (e MergeArtistsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSingerResponseValidationError) Key() bool { return e.key }
+func (e MergeArtistsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSingerResponseValidationError) ErrorName() string {
-	return "CreateSingerResponseValidationError"
+func (e MergeArtistsResponseValidationError) ErrorName() string {
+	return "MergeArtistsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSingerResponseValidationError) Error() string {
+func (e MergeArtistsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1405,14 +1503,14 @@ func (e CreateSingerResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSingerResponse.%s: %s%s",
+		"invalid %sMergeArtistsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSingerResponseValidationError{}
+var _ error = MergeArtistsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1420,24 +1518,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSingerResponseValidationError{}
+} = MergeArtistsResponseValidationError{}
 
-// Validate checks the field values on GetUnitsRequest with the rules defined
+// Validate checks the field values on GetSingersRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetUnitsRequest) Validate() error {
+func (m *GetSingersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUnitsRequest with the rules
+// ValidateAll checks the field values on GetSingersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUnitsRequestMultiError, or nil if none found.
-func (m *GetUnitsRequest) ValidateAll() error {
+// GetSingersRequestMultiError, or nil if none found.
+func (m *GetSingersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUnitsRequest) validate(all bool) error {
+func (m *GetSingersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1445,19 +1543,19 @@ func (m *GetUnitsRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GetUnitsRequestMultiError(errors)
+		return GetSingersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUnitsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetUnitsRequest.ValidateAll() if the designated constraints
+// GetSingersRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSingersRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetUnitsRequestMultiError []error
+type GetSingersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUnitsRequestMultiError) Error() string {
+func (m GetSingersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1466,11 +1564,11 @@ func (m GetUnitsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUnitsRequestMultiError) AllErrors() []error { return m }
+func (m GetSingersRequestMultiError) AllErrors() []error { return m }
 
-// GetUnitsRequestValidationError is the validation error returned by
-// GetUnitsRequest.Validate if the designated constraints aren't met.
-type GetUnitsRequestValidationError struct {
+// GetSingersRequestValidationError is the validation error returned by
+// GetSingersRequest.Validate if the designated constraints aren't met.
+type GetSingersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1478,22 +1576,24 @@ type GetUnitsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUnitsRequestValidationError) Field() string { return e.field }
+func (e GetSingersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUnitsRequestValidationError) Reason() string { return e.reason }
+func (e GetSingersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUnitsRequestValidationError) Cause() error { return e.cause }
+func (e GetSingersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUnitsRequestValidationError) Key() bool { return e.key }
+func (e GetSingersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUnitsRequestValidationError) ErrorName() string { return "GetUnitsRequestValidationError" }
+func (e GetSingersRequestValidationError) ErrorName() string {
+	return "GetSingersRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUnitsRequestValidationError) Error() string {
+func (e GetSingersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1505,14 +1605,14 @@ func (e GetUnitsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUnitsRequest.%s: %s%s",
+		"invalid %sGetSingersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUnitsRequestValidationError{}
+var _ error = GetSingersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1520,47 +1620,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUnitsRequestValidationError{}
+} = GetSingersRequestValidationError{}
 
-// Validate checks the field values on GetUnitsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetUnitsResponse) Validate() error {
+// Validate checks the field values on GetSingersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSingersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUnitsResponse with the rules
+// ValidateAll checks the field values on GetSingersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUnitsResponseMultiError, or nil if none found.
-func (m *GetUnitsResponse) ValidateAll() error {
+// GetSingersResponseMultiError, or nil if none found.
+func (m *GetSingersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUnitsResponse) validate(all bool) error {
+func (m *GetSingersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUnits() {
+	for idx, item := range m.GetSingers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetUnitsResponseValidationError{
-						field:  fmt.Sprintf("Units[%v]", idx),
+					errors = append(errors, GetSingersResponseValidationError{
+						field:  fmt.Sprintf("Singers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetUnitsResponseValidationError{
-						field:  fmt.Sprintf("Units[%v]", idx),
+					errors = append(errors, GetSingersResponseValidationError{
+						field:  fmt.Sprintf("Singers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1568,8 +1668,8 @@ func (m *GetUnitsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetUnitsResponseValidationError{
-					field:  fmt.Sprintf("Units[%v]", idx),
+				return GetSingersResponseValidationError{
+					field:  fmt.Sprintf("Singers[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1579,19 +1679,19 @@ func (m *GetUnitsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUnitsResponseMultiError(errors)
+		return GetSingersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUnitsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetUnitsResponse.ValidateAll() if the designated constraints
+// GetSingersResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSingersResponse.ValidateAll() if the designated constraints
 // aren't met.
-type GetUnitsResponseMultiError []error
+type GetSingersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUnitsResponseMultiError) Error() string {
+func (m GetSingersResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1600,11 +1700,11 @@ func (m GetUnitsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUnitsResponseMultiError) AllErrors() []error { return m }
+func (m GetSingersResponseMultiError) AllErrors() []error { return m }
 
-// GetUnitsResponseValidationError is the validation error returned by
-// GetUnitsResponse.Validate if the designated constraints aren't met.
-type GetUnitsResponseValidationError struct {
+// GetSingersResponseValidationError is the validation error returned by
+// GetSingersResponse.Validate if the designated constraints aren't met.
+type GetSingersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1612,22 +1712,24 @@ type GetUnitsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUnitsResponseValidationError) Field() string { return e.field }
+func (e GetSingersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUnitsResponseValidationError) Reason() string { return e.reason }
+func (e GetSingersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUnitsResponseValidationError) Cause() error { return e.cause }
+func (e GetSingersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUnitsResponseValidationError) Key() bool { return e.key }
+func (e GetSingersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUnitsResponseValidationError) ErrorName() string { return "GetUnitsResponseValidationError" }
+func (e GetSingersResponseValidationError) ErrorName() string {
+	return "GetSingersResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUnitsResponseValidationError) Error() string {
+func (e GetSingersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1639,14 +1741,14 @@ func (e GetUnitsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUnitsResponse.%s: %s%s",
+		"invalid %sGetSingersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUnitsResponseValidationError{}
+var _ error = GetSingersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1654,24 +1756,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUnitsResponseValidationError{}
+} = GetSingersResponseValidationError{}
 
-// Validate checks the field values on GetUnitRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetUnitRequest) Validate() error {
+// Validate checks the field values on GetSingerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSingerRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUnitRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetUnitRequestMultiError,
-// or nil if none found.
-func (m *GetUnitRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetSingerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSingerRequestMultiError, or nil if none found.
+func (m *GetSingerRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUnitRequest) validate(all bool) error {
+func (m *GetSingerRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1681,19 +1783,19 @@ func (m *GetUnitRequest) validate(all bool) error {
 	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetUnitRequestMultiError(errors)
+		return GetSingerRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUnitRequestMultiError is an error wrapping multiple validation errors
-// returned by GetUnitRequest.ValidateAll() if the designated constraints
+// GetSingerRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSingerRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetUnitRequestMultiError []error
+type GetSingerRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUnitRequestMultiError) Error() string {
+func (m GetSingerRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1702,11 +1804,11 @@ func (m GetUnitRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUnitRequestMultiError) AllErrors() []error { return m }
+func (m GetSingerRequestMultiError) AllErrors() []error { return m }
 
-// GetUnitRequestValidationError is the validation error returned by
-// GetUnitRequest.Validate if the designated constraints aren't met.
-type GetUnitRequestValidationError struct {
+// GetSingerRequestValidationError is the validation error returned by
+// GetSingerRequest.Validate if the designated constraints aren't met.
+type GetSingerRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1714,22 +1816,22 @@ type GetUnitRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUnitRequestValidationError) Field() string { return e.field }
+func (e GetSingerRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUnitRequestValidationError) Reason() string { return e.reason }
+func (e GetSingerRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUnitRequestValidationError) Cause() error { return e.cause }
+func (e GetSingerRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUnitRequestValidationError) Key() bool { return e.key }
+func (e GetSingerRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUnitRequestValidationError) ErrorName() string { return "GetUnitRequestValidationError" }
+func (e GetSingerRequestValidationError) ErrorName() string { return "GetSingerRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetUnitRequestValidationError) Error() string {
+func (e GetSingerRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1741,14 +1843,14 @@ func (e GetUnitRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUnitRequest.%s: %s%s",
+		"invalid %sGetSingerRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUnitRequestValidationError{}
+var _ error = GetSingerRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1756,24 +1858,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUnitRequestValidationError{}
+} = GetSingerRequestValidationError{}
 
-// Validate checks the field values on GetUnitResponse with the rules defined
+// Validate checks the field values on GetSingerResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetUnitResponse) Validate() error {
+func (m *GetSingerResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUnitResponse with the rules
+// ValidateAll checks the field values on GetSingerResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUnitResponseMultiError, or nil if none found.
-func (m *GetUnitResponse) ValidateAll() error {
+// GetSingerResponseMultiError, or nil if none found.
+func (m *GetSingerResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUnitResponse) validate(all bool) error {
+func (m *GetSingerResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1781,28 +1883,28 @@ func (m *GetUnitResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetUnit()).(type) {
+		switch v := interface{}(m.GetSinger()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetUnitResponseValidationError{
-					field:  "Unit",
+				errors = append(errors, GetSingerResponseValidationError{
+					field:  "Singer",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetUnitResponseValidationError{
-					field:  "Unit",
+				errors = append(errors, GetSingerResponseValidationError{
+					field:  "Singer",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUnit()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSinger()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetUnitResponseValidationError{
-				field:  "Unit",
+			return GetSingerResponseValidationError{
+				field:  "Singer",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1810,19 +1912,19 @@ func (m *GetUnitResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUnitResponseMultiError(errors)
+		return GetSingerResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUnitResponseMultiError is an error wrapping multiple validation errors
-// returned by GetUnitResponse.ValidateAll() if the designated constraints
+// GetSingerResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSingerResponse.ValidateAll() if the designated constraints
 // aren't met.
-type GetUnitResponseMultiError []error
+type GetSingerResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUnitResponseMultiError) Error() string {
+func (m GetSingerResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1831,11 +1933,11 @@ func (m GetUnitResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUnitResponseMultiError) AllErrors() []error { return m }
+func (m GetSingerResponseMultiError) AllErrors() []error { return m }
 
-// GetUnitResponseValidationError is the validation error returned by
-// GetUnitResponse.Validate if the designated constraints aren't met.
-type GetUnitResponseValidationError struct {
+// GetSingerResponseValidationError is the validation error returned by
+// GetSingerResponse.Validate if the designated constraints aren't met.
+type GetSingerResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1843,22 +1945,24 @@ type GetUnitResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUnitResponseValidationError) Field() string { return e.field }
+func (e GetSingerResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUnitResponseValidationError) Reason() string { return e.reason }
+func (e GetSingerResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUnitResponseValidationError) Cause() error { return e.cause }
+func (e GetSingerResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUnitResponseValidationError) Key() bool { return e.key }
+func (e GetSingerResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUnitResponseValidationError) ErrorName() string { return "GetUnitResponseValidationError" }
+func (e GetSingerResponseValidationError) ErrorName() string {
+	return "GetSingerResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUnitResponseValidationError) Error() string {
+func (e GetSingerResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1870,14 +1974,14 @@ func (e GetUnitResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUnitResponse.%s: %s%s",
+		"invalid %sGetSingerResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUnitResponseValidationError{}
+var _ error = GetSingerResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1885,24 +1989,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUnitResponseValidationError{}
+} = GetSingerResponseValidationError{}
 
-// Validate checks the field values on CreateUnitRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateUnitRequest) Validate() error {
+// Validate checks the field values on CreateSingerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSingerRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateUnitRequest with the rules
+// ValidateAll checks the field values on CreateSingerRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateUnitRequestMultiError, or nil if none found.
-func (m *CreateUnitRequest) ValidateAll() error {
+// CreateSingerRequestMultiError, or nil if none found.
+func (m *CreateSingerRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateUnitRequest) validate(all bool) error {
+func (m *CreateSingerRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1910,7 +2014,7 @@ func (m *CreateUnitRequest) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
-		err := CreateUnitRequestValidationError{
+		err := CreateSingerRequestValidationError{
 			field:  "Name",
 			reason: "value length must be between 1 and 255 runes, inclusive",
 		}
@@ -1921,19 +2025,19 @@ func (m *CreateUnitRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateUnitRequestMultiError(errors)
+		return CreateSingerRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateUnitRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateUnitRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateUnitRequestMultiError []error
+// CreateSingerRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSingerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSingerRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateUnitRequestMultiError) Error() string {
+func (m CreateSingerRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1942,11 +2046,11 @@ func (m CreateUnitRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateUnitRequestMultiError) AllErrors() []error { return m }
+func (m CreateSingerRequestMultiError) AllErrors() []error { return m }
 
-// CreateUnitRequestValidationError is the validation error returned by
-// CreateUnitRequest.Validate if the designated constraints aren't met.
-type CreateUnitRequestValidationError struct {
+// CreateSingerRequestValidationError is the validation error returned by
+// CreateSingerRequest.Validate if the designated constraints aren't met.
+type CreateSingerRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1954,24 +2058,24 @@ type CreateUnitRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateUnitRequestValidationError) Field() string { return e.field }
+func (e CreateSingerRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateUnitRequestValidationError) Reason() string { return e.reason }
+func (e CreateSingerRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateUnitRequestValidationError) Cause() error { return e.cause }
+func (e CreateSingerRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateUnitRequestValidationError) Key() bool { return e.key }
+func (e CreateSingerRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateUnitRequestValidationError) ErrorName() string {
-	return "CreateUnitRequestValidationError"
+func (e CreateSingerRequestValidationError) ErrorName() string {
+	return "CreateSingerRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateUnitRequestValidationError) Error() string {
+func (e CreateSingerRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1983,14 +2087,14 @@ func (e CreateUnitRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateUnitRequest.%s: %s%s",
+		"invalid %sCreateSingerRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateUnitRequestValidationError{}
+var _ error = CreateSingerRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1998,24 +2102,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateUnitRequestValidationError{}
+} = CreateSingerRequestValidationError{}
 
-// Validate checks the field values on CreateUnitResponse with the rules
+// Validate checks the field values on CreateSingerResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateUnitResponse) Validate() error {
+func (m *CreateSingerResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateUnitResponse with the rules
+// ValidateAll checks the field values on CreateSingerResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateUnitResponseMultiError, or nil if none found.
-func (m *CreateUnitResponse) ValidateAll() error {
+// CreateSingerResponseMultiError, or nil if none found.
+func (m *CreateSingerResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateUnitResponse) validate(all bool) error {
+func (m *CreateSingerResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2023,19 +2127,19 @@ func (m *CreateUnitResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return CreateUnitResponseMultiError(errors)
+		return CreateSingerResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateUnitResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateUnitResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CreateUnitResponseMultiError []error
+// CreateSingerResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSingerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSingerResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateUnitResponseMultiError) Error() string {
+func (m CreateSingerResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2044,11 +2148,11 @@ func (m CreateUnitResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateUnitResponseMultiError) AllErrors() []error { return m }
+func (m CreateSingerResponseMultiError) AllErrors() []error { return m }
 
-// CreateUnitResponseValidationError is the validation error returned by
-// CreateUnitResponse.Validate if the designated constraints aren't met.
-type CreateUnitResponseValidationError struct {
+// CreateSingerResponseValidationError is the validation error returned by
+// CreateSingerResponse.Validate if the designated constraints aren't met.
+type CreateSingerResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2056,24 +2160,24 @@ type CreateUnitResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateUnitResponseValidationError) Field() string { return e.field }
+func (e CreateSingerResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateUnitResponseValidationError) Reason() string { return e.reason }
+func (e CreateSingerResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateUnitResponseValidationError) Cause() error { return e.cause }
+func (e CreateSingerResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateUnitResponseValidationError) Key() bool { return e.key }
+func (e CreateSingerResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateUnitResponseValidationError) ErrorName() string {
-	return "CreateUnitResponseValidationError"
+func (e CreateSingerResponseValidationError) ErrorName() string {
+	return "CreateSingerResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateUnitResponseValidationError) Error() string {
+func (e CreateSingerResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2085,14 +2189,14 @@ func (e CreateUnitResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateUnitResponse.%s: %s%s",
+		"invalid %sCreateSingerResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateUnitResponseValidationError{}
+var _ error = CreateSingerResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2100,33 +2204,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateUnitResponseValidationError{}
+} = CreateSingerResponseValidationError{}
 
-// Validate checks the field values on CreateVocalPatternRequest with the rules
+// Validate checks the field values on UpdateSingerRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateVocalPatternRequest) Validate() error {
+func (m *UpdateSingerRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateVocalPatternRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateSingerRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateVocalPatternRequestMultiError, or nil if none found.
-func (m *CreateVocalPatternRequest) ValidateAll() error {
+// UpdateSingerRequestMultiError, or nil if none found.
+func (m *UpdateSingerRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateVocalPatternRequest) validate(all bool) error {
+func (m *UpdateSingerRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetSongId() < 1 {
-		err := CreateVocalPatternRequestValidationError{
-			field:  "SongId",
+	if m.GetId() < 1 {
+		err := UpdateSingerRequestValidationError{
+			field:  "Id",
 			reason: "value must be greater than or equal to 1",
 		}
 		if !all {
@@ -2136,7 +2240,7 @@ func (m *CreateVocalPatternRequest) validate(all bool) error {
 	}
 
 	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
-		err := CreateVocalPatternRequestValidationError{
+		err := UpdateSingerRequestValidationError{
 			field:  "Name",
 			reason: "value length must be between 1 and 255 runes, inclusive",
 		}
@@ -2146,52 +2250,20 @@ func (m *CreateVocalPatternRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetSingerIds() {
-		_, _ = idx, item
-
-		if item < 1 {
-			err := CreateVocalPatternRequestValidationError{
-				field:  fmt.Sprintf("SingerIds[%v]", idx),
-				reason: "value must be greater than or equal to 1",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	for idx, item := range m.GetSingerPositions() {
-		_, _ = idx, item
-
-		if item < 1 {
-			err := CreateVocalPatternRequestValidationError{
-				field:  fmt.Sprintf("SingerPositions[%v]", idx),
-				reason: "value must be greater than or equal to 1",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return CreateVocalPatternRequestMultiError(errors)
+		return UpdateSingerRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateVocalPatternRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateVocalPatternRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CreateVocalPatternRequestMultiError []error
+// UpdateSingerRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSingerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSingerRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateVocalPatternRequestMultiError) Error() string {
+func (m UpdateSingerRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2200,11 +2272,11 @@ func (m CreateVocalPatternRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateVocalPatternRequestMultiError) AllErrors() []error { return m }
+func (m UpdateSingerRequestMultiError) AllErrors() []error { return m }
 
-// CreateVocalPatternRequestValidationError is the validation error returned by
-// CreateVocalPatternRequest.Validate if the designated constraints aren't met.
-type CreateVocalPatternRequestValidationError struct {
+// UpdateSingerRequestValidationError is the validation error returned by
+// UpdateSingerRequest.Validate if the designated constraints aren't met.
+type UpdateSingerRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2212,24 +2284,24 @@ type CreateVocalPatternRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateVocalPatternRequestValidationError) Field() string { return e.field }
+func (e UpdateSingerRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateVocalPatternRequestValidationError) Reason() string { return e.reason }
+func (e UpdateSingerRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateVocalPatternRequestValidationError) Cause() error { return e.cause }
+func (e UpdateSingerRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateVocalPatternRequestValidationError) Key() bool { return e.key }
+func (e UpdateSingerRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateVocalPatternRequestValidationError) ErrorName() string {
-	return "CreateVocalPatternRequestValidationError"
+func (e UpdateSingerRequestValidationError) ErrorName() string {
+	return "UpdateSingerRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateVocalPatternRequestValidationError) Error() string {
+func (e UpdateSingerRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2241,14 +2313,14 @@ func (e CreateVocalPatternRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateVocalPatternRequest.%s: %s%s",
+		"invalid %sUpdateSingerRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateVocalPatternRequestValidationError{}
+var _ error = UpdateSingerRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2256,24 +2328,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateVocalPatternRequestValidationError{}
+} = UpdateSingerRequestValidationError{}
 
-// Validate checks the field values on CreateVocalPatternResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateSingerResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateVocalPatternResponse) Validate() error {
+func (m *UpdateSingerResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateVocalPatternResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateSingerResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateVocalPatternResponseMultiError, or nil if none found.
-func (m *CreateVocalPatternResponse) ValidateAll() error {
+// UpdateSingerResponseMultiError, or nil if none found.
+func (m *UpdateSingerResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateVocalPatternResponse) validate(all bool) error {
+func (m *UpdateSingerResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2281,19 +2353,19 @@ func (m *CreateVocalPatternResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return CreateVocalPatternResponseMultiError(errors)
+		return UpdateSingerResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateVocalPatternResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateVocalPatternResponse.ValidateAll() if
-// the designated constraints aren't met.
-type CreateVocalPatternResponseMultiError []error
+// UpdateSingerResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateSingerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSingerResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateVocalPatternResponseMultiError) Error() string {
+func (m UpdateSingerResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2302,11 +2374,11 @@ func (m CreateVocalPatternResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateVocalPatternResponseMultiError) AllErrors() []error { return m }
+func (m UpdateSingerResponseMultiError) AllErrors() []error { return m }
 
-// CreateVocalPatternResponseValidationError is the validation error returned
-// by CreateVocalPatternResponse.Validate if the designated constraints aren't met.
-type CreateVocalPatternResponseValidationError struct {
+// UpdateSingerResponseValidationError is the validation error returned by
+// UpdateSingerResponse.Validate if the designated constraints aren't met.
+type UpdateSingerResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2314,24 +2386,24 @@ type CreateVocalPatternResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateVocalPatternResponseValidationError) Field() string { return e.field }
+func (e UpdateSingerResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateVocalPatternResponseValidationError) Reason() string { return e.reason }
+func (e UpdateSingerResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateVocalPatternResponseValidationError) Cause() error { return e.cause }
+func (e UpdateSingerResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateVocalPatternResponseValidationError) Key() bool { return e.key }
+func (e UpdateSingerResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateVocalPatternResponseValidationError) ErrorName() string {
-	return "CreateVocalPatternResponseValidationError"
+func (e UpdateSingerResponseValidationError) ErrorName() string {
+	return "UpdateSingerResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateVocalPatternResponseValidationError) Error() string {
+func (e UpdateSingerResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2343,14 +2415,14 @@ func (e CreateVocalPatternResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateVocalPatternResponse.%s: %s%s",
+		"invalid %sUpdateSingerResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateVocalPatternResponseValidationError{}
+var _ error = UpdateSingerResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2358,24 +2430,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateVocalPatternResponseValidationError{}
+} = UpdateSingerResponseValidationError{}
 
-// Validate checks the field values on GetSongsRequest with the rules defined
+// Validate checks the field values on GetUnitsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetSongsRequest) Validate() error {
+func (m *GetUnitsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSongsRequest with the rules
+// ValidateAll checks the field values on GetUnitsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSongsRequestMultiError, or nil if none found.
-func (m *GetSongsRequest) ValidateAll() error {
+// GetUnitsRequestMultiError, or nil if none found.
+func (m *GetUnitsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSongsRequest) validate(all bool) error {
+func (m *GetUnitsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2383,19 +2455,19 @@ func (m *GetSongsRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GetSongsRequestMultiError(errors)
+		return GetUnitsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSongsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetSongsRequest.ValidateAll() if the designated constraints
+// GetUnitsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUnitsRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetSongsRequestMultiError []error
+type GetUnitsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSongsRequestMultiError) Error() string {
+func (m GetUnitsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2404,11 +2476,11 @@ func (m GetSongsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSongsRequestMultiError) AllErrors() []error { return m }
+func (m GetUnitsRequestMultiError) AllErrors() []error { return m }
 
-// GetSongsRequestValidationError is the validation error returned by
-// GetSongsRequest.Validate if the designated constraints aren't met.
-type GetSongsRequestValidationError struct {
+// GetUnitsRequestValidationError is the validation error returned by
+// GetUnitsRequest.Validate if the designated constraints aren't met.
+type GetUnitsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2416,22 +2488,22 @@ type GetSongsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSongsRequestValidationError) Field() string { return e.field }
+func (e GetUnitsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSongsRequestValidationError) Reason() string { return e.reason }
+func (e GetUnitsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSongsRequestValidationError) Cause() error { return e.cause }
+func (e GetUnitsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSongsRequestValidationError) Key() bool { return e.key }
+func (e GetUnitsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSongsRequestValidationError) ErrorName() string { return "GetSongsRequestValidationError" }
+func (e GetUnitsRequestValidationError) ErrorName() string { return "GetUnitsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetSongsRequestValidationError) Error() string {
+func (e GetUnitsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2443,14 +2515,14 @@ func (e GetSongsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSongsRequest.%s: %s%s",
+		"invalid %sGetUnitsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSongsRequestValidationError{}
+var _ error = GetUnitsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2458,47 +2530,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSongsRequestValidationError{}
+} = GetUnitsRequestValidationError{}
 
-// Validate checks the field values on GetSongsResponse with the rules defined
+// Validate checks the field values on GetUnitsResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetSongsResponse) Validate() error {
+func (m *GetUnitsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSongsResponse with the rules
+// ValidateAll checks the field values on GetUnitsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSongsResponseMultiError, or nil if none found.
-func (m *GetSongsResponse) ValidateAll() error {
+// GetUnitsResponseMultiError, or nil if none found.
+func (m *GetUnitsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSongsResponse) validate(all bool) error {
+func (m *GetUnitsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSongs() {
+	for idx, item := range m.GetUnits() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetSongsResponseValidationError{
-						field:  fmt.Sprintf("Songs[%v]", idx),
+					errors = append(errors, GetUnitsResponseValidationError{
+						field:  fmt.Sprintf("Units[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetSongsResponseValidationError{
-						field:  fmt.Sprintf("Songs[%v]", idx),
+					errors = append(errors, GetUnitsResponseValidationError{
+						field:  fmt.Sprintf("Units[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2506,8 +2578,8 @@ func (m *GetSongsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetSongsResponseValidationError{
-					field:  fmt.Sprintf("Songs[%v]", idx),
+				return GetUnitsResponseValidationError{
+					field:  fmt.Sprintf("Units[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2517,19 +2589,19 @@ func (m *GetSongsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetSongsResponseMultiError(errors)
+		return GetUnitsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSongsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetSongsResponse.ValidateAll() if the designated constraints
+// GetUnitsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUnitsResponse.ValidateAll() if the designated constraints
 // aren't met.
-type GetSongsResponseMultiError []error
+type GetUnitsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSongsResponseMultiError) Error() string {
+func (m GetUnitsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2538,11 +2610,11 @@ func (m GetSongsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSongsResponseMultiError) AllErrors() []error { return m }
+func (m GetUnitsResponseMultiError) AllErrors() []error { return m }
 
-// GetSongsResponseValidationError is the validation error returned by
-// GetSongsResponse.Validate if the designated constraints aren't met.
-type GetSongsResponseValidationError struct {
+// GetUnitsResponseValidationError is the validation error returned by
+// GetUnitsResponse.Validate if the designated constraints aren't met.
+type GetUnitsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2550,22 +2622,22 @@ type GetSongsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSongsResponseValidationError) Field() string { return e.field }
+func (e GetUnitsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSongsResponseValidationError) Reason() string { return e.reason }
+func (e GetUnitsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSongsResponseValidationError) Cause() error { return e.cause }
+func (e GetUnitsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSongsResponseValidationError) Key() bool { return e.key }
+func (e GetUnitsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSongsResponseValidationError) ErrorName() string { return "GetSongsResponseValidationError" }
+func (e GetUnitsResponseValidationError) ErrorName() string { return "GetUnitsResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetSongsResponseValidationError) Error() string {
+func (e GetUnitsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2577,14 +2649,14 @@ func (e GetSongsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSongsResponse.%s: %s%s",
+		"invalid %sGetUnitsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSongsResponseValidationError{}
+var _ error = GetUnitsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2592,24 +2664,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSongsResponseValidationError{}
+} = GetUnitsResponseValidationError{}
 
-// Validate checks the field values on GetSongRequest with the rules defined in
+// Validate checks the field values on GetUnitRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GetSongRequest) Validate() error {
+func (m *GetUnitRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSongRequest with the rules defined
+// ValidateAll checks the field values on GetUnitRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetSongRequestMultiError,
+// result is a list of violation errors wrapped in GetUnitRequestMultiError,
 // or nil if none found.
-func (m *GetSongRequest) ValidateAll() error {
+func (m *GetUnitRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSongRequest) validate(all bool) error {
+func (m *GetUnitRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2619,19 +2691,19 @@ func (m *GetSongRequest) validate(all bool) error {
 	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetSongRequestMultiError(errors)
+		return GetUnitRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSongRequestMultiError is an error wrapping multiple validation errors
-// returned by GetSongRequest.ValidateAll() if the designated constraints
+// GetUnitRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUnitRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetSongRequestMultiError []error
+type GetUnitRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSongRequestMultiError) Error() string {
+func (m GetUnitRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2640,11 +2712,11 @@ func (m GetSongRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSongRequestMultiError) AllErrors() []error { return m }
+func (m GetUnitRequestMultiError) AllErrors() []error { return m }
 
-// GetSongRequestValidationError is the validation error returned by
-// GetSongRequest.Validate if the designated constraints aren't met.
-type GetSongRequestValidationError struct {
+// GetUnitRequestValidationError is the validation error returned by
+// GetUnitRequest.Validate if the designated constraints aren't met.
+type GetUnitRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2652,22 +2724,22 @@ type GetSongRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSongRequestValidationError) Field() string { return e.field }
+func (e GetUnitRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSongRequestValidationError) Reason() string { return e.reason }
+func (e GetUnitRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSongRequestValidationError) Cause() error { return e.cause }
+func (e GetUnitRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSongRequestValidationError) Key() bool { return e.key }
+func (e GetUnitRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSongRequestValidationError) ErrorName() string { return "GetSongRequestValidationError" }
+func (e GetUnitRequestValidationError) ErrorName() string { return "GetUnitRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetSongRequestValidationError) Error() string {
+func (e GetUnitRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2679,14 +2751,14 @@ func (e GetSongRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSongRequest.%s: %s%s",
+		"invalid %sGetUnitRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSongRequestValidationError{}
+var _ error = GetUnitRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2694,24 +2766,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSongRequestValidationError{}
+} = GetUnitRequestValidationError{}
 
-// Validate checks the field values on GetSongResponse with the rules defined
+// Validate checks the field values on GetUnitResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetSongResponse) Validate() error {
+func (m *GetUnitResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSongResponse with the rules
+// ValidateAll checks the field values on GetUnitResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSongResponseMultiError, or nil if none found.
-func (m *GetSongResponse) ValidateAll() error {
+// GetUnitResponseMultiError, or nil if none found.
+func (m *GetUnitResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSongResponse) validate(all bool) error {
+func (m *GetUnitResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2719,28 +2791,28 @@ func (m *GetSongResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSong()).(type) {
+		switch v := interface{}(m.GetUnit()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetSongResponseValidationError{
-					field:  "Song",
+				errors = append(errors, GetUnitResponseValidationError{
+					field:  "Unit",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetSongResponseValidationError{
-					field:  "Song",
+				errors = append(errors, GetUnitResponseValidationError{
+					field:  "Unit",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSong()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUnit()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetSongResponseValidationError{
-				field:  "Song",
+			return GetUnitResponseValidationError{
+				field:  "Unit",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2748,19 +2820,19 @@ func (m *GetSongResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetSongResponseMultiError(errors)
+		return GetUnitResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSongResponseMultiError is an error wrapping multiple validation errors
-// returned by GetSongResponse.ValidateAll() if the designated constraints
+// GetUnitResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUnitResponse.ValidateAll() if the designated constraints
 // aren't met.
-type GetSongResponseMultiError []error
+type GetUnitResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSongResponseMultiError) Error() string {
+func (m GetUnitResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2769,11 +2841,11 @@ func (m GetSongResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSongResponseMultiError) AllErrors() []error { return m }
+func (m GetUnitResponseMultiError) AllErrors() []error { return m }
 
-// GetSongResponseValidationError is the validation error returned by
-// GetSongResponse.Validate if the designated constraints aren't met.
-type GetSongResponseValidationError struct {
+// GetUnitResponseValidationError is the validation error returned by
+// GetUnitResponse.Validate if the designated constraints aren't met.
+type GetUnitResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2781,22 +2853,22 @@ type GetSongResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSongResponseValidationError) Field() string { return e.field }
+func (e GetUnitResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSongResponseValidationError) Reason() string { return e.reason }
+func (e GetUnitResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSongResponseValidationError) Cause() error { return e.cause }
+func (e GetUnitResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSongResponseValidationError) Key() bool { return e.key }
+func (e GetUnitResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSongResponseValidationError) ErrorName() string { return "GetSongResponseValidationError" }
+func (e GetUnitResponseValidationError) ErrorName() string { return "GetUnitResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetSongResponseValidationError) Error() string {
+func (e GetUnitResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2808,14 +2880,14 @@ func (e GetSongResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSongResponse.%s: %s%s",
+		"invalid %sGetUnitResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSongResponseValidationError{}
+var _ error = GetUnitResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2823,24 +2895,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSongResponseValidationError{}
+} = GetUnitResponseValidationError{}
 
-// Validate checks the field values on CreateSongRequest with the rules defined
+// Validate checks the field values on CreateUnitRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CreateSongRequest) Validate() error {
+func (m *CreateUnitRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSongRequest with the rules
+// ValidateAll checks the field values on CreateUnitRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSongRequestMultiError, or nil if none found.
-func (m *CreateSongRequest) ValidateAll() error {
+// CreateUnitRequestMultiError, or nil if none found.
+func (m *CreateUnitRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSongRequest) validate(all bool) error {
+func (m *CreateUnitRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2848,7 +2920,7 @@ func (m *CreateSongRequest) validate(all bool) error {
 	var errors []error
 
 	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
-		err := CreateSongRequestValidationError{
+		err := CreateUnitRequestValidationError{
 			field:  "Name",
 			reason: "value length must be between 1 and 255 runes, inclusive",
 		}
@@ -2858,131 +2930,122 @@ func (m *CreateSongRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetKana()); l < 1 || l > 255 {
-		err := CreateSongRequestValidationError{
-			field:  "Kana",
-			reason: "value length must be between 1 and 255 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return CreateUnitRequestMultiError(errors)
 	}
 
-	if m.GetLyricsId() < 1 {
-		err := CreateSongRequestValidationError{
-			field:  "LyricsId",
-			reason: "value must be greater than or equal to 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	return nil
+}
 
-	if m.GetMusicId() < 1 {
-		err := CreateSongRequestValidationError{
-			field:  "MusicId",
-			reason: "value must be greater than or equal to 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+// CreateUnitRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateUnitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateUnitRequestMultiError []error
 
-	if m.GetArrangementId() < 1 {
-		err := CreateSongRequestValidationError{
-			field:  "ArrangementId",
-			reason: "value must be greater than or equal to 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUnitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if utf8.RuneCountInString(m.GetThumbnail()) < 1 {
-		err := CreateSongRequestValidationError{
-			field:  "Thumbnail",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+// AllErrors returns a list of validation violation errors.
+func (m CreateUnitRequestMultiError) AllErrors() []error { return m }
 
-	if utf8.RuneCountInString(m.GetOriginalVideo()) < 1 {
-		err := CreateSongRequestValidationError{
-			field:  "OriginalVideo",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+// CreateUnitRequestValidationError is the validation error returned by
+// CreateUnitRequest.Validate if the designated constraints aren't met.
+type CreateUnitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	if m.GetReleaseTime() == nil {
-		err := CreateSongRequestValidationError{
-			field:  "ReleaseTime",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+// Field function returns field value.
+func (e CreateUnitRequestValidationError) Field() string { return e.field }
 
-	// no validation rules for Deleted
+// Reason function returns reason value.
+func (e CreateUnitRequestValidationError) Reason() string { return e.reason }
 
-	for idx, item := range m.GetUnitIds() {
-		_, _ = idx, item
+// Cause function returns cause value.
+func (e CreateUnitRequestValidationError) Cause() error { return e.cause }
 
-		if item < 1 {
-			err := CreateSongRequestValidationError{
-				field:  fmt.Sprintf("UnitIds[%v]", idx),
-				reason: "value must be greater than or equal to 1",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// Key function returns key value.
+func (e CreateUnitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUnitRequestValidationError) ErrorName() string {
+	return "CreateUnitRequestValidationError"
+}
 
+// Error satisfies the builtin error interface
+func (e CreateUnitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	for idx, item := range m.GetMusicVideoTypes() {
-		_, _ = idx, item
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-		if _, ok := enums.MusicVideoType_name[int32(item)]; !ok {
-			err := CreateSongRequestValidationError{
-				field:  fmt.Sprintf("MusicVideoTypes[%v]", idx),
-				reason: "value must be one of the defined enum values",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	return fmt.Sprintf(
+		"invalid %sCreateUnitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUnitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUnitRequestValidationError{}
+
+// Validate checks the field values on CreateUnitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUnitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUnitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUnitResponseMultiError, or nil if none found.
+func (m *CreateUnitResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *CreateUnitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return CreateSongRequestMultiError(errors)
+		return CreateUnitResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSongRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateSongRequest.ValidateAll() if the designated constraints
+// CreateUnitResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateUnitResponse.ValidateAll() if the designated constraints
 // aren't met.
-type CreateSongRequestMultiError []error
+type CreateUnitResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSongRequestMultiError) Error() string {
+func (m CreateUnitResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2991,11 +3054,11 @@ func (m CreateSongRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSongRequestMultiError) AllErrors() []error { return m }
+func (m CreateUnitResponseMultiError) AllErrors() []error { return m }
 
-// CreateSongRequestValidationError is the validation error returned by
-// CreateSongRequest.Validate if the designated constraints aren't met.
-type CreateSongRequestValidationError struct {
+// CreateUnitResponseValidationError is the validation error returned by
+// CreateUnitResponse.Validate if the designated constraints aren't met.
+type CreateUnitResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3003,24 +3066,7005 @@ type CreateSongRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSongRequestValidationError) Field() string { return e.field }
+func (e CreateUnitResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSongRequestValidationError) Reason() string { return e.reason }
+func (e CreateUnitResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSongRequestValidationError) Cause() error { return e.cause }
+func (e CreateUnitResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSongRequestValidationError) Key() bool { return e.key }
+func (e CreateUnitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUnitResponseValidationError) ErrorName() string {
+	return "CreateUnitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUnitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUnitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUnitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUnitResponseValidationError{}
+
+// Validate checks the field values on UpdateUnitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUnitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUnitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUnitRequestMultiError, or nil if none found.
+func (m *UpdateUnitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUnitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := UpdateUnitRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := UpdateUnitRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateUnitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUnitRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateUnitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateUnitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUnitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUnitRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUnitRequestValidationError is the validation error returned by
+// UpdateUnitRequest.Validate if the designated constraints aren't met.
+type UpdateUnitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUnitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUnitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUnitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUnitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUnitRequestValidationError) ErrorName() string {
+	return "UpdateUnitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUnitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUnitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUnitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUnitRequestValidationError{}
+
+// Validate checks the field values on UpdateUnitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUnitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUnitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUnitResponseMultiError, or nil if none found.
+func (m *UpdateUnitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUnitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateUnitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUnitResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateUnitResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateUnitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUnitResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUnitResponseMultiError) AllErrors() []error { return m }
+
+// UpdateUnitResponseValidationError is the validation error returned by
+// UpdateUnitResponse.Validate if the designated constraints aren't met.
+type UpdateUnitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUnitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUnitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUnitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUnitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUnitResponseValidationError) ErrorName() string {
+	return "UpdateUnitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUnitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUnitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUnitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUnitResponseValidationError{}
+
+// Validate checks the field values on CreateUnitSingerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUnitSingerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUnitSingerRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUnitSingerRequestMultiError, or nil if none found.
+func (m *CreateUnitSingerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUnitSingerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUnitId() < 1 {
+		err := CreateUnitSingerRequestValidationError{
+			field:  "UnitId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSingerId() < 1 {
+		err := CreateUnitSingerRequestValidationError{
+			field:  "SingerId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPosition() < 1 {
+		err := CreateUnitSingerRequestValidationError{
+			field:  "Position",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateUnitSingerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUnitSingerRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateUnitSingerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUnitSingerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUnitSingerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUnitSingerRequestMultiError) AllErrors() []error { return m }
+
+// CreateUnitSingerRequestValidationError is the validation error returned by
+// CreateUnitSingerRequest.Validate if the designated constraints aren't met.
+type CreateUnitSingerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUnitSingerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUnitSingerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUnitSingerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUnitSingerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUnitSingerRequestValidationError) ErrorName() string {
+	return "CreateUnitSingerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUnitSingerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUnitSingerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUnitSingerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUnitSingerRequestValidationError{}
+
+// Validate checks the field values on CreateUnitSingerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUnitSingerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUnitSingerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUnitSingerResponseMultiError, or nil if none found.
+func (m *CreateUnitSingerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUnitSingerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateUnitSingerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUnitSingerResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateUnitSingerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUnitSingerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUnitSingerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUnitSingerResponseMultiError) AllErrors() []error { return m }
+
+// CreateUnitSingerResponseValidationError is the validation error returned by
+// CreateUnitSingerResponse.Validate if the designated constraints aren't met.
+type CreateUnitSingerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUnitSingerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUnitSingerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUnitSingerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUnitSingerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUnitSingerResponseValidationError) ErrorName() string {
+	return "CreateUnitSingerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUnitSingerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUnitSingerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUnitSingerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUnitSingerResponseValidationError{}
+
+// Validate checks the field values on UpdateUnitSingerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUnitSingerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUnitSingerRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUnitSingerRequestMultiError, or nil if none found.
+func (m *UpdateUnitSingerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUnitSingerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUnitId() < 1 {
+		err := UpdateUnitSingerRequestValidationError{
+			field:  "UnitId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSingerId() < 1 {
+		err := UpdateUnitSingerRequestValidationError{
+			field:  "SingerId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPosition() < 1 {
+		err := UpdateUnitSingerRequestValidationError{
+			field:  "Position",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateUnitSingerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUnitSingerRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateUnitSingerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateUnitSingerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUnitSingerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUnitSingerRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUnitSingerRequestValidationError is the validation error returned by
+// UpdateUnitSingerRequest.Validate if the designated constraints aren't met.
+type UpdateUnitSingerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUnitSingerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUnitSingerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUnitSingerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUnitSingerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUnitSingerRequestValidationError) ErrorName() string {
+	return "UpdateUnitSingerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUnitSingerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUnitSingerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUnitSingerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUnitSingerRequestValidationError{}
+
+// Validate checks the field values on UpdateUnitSingerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUnitSingerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUnitSingerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUnitSingerResponseMultiError, or nil if none found.
+func (m *UpdateUnitSingerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUnitSingerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateUnitSingerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUnitSingerResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateUnitSingerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateUnitSingerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUnitSingerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUnitSingerResponseMultiError) AllErrors() []error { return m }
+
+// UpdateUnitSingerResponseValidationError is the validation error returned by
+// UpdateUnitSingerResponse.Validate if the designated constraints aren't met.
+type UpdateUnitSingerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUnitSingerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUnitSingerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUnitSingerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUnitSingerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUnitSingerResponseValidationError) ErrorName() string {
+	return "UpdateUnitSingerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUnitSingerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUnitSingerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUnitSingerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUnitSingerResponseValidationError{}
+
+// Validate checks the field values on DeleteUnitSingerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUnitSingerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUnitSingerRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUnitSingerRequestMultiError, or nil if none found.
+func (m *DeleteUnitSingerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUnitSingerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUnitId() < 1 {
+		err := DeleteUnitSingerRequestValidationError{
+			field:  "UnitId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSingerId() < 1 {
+		err := DeleteUnitSingerRequestValidationError{
+			field:  "SingerId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteUnitSingerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUnitSingerRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteUnitSingerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUnitSingerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUnitSingerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUnitSingerRequestMultiError) AllErrors() []error { return m }
+
+// DeleteUnitSingerRequestValidationError is the validation error returned by
+// DeleteUnitSingerRequest.Validate if the designated constraints aren't met.
+type DeleteUnitSingerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUnitSingerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUnitSingerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUnitSingerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUnitSingerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUnitSingerRequestValidationError) ErrorName() string {
+	return "DeleteUnitSingerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUnitSingerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUnitSingerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUnitSingerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUnitSingerRequestValidationError{}
+
+// Validate checks the field values on DeleteUnitSingerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUnitSingerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUnitSingerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUnitSingerResponseMultiError, or nil if none found.
+func (m *DeleteUnitSingerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUnitSingerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteUnitSingerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUnitSingerResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteUnitSingerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUnitSingerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUnitSingerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUnitSingerResponseMultiError) AllErrors() []error { return m }
+
+// DeleteUnitSingerResponseValidationError is the validation error returned by
+// DeleteUnitSingerResponse.Validate if the designated constraints aren't met.
+type DeleteUnitSingerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUnitSingerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUnitSingerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUnitSingerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUnitSingerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUnitSingerResponseValidationError) ErrorName() string {
+	return "DeleteUnitSingerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUnitSingerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUnitSingerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUnitSingerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUnitSingerResponseValidationError{}
+
+// Validate checks the field values on GetVocalPatternsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVocalPatternsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVocalPatternsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVocalPatternsRequestMultiError, or nil if none found.
+func (m *GetVocalPatternsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVocalPatternsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSongId() < 0 {
+		err := GetVocalPatternsRequestValidationError{
+			field:  "SongId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetVocalPatternsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVocalPatternsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetVocalPatternsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetVocalPatternsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVocalPatternsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVocalPatternsRequestMultiError) AllErrors() []error { return m }
+
+// GetVocalPatternsRequestValidationError is the validation error returned by
+// GetVocalPatternsRequest.Validate if the designated constraints aren't met.
+type GetVocalPatternsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVocalPatternsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVocalPatternsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVocalPatternsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVocalPatternsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVocalPatternsRequestValidationError) ErrorName() string {
+	return "GetVocalPatternsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVocalPatternsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVocalPatternsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVocalPatternsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVocalPatternsRequestValidationError{}
+
+// Validate checks the field values on GetVocalPatternsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVocalPatternsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVocalPatternsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVocalPatternsResponseMultiError, or nil if none found.
+func (m *GetVocalPatternsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVocalPatternsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVocalPatterns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetVocalPatternsResponseValidationError{
+						field:  fmt.Sprintf("VocalPatterns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetVocalPatternsResponseValidationError{
+						field:  fmt.Sprintf("VocalPatterns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetVocalPatternsResponseValidationError{
+					field:  fmt.Sprintf("VocalPatterns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetVocalPatternsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVocalPatternsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetVocalPatternsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetVocalPatternsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVocalPatternsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVocalPatternsResponseMultiError) AllErrors() []error { return m }
+
+// GetVocalPatternsResponseValidationError is the validation error returned by
+// GetVocalPatternsResponse.Validate if the designated constraints aren't met.
+type GetVocalPatternsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVocalPatternsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVocalPatternsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVocalPatternsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVocalPatternsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVocalPatternsResponseValidationError) ErrorName() string {
+	return "GetVocalPatternsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVocalPatternsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVocalPatternsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVocalPatternsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVocalPatternsResponseValidationError{}
+
+// Validate checks the field values on GetVocalPatternRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVocalPatternRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVocalPatternRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVocalPatternRequestMultiError, or nil if none found.
+func (m *GetVocalPatternRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVocalPatternRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetVocalPatternRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVocalPatternRequestMultiError is an error wrapping multiple validation
+// errors returned by GetVocalPatternRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetVocalPatternRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVocalPatternRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVocalPatternRequestMultiError) AllErrors() []error { return m }
+
+// GetVocalPatternRequestValidationError is the validation error returned by
+// GetVocalPatternRequest.Validate if the designated constraints aren't met.
+type GetVocalPatternRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVocalPatternRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVocalPatternRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVocalPatternRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVocalPatternRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVocalPatternRequestValidationError) ErrorName() string {
+	return "GetVocalPatternRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVocalPatternRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVocalPatternRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVocalPatternRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVocalPatternRequestValidationError{}
+
+// Validate checks the field values on GetVocalPatternResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVocalPatternResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVocalPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVocalPatternResponseMultiError, or nil if none found.
+func (m *GetVocalPatternResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVocalPatternResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetVocalPattern()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetVocalPatternResponseValidationError{
+					field:  "VocalPattern",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetVocalPatternResponseValidationError{
+					field:  "VocalPattern",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVocalPattern()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetVocalPatternResponseValidationError{
+				field:  "VocalPattern",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetVocalPatternResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetVocalPatternResponseMultiError is an error wrapping multiple validation
+// errors returned by GetVocalPatternResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetVocalPatternResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetVocalPatternResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetVocalPatternResponseMultiError) AllErrors() []error { return m }
+
+// GetVocalPatternResponseValidationError is the validation error returned by
+// GetVocalPatternResponse.Validate if the designated constraints aren't met.
+type GetVocalPatternResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetVocalPatternResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetVocalPatternResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetVocalPatternResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetVocalPatternResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetVocalPatternResponseValidationError) ErrorName() string {
+	return "GetVocalPatternResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetVocalPatternResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetVocalPatternResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetVocalPatternResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetVocalPatternResponseValidationError{}
+
+// Validate checks the field values on CreateVocalPatternRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateVocalPatternRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateVocalPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateVocalPatternRequestMultiError, or nil if none found.
+func (m *CreateVocalPatternRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateVocalPatternRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSongId() < 1 {
+		err := CreateVocalPatternRequestValidationError{
+			field:  "SongId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := CreateVocalPatternRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSingerIds() {
+		_, _ = idx, item
+
+		if item < 1 {
+			err := CreateVocalPatternRequestValidationError{
+				field:  fmt.Sprintf("SingerIds[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetSingerPositions() {
+		_, _ = idx, item
+
+		if item < 1 {
+			err := CreateVocalPatternRequestValidationError{
+				field:  fmt.Sprintf("SingerPositions[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateVocalPatternRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateVocalPatternRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateVocalPatternRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateVocalPatternRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateVocalPatternRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateVocalPatternRequestMultiError) AllErrors() []error { return m }
+
+// CreateVocalPatternRequestValidationError is the validation error returned by
+// CreateVocalPatternRequest.Validate if the designated constraints aren't met.
+type CreateVocalPatternRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateVocalPatternRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateVocalPatternRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateVocalPatternRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateVocalPatternRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateVocalPatternRequestValidationError) ErrorName() string {
+	return "CreateVocalPatternRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateVocalPatternRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateVocalPatternRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateVocalPatternRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateVocalPatternRequestValidationError{}
+
+// Validate checks the field values on CreateVocalPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateVocalPatternResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateVocalPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateVocalPatternResponseMultiError, or nil if none found.
+func (m *CreateVocalPatternResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateVocalPatternResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateVocalPatternResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateVocalPatternResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateVocalPatternResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateVocalPatternResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateVocalPatternResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateVocalPatternResponseMultiError) AllErrors() []error { return m }
+
+// CreateVocalPatternResponseValidationError is the validation error returned
+// by CreateVocalPatternResponse.Validate if the designated constraints aren't met.
+type CreateVocalPatternResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateVocalPatternResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateVocalPatternResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateVocalPatternResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateVocalPatternResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateVocalPatternResponseValidationError) ErrorName() string {
+	return "CreateVocalPatternResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateVocalPatternResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateVocalPatternResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateVocalPatternResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateVocalPatternResponseValidationError{}
+
+// Validate checks the field values on UpdateVocalPatternRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateVocalPatternRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateVocalPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateVocalPatternRequestMultiError, or nil if none found.
+func (m *UpdateVocalPatternRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateVocalPatternRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := UpdateVocalPatternRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := UpdateVocalPatternRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSingerIds() {
+		_, _ = idx, item
+
+		if item < 1 {
+			err := UpdateVocalPatternRequestValidationError{
+				field:  fmt.Sprintf("SingerIds[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetSingerPositions() {
+		_, _ = idx, item
+
+		if item < 1 {
+			err := UpdateVocalPatternRequestValidationError{
+				field:  fmt.Sprintf("SingerPositions[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateVocalPatternRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateVocalPatternRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateVocalPatternRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateVocalPatternRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateVocalPatternRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateVocalPatternRequestMultiError) AllErrors() []error { return m }
+
+// UpdateVocalPatternRequestValidationError is the validation error returned by
+// UpdateVocalPatternRequest.Validate if the designated constraints aren't met.
+type UpdateVocalPatternRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateVocalPatternRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateVocalPatternRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateVocalPatternRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateVocalPatternRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateVocalPatternRequestValidationError) ErrorName() string {
+	return "UpdateVocalPatternRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateVocalPatternRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateVocalPatternRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateVocalPatternRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateVocalPatternRequestValidationError{}
+
+// Validate checks the field values on UpdateVocalPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateVocalPatternResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateVocalPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateVocalPatternResponseMultiError, or nil if none found.
+func (m *UpdateVocalPatternResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateVocalPatternResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateVocalPatternResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateVocalPatternResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateVocalPatternResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateVocalPatternResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateVocalPatternResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateVocalPatternResponseMultiError) AllErrors() []error { return m }
+
+// UpdateVocalPatternResponseValidationError is the validation error returned
+// by UpdateVocalPatternResponse.Validate if the designated constraints aren't met.
+type UpdateVocalPatternResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateVocalPatternResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateVocalPatternResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateVocalPatternResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateVocalPatternResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateVocalPatternResponseValidationError) ErrorName() string {
+	return "UpdateVocalPatternResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateVocalPatternResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateVocalPatternResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateVocalPatternResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateVocalPatternResponseValidationError{}
+
+// Validate checks the field values on DeleteVocalPatternRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteVocalPatternRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteVocalPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteVocalPatternRequestMultiError, or nil if none found.
+func (m *DeleteVocalPatternRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteVocalPatternRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := DeleteVocalPatternRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteVocalPatternRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteVocalPatternRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteVocalPatternRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteVocalPatternRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteVocalPatternRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteVocalPatternRequestMultiError) AllErrors() []error { return m }
+
+// DeleteVocalPatternRequestValidationError is the validation error returned by
+// DeleteVocalPatternRequest.Validate if the designated constraints aren't met.
+type DeleteVocalPatternRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteVocalPatternRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteVocalPatternRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteVocalPatternRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteVocalPatternRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteVocalPatternRequestValidationError) ErrorName() string {
+	return "DeleteVocalPatternRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteVocalPatternRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteVocalPatternRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteVocalPatternRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteVocalPatternRequestValidationError{}
+
+// Validate checks the field values on DeleteVocalPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteVocalPatternResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteVocalPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteVocalPatternResponseMultiError, or nil if none found.
+func (m *DeleteVocalPatternResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteVocalPatternResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteVocalPatternResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteVocalPatternResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteVocalPatternResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteVocalPatternResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteVocalPatternResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteVocalPatternResponseMultiError) AllErrors() []error { return m }
+
+// DeleteVocalPatternResponseValidationError is the validation error returned
+// by DeleteVocalPatternResponse.Validate if the designated constraints aren't met.
+type DeleteVocalPatternResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteVocalPatternResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteVocalPatternResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteVocalPatternResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteVocalPatternResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteVocalPatternResponseValidationError) ErrorName() string {
+	return "DeleteVocalPatternResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteVocalPatternResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteVocalPatternResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteVocalPatternResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteVocalPatternResponseValidationError{}
+
+// Validate checks the field values on GetSongsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSongsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSongsRequestMultiError, or nil if none found.
+func (m *GetSongsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSongsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return GetSongsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSongsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSongsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSongsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSongsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSongsRequestMultiError) AllErrors() []error { return m }
+
+// GetSongsRequestValidationError is the validation error returned by
+// GetSongsRequest.Validate if the designated constraints aren't met.
+type GetSongsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSongsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSongsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSongsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSongsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSongsRequestValidationError) ErrorName() string { return "GetSongsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSongsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSongsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSongsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSongsRequestValidationError{}
+
+// Validate checks the field values on GetSongsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSongsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSongsResponseMultiError, or nil if none found.
+func (m *GetSongsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSongsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSongs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSongsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSongsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSongsResponseValidationError{
+					field:  fmt.Sprintf("Songs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSongsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSongsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSongsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetSongsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSongsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSongsResponseMultiError) AllErrors() []error { return m }
+
+// GetSongsResponseValidationError is the validation error returned by
+// GetSongsResponse.Validate if the designated constraints aren't met.
+type GetSongsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSongsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSongsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSongsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSongsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSongsResponseValidationError) ErrorName() string { return "GetSongsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSongsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSongsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSongsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSongsResponseValidationError{}
+
+// Validate checks the field values on GetSongRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetSongRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSongRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetSongRequestMultiError,
+// or nil if none found.
+func (m *GetSongRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSongRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetSongRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSongRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSongRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSongRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSongRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSongRequestMultiError) AllErrors() []error { return m }
+
+// GetSongRequestValidationError is the validation error returned by
+// GetSongRequest.Validate if the designated constraints aren't met.
+type GetSongRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSongRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSongRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSongRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSongRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSongRequestValidationError) ErrorName() string { return "GetSongRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSongRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSongRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSongRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSongRequestValidationError{}
+
+// Validate checks the field values on GetSongResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetSongResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSongResponseMultiError, or nil if none found.
+func (m *GetSongResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSongResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSong()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSongResponseValidationError{
+					field:  "Song",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSongResponseValidationError{
+					field:  "Song",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSong()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSongResponseValidationError{
+				field:  "Song",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSongResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSongResponseMultiError is an error wrapping multiple validation errors
+// returned by GetSongResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetSongResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSongResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSongResponseMultiError) AllErrors() []error { return m }
+
+// GetSongResponseValidationError is the validation error returned by
+// GetSongResponse.Validate if the designated constraints aren't met.
+type GetSongResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSongResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSongResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSongResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSongResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSongResponseValidationError) ErrorName() string { return "GetSongResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetSongResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSongResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSongResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSongResponseValidationError{}
+
+// Validate checks the field values on SongCreditInput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongCreditInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongCreditInput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongCreditInputMultiError, or nil if none found.
+func (m *SongCreditInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongCreditInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetArtistId() < 1 {
+		err := SongCreditInputValidationError{
+			field:  "ArtistId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SongCreditInput_Role_NotInLookup[m.GetRole()]; ok {
+		err := SongCreditInputValidationError{
+			field:  "Role",
+			reason: "value must not be in list [CREDIT_ROLE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := enums.CreditRole_name[int32(m.GetRole())]; !ok {
+		err := SongCreditInputValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SongCreditInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongCreditInputMultiError is an error wrapping multiple validation errors
+// returned by SongCreditInput.ValidateAll() if the designated constraints
+// aren't met.
+type SongCreditInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongCreditInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongCreditInputMultiError) AllErrors() []error { return m }
+
+// SongCreditInputValidationError is the validation error returned by
+// SongCreditInput.Validate if the designated constraints aren't met.
+type SongCreditInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongCreditInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongCreditInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongCreditInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongCreditInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongCreditInputValidationError) ErrorName() string { return "SongCreditInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongCreditInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongCreditInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongCreditInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongCreditInputValidationError{}
+
+var _SongCreditInput_Role_NotInLookup = map[enums.CreditRole]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateSongRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateSongRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSongRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSongRequestMultiError, or nil if none found.
+func (m *CreateSongRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSongRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := CreateSongRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetKana()); l < 1 || l > 255 {
+		err := CreateSongRequestValidationError{
+			field:  "Kana",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetThumbnail()) < 1 {
+		err := CreateSongRequestValidationError{
+			field:  "Thumbnail",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOriginalVideo()) < 1 {
+		err := CreateSongRequestValidationError{
+			field:  "OriginalVideo",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReleaseTime() == nil {
+		err := CreateSongRequestValidationError{
+			field:  "ReleaseTime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Deleted
+
+	for idx, item := range m.GetUnitIds() {
+		_, _ = idx, item
+
+		if item < 1 {
+			err := CreateSongRequestValidationError{
+				field:  fmt.Sprintf("UnitIds[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetMusicVideoTypes() {
+		_, _ = idx, item
+
+		if _, ok := enums.MusicVideoType_name[int32(item)]; !ok {
+			err := CreateSongRequestValidationError{
+				field:  fmt.Sprintf("MusicVideoTypes[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetCredits()) < 3 {
+		err := CreateSongRequestValidationError{
+			field:  "Credits",
+			reason: "value must contain at least 3 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateSongRequestValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateSongRequestValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateSongRequestValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateSongRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSongRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateSongRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateSongRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSongRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSongRequestMultiError) AllErrors() []error { return m }
+
+// CreateSongRequestValidationError is the validation error returned by
+// CreateSongRequest.Validate if the designated constraints aren't met.
+type CreateSongRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSongRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSongRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSongRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSongRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSongRequestValidationError) ErrorName() string {
+	return "CreateSongRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSongRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSongRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSongRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSongRequestValidationError{}
+
+// Validate checks the field values on CreateSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSongResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSongResponseMultiError, or nil if none found.
+func (m *CreateSongResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSongResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateSongResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSongResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateSongResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateSongResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSongResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSongResponseMultiError) AllErrors() []error { return m }
+
+// CreateSongResponseValidationError is the validation error returned by
+// CreateSongResponse.Validate if the designated constraints aren't met.
+type CreateSongResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSongResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSongResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSongResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSongResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSongResponseValidationError) ErrorName() string {
+	return "CreateSongResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSongResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSongResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSongResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSongResponseValidationError{}
+
+// Validate checks the field values on UpdateSongRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSongRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSongRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSongRequestMultiError, or nil if none found.
+func (m *UpdateSongRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSongRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := UpdateSongRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := UpdateSongRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetKana()); l < 1 || l > 255 {
+		err := UpdateSongRequestValidationError{
+			field:  "Kana",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetThumbnail()) < 1 {
+		err := UpdateSongRequestValidationError{
+			field:  "Thumbnail",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOriginalVideo()) < 1 {
+		err := UpdateSongRequestValidationError{
+			field:  "OriginalVideo",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReleaseTime() == nil {
+		err := UpdateSongRequestValidationError{
+			field:  "ReleaseTime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Deleted
+
+	for idx, item := range m.GetUnitIds() {
+		_, _ = idx, item
+
+		if item < 1 {
+			err := UpdateSongRequestValidationError{
+				field:  fmt.Sprintf("UnitIds[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetMusicVideoTypes() {
+		_, _ = idx, item
+
+		if _, ok := enums.MusicVideoType_name[int32(item)]; !ok {
+			err := UpdateSongRequestValidationError{
+				field:  fmt.Sprintf("MusicVideoTypes[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetCredits()) < 3 {
+		err := UpdateSongRequestValidationError{
+			field:  "Credits",
+			reason: "value must contain at least 3 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateSongRequestValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateSongRequestValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateSongRequestValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateSongRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSongRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateSongRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateSongRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSongRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSongRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSongRequestValidationError is the validation error returned by
+// UpdateSongRequest.Validate if the designated constraints aren't met.
+type UpdateSongRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSongRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSongRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSongRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSongRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSongRequestValidationError) ErrorName() string {
+	return "UpdateSongRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSongRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSongRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSongRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSongRequestValidationError{}
+
+// Validate checks the field values on UpdateSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSongResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSongResponseMultiError, or nil if none found.
+func (m *UpdateSongResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSongResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateSongResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSongResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateSongResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateSongResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSongResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSongResponseMultiError) AllErrors() []error { return m }
+
+// UpdateSongResponseValidationError is the validation error returned by
+// UpdateSongResponse.Validate if the designated constraints aren't met.
+type UpdateSongResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSongResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSongResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSongResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSongResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSongResponseValidationError) ErrorName() string {
+	return "UpdateSongResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSongResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSongResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSongResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSongResponseValidationError{}
+
+// Validate checks the field values on DeleteSongRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSongRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSongRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSongRequestMultiError, or nil if none found.
+func (m *DeleteSongRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSongRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := DeleteSongRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSongRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSongRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteSongRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteSongRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSongRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSongRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSongRequestValidationError is the validation error returned by
+// DeleteSongRequest.Validate if the designated constraints aren't met.
+type DeleteSongRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSongRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSongRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSongRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSongRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSongRequestValidationError) ErrorName() string {
+	return "DeleteSongRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSongRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSongRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSongRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSongRequestValidationError{}
+
+// Validate checks the field values on DeleteSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSongResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSongResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSongResponseMultiError, or nil if none found.
+func (m *DeleteSongResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSongResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSongResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSongResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteSongResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteSongResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSongResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSongResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSongResponseValidationError is the validation error returned by
+// DeleteSongResponse.Validate if the designated constraints aren't met.
+type DeleteSongResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSongResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSongResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSongResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSongResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSongResponseValidationError) ErrorName() string {
+	return "DeleteSongResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSongResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSongResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSongResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSongResponseValidationError{}
+
+// Validate checks the field values on SearchSongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchSongsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchSongsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchSongsRequestMultiError, or nil if none found.
+func (m *SearchSongsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchSongsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetQuery()) > 255 {
+		err := SearchSongsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLyricsId() < 0 {
+		err := SearchSongsRequestValidationError{
+			field:  "LyricsId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMusicId() < 0 {
+		err := SearchSongsRequestValidationError{
+			field:  "MusicId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetArrangementId() < 0 {
+		err := SearchSongsRequestValidationError{
+			field:  "ArrangementId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUnitId() < 0 {
+		err := SearchSongsRequestValidationError{
+			field:  "UnitId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSingerId() < 0 {
+		err := SearchSongsRequestValidationError{
+			field:  "SingerId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := enums.MusicVideoType_name[int32(m.GetMusicVideoType())]; !ok {
+		err := SearchSongsRequestValidationError{
+			field:  "MusicVideoType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetReleaseFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchSongsRequestValidationError{
+					field:  "ReleaseFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchSongsRequestValidationError{
+					field:  "ReleaseFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchSongsRequestValidationError{
+				field:  "ReleaseFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReleaseTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchSongsRequestValidationError{
+					field:  "ReleaseTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchSongsRequestValidationError{
+					field:  "ReleaseTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchSongsRequestValidationError{
+				field:  "ReleaseTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := SearchSongsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return SearchSongsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchSongsRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchSongsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchSongsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchSongsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchSongsRequestMultiError) AllErrors() []error { return m }
+
+// SearchSongsRequestValidationError is the validation error returned by
+// SearchSongsRequest.Validate if the designated constraints aren't met.
+type SearchSongsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchSongsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchSongsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchSongsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchSongsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchSongsRequestValidationError) ErrorName() string {
+	return "SearchSongsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchSongsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchSongsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchSongsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchSongsRequestValidationError{}
+
+// Validate checks the field values on SearchSongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchSongsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchSongsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchSongsResponseMultiError, or nil if none found.
+func (m *SearchSongsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchSongsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSongs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchSongsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchSongsResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchSongsResponseValidationError{
+					field:  fmt.Sprintf("Songs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchSongsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchSongsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchSongsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchSongsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchSongsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchSongsResponseMultiError) AllErrors() []error { return m }
+
+// SearchSongsResponseValidationError is the validation error returned by
+// SearchSongsResponse.Validate if the designated constraints aren't met.
+type SearchSongsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchSongsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchSongsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchSongsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchSongsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchSongsResponseValidationError) ErrorName() string {
+	return "SearchSongsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchSongsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchSongsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchSongsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchSongsResponseValidationError{}
+
+// Validate checks the field values on ListSongReleasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSongReleasesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSongReleasesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSongReleasesRequestMultiError, or nil if none found.
+func (m *ListSongReleasesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSongReleasesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUnitId() < 0 {
+		err := ListSongReleasesRequestValidationError{
+			field:  "UnitId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListSongReleasesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSongReleasesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSongReleasesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSongReleasesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSongReleasesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSongReleasesRequestMultiError) AllErrors() []error { return m }
+
+// ListSongReleasesRequestValidationError is the validation error returned by
+// ListSongReleasesRequest.Validate if the designated constraints aren't met.
+type ListSongReleasesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSongReleasesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSongReleasesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSongReleasesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSongReleasesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSongReleasesRequestValidationError) ErrorName() string {
+	return "ListSongReleasesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSongReleasesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSongReleasesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSongReleasesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSongReleasesRequestValidationError{}
+
+// Validate checks the field values on ListSongReleasesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSongReleasesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSongReleasesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSongReleasesResponseMultiError, or nil if none found.
+func (m *ListSongReleasesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSongReleasesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMonths() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSongReleasesResponseValidationError{
+						field:  fmt.Sprintf("Months[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSongReleasesResponseValidationError{
+						field:  fmt.Sprintf("Months[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSongReleasesResponseValidationError{
+					field:  fmt.Sprintf("Months[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSongReleasesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSongReleasesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSongReleasesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSongReleasesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSongReleasesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSongReleasesResponseMultiError) AllErrors() []error { return m }
+
+// ListSongReleasesResponseValidationError is the validation error returned by
+// ListSongReleasesResponse.Validate if the designated constraints aren't met.
+type ListSongReleasesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSongReleasesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSongReleasesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSongReleasesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSongReleasesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSongReleasesResponseValidationError) ErrorName() string {
+	return "ListSongReleasesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSongReleasesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSongReleasesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSongReleasesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSongReleasesResponseValidationError{}
+
+// Validate checks the field values on GetChartsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetChartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChartsRequestMultiError, or nil if none found.
+func (m *GetChartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeDeleted
+
+	if m.GetMinNoteCount() < 0 {
+		err := GetChartsRequestValidationError{
+			field:  "MinNoteCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxNoteCount() < 0 {
+		err := GetChartsRequestValidationError{
+			field:  "MaxNoteCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinBpm() < 0 {
+		err := GetChartsRequestValidationError{
+			field:  "MinBpm",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxBpm() < 0 {
+		err := GetChartsRequestValidationError{
+			field:  "MaxBpm",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinDurationSeconds() < 0 {
+		err := GetChartsRequestValidationError{
+			field:  "MinDurationSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxDurationSeconds() < 0 {
+		err := GetChartsRequestValidationError{
+			field:  "MaxDurationSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinConstant() < 0 {
+		err := GetChartsRequestValidationError{
+			field:  "MinConstant",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxConstant() < 0 {
+		err := GetChartsRequestValidationError{
+			field:  "MaxConstant",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := enums.ChartSortKey_name[int32(m.GetSortKey())]; !ok {
+		err := GetChartsRequestValidationError{
+			field:  "SortKey",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Descending
+
+	if len(errors) > 0 {
+		return GetChartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChartsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetChartsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetChartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChartsRequestMultiError) AllErrors() []error { return m }
+
+// GetChartsRequestValidationError is the validation error returned by
+// GetChartsRequest.Validate if the designated constraints aren't met.
+type GetChartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChartsRequestValidationError) ErrorName() string { return "GetChartsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetChartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChartsRequestValidationError{}
+
+// Validate checks the field values on GetChartsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetChartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChartsResponseMultiError, or nil if none found.
+func (m *GetChartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCharts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetChartsResponseValidationError{
+						field:  fmt.Sprintf("Charts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetChartsResponseValidationError{
+						field:  fmt.Sprintf("Charts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetChartsResponseValidationError{
+					field:  fmt.Sprintf("Charts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetChartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChartsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetChartsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetChartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChartsResponseMultiError) AllErrors() []error { return m }
+
+// GetChartsResponseValidationError is the validation error returned by
+// GetChartsResponse.Validate if the designated constraints aren't met.
+type GetChartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChartsResponseValidationError) ErrorName() string {
+	return "GetChartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChartsResponseValidationError{}
+
+// Validate checks the field values on GetChartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetChartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChartRequestMultiError, or nil if none found.
+func (m *GetChartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetAsOf()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetChartRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetChartRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetChartRequestValidationError{
+				field:  "AsOf",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetChartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChartRequestMultiError is an error wrapping multiple validation errors
+// returned by GetChartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetChartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChartRequestMultiError) AllErrors() []error { return m }
+
+// GetChartRequestValidationError is the validation error returned by
+// GetChartRequest.Validate if the designated constraints aren't met.
+type GetChartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChartRequestValidationError) ErrorName() string { return "GetChartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetChartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChartRequestValidationError{}
+
+// Validate checks the field values on GetChartResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetChartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChartResponseMultiError, or nil if none found.
+func (m *GetChartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetChartResponseValidationError{
+					field:  "Chart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetChartResponseValidationError{
+					field:  "Chart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetChartResponseValidationError{
+				field:  "Chart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetChartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChartResponseMultiError is an error wrapping multiple validation errors
+// returned by GetChartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetChartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChartResponseMultiError) AllErrors() []error { return m }
+
+// GetChartResponseValidationError is the validation error returned by
+// GetChartResponse.Validate if the designated constraints aren't met.
+type GetChartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChartResponseValidationError) ErrorName() string { return "GetChartResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetChartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChartResponseValidationError{}
+
+// Validate checks the field values on CreateChartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateChartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateChartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateChartRequestMultiError, or nil if none found.
+func (m *CreateChartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateChartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSongId() < 1 {
+		err := CreateChartRequestValidationError{
+			field:  "SongId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := enums.DifficultyType_name[int32(m.GetDifficultyType())]; !ok {
+		err := CreateChartRequestValidationError{
+			field:  "DifficultyType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLevel(); val < 1 || val >= 100 {
+		err := CreateChartRequestValidationError{
+			field:  "Level",
+			reason: "value must be inside range [1, 100)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetChartViewLink()) < 1 {
+		err := CreateChartRequestValidationError{
+			field:  "ChartViewLink",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetNoteCount(); val < 0 || val > 100000 {
+		err := CreateChartRequestValidationError{
+			field:  "NoteCount",
+			reason: "value must be inside range [0, 100000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetBpmMin(); val < 0 || val > 1000 {
+		err := CreateChartRequestValidationError{
+			field:  "BpmMin",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetBpmMax(); val < 0 || val > 1000 {
+		err := CreateChartRequestValidationError{
+			field:  "BpmMax",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDurationSeconds(); val < 0 || val > 3600 {
+		err := CreateChartRequestValidationError{
+			field:  "DurationSeconds",
+			reason: "value must be inside range [0, 3600]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetConstant(); val < 0 || val >= 100 {
+		err := CreateChartRequestValidationError{
+			field:  "Constant",
+			reason: "value must be inside range [0, 100)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateChartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateChartRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateChartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateChartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateChartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateChartRequestMultiError) AllErrors() []error { return m }
+
+// CreateChartRequestValidationError is the validation error returned by
+// CreateChartRequest.Validate if the designated constraints aren't met.
+type CreateChartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateChartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateChartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateChartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateChartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateChartRequestValidationError) ErrorName() string {
+	return "CreateChartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateChartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateChartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateChartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateChartRequestValidationError{}
+
+// Validate checks the field values on CreateChartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateChartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateChartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateChartResponseMultiError, or nil if none found.
+func (m *CreateChartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateChartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateChartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateChartResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateChartResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateChartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateChartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateChartResponseMultiError) AllErrors() []error { return m }
+
+// CreateChartResponseValidationError is the validation error returned by
+// CreateChartResponse.Validate if the designated constraints aren't met.
+type CreateChartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateChartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateChartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateChartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateChartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateChartResponseValidationError) ErrorName() string {
+	return "CreateChartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateChartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateChartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateChartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateChartResponseValidationError{}
+
+// Validate checks the field values on UpdateChartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateChartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateChartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateChartRequestMultiError, or nil if none found.
+func (m *UpdateChartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateChartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := UpdateChartRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSongId() < 1 {
+		err := UpdateChartRequestValidationError{
+			field:  "SongId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := enums.DifficultyType_name[int32(m.GetDifficultyType())]; !ok {
+		err := UpdateChartRequestValidationError{
+			field:  "DifficultyType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLevel(); val < 1 || val >= 100 {
+		err := UpdateChartRequestValidationError{
+			field:  "Level",
+			reason: "value must be inside range [1, 100)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetChartViewLink()) < 1 {
+		err := UpdateChartRequestValidationError{
+			field:  "ChartViewLink",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetNoteCount(); val < 0 || val > 100000 {
+		err := UpdateChartRequestValidationError{
+			field:  "NoteCount",
+			reason: "value must be inside range [0, 100000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetBpmMin(); val < 0 || val > 1000 {
+		err := UpdateChartRequestValidationError{
+			field:  "BpmMin",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetBpmMax(); val < 0 || val > 1000 {
+		err := UpdateChartRequestValidationError{
+			field:  "BpmMax",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDurationSeconds(); val < 0 || val > 3600 {
+		err := UpdateChartRequestValidationError{
+			field:  "DurationSeconds",
+			reason: "value must be inside range [0, 3600]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetConstant(); val < 0 || val >= 100 {
+		err := UpdateChartRequestValidationError{
+			field:  "Constant",
+			reason: "value must be inside range [0, 100)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLevelEffectiveFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateChartRequestValidationError{
+					field:  "LevelEffectiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateChartRequestValidationError{
+					field:  "LevelEffectiveFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLevelEffectiveFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateChartRequestValidationError{
+				field:  "LevelEffectiveFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateChartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateChartRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateChartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateChartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateChartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateChartRequestMultiError) AllErrors() []error { return m }
+
+// UpdateChartRequestValidationError is the validation error returned by
+// UpdateChartRequest.Validate if the designated constraints aren't met.
+type UpdateChartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateChartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateChartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateChartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateChartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateChartRequestValidationError) ErrorName() string {
+	return "UpdateChartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateChartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateChartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateChartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateChartRequestValidationError{}
+
+// Validate checks the field values on UpdateChartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateChartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateChartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateChartResponseMultiError, or nil if none found.
+func (m *UpdateChartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateChartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateChartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateChartResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateChartResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateChartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateChartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateChartResponseMultiError) AllErrors() []error { return m }
+
+// UpdateChartResponseValidationError is the validation error returned by
+// UpdateChartResponse.Validate if the designated constraints aren't met.
+type UpdateChartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateChartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateChartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateChartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateChartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateChartResponseValidationError) ErrorName() string {
+	return "UpdateChartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateChartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateChartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateChartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateChartResponseValidationError{}
+
+// Validate checks the field values on DeleteChartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteChartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteChartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteChartRequestMultiError, or nil if none found.
+func (m *DeleteChartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteChartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := DeleteChartRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteChartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteChartRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteChartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteChartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteChartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteChartRequestMultiError) AllErrors() []error { return m }
+
+// DeleteChartRequestValidationError is the validation error returned by
+// DeleteChartRequest.Validate if the designated constraints aren't met.
+type DeleteChartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteChartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteChartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteChartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteChartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteChartRequestValidationError) ErrorName() string {
+	return "DeleteChartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteChartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteChartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteChartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteChartRequestValidationError{}
+
+// Validate checks the field values on DeleteChartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteChartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteChartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteChartResponseMultiError, or nil if none found.
+func (m *DeleteChartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteChartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteChartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteChartResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteChartResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteChartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteChartResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteChartResponseMultiError) AllErrors() []error { return m }
+
+// DeleteChartResponseValidationError is the validation error returned by
+// DeleteChartResponse.Validate if the designated constraints aren't met.
+type DeleteChartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteChartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteChartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteChartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteChartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteChartResponseValidationError) ErrorName() string {
+	return "DeleteChartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteChartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteChartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteChartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteChartResponseValidationError{}
+
+// Validate checks the field values on GetChartHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChartHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChartHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChartHistoryRequestMultiError, or nil if none found.
+func (m *GetChartHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChartHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChartId() < 1 {
+		err := GetChartHistoryRequestValidationError{
+			field:  "ChartId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetChartHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChartHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetChartHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetChartHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChartHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChartHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetChartHistoryRequestValidationError is the validation error returned by
+// GetChartHistoryRequest.Validate if the designated constraints aren't met.
+type GetChartHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChartHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChartHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChartHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChartHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChartHistoryRequestValidationError) ErrorName() string {
+	return "GetChartHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChartHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChartHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChartHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChartHistoryRequestValidationError{}
+
+// Validate checks the field values on GetChartHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetChartHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChartHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChartHistoryResponseMultiError, or nil if none found.
+func (m *GetChartHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChartHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHistories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetChartHistoryResponseValidationError{
+						field:  fmt.Sprintf("Histories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetChartHistoryResponseValidationError{
+						field:  fmt.Sprintf("Histories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetChartHistoryResponseValidationError{
+					field:  fmt.Sprintf("Histories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetChartHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChartHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetChartHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetChartHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChartHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChartHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetChartHistoryResponseValidationError is the validation error returned by
+// GetChartHistoryResponse.Validate if the designated constraints aren't met.
+type GetChartHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChartHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChartHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChartHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChartHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChartHistoryResponseValidationError) ErrorName() string {
+	return "GetChartHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetChartHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChartHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChartHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChartHistoryResponseValidationError{}
+
+// Validate checks the field values on SearchChartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchChartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchChartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchChartsRequestMultiError, or nil if none found.
+func (m *SearchChartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchChartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetQuery()) > 255 {
+		err := SearchChartsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDifficultyTypes() {
+		_, _ = idx, item
+
+		if _, ok := enums.DifficultyType_name[int32(item)]; !ok {
+			err := SearchChartsRequestValidationError{
+				field:  fmt.Sprintf("DifficultyTypes[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetMinLevel(); val < 0 || val > 99 {
+		err := SearchChartsRequestValidationError{
+			field:  "MinLevel",
+			reason: "value must be inside range [0, 99]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxLevel(); val < 0 || val > 99 {
+		err := SearchChartsRequestValidationError{
+			field:  "MaxLevel",
+			reason: "value must be inside range [0, 99]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUnitIds() {
+		_, _ = idx, item
+
+		if item < 1 {
+			err := SearchChartsRequestValidationError{
+				field:  fmt.Sprintf("UnitIds[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetSingerIds() {
+		_, _ = idx, item
+
+		if item < 1 {
+			err := SearchChartsRequestValidationError{
+				field:  fmt.Sprintf("SingerIds[%v]", idx),
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetReleaseFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchChartsRequestValidationError{
+					field:  "ReleaseFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchChartsRequestValidationError{
+					field:  "ReleaseFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchChartsRequestValidationError{
+				field:  "ReleaseFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReleaseTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchChartsRequestValidationError{
+					field:  "ReleaseTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchChartsRequestValidationError{
+					field:  "ReleaseTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleaseTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchChartsRequestValidationError{
+				field:  "ReleaseTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := SearchChartsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return SearchChartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchChartsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchChartsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchChartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchChartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchChartsRequestMultiError) AllErrors() []error { return m }
+
+// SearchChartsRequestValidationError is the validation error returned by
+// SearchChartsRequest.Validate if the designated constraints aren't met.
+type SearchChartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchChartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchChartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchChartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchChartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchChartsRequestValidationError) ErrorName() string {
+	return "SearchChartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchChartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchChartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchChartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchChartsRequestValidationError{}
+
+// Validate checks the field values on SearchChartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchChartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchChartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchChartsResponseMultiError, or nil if none found.
+func (m *SearchChartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchChartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCharts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchChartsResponseValidationError{
+						field:  fmt.Sprintf("Charts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchChartsResponseValidationError{
+						field:  fmt.Sprintf("Charts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchChartsResponseValidationError{
+					field:  fmt.Sprintf("Charts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return SearchChartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchChartsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchChartsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchChartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchChartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchChartsResponseMultiError) AllErrors() []error { return m }
+
+// SearchChartsResponseValidationError is the validation error returned by
+// SearchChartsResponse.Validate if the designated constraints aren't met.
+type SearchChartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchChartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchChartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchChartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchChartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchChartsResponseValidationError) ErrorName() string {
+	return "SearchChartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchChartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchChartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchChartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchChartsResponseValidationError{}
+
+// Validate checks the field values on MasterChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MasterChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MasterChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MasterChangeMultiError, or
+// nil if none found.
+func (m *MasterChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MasterChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Key
+
+	// no validation rules for Operation
+
+	if len(errors) > 0 {
+		return MasterChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// MasterChangeMultiError is an error wrapping multiple validation errors
+// returned by MasterChange.ValidateAll() if the designated constraints aren't met.
+type MasterChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MasterChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MasterChangeMultiError) AllErrors() []error { return m }
+
+// MasterChangeValidationError is the validation error returned by
+// MasterChange.Validate if the designated constraints aren't met.
+type MasterChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MasterChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MasterChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MasterChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MasterChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MasterChangeValidationError) ErrorName() string { return "MasterChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MasterChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMasterChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MasterChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MasterChangeValidationError{}
+
+// Validate checks the field values on ImportMasterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportMasterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportMasterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportMasterRequestMultiError, or nil if none found.
+func (m *ImportMasterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportMasterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ImportMasterRequest_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ImportMasterRequestValidationError{
+			field:  "Format",
+			reason: "value must not be in list [MASTER_BUNDLE_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := enums.MasterBundleFormat_name[int32(m.GetFormat())]; !ok {
+		err := ImportMasterRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetData()) < 1 {
+		err := ImportMasterRequestValidationError{
+			field:  "Data",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportMasterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportMasterRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportMasterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportMasterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportMasterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportMasterRequestMultiError) AllErrors() []error { return m }
+
+// ImportMasterRequestValidationError is the validation error returned by
+// ImportMasterRequest.Validate if the designated constraints aren't met.
+type ImportMasterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportMasterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportMasterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportMasterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportMasterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportMasterRequestValidationError) ErrorName() string {
+	return "ImportMasterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportMasterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportMasterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportMasterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportMasterRequestValidationError{}
+
+var _ImportMasterRequest_Format_NotInLookup = map[enums.MasterBundleFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ImportMasterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportMasterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportMasterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportMasterResponseMultiError, or nil if none found.
+func (m *ImportMasterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportMasterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportMasterResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportMasterResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportMasterResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Applied
+
+	if len(errors) > 0 {
+		return ImportMasterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportMasterResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportMasterResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportMasterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportMasterResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportMasterResponseMultiError) AllErrors() []error { return m }
+
+// ImportMasterResponseValidationError is the validation error returned by
+// ImportMasterResponse.Validate if the designated constraints aren't met.
+type ImportMasterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportMasterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportMasterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportMasterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportMasterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportMasterResponseValidationError) ErrorName() string {
+	return "ImportMasterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportMasterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportMasterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportMasterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportMasterResponseValidationError{}
+
+// Validate checks the field values on ExportMasterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMasterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMasterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportMasterRequestMultiError, or nil if none found.
+func (m *ExportMasterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMasterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ExportMasterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMasterRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportMasterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportMasterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMasterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMasterRequestMultiError) AllErrors() []error { return m }
+
+// ExportMasterRequestValidationError is the validation error returned by
+// ExportMasterRequest.Validate if the designated constraints aren't met.
+type ExportMasterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMasterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMasterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMasterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMasterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMasterRequestValidationError) ErrorName() string {
+	return "ExportMasterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMasterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMasterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMasterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMasterRequestValidationError{}
+
+// Validate checks the field values on ExportMasterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMasterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMasterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportMasterResponseMultiError, or nil if none found.
+func (m *ExportMasterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMasterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return ExportMasterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMasterResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportMasterResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportMasterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMasterResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMasterResponseMultiError) AllErrors() []error { return m }
+
+// ExportMasterResponseValidationError is the validation error returned by
+// ExportMasterResponse.Validate if the designated constraints aren't met.
+type ExportMasterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMasterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMasterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMasterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMasterResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSongRequestValidationError) ErrorName() string {
-	return "CreateSongRequestValidationError"
+func (e ExportMasterResponseValidationError) ErrorName() string {
+	return "ExportMasterResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSongRequestValidationError) Error() string {
+func (e ExportMasterResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3032,14 +10076,14 @@ func (e CreateSongRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSongRequest.%s: %s%s",
+		"invalid %sExportMasterResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSongRequestValidationError{}
+var _ error = ExportMasterResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3047,24 +10091,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSongRequestValidationError{}
+} = ExportMasterResponseValidationError{}
 
-// Validate checks the field values on CreateSongResponse with the rules
+// Validate checks the field values on GetMasterVersionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSongResponse) Validate() error {
+func (m *GetMasterVersionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSongResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetMasterVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSongResponseMultiError, or nil if none found.
-func (m *CreateSongResponse) ValidateAll() error {
+// GetMasterVersionRequestMultiError, or nil if none found.
+func (m *GetMasterVersionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSongResponse) validate(all bool) error {
+func (m *GetMasterVersionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3072,19 +10116,19 @@ func (m *CreateSongResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return CreateSongResponseMultiError(errors)
+		return GetMasterVersionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSongResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateSongResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CreateSongResponseMultiError []error
+// GetMasterVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMasterVersionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMasterVersionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSongResponseMultiError) Error() string {
+func (m GetMasterVersionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3093,11 +10137,11 @@ func (m CreateSongResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSongResponseMultiError) AllErrors() []error { return m }
+func (m GetMasterVersionRequestMultiError) AllErrors() []error { return m }
 
-// CreateSongResponseValidationError is the validation error returned by
-// CreateSongResponse.Validate if the designated constraints aren't met.
-type CreateSongResponseValidationError struct {
+// GetMasterVersionRequestValidationError is the validation error returned by
+// GetMasterVersionRequest.Validate if the designated constraints aren't met.
+type GetMasterVersionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3105,24 +10149,24 @@ type CreateSongResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSongResponseValidationError) Field() string { return e.field }
+func (e GetMasterVersionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSongResponseValidationError) Reason() string { return e.reason }
+func (e GetMasterVersionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSongResponseValidationError) Cause() error { return e.cause }
+func (e GetMasterVersionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSongResponseValidationError) Key() bool { return e.key }
+func (e GetMasterVersionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSongResponseValidationError) ErrorName() string {
-	return "CreateSongResponseValidationError"
+func (e GetMasterVersionRequestValidationError) ErrorName() string {
+	return "GetMasterVersionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSongResponseValidationError) Error() string {
+func (e GetMasterVersionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3134,14 +10178,14 @@ func (e CreateSongResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSongResponse.%s: %s%s",
+		"invalid %sGetMasterVersionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSongResponseValidationError{}
+var _ error = GetMasterVersionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3149,44 +10193,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSongResponseValidationError{}
+} = GetMasterVersionRequestValidationError{}
 
-// Validate checks the field values on GetChartsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetChartsRequest) Validate() error {
+// Validate checks the field values on GetMasterVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMasterVersionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetChartsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetMasterVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetChartsRequestMultiError, or nil if none found.
-func (m *GetChartsRequest) ValidateAll() error {
+// GetMasterVersionResponseMultiError, or nil if none found.
+func (m *GetMasterVersionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetChartsRequest) validate(all bool) error {
+func (m *GetMasterVersionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Revision
+
 	if len(errors) > 0 {
-		return GetChartsRequestMultiError(errors)
+		return GetMasterVersionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetChartsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetChartsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetChartsRequestMultiError []error
+// GetMasterVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMasterVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMasterVersionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetChartsRequestMultiError) Error() string {
+func (m GetMasterVersionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3195,11 +10241,11 @@ func (m GetChartsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetChartsRequestMultiError) AllErrors() []error { return m }
+func (m GetMasterVersionResponseMultiError) AllErrors() []error { return m }
 
-// GetChartsRequestValidationError is the validation error returned by
-// GetChartsRequest.Validate if the designated constraints aren't met.
-type GetChartsRequestValidationError struct {
+// GetMasterVersionResponseValidationError is the validation error returned by
+// GetMasterVersionResponse.Validate if the designated constraints aren't met.
+type GetMasterVersionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3207,22 +10253,24 @@ type GetChartsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetChartsRequestValidationError) Field() string { return e.field }
+func (e GetMasterVersionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetChartsRequestValidationError) Reason() string { return e.reason }
+func (e GetMasterVersionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetChartsRequestValidationError) Cause() error { return e.cause }
+func (e GetMasterVersionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetChartsRequestValidationError) Key() bool { return e.key }
+func (e GetMasterVersionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetChartsRequestValidationError) ErrorName() string { return "GetChartsRequestValidationError" }
+func (e GetMasterVersionResponseValidationError) ErrorName() string {
+	return "GetMasterVersionResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetChartsRequestValidationError) Error() string {
+func (e GetMasterVersionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3234,14 +10282,14 @@ func (e GetChartsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetChartsRequest.%s: %s%s",
+		"invalid %sGetMasterVersionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetChartsRequestValidationError{}
+var _ error = GetMasterVersionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3249,78 +10297,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetChartsRequestValidationError{}
+} = GetMasterVersionResponseValidationError{}
 
-// Validate checks the field values on GetChartsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetChartsResponse) Validate() error {
+// Validate checks the field values on GetMasterDeltaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMasterDeltaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetChartsResponse with the rules
+// ValidateAll checks the field values on GetMasterDeltaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetChartsResponseMultiError, or nil if none found.
-func (m *GetChartsResponse) ValidateAll() error {
+// GetMasterDeltaRequestMultiError, or nil if none found.
+func (m *GetMasterDeltaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetChartsResponse) validate(all bool) error {
+func (m *GetMasterDeltaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetCharts() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetChartsResponseValidationError{
-						field:  fmt.Sprintf("Charts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetChartsResponseValidationError{
-						field:  fmt.Sprintf("Charts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetChartsResponseValidationError{
-					field:  fmt.Sprintf("Charts[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if m.GetSinceRevision() < 0 {
+		err := GetMasterDeltaRequestValidationError{
+			field:  "SinceRevision",
+			reason: "value must be greater than or equal to 0",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for IncludeDeleted
+
 	if len(errors) > 0 {
-		return GetChartsResponseMultiError(errors)
+		return GetMasterDeltaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetChartsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetChartsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetChartsResponseMultiError []error
+// GetMasterDeltaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMasterDeltaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMasterDeltaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetChartsResponseMultiError) Error() string {
+func (m GetMasterDeltaRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3329,11 +10356,11 @@ func (m GetChartsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetChartsResponseMultiError) AllErrors() []error { return m }
+func (m GetMasterDeltaRequestMultiError) AllErrors() []error { return m }
 
-// GetChartsResponseValidationError is the validation error returned by
-// GetChartsResponse.Validate if the designated constraints aren't met.
-type GetChartsResponseValidationError struct {
+// GetMasterDeltaRequestValidationError is the validation error returned by
+// GetMasterDeltaRequest.Validate if the designated constraints aren't met.
+type GetMasterDeltaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3341,24 +10368,24 @@ type GetChartsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetChartsResponseValidationError) Field() string { return e.field }
+func (e GetMasterDeltaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetChartsResponseValidationError) Reason() string { return e.reason }
+func (e GetMasterDeltaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetChartsResponseValidationError) Cause() error { return e.cause }
+func (e GetMasterDeltaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetChartsResponseValidationError) Key() bool { return e.key }
+func (e GetMasterDeltaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetChartsResponseValidationError) ErrorName() string {
-	return "GetChartsResponseValidationError"
+func (e GetMasterDeltaRequestValidationError) ErrorName() string {
+	return "GetMasterDeltaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetChartsResponseValidationError) Error() string {
+func (e GetMasterDeltaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3370,14 +10397,14 @@ func (e GetChartsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetChartsResponse.%s: %s%s",
+		"invalid %sGetMasterDeltaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetChartsResponseValidationError{}
+var _ error = GetMasterDeltaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3385,46 +10412,216 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetChartsResponseValidationError{}
+} = GetMasterDeltaRequestValidationError{}
 
-// Validate checks the field values on GetChartRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetChartRequest) Validate() error {
+// Validate checks the field values on GetMasterDeltaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMasterDeltaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetChartRequest with the rules
+// ValidateAll checks the field values on GetMasterDeltaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetChartRequestMultiError, or nil if none found.
-func (m *GetChartRequest) ValidateAll() error {
+// GetMasterDeltaResponseMultiError, or nil if none found.
+func (m *GetMasterDeltaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetChartRequest) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *GetMasterDeltaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revision
+
+	for idx, item := range m.GetArtists() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMasterDeltaResponseValidationError{
+						field:  fmt.Sprintf("Artists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMasterDeltaResponseValidationError{
+						field:  fmt.Sprintf("Artists[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMasterDeltaResponseValidationError{
+					field:  fmt.Sprintf("Artists[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSingers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMasterDeltaResponseValidationError{
+						field:  fmt.Sprintf("Singers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMasterDeltaResponseValidationError{
+						field:  fmt.Sprintf("Singers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMasterDeltaResponseValidationError{
+					field:  fmt.Sprintf("Singers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetUnits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMasterDeltaResponseValidationError{
+						field:  fmt.Sprintf("Units[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMasterDeltaResponseValidationError{
+						field:  fmt.Sprintf("Units[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMasterDeltaResponseValidationError{
+					field:  fmt.Sprintf("Units[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSongs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMasterDeltaResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMasterDeltaResponseValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMasterDeltaResponseValidationError{
+					field:  fmt.Sprintf("Songs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
+	for idx, item := range m.GetCharts() {
+		_, _ = idx, item
 
-	// no validation rules for Id
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMasterDeltaResponseValidationError{
+						field:  fmt.Sprintf("Charts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMasterDeltaResponseValidationError{
+						field:  fmt.Sprintf("Charts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMasterDeltaResponseValidationError{
+					field:  fmt.Sprintf("Charts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetChartRequestMultiError(errors)
+		return GetMasterDeltaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetChartRequestMultiError is an error wrapping multiple validation errors
-// returned by GetChartRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetChartRequestMultiError []error
+// GetMasterDeltaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMasterDeltaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMasterDeltaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetChartRequestMultiError) Error() string {
+func (m GetMasterDeltaResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3433,11 +10630,11 @@ func (m GetChartRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetChartRequestMultiError) AllErrors() []error { return m }
+func (m GetMasterDeltaResponseMultiError) AllErrors() []error { return m }
 
-// GetChartRequestValidationError is the validation error returned by
-// GetChartRequest.Validate if the designated constraints aren't met.
-type GetChartRequestValidationError struct {
+// GetMasterDeltaResponseValidationError is the validation error returned by
+// GetMasterDeltaResponse.Validate if the designated constraints aren't met.
+type GetMasterDeltaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3445,22 +10642,24 @@ type GetChartRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetChartRequestValidationError) Field() string { return e.field }
+func (e GetMasterDeltaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetChartRequestValidationError) Reason() string { return e.reason }
+func (e GetMasterDeltaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetChartRequestValidationError) Cause() error { return e.cause }
+func (e GetMasterDeltaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetChartRequestValidationError) Key() bool { return e.key }
+func (e GetMasterDeltaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetChartRequestValidationError) ErrorName() string { return "GetChartRequestValidationError" }
+func (e GetMasterDeltaResponseValidationError) ErrorName() string {
+	return "GetMasterDeltaResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetChartRequestValidationError) Error() string {
+func (e GetMasterDeltaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3472,14 +10671,14 @@ func (e GetChartRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetChartRequest.%s: %s%s",
+		"invalid %sGetMasterDeltaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetChartRequestValidationError{}
+var _ error = GetMasterDeltaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3487,73 +10686,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetChartRequestValidationError{}
+} = GetMasterDeltaResponseValidationError{}
 
-// Validate checks the field values on GetChartResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetChartResponse) Validate() error {
+// Validate checks the field values on MasterEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MasterEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetChartResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetChartResponseMultiError, or nil if none found.
-func (m *GetChartResponse) ValidateAll() error {
+// ValidateAll checks the field values on MasterEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MasterEventMultiError, or
+// nil if none found.
+func (m *MasterEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetChartResponse) validate(all bool) error {
+func (m *MasterEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetChart()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetChartResponseValidationError{
-					field:  "Chart",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetChartResponseValidationError{
-					field:  "Chart",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetChart()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetChartResponseValidationError{
-				field:  "Chart",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Kind
+
+	// no validation rules for Id
+
+	// no validation rules for Operation
 
 	if len(errors) > 0 {
-		return GetChartResponseMultiError(errors)
+		return MasterEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetChartResponseMultiError is an error wrapping multiple validation errors
-// returned by GetChartResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetChartResponseMultiError []error
+// MasterEventMultiError is an error wrapping multiple validation errors
+// returned by MasterEvent.ValidateAll() if the designated constraints aren't met.
+type MasterEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetChartResponseMultiError) Error() string {
+func (m MasterEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3562,11 +10737,11 @@ func (m GetChartResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetChartResponseMultiError) AllErrors() []error { return m }
+func (m MasterEventMultiError) AllErrors() []error { return m }
 
-// GetChartResponseValidationError is the validation error returned by
-// GetChartResponse.Validate if the designated constraints aren't met.
-type GetChartResponseValidationError struct {
+// MasterEventValidationError is the validation error returned by
+// MasterEvent.Validate if the designated constraints aren't met.
+type MasterEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3574,22 +10749,22 @@ type GetChartResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetChartResponseValidationError) Field() string { return e.field }
+func (e MasterEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetChartResponseValidationError) Reason() string { return e.reason }
+func (e MasterEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetChartResponseValidationError) Cause() error { return e.cause }
+func (e MasterEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetChartResponseValidationError) Key() bool { return e.key }
+func (e MasterEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetChartResponseValidationError) ErrorName() string { return "GetChartResponseValidationError" }
+func (e MasterEventValidationError) ErrorName() string { return "MasterEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetChartResponseValidationError) Error() string {
+func (e MasterEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3601,14 +10776,14 @@ func (e GetChartResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetChartResponse.%s: %s%s",
+		"invalid %sMasterEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetChartResponseValidationError{}
+var _ error = MasterEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -3616,88 +10791,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetChartResponseValidationError{}
+} = MasterEventValidationError{}
 
-// Validate checks the field values on CreateChartRequest with the rules
+// Validate checks the field values on WatchMasterRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateChartRequest) Validate() error {
+func (m *WatchMasterRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateChartRequest with the rules
+// ValidateAll checks the field values on WatchMasterRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateChartRequestMultiError, or nil if none found.
-func (m *CreateChartRequest) ValidateAll() error {
+// WatchMasterRequestMultiError, or nil if none found.
+func (m *WatchMasterRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateChartRequest) validate(all bool) error {
+func (m *WatchMasterRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetSongId() < 1 {
-		err := CreateChartRequestValidationError{
-			field:  "SongId",
-			reason: "value must be greater than or equal to 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := enums.DifficultyType_name[int32(m.GetDifficultyType())]; !ok {
-		err := CreateChartRequestValidationError{
-			field:  "DifficultyType",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if val := m.GetLevel(); val < 1 || val >= 100 {
-		err := CreateChartRequestValidationError{
-			field:  "Level",
-			reason: "value must be inside range [1, 100)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetChartViewLink()) < 1 {
-		err := CreateChartRequestValidationError{
-			field:  "ChartViewLink",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for IncludeDeleted
 
 	if len(errors) > 0 {
-		return CreateChartRequestMultiError(errors)
+		return WatchMasterRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateChartRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateChartRequest.ValidateAll() if the designated constraints
+// WatchMasterRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchMasterRequest.ValidateAll() if the designated constraints
 // aren't met.
-type CreateChartRequestMultiError []error
+type WatchMasterRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateChartRequestMultiError) Error() string {
+func (m WatchMasterRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3706,11 +10839,11 @@ func (m CreateChartRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateChartRequestMultiError) AllErrors() []error { return m }
+func (m WatchMasterRequestMultiError) AllErrors() []error { return m }
 
-// CreateChartRequestValidationError is the validation error returned by
-// CreateChartRequest.Validate if the designated constraints aren't met.
-type CreateChartRequestValidationError struct {
+// WatchMasterRequestValidationError is the validation error returned by
+// WatchMasterRequest.Validate if the designated constraints aren't met.
+type WatchMasterRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3718,24 +10851,24 @@ type CreateChartRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateChartRequestValidationError) Field() string { return e.field }
+func (e WatchMasterRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateChartRequestValidationError) Reason() string { return e.reason }
+func (e WatchMasterRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateChartRequestValidationError) Cause() error { return e.cause }
+func (e WatchMasterRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateChartRequestValidationError) Key() bool { return e.key }
+func (e WatchMasterRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateChartRequestValidationError) ErrorName() string {
-	return "CreateChartRequestValidationError"
+func (e WatchMasterRequestValidationError) ErrorName() string {
+	return "WatchMasterRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateChartRequestValidationError) Error() string {
+func (e WatchMasterRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3747,14 +10880,14 @@ func (e CreateChartRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateChartRequest.%s: %s%s",
+		"invalid %sWatchMasterRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateChartRequestValidationError{}
+var _ error = WatchMasterRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3762,44 +10895,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateChartRequestValidationError{}
+} = WatchMasterRequestValidationError{}
 
-// Validate checks the field values on CreateChartResponse with the rules
+// Validate checks the field values on WatchMasterResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateChartResponse) Validate() error {
+func (m *WatchMasterResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateChartResponse with the rules
+// ValidateAll checks the field values on WatchMasterResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateChartResponseMultiError, or nil if none found.
-func (m *CreateChartResponse) ValidateAll() error {
+// WatchMasterResponseMultiError, or nil if none found.
+func (m *WatchMasterResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateChartResponse) validate(all bool) error {
+func (m *WatchMasterResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Revision
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchMasterResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchMasterResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchMasterResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return CreateChartResponseMultiError(errors)
+		return WatchMasterResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateChartResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateChartResponse.ValidateAll() if the designated
+// WatchMasterResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchMasterResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateChartResponseMultiError []error
+type WatchMasterResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateChartResponseMultiError) Error() string {
+func (m WatchMasterResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3808,11 +10977,11 @@ func (m CreateChartResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateChartResponseMultiError) AllErrors() []error { return m }
+func (m WatchMasterResponseMultiError) AllErrors() []error { return m }
 
-// CreateChartResponseValidationError is the validation error returned by
-// CreateChartResponse.Validate if the designated constraints aren't met.
-type CreateChartResponseValidationError struct {
+// WatchMasterResponseValidationError is the validation error returned by
+// WatchMasterResponse.Validate if the designated constraints aren't met.
+type WatchMasterResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3820,24 +10989,24 @@ type CreateChartResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateChartResponseValidationError) Field() string { return e.field }
+func (e WatchMasterResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateChartResponseValidationError) Reason() string { return e.reason }
+func (e WatchMasterResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateChartResponseValidationError) Cause() error { return e.cause }
+func (e WatchMasterResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateChartResponseValidationError) Key() bool { return e.key }
+func (e WatchMasterResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateChartResponseValidationError) ErrorName() string {
-	return "CreateChartResponseValidationError"
+func (e WatchMasterResponseValidationError) ErrorName() string {
+	return "WatchMasterResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateChartResponseValidationError) Error() string {
+func (e WatchMasterResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3849,14 +11018,14 @@ func (e CreateChartResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateChartResponse.%s: %s%s",
+		"invalid %sWatchMasterResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateChartResponseValidationError{}
+var _ error = WatchMasterResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3864,4 +11033,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateChartResponseValidationError{}
+} = WatchMasterResponseValidationError{}
@@ -22,19 +22,44 @@ const (
 	MasterService_GetArtists_FullMethodName         = "/master.MasterService/GetArtists"
 	MasterService_GetArtist_FullMethodName          = "/master.MasterService/GetArtist"
 	MasterService_CreateArtist_FullMethodName       = "/master.MasterService/CreateArtist"
+	MasterService_UpdateArtist_FullMethodName       = "/master.MasterService/UpdateArtist"
+	MasterService_DeleteArtist_FullMethodName       = "/master.MasterService/DeleteArtist"
+	MasterService_MergeArtists_FullMethodName       = "/master.MasterService/MergeArtists"
 	MasterService_GetSingers_FullMethodName         = "/master.MasterService/GetSingers"
 	MasterService_GetSinger_FullMethodName          = "/master.MasterService/GetSinger"
 	MasterService_CreateSinger_FullMethodName       = "/master.MasterService/CreateSinger"
+	MasterService_UpdateSinger_FullMethodName       = "/master.MasterService/UpdateSinger"
 	MasterService_GetUnits_FullMethodName           = "/master.MasterService/GetUnits"
 	MasterService_GetUnit_FullMethodName            = "/master.MasterService/GetUnit"
 	MasterService_CreateUnit_FullMethodName         = "/master.MasterService/CreateUnit"
+	MasterService_UpdateUnit_FullMethodName         = "/master.MasterService/UpdateUnit"
+	MasterService_CreateUnitSinger_FullMethodName   = "/master.MasterService/CreateUnitSinger"
+	MasterService_UpdateUnitSinger_FullMethodName   = "/master.MasterService/UpdateUnitSinger"
+	MasterService_DeleteUnitSinger_FullMethodName   = "/master.MasterService/DeleteUnitSinger"
+	MasterService_GetVocalPatterns_FullMethodName   = "/master.MasterService/GetVocalPatterns"
+	MasterService_GetVocalPattern_FullMethodName    = "/master.MasterService/GetVocalPattern"
 	MasterService_CreateVocalPattern_FullMethodName = "/master.MasterService/CreateVocalPattern"
+	MasterService_UpdateVocalPattern_FullMethodName = "/master.MasterService/UpdateVocalPattern"
+	MasterService_DeleteVocalPattern_FullMethodName = "/master.MasterService/DeleteVocalPattern"
 	MasterService_GetSongs_FullMethodName           = "/master.MasterService/GetSongs"
 	MasterService_GetSong_FullMethodName            = "/master.MasterService/GetSong"
 	MasterService_CreateSong_FullMethodName         = "/master.MasterService/CreateSong"
+	MasterService_UpdateSong_FullMethodName         = "/master.MasterService/UpdateSong"
+	MasterService_DeleteSong_FullMethodName         = "/master.MasterService/DeleteSong"
+	MasterService_SearchSongs_FullMethodName        = "/master.MasterService/SearchSongs"
+	MasterService_ListSongReleases_FullMethodName   = "/master.MasterService/ListSongReleases"
 	MasterService_GetCharts_FullMethodName          = "/master.MasterService/GetCharts"
 	MasterService_GetChart_FullMethodName           = "/master.MasterService/GetChart"
 	MasterService_CreateChart_FullMethodName        = "/master.MasterService/CreateChart"
+	MasterService_UpdateChart_FullMethodName        = "/master.MasterService/UpdateChart"
+	MasterService_DeleteChart_FullMethodName        = "/master.MasterService/DeleteChart"
+	MasterService_SearchCharts_FullMethodName       = "/master.MasterService/SearchCharts"
+	MasterService_GetChartHistory_FullMethodName    = "/master.MasterService/GetChartHistory"
+	MasterService_ImportMaster_FullMethodName       = "/master.MasterService/ImportMaster"
+	MasterService_ExportMaster_FullMethodName       = "/master.MasterService/ExportMaster"
+	MasterService_GetMasterVersion_FullMethodName   = "/master.MasterService/GetMasterVersion"
+	MasterService_GetMasterDelta_FullMethodName     = "/master.MasterService/GetMasterDelta"
+	MasterService_WatchMaster_FullMethodName        = "/master.MasterService/WatchMaster"
 )
 
 // MasterServiceClient is the client API for MasterService service.
@@ -45,26 +70,52 @@ type MasterServiceClient interface {
 	GetArtists(ctx context.Context, in *GetArtistsRequest, opts ...grpc.CallOption) (*GetArtistsResponse, error)
 	GetArtist(ctx context.Context, in *GetArtistRequest, opts ...grpc.CallOption) (*GetArtistResponse, error)
 	CreateArtist(ctx context.Context, in *CreateArtistRequest, opts ...grpc.CallOption) (*CreateArtistResponse, error)
+	UpdateArtist(ctx context.Context, in *UpdateArtistRequest, opts ...grpc.CallOption) (*UpdateArtistResponse, error)
+	DeleteArtist(ctx context.Context, in *DeleteArtistRequest, opts ...grpc.CallOption) (*DeleteArtistResponse, error)
+	MergeArtists(ctx context.Context, in *MergeArtistsRequest, opts ...grpc.CallOption) (*MergeArtistsResponse, error)
 	// Singer
 	GetSingers(ctx context.Context, in *GetSingersRequest, opts ...grpc.CallOption) (*GetSingersResponse, error)
 	GetSinger(ctx context.Context, in *GetSingerRequest, opts ...grpc.CallOption) (*GetSingerResponse, error)
 	CreateSinger(ctx context.Context, in *CreateSingerRequest, opts ...grpc.CallOption) (*CreateSingerResponse, error)
+	UpdateSinger(ctx context.Context, in *UpdateSingerRequest, opts ...grpc.CallOption) (*UpdateSingerResponse, error)
 	// Unit
 	GetUnits(ctx context.Context, in *GetUnitsRequest, opts ...grpc.CallOption) (*GetUnitsResponse, error)
 	GetUnit(ctx context.Context, in *GetUnitRequest, opts ...grpc.CallOption) (*GetUnitResponse, error)
 	CreateUnit(ctx context.Context, in *CreateUnitRequest, opts ...grpc.CallOption) (*CreateUnitResponse, error)
+	UpdateUnit(ctx context.Context, in *UpdateUnitRequest, opts ...grpc.CallOption) (*UpdateUnitResponse, error)
+	CreateUnitSinger(ctx context.Context, in *CreateUnitSingerRequest, opts ...grpc.CallOption) (*CreateUnitSingerResponse, error)
+	UpdateUnitSinger(ctx context.Context, in *UpdateUnitSingerRequest, opts ...grpc.CallOption) (*UpdateUnitSingerResponse, error)
+	DeleteUnitSinger(ctx context.Context, in *DeleteUnitSingerRequest, opts ...grpc.CallOption) (*DeleteUnitSingerResponse, error)
 	// VocalPattern
-	// rpc GetVocalPatterns(GetVocalPatternsRequest) returns (GetVocalPatternsResponse);
-	// rpc GetVocalPattern(GetVocalPatternRequest) returns (GetVocalPatternResponse);
+	GetVocalPatterns(ctx context.Context, in *GetVocalPatternsRequest, opts ...grpc.CallOption) (*GetVocalPatternsResponse, error)
+	GetVocalPattern(ctx context.Context, in *GetVocalPatternRequest, opts ...grpc.CallOption) (*GetVocalPatternResponse, error)
 	CreateVocalPattern(ctx context.Context, in *CreateVocalPatternRequest, opts ...grpc.CallOption) (*CreateVocalPatternResponse, error)
+	UpdateVocalPattern(ctx context.Context, in *UpdateVocalPatternRequest, opts ...grpc.CallOption) (*UpdateVocalPatternResponse, error)
+	DeleteVocalPattern(ctx context.Context, in *DeleteVocalPatternRequest, opts ...grpc.CallOption) (*DeleteVocalPatternResponse, error)
 	// Song
 	GetSongs(ctx context.Context, in *GetSongsRequest, opts ...grpc.CallOption) (*GetSongsResponse, error)
 	GetSong(ctx context.Context, in *GetSongRequest, opts ...grpc.CallOption) (*GetSongResponse, error)
 	CreateSong(ctx context.Context, in *CreateSongRequest, opts ...grpc.CallOption) (*CreateSongResponse, error)
+	UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*UpdateSongResponse, error)
+	DeleteSong(ctx context.Context, in *DeleteSongRequest, opts ...grpc.CallOption) (*DeleteSongResponse, error)
+	SearchSongs(ctx context.Context, in *SearchSongsRequest, opts ...grpc.CallOption) (*SearchSongsResponse, error)
+	ListSongReleases(ctx context.Context, in *ListSongReleasesRequest, opts ...grpc.CallOption) (*ListSongReleasesResponse, error)
 	// Chart
 	GetCharts(ctx context.Context, in *GetChartsRequest, opts ...grpc.CallOption) (*GetChartsResponse, error)
 	GetChart(ctx context.Context, in *GetChartRequest, opts ...grpc.CallOption) (*GetChartResponse, error)
 	CreateChart(ctx context.Context, in *CreateChartRequest, opts ...grpc.CallOption) (*CreateChartResponse, error)
+	UpdateChart(ctx context.Context, in *UpdateChartRequest, opts ...grpc.CallOption) (*UpdateChartResponse, error)
+	DeleteChart(ctx context.Context, in *DeleteChartRequest, opts ...grpc.CallOption) (*DeleteChartResponse, error)
+	SearchCharts(ctx context.Context, in *SearchChartsRequest, opts ...grpc.CallOption) (*SearchChartsResponse, error)
+	GetChartHistory(ctx context.Context, in *GetChartHistoryRequest, opts ...grpc.CallOption) (*GetChartHistoryResponse, error)
+	// MasterImport
+	ImportMaster(ctx context.Context, in *ImportMasterRequest, opts ...grpc.CallOption) (*ImportMasterResponse, error)
+	ExportMaster(ctx context.Context, in *ExportMasterRequest, opts ...grpc.CallOption) (*ExportMasterResponse, error)
+	// MasterRevision
+	GetMasterVersion(ctx context.Context, in *GetMasterVersionRequest, opts ...grpc.CallOption) (*GetMasterVersionResponse, error)
+	GetMasterDelta(ctx context.Context, in *GetMasterDeltaRequest, opts ...grpc.CallOption) (*GetMasterDeltaResponse, error)
+	// マスタの変更を通知し続ける 受け取ったらGetMasterDeltaで内容を取り直す
+	WatchMaster(ctx context.Context, in *WatchMasterRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMasterResponse], error)
 }
 
 type masterServiceClient struct {
@@ -105,6 +156,36 @@ func (c *masterServiceClient) CreateArtist(ctx context.Context, in *CreateArtist
 	return out, nil
 }
 
+func (c *masterServiceClient) UpdateArtist(ctx context.Context, in *UpdateArtistRequest, opts ...grpc.CallOption) (*UpdateArtistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateArtistResponse)
+	err := c.cc.Invoke(ctx, MasterService_UpdateArtist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) DeleteArtist(ctx context.Context, in *DeleteArtistRequest, opts ...grpc.CallOption) (*DeleteArtistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteArtistResponse)
+	err := c.cc.Invoke(ctx, MasterService_DeleteArtist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) MergeArtists(ctx context.Context, in *MergeArtistsRequest, opts ...grpc.CallOption) (*MergeArtistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeArtistsResponse)
+	err := c.cc.Invoke(ctx, MasterService_MergeArtists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *masterServiceClient) GetSingers(ctx context.Context, in *GetSingersRequest, opts ...grpc.CallOption) (*GetSingersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSingersResponse)
@@ -135,6 +216,16 @@ func (c *masterServiceClient) CreateSinger(ctx context.Context, in *CreateSinger
 	return out, nil
 }
 
+func (c *masterServiceClient) UpdateSinger(ctx context.Context, in *UpdateSingerRequest, opts ...grpc.CallOption) (*UpdateSingerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSingerResponse)
+	err := c.cc.Invoke(ctx, MasterService_UpdateSinger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *masterServiceClient) GetUnits(ctx context.Context, in *GetUnitsRequest, opts ...grpc.CallOption) (*GetUnitsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUnitsResponse)
@@ -165,6 +256,66 @@ func (c *masterServiceClient) CreateUnit(ctx context.Context, in *CreateUnitRequ
 	return out, nil
 }
 
+func (c *masterServiceClient) UpdateUnit(ctx context.Context, in *UpdateUnitRequest, opts ...grpc.CallOption) (*UpdateUnitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUnitResponse)
+	err := c.cc.Invoke(ctx, MasterService_UpdateUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) CreateUnitSinger(ctx context.Context, in *CreateUnitSingerRequest, opts ...grpc.CallOption) (*CreateUnitSingerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUnitSingerResponse)
+	err := c.cc.Invoke(ctx, MasterService_CreateUnitSinger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) UpdateUnitSinger(ctx context.Context, in *UpdateUnitSingerRequest, opts ...grpc.CallOption) (*UpdateUnitSingerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUnitSingerResponse)
+	err := c.cc.Invoke(ctx, MasterService_UpdateUnitSinger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) DeleteUnitSinger(ctx context.Context, in *DeleteUnitSingerRequest, opts ...grpc.CallOption) (*DeleteUnitSingerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUnitSingerResponse)
+	err := c.cc.Invoke(ctx, MasterService_DeleteUnitSinger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) GetVocalPatterns(ctx context.Context, in *GetVocalPatternsRequest, opts ...grpc.CallOption) (*GetVocalPatternsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVocalPatternsResponse)
+	err := c.cc.Invoke(ctx, MasterService_GetVocalPatterns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) GetVocalPattern(ctx context.Context, in *GetVocalPatternRequest, opts ...grpc.CallOption) (*GetVocalPatternResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVocalPatternResponse)
+	err := c.cc.Invoke(ctx, MasterService_GetVocalPattern_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *masterServiceClient) CreateVocalPattern(ctx context.Context, in *CreateVocalPatternRequest, opts ...grpc.CallOption) (*CreateVocalPatternResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateVocalPatternResponse)
@@ -175,6 +326,26 @@ func (c *masterServiceClient) CreateVocalPattern(ctx context.Context, in *Create
 	return out, nil
 }
 
+func (c *masterServiceClient) UpdateVocalPattern(ctx context.Context, in *UpdateVocalPatternRequest, opts ...grpc.CallOption) (*UpdateVocalPatternResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVocalPatternResponse)
+	err := c.cc.Invoke(ctx, MasterService_UpdateVocalPattern_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) DeleteVocalPattern(ctx context.Context, in *DeleteVocalPatternRequest, opts ...grpc.CallOption) (*DeleteVocalPatternResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVocalPatternResponse)
+	err := c.cc.Invoke(ctx, MasterService_DeleteVocalPattern_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *masterServiceClient) GetSongs(ctx context.Context, in *GetSongsRequest, opts ...grpc.CallOption) (*GetSongsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSongsResponse)
@@ -205,6 +376,46 @@ func (c *masterServiceClient) CreateSong(ctx context.Context, in *CreateSongRequ
 	return out, nil
 }
 
+func (c *masterServiceClient) UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*UpdateSongResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSongResponse)
+	err := c.cc.Invoke(ctx, MasterService_UpdateSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) DeleteSong(ctx context.Context, in *DeleteSongRequest, opts ...grpc.CallOption) (*DeleteSongResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSongResponse)
+	err := c.cc.Invoke(ctx, MasterService_DeleteSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) SearchSongs(ctx context.Context, in *SearchSongsRequest, opts ...grpc.CallOption) (*SearchSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchSongsResponse)
+	err := c.cc.Invoke(ctx, MasterService_SearchSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) ListSongReleases(ctx context.Context, in *ListSongReleasesRequest, opts ...grpc.CallOption) (*ListSongReleasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSongReleasesResponse)
+	err := c.cc.Invoke(ctx, MasterService_ListSongReleases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *masterServiceClient) GetCharts(ctx context.Context, in *GetChartsRequest, opts ...grpc.CallOption) (*GetChartsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetChartsResponse)
@@ -235,6 +446,105 @@ func (c *masterServiceClient) CreateChart(ctx context.Context, in *CreateChartRe
 	return out, nil
 }
 
+func (c *masterServiceClient) UpdateChart(ctx context.Context, in *UpdateChartRequest, opts ...grpc.CallOption) (*UpdateChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChartResponse)
+	err := c.cc.Invoke(ctx, MasterService_UpdateChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) DeleteChart(ctx context.Context, in *DeleteChartRequest, opts ...grpc.CallOption) (*DeleteChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChartResponse)
+	err := c.cc.Invoke(ctx, MasterService_DeleteChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) SearchCharts(ctx context.Context, in *SearchChartsRequest, opts ...grpc.CallOption) (*SearchChartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchChartsResponse)
+	err := c.cc.Invoke(ctx, MasterService_SearchCharts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) GetChartHistory(ctx context.Context, in *GetChartHistoryRequest, opts ...grpc.CallOption) (*GetChartHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChartHistoryResponse)
+	err := c.cc.Invoke(ctx, MasterService_GetChartHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) ImportMaster(ctx context.Context, in *ImportMasterRequest, opts ...grpc.CallOption) (*ImportMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMasterResponse)
+	err := c.cc.Invoke(ctx, MasterService_ImportMaster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) ExportMaster(ctx context.Context, in *ExportMasterRequest, opts ...grpc.CallOption) (*ExportMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMasterResponse)
+	err := c.cc.Invoke(ctx, MasterService_ExportMaster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) GetMasterVersion(ctx context.Context, in *GetMasterVersionRequest, opts ...grpc.CallOption) (*GetMasterVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMasterVersionResponse)
+	err := c.cc.Invoke(ctx, MasterService_GetMasterVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) GetMasterDelta(ctx context.Context, in *GetMasterDeltaRequest, opts ...grpc.CallOption) (*GetMasterDeltaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMasterDeltaResponse)
+	err := c.cc.Invoke(ctx, MasterService_GetMasterDelta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterServiceClient) WatchMaster(ctx context.Context, in *WatchMasterRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMasterResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MasterService_ServiceDesc.Streams[0], MasterService_WatchMaster_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMasterRequest, WatchMasterResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MasterService_WatchMasterClient = grpc.ServerStreamingClient[WatchMasterResponse]
+
 // MasterServiceServer is the server API for MasterService service.
 // All implementations must embed UnimplementedMasterServiceServer
 // for forward compatibility.
@@ -243,26 +553,52 @@ type MasterServiceServer interface {
 	GetArtists(context.Context, *GetArtistsRequest) (*GetArtistsResponse, error)
 	GetArtist(context.Context, *GetArtistRequest) (*GetArtistResponse, error)
 	CreateArtist(context.Context, *CreateArtistRequest) (*CreateArtistResponse, error)
+	UpdateArtist(context.Context, *UpdateArtistRequest) (*UpdateArtistResponse, error)
+	DeleteArtist(context.Context, *DeleteArtistRequest) (*DeleteArtistResponse, error)
+	MergeArtists(context.Context, *MergeArtistsRequest) (*MergeArtistsResponse, error)
 	// Singer
 	GetSingers(context.Context, *GetSingersRequest) (*GetSingersResponse, error)
 	GetSinger(context.Context, *GetSingerRequest) (*GetSingerResponse, error)
 	CreateSinger(context.Context, *CreateSingerRequest) (*CreateSingerResponse, error)
+	UpdateSinger(context.Context, *UpdateSingerRequest) (*UpdateSingerResponse, error)
 	// Unit
 	GetUnits(context.Context, *GetUnitsRequest) (*GetUnitsResponse, error)
 	GetUnit(context.Context, *GetUnitRequest) (*GetUnitResponse, error)
 	CreateUnit(context.Context, *CreateUnitRequest) (*CreateUnitResponse, error)
+	UpdateUnit(context.Context, *UpdateUnitRequest) (*UpdateUnitResponse, error)
+	CreateUnitSinger(context.Context, *CreateUnitSingerRequest) (*CreateUnitSingerResponse, error)
+	UpdateUnitSinger(context.Context, *UpdateUnitSingerRequest) (*UpdateUnitSingerResponse, error)
+	DeleteUnitSinger(context.Context, *DeleteUnitSingerRequest) (*DeleteUnitSingerResponse, error)
 	// VocalPattern
-	// rpc GetVocalPatterns(GetVocalPatternsRequest) returns (GetVocalPatternsResponse);
-	// rpc GetVocalPattern(GetVocalPatternRequest) returns (GetVocalPatternResponse);
+	GetVocalPatterns(context.Context, *GetVocalPatternsRequest) (*GetVocalPatternsResponse, error)
+	GetVocalPattern(context.Context, *GetVocalPatternRequest) (*GetVocalPatternResponse, error)
 	CreateVocalPattern(context.Context, *CreateVocalPatternRequest) (*CreateVocalPatternResponse, error)
+	UpdateVocalPattern(context.Context, *UpdateVocalPatternRequest) (*UpdateVocalPatternResponse, error)
+	DeleteVocalPattern(context.Context, *DeleteVocalPatternRequest) (*DeleteVocalPatternResponse, error)
 	// Song
 	GetSongs(context.Context, *GetSongsRequest) (*GetSongsResponse, error)
 	GetSong(context.Context, *GetSongRequest) (*GetSongResponse, error)
 	CreateSong(context.Context, *CreateSongRequest) (*CreateSongResponse, error)
+	UpdateSong(context.Context, *UpdateSongRequest) (*UpdateSongResponse, error)
+	DeleteSong(context.Context, *DeleteSongRequest) (*DeleteSongResponse, error)
+	SearchSongs(context.Context, *SearchSongsRequest) (*SearchSongsResponse, error)
+	ListSongReleases(context.Context, *ListSongReleasesRequest) (*ListSongReleasesResponse, error)
 	// Chart
 	GetCharts(context.Context, *GetChartsRequest) (*GetChartsResponse, error)
 	GetChart(context.Context, *GetChartRequest) (*GetChartResponse, error)
 	CreateChart(context.Context, *CreateChartRequest) (*CreateChartResponse, error)
+	UpdateChart(context.Context, *UpdateChartRequest) (*UpdateChartResponse, error)
+	DeleteChart(context.Context, *DeleteChartRequest) (*DeleteChartResponse, error)
+	SearchCharts(context.Context, *SearchChartsRequest) (*SearchChartsResponse, error)
+	GetChartHistory(context.Context, *GetChartHistoryRequest) (*GetChartHistoryResponse, error)
+	// MasterImport
+	ImportMaster(context.Context, *ImportMasterRequest) (*ImportMasterResponse, error)
+	ExportMaster(context.Context, *ExportMasterRequest) (*ExportMasterResponse, error)
+	// MasterRevision
+	GetMasterVersion(context.Context, *GetMasterVersionRequest) (*GetMasterVersionResponse, error)
+	GetMasterDelta(context.Context, *GetMasterDeltaRequest) (*GetMasterDeltaResponse, error)
+	// マスタの変更を通知し続ける 受け取ったらGetMasterDeltaで内容を取り直す
+	WatchMaster(*WatchMasterRequest, grpc.ServerStreamingServer[WatchMasterResponse]) error
 	mustEmbedUnimplementedMasterServiceServer()
 }
 
@@ -282,6 +618,15 @@ func (UnimplementedMasterServiceServer) GetArtist(context.Context, *GetArtistReq
 func (UnimplementedMasterServiceServer) CreateArtist(context.Context, *CreateArtistRequest) (*CreateArtistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateArtist not implemented")
 }
+func (UnimplementedMasterServiceServer) UpdateArtist(context.Context, *UpdateArtistRequest) (*UpdateArtistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateArtist not implemented")
+}
+func (UnimplementedMasterServiceServer) DeleteArtist(context.Context, *DeleteArtistRequest) (*DeleteArtistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArtist not implemented")
+}
+func (UnimplementedMasterServiceServer) MergeArtists(context.Context, *MergeArtistsRequest) (*MergeArtistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeArtists not implemented")
+}
 func (UnimplementedMasterServiceServer) GetSingers(context.Context, *GetSingersRequest) (*GetSingersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSingers not implemented")
 }
@@ -291,6 +636,9 @@ func (UnimplementedMasterServiceServer) GetSinger(context.Context, *GetSingerReq
 func (UnimplementedMasterServiceServer) CreateSinger(context.Context, *CreateSingerRequest) (*CreateSingerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSinger not implemented")
 }
+func (UnimplementedMasterServiceServer) UpdateSinger(context.Context, *UpdateSingerRequest) (*UpdateSingerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSinger not implemented")
+}
 func (UnimplementedMasterServiceServer) GetUnits(context.Context, *GetUnitsRequest) (*GetUnitsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUnits not implemented")
 }
@@ -300,9 +648,33 @@ func (UnimplementedMasterServiceServer) GetUnit(context.Context, *GetUnitRequest
 func (UnimplementedMasterServiceServer) CreateUnit(context.Context, *CreateUnitRequest) (*CreateUnitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUnit not implemented")
 }
+func (UnimplementedMasterServiceServer) UpdateUnit(context.Context, *UpdateUnitRequest) (*UpdateUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUnit not implemented")
+}
+func (UnimplementedMasterServiceServer) CreateUnitSinger(context.Context, *CreateUnitSingerRequest) (*CreateUnitSingerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUnitSinger not implemented")
+}
+func (UnimplementedMasterServiceServer) UpdateUnitSinger(context.Context, *UpdateUnitSingerRequest) (*UpdateUnitSingerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUnitSinger not implemented")
+}
+func (UnimplementedMasterServiceServer) DeleteUnitSinger(context.Context, *DeleteUnitSingerRequest) (*DeleteUnitSingerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUnitSinger not implemented")
+}
+func (UnimplementedMasterServiceServer) GetVocalPatterns(context.Context, *GetVocalPatternsRequest) (*GetVocalPatternsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVocalPatterns not implemented")
+}
+func (UnimplementedMasterServiceServer) GetVocalPattern(context.Context, *GetVocalPatternRequest) (*GetVocalPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVocalPattern not implemented")
+}
 func (UnimplementedMasterServiceServer) CreateVocalPattern(context.Context, *CreateVocalPatternRequest) (*CreateVocalPatternResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateVocalPattern not implemented")
 }
+func (UnimplementedMasterServiceServer) UpdateVocalPattern(context.Context, *UpdateVocalPatternRequest) (*UpdateVocalPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVocalPattern not implemented")
+}
+func (UnimplementedMasterServiceServer) DeleteVocalPattern(context.Context, *DeleteVocalPatternRequest) (*DeleteVocalPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVocalPattern not implemented")
+}
 func (UnimplementedMasterServiceServer) GetSongs(context.Context, *GetSongsRequest) (*GetSongsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSongs not implemented")
 }
@@ -312,6 +684,18 @@ func (UnimplementedMasterServiceServer) GetSong(context.Context, *GetSongRequest
 func (UnimplementedMasterServiceServer) CreateSong(context.Context, *CreateSongRequest) (*CreateSongResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSong not implemented")
 }
+func (UnimplementedMasterServiceServer) UpdateSong(context.Context, *UpdateSongRequest) (*UpdateSongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSong not implemented")
+}
+func (UnimplementedMasterServiceServer) DeleteSong(context.Context, *DeleteSongRequest) (*DeleteSongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSong not implemented")
+}
+func (UnimplementedMasterServiceServer) SearchSongs(context.Context, *SearchSongsRequest) (*SearchSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSongs not implemented")
+}
+func (UnimplementedMasterServiceServer) ListSongReleases(context.Context, *ListSongReleasesRequest) (*ListSongReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSongReleases not implemented")
+}
 func (UnimplementedMasterServiceServer) GetCharts(context.Context, *GetChartsRequest) (*GetChartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCharts not implemented")
 }
@@ -321,6 +705,33 @@ func (UnimplementedMasterServiceServer) GetChart(context.Context, *GetChartReque
 func (UnimplementedMasterServiceServer) CreateChart(context.Context, *CreateChartRequest) (*CreateChartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateChart not implemented")
 }
+func (UnimplementedMasterServiceServer) UpdateChart(context.Context, *UpdateChartRequest) (*UpdateChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChart not implemented")
+}
+func (UnimplementedMasterServiceServer) DeleteChart(context.Context, *DeleteChartRequest) (*DeleteChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChart not implemented")
+}
+func (UnimplementedMasterServiceServer) SearchCharts(context.Context, *SearchChartsRequest) (*SearchChartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCharts not implemented")
+}
+func (UnimplementedMasterServiceServer) GetChartHistory(context.Context, *GetChartHistoryRequest) (*GetChartHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChartHistory not implemented")
+}
+func (UnimplementedMasterServiceServer) ImportMaster(context.Context, *ImportMasterRequest) (*ImportMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMaster not implemented")
+}
+func (UnimplementedMasterServiceServer) ExportMaster(context.Context, *ExportMasterRequest) (*ExportMasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMaster not implemented")
+}
+func (UnimplementedMasterServiceServer) GetMasterVersion(context.Context, *GetMasterVersionRequest) (*GetMasterVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMasterVersion not implemented")
+}
+func (UnimplementedMasterServiceServer) GetMasterDelta(context.Context, *GetMasterDeltaRequest) (*GetMasterDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMasterDelta not implemented")
+}
+func (UnimplementedMasterServiceServer) WatchMaster(*WatchMasterRequest, grpc.ServerStreamingServer[WatchMasterResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMaster not implemented")
+}
 func (UnimplementedMasterServiceServer) mustEmbedUnimplementedMasterServiceServer() {}
 func (UnimplementedMasterServiceServer) testEmbeddedByValue()                       {}
 
@@ -396,6 +807,60 @@ func _MasterService_CreateArtist_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MasterService_UpdateArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).UpdateArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_UpdateArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).UpdateArtist(ctx, req.(*UpdateArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_DeleteArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).DeleteArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_DeleteArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).DeleteArtist(ctx, req.(*DeleteArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_MergeArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeArtistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).MergeArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_MergeArtists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).MergeArtists(ctx, req.(*MergeArtistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MasterService_GetSingers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSingersRequest)
 	if err := dec(in); err != nil {
@@ -450,6 +915,24 @@ func _MasterService_CreateSinger_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MasterService_UpdateSinger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSingerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).UpdateSinger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_UpdateSinger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).UpdateSinger(ctx, req.(*UpdateSingerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MasterService_GetUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUnitsRequest)
 	if err := dec(in); err != nil {
@@ -504,6 +987,114 @@ func _MasterService_CreateUnit_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MasterService_UpdateUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).UpdateUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_UpdateUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).UpdateUnit(ctx, req.(*UpdateUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_CreateUnitSinger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUnitSingerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).CreateUnitSinger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_CreateUnitSinger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).CreateUnitSinger(ctx, req.(*CreateUnitSingerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_UpdateUnitSinger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUnitSingerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).UpdateUnitSinger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_UpdateUnitSinger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).UpdateUnitSinger(ctx, req.(*UpdateUnitSingerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_DeleteUnitSinger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUnitSingerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).DeleteUnitSinger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_DeleteUnitSinger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).DeleteUnitSinger(ctx, req.(*DeleteUnitSingerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_GetVocalPatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVocalPatternsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).GetVocalPatterns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_GetVocalPatterns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).GetVocalPatterns(ctx, req.(*GetVocalPatternsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_GetVocalPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVocalPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).GetVocalPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_GetVocalPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).GetVocalPattern(ctx, req.(*GetVocalPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MasterService_CreateVocalPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateVocalPatternRequest)
 	if err := dec(in); err != nil {
@@ -522,6 +1113,42 @@ func _MasterService_CreateVocalPattern_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MasterService_UpdateVocalPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVocalPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).UpdateVocalPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_UpdateVocalPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).UpdateVocalPattern(ctx, req.(*UpdateVocalPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_DeleteVocalPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVocalPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).DeleteVocalPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_DeleteVocalPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).DeleteVocalPattern(ctx, req.(*DeleteVocalPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MasterService_GetSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSongsRequest)
 	if err := dec(in); err != nil {
@@ -576,6 +1203,78 @@ func _MasterService_CreateSong_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MasterService_UpdateSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).UpdateSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_UpdateSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).UpdateSong(ctx, req.(*UpdateSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_DeleteSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).DeleteSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_DeleteSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).DeleteSong(ctx, req.(*DeleteSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_SearchSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).SearchSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_SearchSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).SearchSongs(ctx, req.(*SearchSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_ListSongReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSongReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).ListSongReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_ListSongReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).ListSongReleases(ctx, req.(*ListSongReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MasterService_GetCharts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetChartsRequest)
 	if err := dec(in); err != nil {
@@ -630,6 +1329,161 @@ func _MasterService_CreateChart_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MasterService_UpdateChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).UpdateChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_UpdateChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).UpdateChart(ctx, req.(*UpdateChartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_DeleteChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).DeleteChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_DeleteChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).DeleteChart(ctx, req.(*DeleteChartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_SearchCharts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchChartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).SearchCharts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_SearchCharts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).SearchCharts(ctx, req.(*SearchChartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_GetChartHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChartHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).GetChartHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_GetChartHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).GetChartHistory(ctx, req.(*GetChartHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_ImportMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).ImportMaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_ImportMaster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).ImportMaster(ctx, req.(*ImportMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_ExportMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).ExportMaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_ExportMaster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).ExportMaster(ctx, req.(*ExportMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_GetMasterVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMasterVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).GetMasterVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_GetMasterVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).GetMasterVersion(ctx, req.(*GetMasterVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_GetMasterDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMasterDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServiceServer).GetMasterDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MasterService_GetMasterDelta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServiceServer).GetMasterDelta(ctx, req.(*GetMasterDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MasterService_WatchMaster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMasterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MasterServiceServer).WatchMaster(m, &grpc.GenericServerStream[WatchMasterRequest, WatchMasterResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MasterService_WatchMasterServer = grpc.ServerStreamingServer[WatchMasterResponse]
+
 // MasterService_ServiceDesc is the grpc.ServiceDesc for MasterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -649,6 +1503,18 @@ var MasterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateArtist",
 			Handler:    _MasterService_CreateArtist_Handler,
 		},
+		{
+			MethodName: "UpdateArtist",
+			Handler:    _MasterService_UpdateArtist_Handler,
+		},
+		{
+			MethodName: "DeleteArtist",
+			Handler:    _MasterService_DeleteArtist_Handler,
+		},
+		{
+			MethodName: "MergeArtists",
+			Handler:    _MasterService_MergeArtists_Handler,
+		},
 		{
 			MethodName: "GetSingers",
 			Handler:    _MasterService_GetSingers_Handler,
@@ -661,6 +1527,10 @@ var MasterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSinger",
 			Handler:    _MasterService_CreateSinger_Handler,
 		},
+		{
+			MethodName: "UpdateSinger",
+			Handler:    _MasterService_UpdateSinger_Handler,
+		},
 		{
 			MethodName: "GetUnits",
 			Handler:    _MasterService_GetUnits_Handler,
@@ -673,10 +1543,42 @@ var MasterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateUnit",
 			Handler:    _MasterService_CreateUnit_Handler,
 		},
+		{
+			MethodName: "UpdateUnit",
+			Handler:    _MasterService_UpdateUnit_Handler,
+		},
+		{
+			MethodName: "CreateUnitSinger",
+			Handler:    _MasterService_CreateUnitSinger_Handler,
+		},
+		{
+			MethodName: "UpdateUnitSinger",
+			Handler:    _MasterService_UpdateUnitSinger_Handler,
+		},
+		{
+			MethodName: "DeleteUnitSinger",
+			Handler:    _MasterService_DeleteUnitSinger_Handler,
+		},
+		{
+			MethodName: "GetVocalPatterns",
+			Handler:    _MasterService_GetVocalPatterns_Handler,
+		},
+		{
+			MethodName: "GetVocalPattern",
+			Handler:    _MasterService_GetVocalPattern_Handler,
+		},
 		{
 			MethodName: "CreateVocalPattern",
 			Handler:    _MasterService_CreateVocalPattern_Handler,
 		},
+		{
+			MethodName: "UpdateVocalPattern",
+			Handler:    _MasterService_UpdateVocalPattern_Handler,
+		},
+		{
+			MethodName: "DeleteVocalPattern",
+			Handler:    _MasterService_DeleteVocalPattern_Handler,
+		},
 		{
 			MethodName: "GetSongs",
 			Handler:    _MasterService_GetSongs_Handler,
@@ -689,6 +1591,22 @@ var MasterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSong",
 			Handler:    _MasterService_CreateSong_Handler,
 		},
+		{
+			MethodName: "UpdateSong",
+			Handler:    _MasterService_UpdateSong_Handler,
+		},
+		{
+			MethodName: "DeleteSong",
+			Handler:    _MasterService_DeleteSong_Handler,
+		},
+		{
+			MethodName: "SearchSongs",
+			Handler:    _MasterService_SearchSongs_Handler,
+		},
+		{
+			MethodName: "ListSongReleases",
+			Handler:    _MasterService_ListSongReleases_Handler,
+		},
 		{
 			MethodName: "GetCharts",
 			Handler:    _MasterService_GetCharts_Handler,
@@ -701,7 +1619,45 @@ var MasterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateChart",
 			Handler:    _MasterService_CreateChart_Handler,
 		},
+		{
+			MethodName: "UpdateChart",
+			Handler:    _MasterService_UpdateChart_Handler,
+		},
+		{
+			MethodName: "DeleteChart",
+			Handler:    _MasterService_DeleteChart_Handler,
+		},
+		{
+			MethodName: "SearchCharts",
+			Handler:    _MasterService_SearchCharts_Handler,
+		},
+		{
+			MethodName: "GetChartHistory",
+			Handler:    _MasterService_GetChartHistory_Handler,
+		},
+		{
+			MethodName: "ImportMaster",
+			Handler:    _MasterService_ImportMaster_Handler,
+		},
+		{
+			MethodName: "ExportMaster",
+			Handler:    _MasterService_ExportMaster_Handler,
+		},
+		{
+			MethodName: "GetMasterVersion",
+			Handler:    _MasterService_GetMasterVersion_Handler,
+		},
+		{
+			MethodName: "GetMasterDelta",
+			Handler:    _MasterService_GetMasterDelta_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMaster",
+			Handler:       _MasterService_WatchMaster_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "master/master.proto",
 }
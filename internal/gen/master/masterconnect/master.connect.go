@@ -41,6 +41,15 @@ const (
 	// MasterServiceCreateArtistProcedure is the fully-qualified name of the MasterService's
 	// CreateArtist RPC.
 	MasterServiceCreateArtistProcedure = "/master.MasterService/CreateArtist"
+	// MasterServiceUpdateArtistProcedure is the fully-qualified name of the MasterService's
+	// UpdateArtist RPC.
+	MasterServiceUpdateArtistProcedure = "/master.MasterService/UpdateArtist"
+	// MasterServiceDeleteArtistProcedure is the fully-qualified name of the MasterService's
+	// DeleteArtist RPC.
+	MasterServiceDeleteArtistProcedure = "/master.MasterService/DeleteArtist"
+	// MasterServiceMergeArtistsProcedure is the fully-qualified name of the MasterService's
+	// MergeArtists RPC.
+	MasterServiceMergeArtistsProcedure = "/master.MasterService/MergeArtists"
 	// MasterServiceGetSingersProcedure is the fully-qualified name of the MasterService's GetSingers
 	// RPC.
 	MasterServiceGetSingersProcedure = "/master.MasterService/GetSingers"
@@ -49,6 +58,9 @@ const (
 	// MasterServiceCreateSingerProcedure is the fully-qualified name of the MasterService's
 	// CreateSinger RPC.
 	MasterServiceCreateSingerProcedure = "/master.MasterService/CreateSinger"
+	// MasterServiceUpdateSingerProcedure is the fully-qualified name of the MasterService's
+	// UpdateSinger RPC.
+	MasterServiceUpdateSingerProcedure = "/master.MasterService/UpdateSinger"
 	// MasterServiceGetUnitsProcedure is the fully-qualified name of the MasterService's GetUnits RPC.
 	MasterServiceGetUnitsProcedure = "/master.MasterService/GetUnits"
 	// MasterServiceGetUnitProcedure is the fully-qualified name of the MasterService's GetUnit RPC.
@@ -56,9 +68,33 @@ const (
 	// MasterServiceCreateUnitProcedure is the fully-qualified name of the MasterService's CreateUnit
 	// RPC.
 	MasterServiceCreateUnitProcedure = "/master.MasterService/CreateUnit"
+	// MasterServiceUpdateUnitProcedure is the fully-qualified name of the MasterService's UpdateUnit
+	// RPC.
+	MasterServiceUpdateUnitProcedure = "/master.MasterService/UpdateUnit"
+	// MasterServiceCreateUnitSingerProcedure is the fully-qualified name of the MasterService's
+	// CreateUnitSinger RPC.
+	MasterServiceCreateUnitSingerProcedure = "/master.MasterService/CreateUnitSinger"
+	// MasterServiceUpdateUnitSingerProcedure is the fully-qualified name of the MasterService's
+	// UpdateUnitSinger RPC.
+	MasterServiceUpdateUnitSingerProcedure = "/master.MasterService/UpdateUnitSinger"
+	// MasterServiceDeleteUnitSingerProcedure is the fully-qualified name of the MasterService's
+	// DeleteUnitSinger RPC.
+	MasterServiceDeleteUnitSingerProcedure = "/master.MasterService/DeleteUnitSinger"
+	// MasterServiceGetVocalPatternsProcedure is the fully-qualified name of the MasterService's
+	// GetVocalPatterns RPC.
+	MasterServiceGetVocalPatternsProcedure = "/master.MasterService/GetVocalPatterns"
+	// MasterServiceGetVocalPatternProcedure is the fully-qualified name of the MasterService's
+	// GetVocalPattern RPC.
+	MasterServiceGetVocalPatternProcedure = "/master.MasterService/GetVocalPattern"
 	// MasterServiceCreateVocalPatternProcedure is the fully-qualified name of the MasterService's
 	// CreateVocalPattern RPC.
 	MasterServiceCreateVocalPatternProcedure = "/master.MasterService/CreateVocalPattern"
+	// MasterServiceUpdateVocalPatternProcedure is the fully-qualified name of the MasterService's
+	// UpdateVocalPattern RPC.
+	MasterServiceUpdateVocalPatternProcedure = "/master.MasterService/UpdateVocalPattern"
+	// MasterServiceDeleteVocalPatternProcedure is the fully-qualified name of the MasterService's
+	// DeleteVocalPattern RPC.
+	MasterServiceDeleteVocalPatternProcedure = "/master.MasterService/DeleteVocalPattern"
 	// MasterServiceGetSongsProcedure is the fully-qualified name of the MasterService's GetSongs RPC.
 	MasterServiceGetSongsProcedure = "/master.MasterService/GetSongs"
 	// MasterServiceGetSongProcedure is the fully-qualified name of the MasterService's GetSong RPC.
@@ -66,6 +102,18 @@ const (
 	// MasterServiceCreateSongProcedure is the fully-qualified name of the MasterService's CreateSong
 	// RPC.
 	MasterServiceCreateSongProcedure = "/master.MasterService/CreateSong"
+	// MasterServiceUpdateSongProcedure is the fully-qualified name of the MasterService's UpdateSong
+	// RPC.
+	MasterServiceUpdateSongProcedure = "/master.MasterService/UpdateSong"
+	// MasterServiceDeleteSongProcedure is the fully-qualified name of the MasterService's DeleteSong
+	// RPC.
+	MasterServiceDeleteSongProcedure = "/master.MasterService/DeleteSong"
+	// MasterServiceSearchSongsProcedure is the fully-qualified name of the MasterService's SearchSongs
+	// RPC.
+	MasterServiceSearchSongsProcedure = "/master.MasterService/SearchSongs"
+	// MasterServiceListSongReleasesProcedure is the fully-qualified name of the MasterService's
+	// ListSongReleases RPC.
+	MasterServiceListSongReleasesProcedure = "/master.MasterService/ListSongReleases"
 	// MasterServiceGetChartsProcedure is the fully-qualified name of the MasterService's GetCharts RPC.
 	MasterServiceGetChartsProcedure = "/master.MasterService/GetCharts"
 	// MasterServiceGetChartProcedure is the fully-qualified name of the MasterService's GetChart RPC.
@@ -73,6 +121,33 @@ const (
 	// MasterServiceCreateChartProcedure is the fully-qualified name of the MasterService's CreateChart
 	// RPC.
 	MasterServiceCreateChartProcedure = "/master.MasterService/CreateChart"
+	// MasterServiceUpdateChartProcedure is the fully-qualified name of the MasterService's UpdateChart
+	// RPC.
+	MasterServiceUpdateChartProcedure = "/master.MasterService/UpdateChart"
+	// MasterServiceDeleteChartProcedure is the fully-qualified name of the MasterService's DeleteChart
+	// RPC.
+	MasterServiceDeleteChartProcedure = "/master.MasterService/DeleteChart"
+	// MasterServiceSearchChartsProcedure is the fully-qualified name of the MasterService's
+	// SearchCharts RPC.
+	MasterServiceSearchChartsProcedure = "/master.MasterService/SearchCharts"
+	// MasterServiceGetChartHistoryProcedure is the fully-qualified name of the MasterService's
+	// GetChartHistory RPC.
+	MasterServiceGetChartHistoryProcedure = "/master.MasterService/GetChartHistory"
+	// MasterServiceImportMasterProcedure is the fully-qualified name of the MasterService's
+	// ImportMaster RPC.
+	MasterServiceImportMasterProcedure = "/master.MasterService/ImportMaster"
+	// MasterServiceExportMasterProcedure is the fully-qualified name of the MasterService's
+	// ExportMaster RPC.
+	MasterServiceExportMasterProcedure = "/master.MasterService/ExportMaster"
+	// MasterServiceGetMasterVersionProcedure is the fully-qualified name of the MasterService's
+	// GetMasterVersion RPC.
+	MasterServiceGetMasterVersionProcedure = "/master.MasterService/GetMasterVersion"
+	// MasterServiceGetMasterDeltaProcedure is the fully-qualified name of the MasterService's
+	// GetMasterDelta RPC.
+	MasterServiceGetMasterDeltaProcedure = "/master.MasterService/GetMasterDelta"
+	// MasterServiceWatchMasterProcedure is the fully-qualified name of the MasterService's WatchMaster
+	// RPC.
+	MasterServiceWatchMasterProcedure = "/master.MasterService/WatchMaster"
 )
 
 // MasterServiceClient is a client for the master.MasterService service.
@@ -81,26 +156,52 @@ type MasterServiceClient interface {
 	GetArtists(context.Context, *connect.Request[master.GetArtistsRequest]) (*connect.Response[master.GetArtistsResponse], error)
 	GetArtist(context.Context, *connect.Request[master.GetArtistRequest]) (*connect.Response[master.GetArtistResponse], error)
 	CreateArtist(context.Context, *connect.Request[master.CreateArtistRequest]) (*connect.Response[master.CreateArtistResponse], error)
+	UpdateArtist(context.Context, *connect.Request[master.UpdateArtistRequest]) (*connect.Response[master.UpdateArtistResponse], error)
+	DeleteArtist(context.Context, *connect.Request[master.DeleteArtistRequest]) (*connect.Response[master.DeleteArtistResponse], error)
+	MergeArtists(context.Context, *connect.Request[master.MergeArtistsRequest]) (*connect.Response[master.MergeArtistsResponse], error)
 	// Singer
 	GetSingers(context.Context, *connect.Request[master.GetSingersRequest]) (*connect.Response[master.GetSingersResponse], error)
 	GetSinger(context.Context, *connect.Request[master.GetSingerRequest]) (*connect.Response[master.GetSingerResponse], error)
 	CreateSinger(context.Context, *connect.Request[master.CreateSingerRequest]) (*connect.Response[master.CreateSingerResponse], error)
+	UpdateSinger(context.Context, *connect.Request[master.UpdateSingerRequest]) (*connect.Response[master.UpdateSingerResponse], error)
 	// Unit
 	GetUnits(context.Context, *connect.Request[master.GetUnitsRequest]) (*connect.Response[master.GetUnitsResponse], error)
 	GetUnit(context.Context, *connect.Request[master.GetUnitRequest]) (*connect.Response[master.GetUnitResponse], error)
 	CreateUnit(context.Context, *connect.Request[master.CreateUnitRequest]) (*connect.Response[master.CreateUnitResponse], error)
+	UpdateUnit(context.Context, *connect.Request[master.UpdateUnitRequest]) (*connect.Response[master.UpdateUnitResponse], error)
+	CreateUnitSinger(context.Context, *connect.Request[master.CreateUnitSingerRequest]) (*connect.Response[master.CreateUnitSingerResponse], error)
+	UpdateUnitSinger(context.Context, *connect.Request[master.UpdateUnitSingerRequest]) (*connect.Response[master.UpdateUnitSingerResponse], error)
+	DeleteUnitSinger(context.Context, *connect.Request[master.DeleteUnitSingerRequest]) (*connect.Response[master.DeleteUnitSingerResponse], error)
 	// VocalPattern
-	// rpc GetVocalPatterns(GetVocalPatternsRequest) returns (GetVocalPatternsResponse);
-	// rpc GetVocalPattern(GetVocalPatternRequest) returns (GetVocalPatternResponse);
+	GetVocalPatterns(context.Context, *connect.Request[master.GetVocalPatternsRequest]) (*connect.Response[master.GetVocalPatternsResponse], error)
+	GetVocalPattern(context.Context, *connect.Request[master.GetVocalPatternRequest]) (*connect.Response[master.GetVocalPatternResponse], error)
 	CreateVocalPattern(context.Context, *connect.Request[master.CreateVocalPatternRequest]) (*connect.Response[master.CreateVocalPatternResponse], error)
+	UpdateVocalPattern(context.Context, *connect.Request[master.UpdateVocalPatternRequest]) (*connect.Response[master.UpdateVocalPatternResponse], error)
+	DeleteVocalPattern(context.Context, *connect.Request[master.DeleteVocalPatternRequest]) (*connect.Response[master.DeleteVocalPatternResponse], error)
 	// Song
 	GetSongs(context.Context, *connect.Request[master.GetSongsRequest]) (*connect.Response[master.GetSongsResponse], error)
 	GetSong(context.Context, *connect.Request[master.GetSongRequest]) (*connect.Response[master.GetSongResponse], error)
 	CreateSong(context.Context, *connect.Request[master.CreateSongRequest]) (*connect.Response[master.CreateSongResponse], error)
+	UpdateSong(context.Context, *connect.Request[master.UpdateSongRequest]) (*connect.Response[master.UpdateSongResponse], error)
+	DeleteSong(context.Context, *connect.Request[master.DeleteSongRequest]) (*connect.Response[master.DeleteSongResponse], error)
+	SearchSongs(context.Context, *connect.Request[master.SearchSongsRequest]) (*connect.Response[master.SearchSongsResponse], error)
+	ListSongReleases(context.Context, *connect.Request[master.ListSongReleasesRequest]) (*connect.Response[master.ListSongReleasesResponse], error)
 	// Chart
 	GetCharts(context.Context, *connect.Request[master.GetChartsRequest]) (*connect.Response[master.GetChartsResponse], error)
 	GetChart(context.Context, *connect.Request[master.GetChartRequest]) (*connect.Response[master.GetChartResponse], error)
 	CreateChart(context.Context, *connect.Request[master.CreateChartRequest]) (*connect.Response[master.CreateChartResponse], error)
+	UpdateChart(context.Context, *connect.Request[master.UpdateChartRequest]) (*connect.Response[master.UpdateChartResponse], error)
+	DeleteChart(context.Context, *connect.Request[master.DeleteChartRequest]) (*connect.Response[master.DeleteChartResponse], error)
+	SearchCharts(context.Context, *connect.Request[master.SearchChartsRequest]) (*connect.Response[master.SearchChartsResponse], error)
+	GetChartHistory(context.Context, *connect.Request[master.GetChartHistoryRequest]) (*connect.Response[master.GetChartHistoryResponse], error)
+	// MasterImport
+	ImportMaster(context.Context, *connect.Request[master.ImportMasterRequest]) (*connect.Response[master.ImportMasterResponse], error)
+	ExportMaster(context.Context, *connect.Request[master.ExportMasterRequest]) (*connect.Response[master.ExportMasterResponse], error)
+	// MasterRevision
+	GetMasterVersion(context.Context, *connect.Request[master.GetMasterVersionRequest]) (*connect.Response[master.GetMasterVersionResponse], error)
+	GetMasterDelta(context.Context, *connect.Request[master.GetMasterDeltaRequest]) (*connect.Response[master.GetMasterDeltaResponse], error)
+	// マスタの変更を通知し続ける 受け取ったらGetMasterDeltaで内容を取り直す
+	WatchMaster(context.Context, *connect.Request[master.WatchMasterRequest]) (*connect.ServerStreamForClient[master.WatchMasterResponse], error)
 }
 
 // NewMasterServiceClient constructs a client for the master.MasterService service. By default, it
@@ -132,6 +233,24 @@ func NewMasterServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(masterServiceMethods.ByName("CreateArtist")),
 			connect.WithClientOptions(opts...),
 		),
+		updateArtist: connect.NewClient[master.UpdateArtistRequest, master.UpdateArtistResponse](
+			httpClient,
+			baseURL+MasterServiceUpdateArtistProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("UpdateArtist")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteArtist: connect.NewClient[master.DeleteArtistRequest, master.DeleteArtistResponse](
+			httpClient,
+			baseURL+MasterServiceDeleteArtistProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("DeleteArtist")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeArtists: connect.NewClient[master.MergeArtistsRequest, master.MergeArtistsResponse](
+			httpClient,
+			baseURL+MasterServiceMergeArtistsProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("MergeArtists")),
+			connect.WithClientOptions(opts...),
+		),
 		getSingers: connect.NewClient[master.GetSingersRequest, master.GetSingersResponse](
 			httpClient,
 			baseURL+MasterServiceGetSingersProcedure,
@@ -150,6 +269,12 @@ func NewMasterServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(masterServiceMethods.ByName("CreateSinger")),
 			connect.WithClientOptions(opts...),
 		),
+		updateSinger: connect.NewClient[master.UpdateSingerRequest, master.UpdateSingerResponse](
+			httpClient,
+			baseURL+MasterServiceUpdateSingerProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("UpdateSinger")),
+			connect.WithClientOptions(opts...),
+		),
 		getUnits: connect.NewClient[master.GetUnitsRequest, master.GetUnitsResponse](
 			httpClient,
 			baseURL+MasterServiceGetUnitsProcedure,
@@ -168,12 +293,60 @@ func NewMasterServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(masterServiceMethods.ByName("CreateUnit")),
 			connect.WithClientOptions(opts...),
 		),
+		updateUnit: connect.NewClient[master.UpdateUnitRequest, master.UpdateUnitResponse](
+			httpClient,
+			baseURL+MasterServiceUpdateUnitProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("UpdateUnit")),
+			connect.WithClientOptions(opts...),
+		),
+		createUnitSinger: connect.NewClient[master.CreateUnitSingerRequest, master.CreateUnitSingerResponse](
+			httpClient,
+			baseURL+MasterServiceCreateUnitSingerProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("CreateUnitSinger")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUnitSinger: connect.NewClient[master.UpdateUnitSingerRequest, master.UpdateUnitSingerResponse](
+			httpClient,
+			baseURL+MasterServiceUpdateUnitSingerProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("UpdateUnitSinger")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteUnitSinger: connect.NewClient[master.DeleteUnitSingerRequest, master.DeleteUnitSingerResponse](
+			httpClient,
+			baseURL+MasterServiceDeleteUnitSingerProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("DeleteUnitSinger")),
+			connect.WithClientOptions(opts...),
+		),
+		getVocalPatterns: connect.NewClient[master.GetVocalPatternsRequest, master.GetVocalPatternsResponse](
+			httpClient,
+			baseURL+MasterServiceGetVocalPatternsProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("GetVocalPatterns")),
+			connect.WithClientOptions(opts...),
+		),
+		getVocalPattern: connect.NewClient[master.GetVocalPatternRequest, master.GetVocalPatternResponse](
+			httpClient,
+			baseURL+MasterServiceGetVocalPatternProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("GetVocalPattern")),
+			connect.WithClientOptions(opts...),
+		),
 		createVocalPattern: connect.NewClient[master.CreateVocalPatternRequest, master.CreateVocalPatternResponse](
 			httpClient,
 			baseURL+MasterServiceCreateVocalPatternProcedure,
 			connect.WithSchema(masterServiceMethods.ByName("CreateVocalPattern")),
 			connect.WithClientOptions(opts...),
 		),
+		updateVocalPattern: connect.NewClient[master.UpdateVocalPatternRequest, master.UpdateVocalPatternResponse](
+			httpClient,
+			baseURL+MasterServiceUpdateVocalPatternProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("UpdateVocalPattern")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteVocalPattern: connect.NewClient[master.DeleteVocalPatternRequest, master.DeleteVocalPatternResponse](
+			httpClient,
+			baseURL+MasterServiceDeleteVocalPatternProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("DeleteVocalPattern")),
+			connect.WithClientOptions(opts...),
+		),
 		getSongs: connect.NewClient[master.GetSongsRequest, master.GetSongsResponse](
 			httpClient,
 			baseURL+MasterServiceGetSongsProcedure,
@@ -192,6 +365,30 @@ func NewMasterServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(masterServiceMethods.ByName("CreateSong")),
 			connect.WithClientOptions(opts...),
 		),
+		updateSong: connect.NewClient[master.UpdateSongRequest, master.UpdateSongResponse](
+			httpClient,
+			baseURL+MasterServiceUpdateSongProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("UpdateSong")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSong: connect.NewClient[master.DeleteSongRequest, master.DeleteSongResponse](
+			httpClient,
+			baseURL+MasterServiceDeleteSongProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("DeleteSong")),
+			connect.WithClientOptions(opts...),
+		),
+		searchSongs: connect.NewClient[master.SearchSongsRequest, master.SearchSongsResponse](
+			httpClient,
+			baseURL+MasterServiceSearchSongsProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("SearchSongs")),
+			connect.WithClientOptions(opts...),
+		),
+		listSongReleases: connect.NewClient[master.ListSongReleasesRequest, master.ListSongReleasesResponse](
+			httpClient,
+			baseURL+MasterServiceListSongReleasesProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("ListSongReleases")),
+			connect.WithClientOptions(opts...),
+		),
 		getCharts: connect.NewClient[master.GetChartsRequest, master.GetChartsResponse](
 			httpClient,
 			baseURL+MasterServiceGetChartsProcedure,
@@ -210,6 +407,60 @@ func NewMasterServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(masterServiceMethods.ByName("CreateChart")),
 			connect.WithClientOptions(opts...),
 		),
+		updateChart: connect.NewClient[master.UpdateChartRequest, master.UpdateChartResponse](
+			httpClient,
+			baseURL+MasterServiceUpdateChartProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("UpdateChart")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteChart: connect.NewClient[master.DeleteChartRequest, master.DeleteChartResponse](
+			httpClient,
+			baseURL+MasterServiceDeleteChartProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("DeleteChart")),
+			connect.WithClientOptions(opts...),
+		),
+		searchCharts: connect.NewClient[master.SearchChartsRequest, master.SearchChartsResponse](
+			httpClient,
+			baseURL+MasterServiceSearchChartsProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("SearchCharts")),
+			connect.WithClientOptions(opts...),
+		),
+		getChartHistory: connect.NewClient[master.GetChartHistoryRequest, master.GetChartHistoryResponse](
+			httpClient,
+			baseURL+MasterServiceGetChartHistoryProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("GetChartHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		importMaster: connect.NewClient[master.ImportMasterRequest, master.ImportMasterResponse](
+			httpClient,
+			baseURL+MasterServiceImportMasterProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("ImportMaster")),
+			connect.WithClientOptions(opts...),
+		),
+		exportMaster: connect.NewClient[master.ExportMasterRequest, master.ExportMasterResponse](
+			httpClient,
+			baseURL+MasterServiceExportMasterProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("ExportMaster")),
+			connect.WithClientOptions(opts...),
+		),
+		getMasterVersion: connect.NewClient[master.GetMasterVersionRequest, master.GetMasterVersionResponse](
+			httpClient,
+			baseURL+MasterServiceGetMasterVersionProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("GetMasterVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		getMasterDelta: connect.NewClient[master.GetMasterDeltaRequest, master.GetMasterDeltaResponse](
+			httpClient,
+			baseURL+MasterServiceGetMasterDeltaProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("GetMasterDelta")),
+			connect.WithClientOptions(opts...),
+		),
+		watchMaster: connect.NewClient[master.WatchMasterRequest, master.WatchMasterResponse](
+			httpClient,
+			baseURL+MasterServiceWatchMasterProcedure,
+			connect.WithSchema(masterServiceMethods.ByName("WatchMaster")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -218,19 +469,44 @@ type masterServiceClient struct {
 	getArtists         *connect.Client[master.GetArtistsRequest, master.GetArtistsResponse]
 	getArtist          *connect.Client[master.GetArtistRequest, master.GetArtistResponse]
 	createArtist       *connect.Client[master.CreateArtistRequest, master.CreateArtistResponse]
+	updateArtist       *connect.Client[master.UpdateArtistRequest, master.UpdateArtistResponse]
+	deleteArtist       *connect.Client[master.DeleteArtistRequest, master.DeleteArtistResponse]
+	mergeArtists       *connect.Client[master.MergeArtistsRequest, master.MergeArtistsResponse]
 	getSingers         *connect.Client[master.GetSingersRequest, master.GetSingersResponse]
 	getSinger          *connect.Client[master.GetSingerRequest, master.GetSingerResponse]
 	createSinger       *connect.Client[master.CreateSingerRequest, master.CreateSingerResponse]
+	updateSinger       *connect.Client[master.UpdateSingerRequest, master.UpdateSingerResponse]
 	getUnits           *connect.Client[master.GetUnitsRequest, master.GetUnitsResponse]
 	getUnit            *connect.Client[master.GetUnitRequest, master.GetUnitResponse]
 	createUnit         *connect.Client[master.CreateUnitRequest, master.CreateUnitResponse]
+	updateUnit         *connect.Client[master.UpdateUnitRequest, master.UpdateUnitResponse]
+	createUnitSinger   *connect.Client[master.CreateUnitSingerRequest, master.CreateUnitSingerResponse]
+	updateUnitSinger   *connect.Client[master.UpdateUnitSingerRequest, master.UpdateUnitSingerResponse]
+	deleteUnitSinger   *connect.Client[master.DeleteUnitSingerRequest, master.DeleteUnitSingerResponse]
+	getVocalPatterns   *connect.Client[master.GetVocalPatternsRequest, master.GetVocalPatternsResponse]
+	getVocalPattern    *connect.Client[master.GetVocalPatternRequest, master.GetVocalPatternResponse]
 	createVocalPattern *connect.Client[master.CreateVocalPatternRequest, master.CreateVocalPatternResponse]
+	updateVocalPattern *connect.Client[master.UpdateVocalPatternRequest, master.UpdateVocalPatternResponse]
+	deleteVocalPattern *connect.Client[master.DeleteVocalPatternRequest, master.DeleteVocalPatternResponse]
 	getSongs           *connect.Client[master.GetSongsRequest, master.GetSongsResponse]
 	getSong            *connect.Client[master.GetSongRequest, master.GetSongResponse]
 	createSong         *connect.Client[master.CreateSongRequest, master.CreateSongResponse]
+	updateSong         *connect.Client[master.UpdateSongRequest, master.UpdateSongResponse]
+	deleteSong         *connect.Client[master.DeleteSongRequest, master.DeleteSongResponse]
+	searchSongs        *connect.Client[master.SearchSongsRequest, master.SearchSongsResponse]
+	listSongReleases   *connect.Client[master.ListSongReleasesRequest, master.ListSongReleasesResponse]
 	getCharts          *connect.Client[master.GetChartsRequest, master.GetChartsResponse]
 	getChart           *connect.Client[master.GetChartRequest, master.GetChartResponse]
 	createChart        *connect.Client[master.CreateChartRequest, master.CreateChartResponse]
+	updateChart        *connect.Client[master.UpdateChartRequest, master.UpdateChartResponse]
+	deleteChart        *connect.Client[master.DeleteChartRequest, master.DeleteChartResponse]
+	searchCharts       *connect.Client[master.SearchChartsRequest, master.SearchChartsResponse]
+	getChartHistory    *connect.Client[master.GetChartHistoryRequest, master.GetChartHistoryResponse]
+	importMaster       *connect.Client[master.ImportMasterRequest, master.ImportMasterResponse]
+	exportMaster       *connect.Client[master.ExportMasterRequest, master.ExportMasterResponse]
+	getMasterVersion   *connect.Client[master.GetMasterVersionRequest, master.GetMasterVersionResponse]
+	getMasterDelta     *connect.Client[master.GetMasterDeltaRequest, master.GetMasterDeltaResponse]
+	watchMaster        *connect.Client[master.WatchMasterRequest, master.WatchMasterResponse]
 }
 
 // GetArtists calls master.MasterService.GetArtists.
@@ -248,6 +524,21 @@ func (c *masterServiceClient) CreateArtist(ctx context.Context, req *connect.Req
 	return c.createArtist.CallUnary(ctx, req)
 }
 
+// UpdateArtist calls master.MasterService.UpdateArtist.
+func (c *masterServiceClient) UpdateArtist(ctx context.Context, req *connect.Request[master.UpdateArtistRequest]) (*connect.Response[master.UpdateArtistResponse], error) {
+	return c.updateArtist.CallUnary(ctx, req)
+}
+
+// DeleteArtist calls master.MasterService.DeleteArtist.
+func (c *masterServiceClient) DeleteArtist(ctx context.Context, req *connect.Request[master.DeleteArtistRequest]) (*connect.Response[master.DeleteArtistResponse], error) {
+	return c.deleteArtist.CallUnary(ctx, req)
+}
+
+// MergeArtists calls master.MasterService.MergeArtists.
+func (c *masterServiceClient) MergeArtists(ctx context.Context, req *connect.Request[master.MergeArtistsRequest]) (*connect.Response[master.MergeArtistsResponse], error) {
+	return c.mergeArtists.CallUnary(ctx, req)
+}
+
 // GetSingers calls master.MasterService.GetSingers.
 func (c *masterServiceClient) GetSingers(ctx context.Context, req *connect.Request[master.GetSingersRequest]) (*connect.Response[master.GetSingersResponse], error) {
 	return c.getSingers.CallUnary(ctx, req)
@@ -263,6 +554,11 @@ func (c *masterServiceClient) CreateSinger(ctx context.Context, req *connect.Req
 	return c.createSinger.CallUnary(ctx, req)
 }
 
+// UpdateSinger calls master.MasterService.UpdateSinger.
+func (c *masterServiceClient) UpdateSinger(ctx context.Context, req *connect.Request[master.UpdateSingerRequest]) (*connect.Response[master.UpdateSingerResponse], error) {
+	return c.updateSinger.CallUnary(ctx, req)
+}
+
 // GetUnits calls master.MasterService.GetUnits.
 func (c *masterServiceClient) GetUnits(ctx context.Context, req *connect.Request[master.GetUnitsRequest]) (*connect.Response[master.GetUnitsResponse], error) {
 	return c.getUnits.CallUnary(ctx, req)
@@ -278,11 +574,51 @@ func (c *masterServiceClient) CreateUnit(ctx context.Context, req *connect.Reque
 	return c.createUnit.CallUnary(ctx, req)
 }
 
+// UpdateUnit calls master.MasterService.UpdateUnit.
+func (c *masterServiceClient) UpdateUnit(ctx context.Context, req *connect.Request[master.UpdateUnitRequest]) (*connect.Response[master.UpdateUnitResponse], error) {
+	return c.updateUnit.CallUnary(ctx, req)
+}
+
+// CreateUnitSinger calls master.MasterService.CreateUnitSinger.
+func (c *masterServiceClient) CreateUnitSinger(ctx context.Context, req *connect.Request[master.CreateUnitSingerRequest]) (*connect.Response[master.CreateUnitSingerResponse], error) {
+	return c.createUnitSinger.CallUnary(ctx, req)
+}
+
+// UpdateUnitSinger calls master.MasterService.UpdateUnitSinger.
+func (c *masterServiceClient) UpdateUnitSinger(ctx context.Context, req *connect.Request[master.UpdateUnitSingerRequest]) (*connect.Response[master.UpdateUnitSingerResponse], error) {
+	return c.updateUnitSinger.CallUnary(ctx, req)
+}
+
+// DeleteUnitSinger calls master.MasterService.DeleteUnitSinger.
+func (c *masterServiceClient) DeleteUnitSinger(ctx context.Context, req *connect.Request[master.DeleteUnitSingerRequest]) (*connect.Response[master.DeleteUnitSingerResponse], error) {
+	return c.deleteUnitSinger.CallUnary(ctx, req)
+}
+
+// GetVocalPatterns calls master.MasterService.GetVocalPatterns.
+func (c *masterServiceClient) GetVocalPatterns(ctx context.Context, req *connect.Request[master.GetVocalPatternsRequest]) (*connect.Response[master.GetVocalPatternsResponse], error) {
+	return c.getVocalPatterns.CallUnary(ctx, req)
+}
+
+// GetVocalPattern calls master.MasterService.GetVocalPattern.
+func (c *masterServiceClient) GetVocalPattern(ctx context.Context, req *connect.Request[master.GetVocalPatternRequest]) (*connect.Response[master.GetVocalPatternResponse], error) {
+	return c.getVocalPattern.CallUnary(ctx, req)
+}
+
 // CreateVocalPattern calls master.MasterService.CreateVocalPattern.
 func (c *masterServiceClient) CreateVocalPattern(ctx context.Context, req *connect.Request[master.CreateVocalPatternRequest]) (*connect.Response[master.CreateVocalPatternResponse], error) {
 	return c.createVocalPattern.CallUnary(ctx, req)
 }
 
+// UpdateVocalPattern calls master.MasterService.UpdateVocalPattern.
+func (c *masterServiceClient) UpdateVocalPattern(ctx context.Context, req *connect.Request[master.UpdateVocalPatternRequest]) (*connect.Response[master.UpdateVocalPatternResponse], error) {
+	return c.updateVocalPattern.CallUnary(ctx, req)
+}
+
+// DeleteVocalPattern calls master.MasterService.DeleteVocalPattern.
+func (c *masterServiceClient) DeleteVocalPattern(ctx context.Context, req *connect.Request[master.DeleteVocalPatternRequest]) (*connect.Response[master.DeleteVocalPatternResponse], error) {
+	return c.deleteVocalPattern.CallUnary(ctx, req)
+}
+
 // GetSongs calls master.MasterService.GetSongs.
 func (c *masterServiceClient) GetSongs(ctx context.Context, req *connect.Request[master.GetSongsRequest]) (*connect.Response[master.GetSongsResponse], error) {
 	return c.getSongs.CallUnary(ctx, req)
@@ -298,6 +634,26 @@ func (c *masterServiceClient) CreateSong(ctx context.Context, req *connect.Reque
 	return c.createSong.CallUnary(ctx, req)
 }
 
+// UpdateSong calls master.MasterService.UpdateSong.
+func (c *masterServiceClient) UpdateSong(ctx context.Context, req *connect.Request[master.UpdateSongRequest]) (*connect.Response[master.UpdateSongResponse], error) {
+	return c.updateSong.CallUnary(ctx, req)
+}
+
+// DeleteSong calls master.MasterService.DeleteSong.
+func (c *masterServiceClient) DeleteSong(ctx context.Context, req *connect.Request[master.DeleteSongRequest]) (*connect.Response[master.DeleteSongResponse], error) {
+	return c.deleteSong.CallUnary(ctx, req)
+}
+
+// SearchSongs calls master.MasterService.SearchSongs.
+func (c *masterServiceClient) SearchSongs(ctx context.Context, req *connect.Request[master.SearchSongsRequest]) (*connect.Response[master.SearchSongsResponse], error) {
+	return c.searchSongs.CallUnary(ctx, req)
+}
+
+// ListSongReleases calls master.MasterService.ListSongReleases.
+func (c *masterServiceClient) ListSongReleases(ctx context.Context, req *connect.Request[master.ListSongReleasesRequest]) (*connect.Response[master.ListSongReleasesResponse], error) {
+	return c.listSongReleases.CallUnary(ctx, req)
+}
+
 // GetCharts calls master.MasterService.GetCharts.
 func (c *masterServiceClient) GetCharts(ctx context.Context, req *connect.Request[master.GetChartsRequest]) (*connect.Response[master.GetChartsResponse], error) {
 	return c.getCharts.CallUnary(ctx, req)
@@ -313,32 +669,103 @@ func (c *masterServiceClient) CreateChart(ctx context.Context, req *connect.Requ
 	return c.createChart.CallUnary(ctx, req)
 }
 
+// UpdateChart calls master.MasterService.UpdateChart.
+func (c *masterServiceClient) UpdateChart(ctx context.Context, req *connect.Request[master.UpdateChartRequest]) (*connect.Response[master.UpdateChartResponse], error) {
+	return c.updateChart.CallUnary(ctx, req)
+}
+
+// DeleteChart calls master.MasterService.DeleteChart.
+func (c *masterServiceClient) DeleteChart(ctx context.Context, req *connect.Request[master.DeleteChartRequest]) (*connect.Response[master.DeleteChartResponse], error) {
+	return c.deleteChart.CallUnary(ctx, req)
+}
+
+// SearchCharts calls master.MasterService.SearchCharts.
+func (c *masterServiceClient) SearchCharts(ctx context.Context, req *connect.Request[master.SearchChartsRequest]) (*connect.Response[master.SearchChartsResponse], error) {
+	return c.searchCharts.CallUnary(ctx, req)
+}
+
+// GetChartHistory calls master.MasterService.GetChartHistory.
+func (c *masterServiceClient) GetChartHistory(ctx context.Context, req *connect.Request[master.GetChartHistoryRequest]) (*connect.Response[master.GetChartHistoryResponse], error) {
+	return c.getChartHistory.CallUnary(ctx, req)
+}
+
+// ImportMaster calls master.MasterService.ImportMaster.
+func (c *masterServiceClient) ImportMaster(ctx context.Context, req *connect.Request[master.ImportMasterRequest]) (*connect.Response[master.ImportMasterResponse], error) {
+	return c.importMaster.CallUnary(ctx, req)
+}
+
+// ExportMaster calls master.MasterService.ExportMaster.
+func (c *masterServiceClient) ExportMaster(ctx context.Context, req *connect.Request[master.ExportMasterRequest]) (*connect.Response[master.ExportMasterResponse], error) {
+	return c.exportMaster.CallUnary(ctx, req)
+}
+
+// GetMasterVersion calls master.MasterService.GetMasterVersion.
+func (c *masterServiceClient) GetMasterVersion(ctx context.Context, req *connect.Request[master.GetMasterVersionRequest]) (*connect.Response[master.GetMasterVersionResponse], error) {
+	return c.getMasterVersion.CallUnary(ctx, req)
+}
+
+// GetMasterDelta calls master.MasterService.GetMasterDelta.
+func (c *masterServiceClient) GetMasterDelta(ctx context.Context, req *connect.Request[master.GetMasterDeltaRequest]) (*connect.Response[master.GetMasterDeltaResponse], error) {
+	return c.getMasterDelta.CallUnary(ctx, req)
+}
+
+// WatchMaster calls master.MasterService.WatchMaster.
+func (c *masterServiceClient) WatchMaster(ctx context.Context, req *connect.Request[master.WatchMasterRequest]) (*connect.ServerStreamForClient[master.WatchMasterResponse], error) {
+	return c.watchMaster.CallServerStream(ctx, req)
+}
+
 // MasterServiceHandler is an implementation of the master.MasterService service.
 type MasterServiceHandler interface {
 	// Artist
 	GetArtists(context.Context, *connect.Request[master.GetArtistsRequest]) (*connect.Response[master.GetArtistsResponse], error)
 	GetArtist(context.Context, *connect.Request[master.GetArtistRequest]) (*connect.Response[master.GetArtistResponse], error)
 	CreateArtist(context.Context, *connect.Request[master.CreateArtistRequest]) (*connect.Response[master.CreateArtistResponse], error)
+	UpdateArtist(context.Context, *connect.Request[master.UpdateArtistRequest]) (*connect.Response[master.UpdateArtistResponse], error)
+	DeleteArtist(context.Context, *connect.Request[master.DeleteArtistRequest]) (*connect.Response[master.DeleteArtistResponse], error)
+	MergeArtists(context.Context, *connect.Request[master.MergeArtistsRequest]) (*connect.Response[master.MergeArtistsResponse], error)
 	// Singer
 	GetSingers(context.Context, *connect.Request[master.GetSingersRequest]) (*connect.Response[master.GetSingersResponse], error)
 	GetSinger(context.Context, *connect.Request[master.GetSingerRequest]) (*connect.Response[master.GetSingerResponse], error)
 	CreateSinger(context.Context, *connect.Request[master.CreateSingerRequest]) (*connect.Response[master.CreateSingerResponse], error)
+	UpdateSinger(context.Context, *connect.Request[master.UpdateSingerRequest]) (*connect.Response[master.UpdateSingerResponse], error)
 	// Unit
 	GetUnits(context.Context, *connect.Request[master.GetUnitsRequest]) (*connect.Response[master.GetUnitsResponse], error)
 	GetUnit(context.Context, *connect.Request[master.GetUnitRequest]) (*connect.Response[master.GetUnitResponse], error)
 	CreateUnit(context.Context, *connect.Request[master.CreateUnitRequest]) (*connect.Response[master.CreateUnitResponse], error)
+	UpdateUnit(context.Context, *connect.Request[master.UpdateUnitRequest]) (*connect.Response[master.UpdateUnitResponse], error)
+	CreateUnitSinger(context.Context, *connect.Request[master.CreateUnitSingerRequest]) (*connect.Response[master.CreateUnitSingerResponse], error)
+	UpdateUnitSinger(context.Context, *connect.Request[master.UpdateUnitSingerRequest]) (*connect.Response[master.UpdateUnitSingerResponse], error)
+	DeleteUnitSinger(context.Context, *connect.Request[master.DeleteUnitSingerRequest]) (*connect.Response[master.DeleteUnitSingerResponse], error)
 	// VocalPattern
-	// rpc GetVocalPatterns(GetVocalPatternsRequest) returns (GetVocalPatternsResponse);
-	// rpc GetVocalPattern(GetVocalPatternRequest) returns (GetVocalPatternResponse);
+	GetVocalPatterns(context.Context, *connect.Request[master.GetVocalPatternsRequest]) (*connect.Response[master.GetVocalPatternsResponse], error)
+	GetVocalPattern(context.Context, *connect.Request[master.GetVocalPatternRequest]) (*connect.Response[master.GetVocalPatternResponse], error)
 	CreateVocalPattern(context.Context, *connect.Request[master.CreateVocalPatternRequest]) (*connect.Response[master.CreateVocalPatternResponse], error)
+	UpdateVocalPattern(context.Context, *connect.Request[master.UpdateVocalPatternRequest]) (*connect.Response[master.UpdateVocalPatternResponse], error)
+	DeleteVocalPattern(context.Context, *connect.Request[master.DeleteVocalPatternRequest]) (*connect.Response[master.DeleteVocalPatternResponse], error)
 	// Song
 	GetSongs(context.Context, *connect.Request[master.GetSongsRequest]) (*connect.Response[master.GetSongsResponse], error)
 	GetSong(context.Context, *connect.Request[master.GetSongRequest]) (*connect.Response[master.GetSongResponse], error)
 	CreateSong(context.Context, *connect.Request[master.CreateSongRequest]) (*connect.Response[master.CreateSongResponse], error)
+	UpdateSong(context.Context, *connect.Request[master.UpdateSongRequest]) (*connect.Response[master.UpdateSongResponse], error)
+	DeleteSong(context.Context, *connect.Request[master.DeleteSongRequest]) (*connect.Response[master.DeleteSongResponse], error)
+	SearchSongs(context.Context, *connect.Request[master.SearchSongsRequest]) (*connect.Response[master.SearchSongsResponse], error)
+	ListSongReleases(context.Context, *connect.Request[master.ListSongReleasesRequest]) (*connect.Response[master.ListSongReleasesResponse], error)
 	// Chart
 	GetCharts(context.Context, *connect.Request[master.GetChartsRequest]) (*connect.Response[master.GetChartsResponse], error)
 	GetChart(context.Context, *connect.Request[master.GetChartRequest]) (*connect.Response[master.GetChartResponse], error)
 	CreateChart(context.Context, *connect.Request[master.CreateChartRequest]) (*connect.Response[master.CreateChartResponse], error)
+	UpdateChart(context.Context, *connect.Request[master.UpdateChartRequest]) (*connect.Response[master.UpdateChartResponse], error)
+	DeleteChart(context.Context, *connect.Request[master.DeleteChartRequest]) (*connect.Response[master.DeleteChartResponse], error)
+	SearchCharts(context.Context, *connect.Request[master.SearchChartsRequest]) (*connect.Response[master.SearchChartsResponse], error)
+	GetChartHistory(context.Context, *connect.Request[master.GetChartHistoryRequest]) (*connect.Response[master.GetChartHistoryResponse], error)
+	// MasterImport
+	ImportMaster(context.Context, *connect.Request[master.ImportMasterRequest]) (*connect.Response[master.ImportMasterResponse], error)
+	ExportMaster(context.Context, *connect.Request[master.ExportMasterRequest]) (*connect.Response[master.ExportMasterResponse], error)
+	// MasterRevision
+	GetMasterVersion(context.Context, *connect.Request[master.GetMasterVersionRequest]) (*connect.Response[master.GetMasterVersionResponse], error)
+	GetMasterDelta(context.Context, *connect.Request[master.GetMasterDeltaRequest]) (*connect.Response[master.GetMasterDeltaResponse], error)
+	// マスタの変更を通知し続ける 受け取ったらGetMasterDeltaで内容を取り直す
+	WatchMaster(context.Context, *connect.Request[master.WatchMasterRequest], *connect.ServerStream[master.WatchMasterResponse]) error
 }
 
 // NewMasterServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -366,6 +793,24 @@ func NewMasterServiceHandler(svc MasterServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(masterServiceMethods.ByName("CreateArtist")),
 		connect.WithHandlerOptions(opts...),
 	)
+	masterServiceUpdateArtistHandler := connect.NewUnaryHandler(
+		MasterServiceUpdateArtistProcedure,
+		svc.UpdateArtist,
+		connect.WithSchema(masterServiceMethods.ByName("UpdateArtist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceDeleteArtistHandler := connect.NewUnaryHandler(
+		MasterServiceDeleteArtistProcedure,
+		svc.DeleteArtist,
+		connect.WithSchema(masterServiceMethods.ByName("DeleteArtist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceMergeArtistsHandler := connect.NewUnaryHandler(
+		MasterServiceMergeArtistsProcedure,
+		svc.MergeArtists,
+		connect.WithSchema(masterServiceMethods.ByName("MergeArtists")),
+		connect.WithHandlerOptions(opts...),
+	)
 	masterServiceGetSingersHandler := connect.NewUnaryHandler(
 		MasterServiceGetSingersProcedure,
 		svc.GetSingers,
@@ -384,6 +829,12 @@ func NewMasterServiceHandler(svc MasterServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(masterServiceMethods.ByName("CreateSinger")),
 		connect.WithHandlerOptions(opts...),
 	)
+	masterServiceUpdateSingerHandler := connect.NewUnaryHandler(
+		MasterServiceUpdateSingerProcedure,
+		svc.UpdateSinger,
+		connect.WithSchema(masterServiceMethods.ByName("UpdateSinger")),
+		connect.WithHandlerOptions(opts...),
+	)
 	masterServiceGetUnitsHandler := connect.NewUnaryHandler(
 		MasterServiceGetUnitsProcedure,
 		svc.GetUnits,
@@ -402,12 +853,60 @@ func NewMasterServiceHandler(svc MasterServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(masterServiceMethods.ByName("CreateUnit")),
 		connect.WithHandlerOptions(opts...),
 	)
+	masterServiceUpdateUnitHandler := connect.NewUnaryHandler(
+		MasterServiceUpdateUnitProcedure,
+		svc.UpdateUnit,
+		connect.WithSchema(masterServiceMethods.ByName("UpdateUnit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceCreateUnitSingerHandler := connect.NewUnaryHandler(
+		MasterServiceCreateUnitSingerProcedure,
+		svc.CreateUnitSinger,
+		connect.WithSchema(masterServiceMethods.ByName("CreateUnitSinger")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceUpdateUnitSingerHandler := connect.NewUnaryHandler(
+		MasterServiceUpdateUnitSingerProcedure,
+		svc.UpdateUnitSinger,
+		connect.WithSchema(masterServiceMethods.ByName("UpdateUnitSinger")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceDeleteUnitSingerHandler := connect.NewUnaryHandler(
+		MasterServiceDeleteUnitSingerProcedure,
+		svc.DeleteUnitSinger,
+		connect.WithSchema(masterServiceMethods.ByName("DeleteUnitSinger")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceGetVocalPatternsHandler := connect.NewUnaryHandler(
+		MasterServiceGetVocalPatternsProcedure,
+		svc.GetVocalPatterns,
+		connect.WithSchema(masterServiceMethods.ByName("GetVocalPatterns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceGetVocalPatternHandler := connect.NewUnaryHandler(
+		MasterServiceGetVocalPatternProcedure,
+		svc.GetVocalPattern,
+		connect.WithSchema(masterServiceMethods.ByName("GetVocalPattern")),
+		connect.WithHandlerOptions(opts...),
+	)
 	masterServiceCreateVocalPatternHandler := connect.NewUnaryHandler(
 		MasterServiceCreateVocalPatternProcedure,
 		svc.CreateVocalPattern,
 		connect.WithSchema(masterServiceMethods.ByName("CreateVocalPattern")),
 		connect.WithHandlerOptions(opts...),
 	)
+	masterServiceUpdateVocalPatternHandler := connect.NewUnaryHandler(
+		MasterServiceUpdateVocalPatternProcedure,
+		svc.UpdateVocalPattern,
+		connect.WithSchema(masterServiceMethods.ByName("UpdateVocalPattern")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceDeleteVocalPatternHandler := connect.NewUnaryHandler(
+		MasterServiceDeleteVocalPatternProcedure,
+		svc.DeleteVocalPattern,
+		connect.WithSchema(masterServiceMethods.ByName("DeleteVocalPattern")),
+		connect.WithHandlerOptions(opts...),
+	)
 	masterServiceGetSongsHandler := connect.NewUnaryHandler(
 		MasterServiceGetSongsProcedure,
 		svc.GetSongs,
@@ -426,6 +925,30 @@ func NewMasterServiceHandler(svc MasterServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(masterServiceMethods.ByName("CreateSong")),
 		connect.WithHandlerOptions(opts...),
 	)
+	masterServiceUpdateSongHandler := connect.NewUnaryHandler(
+		MasterServiceUpdateSongProcedure,
+		svc.UpdateSong,
+		connect.WithSchema(masterServiceMethods.ByName("UpdateSong")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceDeleteSongHandler := connect.NewUnaryHandler(
+		MasterServiceDeleteSongProcedure,
+		svc.DeleteSong,
+		connect.WithSchema(masterServiceMethods.ByName("DeleteSong")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceSearchSongsHandler := connect.NewUnaryHandler(
+		MasterServiceSearchSongsProcedure,
+		svc.SearchSongs,
+		connect.WithSchema(masterServiceMethods.ByName("SearchSongs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceListSongReleasesHandler := connect.NewUnaryHandler(
+		MasterServiceListSongReleasesProcedure,
+		svc.ListSongReleases,
+		connect.WithSchema(masterServiceMethods.ByName("ListSongReleases")),
+		connect.WithHandlerOptions(opts...),
+	)
 	masterServiceGetChartsHandler := connect.NewUnaryHandler(
 		MasterServiceGetChartsProcedure,
 		svc.GetCharts,
@@ -444,6 +967,60 @@ func NewMasterServiceHandler(svc MasterServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(masterServiceMethods.ByName("CreateChart")),
 		connect.WithHandlerOptions(opts...),
 	)
+	masterServiceUpdateChartHandler := connect.NewUnaryHandler(
+		MasterServiceUpdateChartProcedure,
+		svc.UpdateChart,
+		connect.WithSchema(masterServiceMethods.ByName("UpdateChart")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceDeleteChartHandler := connect.NewUnaryHandler(
+		MasterServiceDeleteChartProcedure,
+		svc.DeleteChart,
+		connect.WithSchema(masterServiceMethods.ByName("DeleteChart")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceSearchChartsHandler := connect.NewUnaryHandler(
+		MasterServiceSearchChartsProcedure,
+		svc.SearchCharts,
+		connect.WithSchema(masterServiceMethods.ByName("SearchCharts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceGetChartHistoryHandler := connect.NewUnaryHandler(
+		MasterServiceGetChartHistoryProcedure,
+		svc.GetChartHistory,
+		connect.WithSchema(masterServiceMethods.ByName("GetChartHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceImportMasterHandler := connect.NewUnaryHandler(
+		MasterServiceImportMasterProcedure,
+		svc.ImportMaster,
+		connect.WithSchema(masterServiceMethods.ByName("ImportMaster")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceExportMasterHandler := connect.NewUnaryHandler(
+		MasterServiceExportMasterProcedure,
+		svc.ExportMaster,
+		connect.WithSchema(masterServiceMethods.ByName("ExportMaster")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceGetMasterVersionHandler := connect.NewUnaryHandler(
+		MasterServiceGetMasterVersionProcedure,
+		svc.GetMasterVersion,
+		connect.WithSchema(masterServiceMethods.ByName("GetMasterVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceGetMasterDeltaHandler := connect.NewUnaryHandler(
+		MasterServiceGetMasterDeltaProcedure,
+		svc.GetMasterDelta,
+		connect.WithSchema(masterServiceMethods.ByName("GetMasterDelta")),
+		connect.WithHandlerOptions(opts...),
+	)
+	masterServiceWatchMasterHandler := connect.NewServerStreamHandler(
+		MasterServiceWatchMasterProcedure,
+		svc.WatchMaster,
+		connect.WithSchema(masterServiceMethods.ByName("WatchMaster")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/master.MasterService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MasterServiceGetArtistsProcedure:
@@ -452,32 +1029,82 @@ func NewMasterServiceHandler(svc MasterServiceHandler, opts ...connect.HandlerOp
 			masterServiceGetArtistHandler.ServeHTTP(w, r)
 		case MasterServiceCreateArtistProcedure:
 			masterServiceCreateArtistHandler.ServeHTTP(w, r)
+		case MasterServiceUpdateArtistProcedure:
+			masterServiceUpdateArtistHandler.ServeHTTP(w, r)
+		case MasterServiceDeleteArtistProcedure:
+			masterServiceDeleteArtistHandler.ServeHTTP(w, r)
+		case MasterServiceMergeArtistsProcedure:
+			masterServiceMergeArtistsHandler.ServeHTTP(w, r)
 		case MasterServiceGetSingersProcedure:
 			masterServiceGetSingersHandler.ServeHTTP(w, r)
 		case MasterServiceGetSingerProcedure:
 			masterServiceGetSingerHandler.ServeHTTP(w, r)
 		case MasterServiceCreateSingerProcedure:
 			masterServiceCreateSingerHandler.ServeHTTP(w, r)
+		case MasterServiceUpdateSingerProcedure:
+			masterServiceUpdateSingerHandler.ServeHTTP(w, r)
 		case MasterServiceGetUnitsProcedure:
 			masterServiceGetUnitsHandler.ServeHTTP(w, r)
 		case MasterServiceGetUnitProcedure:
 			masterServiceGetUnitHandler.ServeHTTP(w, r)
 		case MasterServiceCreateUnitProcedure:
 			masterServiceCreateUnitHandler.ServeHTTP(w, r)
+		case MasterServiceUpdateUnitProcedure:
+			masterServiceUpdateUnitHandler.ServeHTTP(w, r)
+		case MasterServiceCreateUnitSingerProcedure:
+			masterServiceCreateUnitSingerHandler.ServeHTTP(w, r)
+		case MasterServiceUpdateUnitSingerProcedure:
+			masterServiceUpdateUnitSingerHandler.ServeHTTP(w, r)
+		case MasterServiceDeleteUnitSingerProcedure:
+			masterServiceDeleteUnitSingerHandler.ServeHTTP(w, r)
+		case MasterServiceGetVocalPatternsProcedure:
+			masterServiceGetVocalPatternsHandler.ServeHTTP(w, r)
+		case MasterServiceGetVocalPatternProcedure:
+			masterServiceGetVocalPatternHandler.ServeHTTP(w, r)
 		case MasterServiceCreateVocalPatternProcedure:
 			masterServiceCreateVocalPatternHandler.ServeHTTP(w, r)
+		case MasterServiceUpdateVocalPatternProcedure:
+			masterServiceUpdateVocalPatternHandler.ServeHTTP(w, r)
+		case MasterServiceDeleteVocalPatternProcedure:
+			masterServiceDeleteVocalPatternHandler.ServeHTTP(w, r)
 		case MasterServiceGetSongsProcedure:
 			masterServiceGetSongsHandler.ServeHTTP(w, r)
 		case MasterServiceGetSongProcedure:
 			masterServiceGetSongHandler.ServeHTTP(w, r)
 		case MasterServiceCreateSongProcedure:
 			masterServiceCreateSongHandler.ServeHTTP(w, r)
+		case MasterServiceUpdateSongProcedure:
+			masterServiceUpdateSongHandler.ServeHTTP(w, r)
+		case MasterServiceDeleteSongProcedure:
+			masterServiceDeleteSongHandler.ServeHTTP(w, r)
+		case MasterServiceSearchSongsProcedure:
+			masterServiceSearchSongsHandler.ServeHTTP(w, r)
+		case MasterServiceListSongReleasesProcedure:
+			masterServiceListSongReleasesHandler.ServeHTTP(w, r)
 		case MasterServiceGetChartsProcedure:
 			masterServiceGetChartsHandler.ServeHTTP(w, r)
 		case MasterServiceGetChartProcedure:
 			masterServiceGetChartHandler.ServeHTTP(w, r)
 		case MasterServiceCreateChartProcedure:
 			masterServiceCreateChartHandler.ServeHTTP(w, r)
+		case MasterServiceUpdateChartProcedure:
+			masterServiceUpdateChartHandler.ServeHTTP(w, r)
+		case MasterServiceDeleteChartProcedure:
+			masterServiceDeleteChartHandler.ServeHTTP(w, r)
+		case MasterServiceSearchChartsProcedure:
+			masterServiceSearchChartsHandler.ServeHTTP(w, r)
+		case MasterServiceGetChartHistoryProcedure:
+			masterServiceGetChartHistoryHandler.ServeHTTP(w, r)
+		case MasterServiceImportMasterProcedure:
+			masterServiceImportMasterHandler.ServeHTTP(w, r)
+		case MasterServiceExportMasterProcedure:
+			masterServiceExportMasterHandler.ServeHTTP(w, r)
+		case MasterServiceGetMasterVersionProcedure:
+			masterServiceGetMasterVersionHandler.ServeHTTP(w, r)
+		case MasterServiceGetMasterDeltaProcedure:
+			masterServiceGetMasterDeltaHandler.ServeHTTP(w, r)
+		case MasterServiceWatchMasterProcedure:
+			masterServiceWatchMasterHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -499,6 +1126,18 @@ func (UnimplementedMasterServiceHandler) CreateArtist(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.CreateArtist is not implemented"))
 }
 
+func (UnimplementedMasterServiceHandler) UpdateArtist(context.Context, *connect.Request[master.UpdateArtistRequest]) (*connect.Response[master.UpdateArtistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.UpdateArtist is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) DeleteArtist(context.Context, *connect.Request[master.DeleteArtistRequest]) (*connect.Response[master.DeleteArtistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.DeleteArtist is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) MergeArtists(context.Context, *connect.Request[master.MergeArtistsRequest]) (*connect.Response[master.MergeArtistsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.MergeArtists is not implemented"))
+}
+
 func (UnimplementedMasterServiceHandler) GetSingers(context.Context, *connect.Request[master.GetSingersRequest]) (*connect.Response[master.GetSingersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.GetSingers is not implemented"))
 }
@@ -511,6 +1150,10 @@ func (UnimplementedMasterServiceHandler) CreateSinger(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.CreateSinger is not implemented"))
 }
 
+func (UnimplementedMasterServiceHandler) UpdateSinger(context.Context, *connect.Request[master.UpdateSingerRequest]) (*connect.Response[master.UpdateSingerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.UpdateSinger is not implemented"))
+}
+
 func (UnimplementedMasterServiceHandler) GetUnits(context.Context, *connect.Request[master.GetUnitsRequest]) (*connect.Response[master.GetUnitsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.GetUnits is not implemented"))
 }
@@ -523,10 +1166,42 @@ func (UnimplementedMasterServiceHandler) CreateUnit(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.CreateUnit is not implemented"))
 }
 
+func (UnimplementedMasterServiceHandler) UpdateUnit(context.Context, *connect.Request[master.UpdateUnitRequest]) (*connect.Response[master.UpdateUnitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.UpdateUnit is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) CreateUnitSinger(context.Context, *connect.Request[master.CreateUnitSingerRequest]) (*connect.Response[master.CreateUnitSingerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.CreateUnitSinger is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) UpdateUnitSinger(context.Context, *connect.Request[master.UpdateUnitSingerRequest]) (*connect.Response[master.UpdateUnitSingerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.UpdateUnitSinger is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) DeleteUnitSinger(context.Context, *connect.Request[master.DeleteUnitSingerRequest]) (*connect.Response[master.DeleteUnitSingerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.DeleteUnitSinger is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) GetVocalPatterns(context.Context, *connect.Request[master.GetVocalPatternsRequest]) (*connect.Response[master.GetVocalPatternsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.GetVocalPatterns is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) GetVocalPattern(context.Context, *connect.Request[master.GetVocalPatternRequest]) (*connect.Response[master.GetVocalPatternResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.GetVocalPattern is not implemented"))
+}
+
 func (UnimplementedMasterServiceHandler) CreateVocalPattern(context.Context, *connect.Request[master.CreateVocalPatternRequest]) (*connect.Response[master.CreateVocalPatternResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.CreateVocalPattern is not implemented"))
 }
 
+func (UnimplementedMasterServiceHandler) UpdateVocalPattern(context.Context, *connect.Request[master.UpdateVocalPatternRequest]) (*connect.Response[master.UpdateVocalPatternResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.UpdateVocalPattern is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) DeleteVocalPattern(context.Context, *connect.Request[master.DeleteVocalPatternRequest]) (*connect.Response[master.DeleteVocalPatternResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.DeleteVocalPattern is not implemented"))
+}
+
 func (UnimplementedMasterServiceHandler) GetSongs(context.Context, *connect.Request[master.GetSongsRequest]) (*connect.Response[master.GetSongsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.GetSongs is not implemented"))
 }
@@ -539,6 +1214,22 @@ func (UnimplementedMasterServiceHandler) CreateSong(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.CreateSong is not implemented"))
 }
 
+func (UnimplementedMasterServiceHandler) UpdateSong(context.Context, *connect.Request[master.UpdateSongRequest]) (*connect.Response[master.UpdateSongResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.UpdateSong is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) DeleteSong(context.Context, *connect.Request[master.DeleteSongRequest]) (*connect.Response[master.DeleteSongResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.DeleteSong is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) SearchSongs(context.Context, *connect.Request[master.SearchSongsRequest]) (*connect.Response[master.SearchSongsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.SearchSongs is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) ListSongReleases(context.Context, *connect.Request[master.ListSongReleasesRequest]) (*connect.Response[master.ListSongReleasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.ListSongReleases is not implemented"))
+}
+
 func (UnimplementedMasterServiceHandler) GetCharts(context.Context, *connect.Request[master.GetChartsRequest]) (*connect.Response[master.GetChartsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.GetCharts is not implemented"))
 }
@@ -550,3 +1241,39 @@ func (UnimplementedMasterServiceHandler) GetChart(context.Context, *connect.Requ
 func (UnimplementedMasterServiceHandler) CreateChart(context.Context, *connect.Request[master.CreateChartRequest]) (*connect.Response[master.CreateChartResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.CreateChart is not implemented"))
 }
+
+func (UnimplementedMasterServiceHandler) UpdateChart(context.Context, *connect.Request[master.UpdateChartRequest]) (*connect.Response[master.UpdateChartResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.UpdateChart is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) DeleteChart(context.Context, *connect.Request[master.DeleteChartRequest]) (*connect.Response[master.DeleteChartResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.DeleteChart is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) SearchCharts(context.Context, *connect.Request[master.SearchChartsRequest]) (*connect.Response[master.SearchChartsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.SearchCharts is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) GetChartHistory(context.Context, *connect.Request[master.GetChartHistoryRequest]) (*connect.Response[master.GetChartHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.GetChartHistory is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) ImportMaster(context.Context, *connect.Request[master.ImportMasterRequest]) (*connect.Response[master.ImportMasterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.ImportMaster is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) ExportMaster(context.Context, *connect.Request[master.ExportMasterRequest]) (*connect.Response[master.ExportMasterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.ExportMaster is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) GetMasterVersion(context.Context, *connect.Request[master.GetMasterVersionRequest]) (*connect.Response[master.GetMasterVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.GetMasterVersion is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) GetMasterDelta(context.Context, *connect.Request[master.GetMasterDeltaRequest]) (*connect.Response[master.GetMasterDeltaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.GetMasterDelta is not implemented"))
+}
+
+func (UnimplementedMasterServiceHandler) WatchMaster(context.Context, *connect.Request[master.WatchMasterRequest], *connect.ServerStream[master.WatchMasterResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("master.MasterService.WatchMaster is not implemented"))
+}
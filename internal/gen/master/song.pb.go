@@ -25,10 +25,11 @@ const (
 
 // Songs
 type Song struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Kana            string                 `protobuf:"bytes,3,opt,name=kana,proto3" json:"kana,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kana  string                 `protobuf:"bytes,3,opt,name=kana,proto3" json:"kana,omitempty"`
+	// 各役割の先頭のクレジット 全員分はcredits
 	Lyrics          *Artist                `protobuf:"bytes,4,opt,name=lyrics,proto3" json:"lyrics,omitempty"`
 	Music           *Artist                `protobuf:"bytes,5,opt,name=music,proto3" json:"music,omitempty"`
 	Arrangement     *Artist                `protobuf:"bytes,6,opt,name=arrangement,proto3" json:"arrangement,omitempty"`
@@ -39,8 +40,10 @@ type Song struct {
 	VocalPatterns   []*VocalPattern        `protobuf:"bytes,11,rep,name=vocal_patterns,json=vocalPatterns,proto3" json:"vocal_patterns,omitempty"`
 	Units           []*Unit                `protobuf:"bytes,12,rep,name=units,proto3" json:"units,omitempty"`
 	MusicVideoTypes []enums.MusicVideoType `protobuf:"varint,13,rep,packed,name=music_video_types,json=musicVideoTypes,proto3,enum=enums.MusicVideoType" json:"music_video_types,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// 役割ごとにpositionの順
+	Credits       []*SongCredit `protobuf:"bytes,14,rep,name=credits,proto3" json:"credits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Song) Reset() {
@@ -164,6 +167,137 @@ func (x *Song) GetMusicVideoTypes() []enums.MusicVideoType {
 	return nil
 }
 
+func (x *Song) GetCredits() []*SongCredit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+// SongReleaseMonths
+// 公開月(日本時間)ごとの曲 公開日時の順
+type SongReleaseMonth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Songs         []*Song                `protobuf:"bytes,3,rep,name=songs,proto3" json:"songs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SongReleaseMonth) Reset() {
+	*x = SongReleaseMonth{}
+	mi := &file_master_song_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongReleaseMonth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongReleaseMonth) ProtoMessage() {}
+
+func (x *SongReleaseMonth) ProtoReflect() protoreflect.Message {
+	mi := &file_master_song_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongReleaseMonth.ProtoReflect.Descriptor instead.
+func (*SongReleaseMonth) Descriptor() ([]byte, []int) {
+	return file_master_song_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SongReleaseMonth) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SongReleaseMonth) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *SongReleaseMonth) GetSongs() []*Song {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+// SongCredits
+type SongCredit struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Artist *Artist                `protobuf:"bytes,1,opt,name=artist,proto3" json:"artist,omitempty"`
+	Role   enums.CreditRole       `protobuf:"varint,2,opt,name=role,proto3,enum=enums.CreditRole" json:"role,omitempty"`
+	// 同じ役割の中での順番 1から
+	Position      int32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SongCredit) Reset() {
+	*x = SongCredit{}
+	mi := &file_master_song_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SongCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongCredit) ProtoMessage() {}
+
+func (x *SongCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_master_song_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongCredit.ProtoReflect.Descriptor instead.
+func (*SongCredit) Descriptor() ([]byte, []int) {
+	return file_master_song_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SongCredit) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+func (x *SongCredit) GetRole() enums.CreditRole {
+	if x != nil {
+		return x.Role
+	}
+	return enums.CreditRole(0)
+}
+
+func (x *SongCredit) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 var File_master_song_proto protoreflect.FileDescriptor
 
 var file_master_song_proto_rawDesc = string([]byte{
@@ -176,7 +310,7 @@ var file_master_song_proto_rawDesc = string([]byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x75, 0x6e,
 	0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
 	0x2f, 0x76, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x04, 0x0a, 0x04, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x0e, 0x0a,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x04, 0x0a, 0x04, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x61, 0x6e, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -208,11 +342,28 @@ var file_master_song_proto_rawDesc = string([]byte{
 	0x64, 0x65, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0e, 0x32,
 	0x15, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x56, 0x69, 0x64,
 	0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x6d, 0x75, 0x73, 0x69, 0x63, 0x56, 0x69, 0x64,
-	0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x68, 0x61, 0x6b, 0x6b, 0x75, 0x75, 0x75, 0x2f, 0x73,
-	0x65, 0x6b, 0x61, 0x69, 0x2d, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x2d, 0x6d, 0x79, 0x6c, 0x69, 0x73,
-	0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x10, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x6e, 0x67,
+	0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x22, 0x77, 0x0a, 0x0a, 0x53, 0x6f, 0x6e, 0x67, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53,
+	0x68, 0x61, 0x6b, 0x6b, 0x75, 0x75, 0x75, 0x2f, 0x73, 0x65, 0x6b, 0x61, 0x69, 0x2d, 0x73, 0x6f,
+	0x6e, 0x67, 0x73, 0x2d, 0x6d, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -227,28 +378,35 @@ func file_master_song_proto_rawDescGZIP() []byte {
 	return file_master_song_proto_rawDescData
 }
 
-var file_master_song_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_master_song_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_master_song_proto_goTypes = []any{
 	(*Song)(nil),                  // 0: master.Song
-	(*Artist)(nil),                // 1: master.Artist
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(*VocalPattern)(nil),          // 3: master.VocalPattern
-	(*Unit)(nil),                  // 4: master.Unit
-	(enums.MusicVideoType)(0),     // 5: enums.MusicVideoType
+	(*SongReleaseMonth)(nil),      // 1: master.SongReleaseMonth
+	(*SongCredit)(nil),            // 2: master.SongCredit
+	(*Artist)(nil),                // 3: master.Artist
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*VocalPattern)(nil),          // 5: master.VocalPattern
+	(*Unit)(nil),                  // 6: master.Unit
+	(enums.MusicVideoType)(0),     // 7: enums.MusicVideoType
+	(enums.CreditRole)(0),         // 8: enums.CreditRole
 }
 var file_master_song_proto_depIdxs = []int32{
-	1, // 0: master.Song.lyrics:type_name -> master.Artist
-	1, // 1: master.Song.music:type_name -> master.Artist
-	1, // 2: master.Song.arrangement:type_name -> master.Artist
-	2, // 3: master.Song.release_time:type_name -> google.protobuf.Timestamp
-	3, // 4: master.Song.vocal_patterns:type_name -> master.VocalPattern
-	4, // 5: master.Song.units:type_name -> master.Unit
-	5, // 6: master.Song.music_video_types:type_name -> enums.MusicVideoType
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	3,  // 0: master.Song.lyrics:type_name -> master.Artist
+	3,  // 1: master.Song.music:type_name -> master.Artist
+	3,  // 2: master.Song.arrangement:type_name -> master.Artist
+	4,  // 3: master.Song.release_time:type_name -> google.protobuf.Timestamp
+	5,  // 4: master.Song.vocal_patterns:type_name -> master.VocalPattern
+	6,  // 5: master.Song.units:type_name -> master.Unit
+	7,  // 6: master.Song.music_video_types:type_name -> enums.MusicVideoType
+	2,  // 7: master.Song.credits:type_name -> master.SongCredit
+	0,  // 8: master.SongReleaseMonth.songs:type_name -> master.Song
+	3,  // 9: master.SongCredit.artist:type_name -> master.Artist
+	8,  // 10: master.SongCredit.role:type_name -> enums.CreditRole
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_master_song_proto_init() }
@@ -265,7 +423,7 @@ func file_master_song_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_master_song_proto_rawDesc), len(file_master_song_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -256,6 +256,40 @@ func (m *Song) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SongValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SongValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SongValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SongMultiError(errors)
 	}
@@ -332,3 +366,273 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SongValidationError{}
+
+// Validate checks the field values on SongReleaseMonth with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SongReleaseMonth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongReleaseMonth with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SongReleaseMonthMultiError, or nil if none found.
+func (m *SongReleaseMonth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongReleaseMonth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Year
+
+	// no validation rules for Month
+
+	for idx, item := range m.GetSongs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SongReleaseMonthValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SongReleaseMonthValidationError{
+						field:  fmt.Sprintf("Songs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SongReleaseMonthValidationError{
+					field:  fmt.Sprintf("Songs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SongReleaseMonthMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongReleaseMonthMultiError is an error wrapping multiple validation errors
+// returned by SongReleaseMonth.ValidateAll() if the designated constraints
+// aren't met.
+type SongReleaseMonthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongReleaseMonthMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongReleaseMonthMultiError) AllErrors() []error { return m }
+
+// SongReleaseMonthValidationError is the validation error returned by
+// SongReleaseMonth.Validate if the designated constraints aren't met.
+type SongReleaseMonthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongReleaseMonthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongReleaseMonthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongReleaseMonthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongReleaseMonthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongReleaseMonthValidationError) ErrorName() string { return "SongReleaseMonthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongReleaseMonthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongReleaseMonth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongReleaseMonthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongReleaseMonthValidationError{}
+
+// Validate checks the field values on SongCredit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SongCredit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SongCredit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SongCreditMultiError, or
+// nil if none found.
+func (m *SongCredit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SongCredit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArtist()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SongCreditValidationError{
+					field:  "Artist",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SongCreditValidationError{
+					field:  "Artist",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArtist()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SongCreditValidationError{
+				field:  "Artist",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	// no validation rules for Position
+
+	if len(errors) > 0 {
+		return SongCreditMultiError(errors)
+	}
+
+	return nil
+}
+
+// SongCreditMultiError is an error wrapping multiple validation errors
+// returned by SongCredit.ValidateAll() if the designated constraints aren't met.
+type SongCreditMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SongCreditMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SongCreditMultiError) AllErrors() []error { return m }
+
+// SongCreditValidationError is the validation error returned by
+// SongCredit.Validate if the designated constraints aren't met.
+type SongCreditValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SongCreditValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SongCreditValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SongCreditValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SongCreditValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SongCreditValidationError) ErrorName() string { return "SongCreditValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SongCreditValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSongCredit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SongCreditValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SongCreditValidationError{}
@@ -60,6 +60,40 @@ func (m *Unit) validate(all bool) error {
 
 	// no validation rules for Name
 
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UnitValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UnitValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UnitValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UnitMultiError(errors)
 	}
@@ -136,3 +170,108 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = UnitValidationError{}
+
+// Validate checks the field values on UnitMember with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnitMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnitMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnitMemberMultiError, or
+// nil if none found.
+func (m *UnitMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnitMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Position
+
+	if len(errors) > 0 {
+		return UnitMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnitMemberMultiError is an error wrapping multiple validation errors
+// returned by UnitMember.ValidateAll() if the designated constraints aren't met.
+type UnitMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnitMemberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnitMemberMultiError) AllErrors() []error { return m }
+
+// UnitMemberValidationError is the validation error returned by
+// UnitMember.Validate if the designated constraints aren't met.
+type UnitMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnitMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnitMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnitMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnitMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnitMemberValidationError) ErrorName() string { return "UnitMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnitMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnitMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnitMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnitMemberValidationError{}
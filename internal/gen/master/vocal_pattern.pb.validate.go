@@ -95,6 +95,8 @@ func (m *VocalPattern) validate(all bool) error {
 
 	}
 
+	// no validation rules for SongId
+
 	if len(errors) > 0 {
 		return VocalPatternMultiError(errors)
 	}
@@ -320,6 +320,8 @@ func (m *MyListChart) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for SongDeleted
+
 	if len(errors) > 0 {
 		return MyListChartMultiError(errors)
 	}
@@ -9,6 +9,17 @@ import (
 	"context"
 )
 
+const deleteArtist = `-- name: DeleteArtist :exec
+DELETE
+FROM artists
+WHERE id = $1
+`
+
+func (q *Queries) DeleteArtist(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteArtist, id)
+	return err
+}
+
 const existsArtist = `-- name: ExistsArtist :one
 SELECT EXISTS (
   SELECT 1 FROM artists WHERE id = $1
@@ -22,37 +33,106 @@ func (q *Queries) ExistsArtist(ctx context.Context, id int32) (bool, error) {
 	return exists, err
 }
 
+const findArtistByNameKey = `-- name: FindArtistByNameKey :one
+SELECT id, name, kana, search_text, created_at
+FROM artists
+WHERE lower(btrim(regexp_replace(normalize(name, NFKC), '\s+', ' ', 'g')))
+    = lower(btrim(regexp_replace(normalize($1::text, NFKC), '\s+', ' ', 'g')))
+ORDER BY id
+LIMIT 1
+`
+
+// artists_name_keyと同じ式で比較する
+func (q *Queries) FindArtistByNameKey(ctx context.Context, name string) (Artist, error) {
+	row := q.db.QueryRowContext(ctx, findArtistByNameKey, name)
+	var i Artist
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Kana,
+		&i.SearchText,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getArtistByID = `-- name: GetArtistByID :one
-SELECT id, name, kana FROM artists WHERE id = $1
+SELECT id, name, kana, search_text, created_at FROM artists WHERE id = $1
 `
 
 func (q *Queries) GetArtistByID(ctx context.Context, id int32) (Artist, error) {
 	row := q.db.QueryRowContext(ctx, getArtistByID, id)
 	var i Artist
-	err := row.Scan(&i.ID, &i.Name, &i.Kana)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Kana,
+		&i.SearchText,
+		&i.CreatedAt,
+	)
 	return i, err
 }
 
 const insertArtist = `-- name: InsertArtist :one
-INSERT INTO artists (name, kana)
-VALUES ($1, $2)
-RETURNING id, name, kana
+INSERT INTO artists (name, kana, search_text)
+VALUES ($1, $2, $3)
+RETURNING id, name, kana, search_text, created_at
 `
 
 type InsertArtistParams struct {
-	Name string
-	Kana string
+	Name       string
+	Kana       string
+	SearchText string
 }
 
 func (q *Queries) InsertArtist(ctx context.Context, arg InsertArtistParams) (Artist, error) {
-	row := q.db.QueryRowContext(ctx, insertArtist, arg.Name, arg.Kana)
+	row := q.db.QueryRowContext(ctx, insertArtist, arg.Name, arg.Kana, arg.SearchText)
 	var i Artist
-	err := row.Scan(&i.ID, &i.Name, &i.Kana)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Kana,
+		&i.SearchText,
+		&i.CreatedAt,
+	)
 	return i, err
 }
 
+const listArtistNamesWithoutSearchText = `-- name: ListArtistNamesWithoutSearchText :many
+SELECT id, name, kana FROM artists WHERE search_text = '' ORDER BY id
+`
+
+type ListArtistNamesWithoutSearchTextRow struct {
+	ID   int32
+	Name string
+	Kana string
+}
+
+func (q *Queries) ListArtistNamesWithoutSearchText(ctx context.Context) ([]ListArtistNamesWithoutSearchTextRow, error) {
+	rows, err := q.db.QueryContext(ctx, listArtistNamesWithoutSearchText)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListArtistNamesWithoutSearchTextRow
+	for rows.Next() {
+		var i ListArtistNamesWithoutSearchTextRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Kana); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listArtists = `-- name: ListArtists :many
-SELECT id, name, kana FROM artists ORDER BY id
+SELECT id, name, kana, search_text, created_at FROM artists ORDER BY id
 `
 
 func (q *Queries) ListArtists(ctx context.Context) ([]Artist, error) {
@@ -64,7 +144,13 @@ func (q *Queries) ListArtists(ctx context.Context) ([]Artist, error) {
 	var items []Artist
 	for rows.Next() {
 		var i Artist
-		if err := rows.Scan(&i.ID, &i.Name, &i.Kana); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Kana,
+			&i.SearchText,
+			&i.CreatedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -77,3 +163,44 @@ func (q *Queries) ListArtists(ctx context.Context) ([]Artist, error) {
 	}
 	return items, nil
 }
+
+const updateArtist = `-- name: UpdateArtist :exec
+UPDATE artists
+SET name = $1,
+    kana = $2,
+    search_text = $3
+WHERE id = $4
+`
+
+type UpdateArtistParams struct {
+	Name       string
+	Kana       string
+	SearchText string
+	ID         int32
+}
+
+func (q *Queries) UpdateArtist(ctx context.Context, arg UpdateArtistParams) error {
+	_, err := q.db.ExecContext(ctx, updateArtist,
+		arg.Name,
+		arg.Kana,
+		arg.SearchText,
+		arg.ID,
+	)
+	return err
+}
+
+const updateArtistSearchText = `-- name: UpdateArtistSearchText :exec
+UPDATE artists
+SET search_text = $1
+WHERE id = $2
+`
+
+type UpdateArtistSearchTextParams struct {
+	SearchText string
+	ID         int32
+}
+
+func (q *Queries) UpdateArtistSearchText(ctx context.Context, arg UpdateArtistSearchTextParams) error {
+	_, err := q.db.ExecContext(ctx, updateArtistSearchText, arg.SearchText, arg.ID)
+	return err
+}
@@ -8,8 +8,93 @@ package sqlcgen
 import (
 	"context"
 	"database/sql"
+
+	"github.com/lib/pq"
 )
 
+const countSearchCharts = `-- name: CountSearchCharts :one
+SELECT COUNT(*)
+FROM charts c
+JOIN songs s ON c.song_id = s.id
+WHERE TRUE
+  AND (
+    cardinality($1::int[]) = 0
+    OR c.difficulty_type = ANY($1::int[])
+  )
+  AND ($2::int IS NULL OR c.level >= $2::int)
+  AND ($3::int IS NULL OR c.level <= $3::int)
+  AND (
+    cardinality($4::int[]) = 0
+    OR EXISTS (
+      SELECT 1 FROM song_units su
+      WHERE su.song_id = s.id AND su.unit_id = ANY($4::int[])
+    )
+  )
+  AND (
+    cardinality($5::int[]) = 0
+    OR EXISTS (
+      SELECT 1 FROM vocal_patterns vp
+      JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+      WHERE vp.song_id = s.id AND vps.singer_id = ANY($5::int[])
+    )
+  )
+  AND ($6::timestamp IS NULL OR s.release_time >= $6::timestamp)
+  AND ($7::timestamp IS NULL OR s.release_time < $7::timestamp)
+  AND ($8::text IS NULL OR s.search_text LIKE '%' || $8::text || '%')
+  AND ($9::boolean OR NOT COALESCE(s.deleted, FALSE))
+`
+
+type CountSearchChartsParams struct {
+	DifficultyTypes []int32
+	MinLevel        sql.NullInt32
+	MaxLevel        sql.NullInt32
+	UnitIds         []int32
+	SingerIds       []int32
+	ReleaseFrom     sql.NullTime
+	ReleaseTo       sql.NullTime
+	Query           sql.NullString
+	IncludeDeleted  bool
+}
+
+func (q *Queries) CountSearchCharts(ctx context.Context, arg CountSearchChartsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchCharts,
+		pq.Array(arg.DifficultyTypes),
+		arg.MinLevel,
+		arg.MaxLevel,
+		pq.Array(arg.UnitIds),
+		pq.Array(arg.SingerIds),
+		arg.ReleaseFrom,
+		arg.ReleaseTo,
+		arg.Query,
+		arg.IncludeDeleted,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteChart = `-- name: DeleteChart :exec
+DELETE
+FROM charts
+WHERE id = $1
+`
+
+func (q *Queries) DeleteChart(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteChart, id)
+	return err
+}
+
+const deleteChartsBySongID = `-- name: DeleteChartsBySongID :exec
+DELETE
+FROM charts
+WHERE song_id = $1
+`
+
+func (q *Queries) DeleteChartsBySongID(ctx context.Context, songID sql.NullInt32) error {
+	_, err := q.db.ExecContext(ctx, deleteChartsBySongID, songID)
+	return err
+}
+
 const existsChart = `-- name: ExistsChart :one
 SELECT EXISTS (
   SELECT 1 FROM charts WHERE id = $1
@@ -23,155 +108,40 @@ func (q *Queries) ExistsChart(ctx context.Context, id int32) (bool, error) {
 	return exists, err
 }
 
-const getChartWithSongWithArtistsByID = `-- name: GetChartWithSongWithArtistsByID :many
-SELECT
-    c.id AS id,
-
-    s.id AS song_id,
-    s.name AS song_name,
-    s.kana AS song_kana,
-
-    la.id AS lyrics_artist_id,
-    la.name AS lyrics_artist_name,
-    la.kana AS lyrics_artist_kana,
-
-    ma.id AS music_artist_id,
-    ma.name AS music_artist_name,
-    ma.kana AS music_artist_kana,
-
-    aa.id AS arrangement_artist_id,
-    aa.name AS arrangement_artist_name,
-    aa.kana AS arrangement_artist_kana,
-
-    s.thumbnail,
-    s.original_video,
-    s.release_time,
-    s.deleted,
-
-    vp.id AS vocal_pattern_id,
-    vp.name AS vocal_pattern_name,
-
-    vps.singer_id,
-    si.name AS singer_name,
-    vps.position AS singer_order,
-
-    su.unit_id,
-    u.name AS unit_name,
-
-    smvt.music_video_type AS music_video_type_id,
-
-    c.difficulty_type,
-    c.level,
-    c.chart_view_link
-
-FROM charts c
-LEFT JOIN songs s ON c.song_id = s.id
-LEFT JOIN artists la ON s.lyrics_id = la.id
-LEFT JOIN artists ma ON s.music_id = ma.id
-LEFT JOIN artists aa ON s.arrangement_id = aa.id
-LEFT JOIN vocal_patterns vp ON vp.song_id = s.id
-LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
-LEFT JOIN singers si ON vps.singer_id = si.id
-LEFT JOIN song_units su ON su.song_id = s.id
-LEFT JOIN units u ON su.unit_id = u.id
-LEFT JOIN song_music_video_types smvt ON smvt.song_id = s.id
-WHERE c.id = $1
+const findChartIDBySongAndDifficulty = `-- name: FindChartIDBySongAndDifficulty :one
+SELECT id
+FROM charts
+WHERE song_id = $1 AND difficulty_type = $2
 `
 
-type GetChartWithSongWithArtistsByIDRow struct {
-	ID                    int32
-	SongID                sql.NullInt32
-	SongName              sql.NullString
-	SongKana              sql.NullString
-	LyricsArtistID        sql.NullInt32
-	LyricsArtistName      sql.NullString
-	LyricsArtistKana      sql.NullString
-	MusicArtistID         sql.NullInt32
-	MusicArtistName       sql.NullString
-	MusicArtistKana       sql.NullString
-	ArrangementArtistID   sql.NullInt32
-	ArrangementArtistName sql.NullString
-	ArrangementArtistKana sql.NullString
-	Thumbnail             sql.NullString
-	OriginalVideo         sql.NullString
-	ReleaseTime           sql.NullTime
-	Deleted               sql.NullBool
-	VocalPatternID        sql.NullInt32
-	VocalPatternName      sql.NullString
-	SingerID              sql.NullInt32
-	SingerName            sql.NullString
-	SingerOrder           sql.NullInt32
-	UnitID                sql.NullInt32
-	UnitName              sql.NullString
-	MusicVideoTypeID      sql.NullInt32
-	DifficultyType        sql.NullInt32
-	Level                 sql.NullInt32
-	ChartViewLink         sql.NullString
+type FindChartIDBySongAndDifficultyParams struct {
+	SongID         sql.NullInt32
+	DifficultyType sql.NullInt32
 }
 
-func (q *Queries) GetChartWithSongWithArtistsByID(ctx context.Context, id int32) ([]GetChartWithSongWithArtistsByIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, getChartWithSongWithArtistsByID, id)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetChartWithSongWithArtistsByIDRow
-	for rows.Next() {
-		var i GetChartWithSongWithArtistsByIDRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.SongID,
-			&i.SongName,
-			&i.SongKana,
-			&i.LyricsArtistID,
-			&i.LyricsArtistName,
-			&i.LyricsArtistKana,
-			&i.MusicArtistID,
-			&i.MusicArtistName,
-			&i.MusicArtistKana,
-			&i.ArrangementArtistID,
-			&i.ArrangementArtistName,
-			&i.ArrangementArtistKana,
-			&i.Thumbnail,
-			&i.OriginalVideo,
-			&i.ReleaseTime,
-			&i.Deleted,
-			&i.VocalPatternID,
-			&i.VocalPatternName,
-			&i.SingerID,
-			&i.SingerName,
-			&i.SingerOrder,
-			&i.UnitID,
-			&i.UnitName,
-			&i.MusicVideoTypeID,
-			&i.DifficultyType,
-			&i.Level,
-			&i.ChartViewLink,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) FindChartIDBySongAndDifficulty(ctx context.Context, arg FindChartIDBySongAndDifficultyParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, findChartIDBySongAndDifficulty, arg.SongID, arg.DifficultyType)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
 }
 
 const insertChart = `-- name: InsertChart :one
-INSERT INTO charts (song_id, difficulty_type, level, chart_view_link)
-VALUES ($1, $2, $3, $4)
-RETURNING id, song_id, difficulty_type, level, chart_view_link
+INSERT INTO charts (song_id, difficulty_type, level, chart_view_link, note_count, bpm_min, bpm_max, duration_seconds, constant)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, song_id, difficulty_type, level, chart_view_link, note_count, bpm_min, bpm_max, duration_seconds, constant, created_at
 `
 
 type InsertChartParams struct {
-	SongID         sql.NullInt32
-	DifficultyType sql.NullInt32
-	Level          sql.NullInt32
-	ChartViewLink  sql.NullString
+	SongID          sql.NullInt32
+	DifficultyType  sql.NullInt32
+	Level           sql.NullInt32
+	ChartViewLink   sql.NullString
+	NoteCount       sql.NullInt32
+	BpmMin          sql.NullInt32
+	BpmMax          sql.NullInt32
+	DurationSeconds sql.NullInt32
+	Constant        sql.NullFloat64
 }
 
 func (q *Queries) InsertChart(ctx context.Context, arg InsertChartParams) (Chart, error) {
@@ -180,6 +150,11 @@ func (q *Queries) InsertChart(ctx context.Context, arg InsertChartParams) (Chart
 		arg.DifficultyType,
 		arg.Level,
 		arg.ChartViewLink,
+		arg.NoteCount,
+		arg.BpmMin,
+		arg.BpmMax,
+		arg.DurationSeconds,
+		arg.Constant,
 	)
 	var i Chart
 	err := row.Scan(
@@ -188,134 +163,98 @@ func (q *Queries) InsertChart(ctx context.Context, arg InsertChartParams) (Chart
 		&i.DifficultyType,
 		&i.Level,
 		&i.ChartViewLink,
+		&i.NoteCount,
+		&i.BpmMin,
+		&i.BpmMax,
+		&i.DurationSeconds,
+		&i.Constant,
+		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const listChartWithSongWithArtists = `-- name: ListChartWithSongWithArtists :many
-SELECT
-    c.id AS id,
-
-    s.id AS song_id,
-    s.name AS song_name,
-    s.kana AS song_kana,
-
-    la.id AS lyrics_artist_id,
-    la.name AS lyrics_artist_name,
-    la.kana AS lyrics_artist_kana,
-
-    ma.id AS music_artist_id,
-    ma.name AS music_artist_name,
-    ma.kana AS music_artist_kana,
-
-    aa.id AS arrangement_artist_id,
-    aa.name AS arrangement_artist_name,
-    aa.kana AS arrangement_artist_kana,
-
-    s.thumbnail,
-    s.original_video,
-    s.release_time,
-    s.deleted,
-
-    vp.id AS vocal_pattern_id,
-    vp.name AS vocal_pattern_name,
-
-    vps.singer_id,
-    si.name AS singer_name,
-    vps.position AS singer_order,
-
-    su.unit_id,
-    u.name AS unit_name,
-
-    smvt.music_video_type AS music_video_type_id,
+const listChartIDs = `-- name: ListChartIDs :many
+SELECT id FROM charts ORDER BY id
+`
 
-    c.difficulty_type,
-    c.level,
-    c.chart_view_link
+func (q *Queries) ListChartIDs(ctx context.Context) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, listChartIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
 
-FROM charts c
-LEFT JOIN songs s ON c.song_id = s.id
-LEFT JOIN artists la ON s.lyrics_id = la.id
-LEFT JOIN artists ma ON s.music_id = ma.id
-LEFT JOIN artists aa ON s.arrangement_id = aa.id
-LEFT JOIN vocal_patterns vp ON vp.song_id = s.id
-LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
-LEFT JOIN singers si ON vps.singer_id = si.id
-LEFT JOIN song_units su ON su.song_id = s.id
-LEFT JOIN units u ON su.unit_id = u.id
-LEFT JOIN song_music_video_types smvt ON smvt.song_id = s.id
-ORDER BY c.id
+const listChartIDsBySongID = `-- name: ListChartIDsBySongID :many
+SELECT id FROM charts WHERE song_id = $1 ORDER BY id
 `
 
-type ListChartWithSongWithArtistsRow struct {
-	ID                    int32
-	SongID                sql.NullInt32
-	SongName              sql.NullString
-	SongKana              sql.NullString
-	LyricsArtistID        sql.NullInt32
-	LyricsArtistName      sql.NullString
-	LyricsArtistKana      sql.NullString
-	MusicArtistID         sql.NullInt32
-	MusicArtistName       sql.NullString
-	MusicArtistKana       sql.NullString
-	ArrangementArtistID   sql.NullInt32
-	ArrangementArtistName sql.NullString
-	ArrangementArtistKana sql.NullString
-	Thumbnail             sql.NullString
-	OriginalVideo         sql.NullString
-	ReleaseTime           sql.NullTime
-	Deleted               sql.NullBool
-	VocalPatternID        sql.NullInt32
-	VocalPatternName      sql.NullString
-	SingerID              sql.NullInt32
-	SingerName            sql.NullString
-	SingerOrder           sql.NullInt32
-	UnitID                sql.NullInt32
-	UnitName              sql.NullString
-	MusicVideoTypeID      sql.NullInt32
-	DifficultyType        sql.NullInt32
-	Level                 sql.NullInt32
-	ChartViewLink         sql.NullString
+func (q *Queries) ListChartIDsBySongID(ctx context.Context, songID sql.NullInt32) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, listChartIDsBySongID, songID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) ListChartWithSongWithArtists(ctx context.Context) ([]ListChartWithSongWithArtistsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listChartWithSongWithArtists)
+const listChartsByIDs = `-- name: ListChartsByIDs :many
+SELECT id, song_id, difficulty_type, level, chart_view_link, note_count, bpm_min, bpm_max, duration_seconds, constant, created_at
+FROM charts
+WHERE id = ANY($1::int[])
+ORDER BY id
+`
+
+func (q *Queries) ListChartsByIDs(ctx context.Context, ids []int32) ([]Chart, error) {
+	rows, err := q.db.QueryContext(ctx, listChartsByIDs, pq.Array(ids))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListChartWithSongWithArtistsRow
+	var items []Chart
 	for rows.Next() {
-		var i ListChartWithSongWithArtistsRow
+		var i Chart
 		if err := rows.Scan(
 			&i.ID,
 			&i.SongID,
-			&i.SongName,
-			&i.SongKana,
-			&i.LyricsArtistID,
-			&i.LyricsArtistName,
-			&i.LyricsArtistKana,
-			&i.MusicArtistID,
-			&i.MusicArtistName,
-			&i.MusicArtistKana,
-			&i.ArrangementArtistID,
-			&i.ArrangementArtistName,
-			&i.ArrangementArtistKana,
-			&i.Thumbnail,
-			&i.OriginalVideo,
-			&i.ReleaseTime,
-			&i.Deleted,
-			&i.VocalPatternID,
-			&i.VocalPatternName,
-			&i.SingerID,
-			&i.SingerName,
-			&i.SingerOrder,
-			&i.UnitID,
-			&i.UnitName,
-			&i.MusicVideoTypeID,
 			&i.DifficultyType,
 			&i.Level,
 			&i.ChartViewLink,
+			&i.NoteCount,
+			&i.BpmMin,
+			&i.BpmMax,
+			&i.DurationSeconds,
+			&i.Constant,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -329,3 +268,129 @@ func (q *Queries) ListChartWithSongWithArtists(ctx context.Context) ([]ListChart
 	}
 	return items, nil
 }
+
+const searchChartIDs = `-- name: SearchChartIDs :many
+SELECT c.id
+FROM charts c
+JOIN songs s ON c.song_id = s.id
+WHERE c.id > $1::int
+  AND (
+    cardinality($2::int[]) = 0
+    OR c.difficulty_type = ANY($2::int[])
+  )
+  AND ($3::int IS NULL OR c.level >= $3::int)
+  AND ($4::int IS NULL OR c.level <= $4::int)
+  AND (
+    cardinality($5::int[]) = 0
+    OR EXISTS (
+      SELECT 1 FROM song_units su
+      WHERE su.song_id = s.id AND su.unit_id = ANY($5::int[])
+    )
+  )
+  AND (
+    cardinality($6::int[]) = 0
+    OR EXISTS (
+      SELECT 1 FROM vocal_patterns vp
+      JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+      WHERE vp.song_id = s.id AND vps.singer_id = ANY($6::int[])
+    )
+  )
+  AND ($7::timestamp IS NULL OR s.release_time >= $7::timestamp)
+  AND ($8::timestamp IS NULL OR s.release_time < $8::timestamp)
+  AND ($9::text IS NULL OR s.search_text LIKE '%' || $9::text || '%')
+  AND ($10::boolean OR NOT COALESCE(s.deleted, FALSE))
+ORDER BY c.id
+LIMIT $11::int
+`
+
+type SearchChartIDsParams struct {
+	AfterID         int32
+	DifficultyTypes []int32
+	MinLevel        sql.NullInt32
+	MaxLevel        sql.NullInt32
+	UnitIds         []int32
+	SingerIds       []int32
+	ReleaseFrom     sql.NullTime
+	ReleaseTo       sql.NullTime
+	Query           sql.NullString
+	IncludeDeleted  bool
+	PageLimit       int32
+}
+
+func (q *Queries) SearchChartIDs(ctx context.Context, arg SearchChartIDsParams) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, searchChartIDs,
+		arg.AfterID,
+		pq.Array(arg.DifficultyTypes),
+		arg.MinLevel,
+		arg.MaxLevel,
+		pq.Array(arg.UnitIds),
+		pq.Array(arg.SingerIds),
+		arg.ReleaseFrom,
+		arg.ReleaseTo,
+		arg.Query,
+		arg.IncludeDeleted,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateChart = `-- name: UpdateChart :exec
+UPDATE charts
+SET song_id = $1,
+    difficulty_type = $2,
+    level = $3,
+    chart_view_link = $4,
+    note_count = $5,
+    bpm_min = $6,
+    bpm_max = $7,
+    duration_seconds = $8,
+    constant = $9
+WHERE id = $10
+`
+
+type UpdateChartParams struct {
+	SongID          sql.NullInt32
+	DifficultyType  sql.NullInt32
+	Level           sql.NullInt32
+	ChartViewLink   sql.NullString
+	NoteCount       sql.NullInt32
+	BpmMin          sql.NullInt32
+	BpmMax          sql.NullInt32
+	DurationSeconds sql.NullInt32
+	Constant        sql.NullFloat64
+	ID              int32
+}
+
+func (q *Queries) UpdateChart(ctx context.Context, arg UpdateChartParams) error {
+	_, err := q.db.ExecContext(ctx, updateChart,
+		arg.SongID,
+		arg.DifficultyType,
+		arg.Level,
+		arg.ChartViewLink,
+		arg.NoteCount,
+		arg.BpmMin,
+		arg.BpmMax,
+		arg.DurationSeconds,
+		arg.Constant,
+		arg.ID,
+	)
+	return err
+}
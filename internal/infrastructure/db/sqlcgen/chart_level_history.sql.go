@@ -0,0 +1,108 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: chart_level_history.sql
+
+package sqlcgen
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const deleteChartLevelHistoryByChartID = `-- name: DeleteChartLevelHistoryByChartID :exec
+DELETE
+FROM chart_level_history
+WHERE chart_id = $1
+`
+
+func (q *Queries) DeleteChartLevelHistoryByChartID(ctx context.Context, chartID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteChartLevelHistoryByChartID, chartID)
+	return err
+}
+
+const deleteChartLevelHistoryBySongID = `-- name: DeleteChartLevelHistoryBySongID :exec
+DELETE
+FROM chart_level_history
+WHERE chart_id IN (SELECT id FROM charts WHERE song_id = $1)
+`
+
+func (q *Queries) DeleteChartLevelHistoryBySongID(ctx context.Context, songID sql.NullInt32) error {
+	_, err := q.db.ExecContext(ctx, deleteChartLevelHistoryBySongID, songID)
+	return err
+}
+
+const getChartLevelAsOf = `-- name: GetChartLevelAsOf :one
+SELECT level
+FROM chart_level_history
+WHERE chart_id = $1 AND effective_from <= $2
+ORDER BY effective_from DESC
+LIMIT 1
+`
+
+type GetChartLevelAsOfParams struct {
+	ChartID       int32
+	EffectiveFrom time.Time
+}
+
+func (q *Queries) GetChartLevelAsOf(ctx context.Context, arg GetChartLevelAsOfParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getChartLevelAsOf, arg.ChartID, arg.EffectiveFrom)
+	var level int32
+	err := row.Scan(&level)
+	return level, err
+}
+
+const listChartLevelHistory = `-- name: ListChartLevelHistory :many
+SELECT id, chart_id, level, effective_from, created_at
+FROM chart_level_history
+WHERE chart_id = $1
+ORDER BY effective_from
+`
+
+func (q *Queries) ListChartLevelHistory(ctx context.Context, chartID int32) ([]ChartLevelHistory, error) {
+	rows, err := q.db.QueryContext(ctx, listChartLevelHistory, chartID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChartLevelHistory
+	for rows.Next() {
+		var i ChartLevelHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChartID,
+			&i.Level,
+			&i.EffectiveFrom,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertChartLevelHistory = `-- name: UpsertChartLevelHistory :exec
+INSERT INTO chart_level_history (chart_id, level, effective_from)
+VALUES ($1, $2, $3)
+ON CONFLICT (chart_id, effective_from)
+DO UPDATE SET level = EXCLUDED.level, created_at = CURRENT_TIMESTAMP
+`
+
+type UpsertChartLevelHistoryParams struct {
+	ChartID       int32
+	Level         int32
+	EffectiveFrom time.Time
+}
+
+func (q *Queries) UpsertChartLevelHistory(ctx context.Context, arg UpsertChartLevelHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, upsertChartLevelHistory, arg.ChartID, arg.Level, arg.EffectiveFrom)
+	return err
+}
@@ -0,0 +1,117 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: master_revision.sql
+
+package sqlcgen
+
+import (
+	"context"
+	"time"
+)
+
+const getMasterRevision = `-- name: GetMasterRevision :one
+SELECT revision FROM master_revision WHERE id = 1
+`
+
+func (q *Queries) GetMasterRevision(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getMasterRevision)
+	var revision int64
+	err := row.Scan(&revision)
+	return revision, err
+}
+
+const getReleasesRecordedAtForUpdate = `-- name: GetReleasesRecordedAtForUpdate :one
+SELECT releases_recorded_at FROM master_revision WHERE id = 1 FOR UPDATE
+`
+
+func (q *Queries) GetReleasesRecordedAtForUpdate(ctx context.Context) (time.Time, error) {
+	row := q.db.QueryRowContext(ctx, getReleasesRecordedAtForUpdate)
+	var releases_recorded_at time.Time
+	err := row.Scan(&releases_recorded_at)
+	return releases_recorded_at, err
+}
+
+const incrementMasterRevision = `-- name: IncrementMasterRevision :one
+UPDATE master_revision SET revision = revision + 1 WHERE id = 1 RETURNING revision
+`
+
+func (q *Queries) IncrementMasterRevision(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, incrementMasterRevision)
+	var revision int64
+	err := row.Scan(&revision)
+	return revision, err
+}
+
+const insertMasterChangeLog = `-- name: InsertMasterChangeLog :exec
+INSERT INTO master_change_logs (revision, kind, entity_id, operation)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertMasterChangeLogParams struct {
+	Revision  int64
+	Kind      string
+	EntityID  int32
+	Operation string
+}
+
+func (q *Queries) InsertMasterChangeLog(ctx context.Context, arg InsertMasterChangeLogParams) error {
+	_, err := q.db.ExecContext(ctx, insertMasterChangeLog,
+		arg.Revision,
+		arg.Kind,
+		arg.EntityID,
+		arg.Operation,
+	)
+	return err
+}
+
+const listLatestMasterChangeLogsSince = `-- name: ListLatestMasterChangeLogsSince :many
+SELECT DISTINCT ON (kind, entity_id) revision, kind, entity_id, operation
+FROM master_change_logs
+WHERE revision > $1
+ORDER BY kind, entity_id, revision DESC, id DESC
+`
+
+type ListLatestMasterChangeLogsSinceRow struct {
+	Revision  int64
+	Kind      string
+	EntityID  int32
+	Operation string
+}
+
+func (q *Queries) ListLatestMasterChangeLogsSince(ctx context.Context, revision int64) ([]ListLatestMasterChangeLogsSinceRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLatestMasterChangeLogsSince, revision)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLatestMasterChangeLogsSinceRow
+	for rows.Next() {
+		var i ListLatestMasterChangeLogsSinceRow
+		if err := rows.Scan(
+			&i.Revision,
+			&i.Kind,
+			&i.EntityID,
+			&i.Operation,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateReleasesRecordedAt = `-- name: UpdateReleasesRecordedAt :exec
+UPDATE master_revision SET releases_recorded_at = $1 WHERE id = 1
+`
+
+func (q *Queries) UpdateReleasesRecordedAt(ctx context.Context, releasesRecordedAt time.Time) error {
+	_, err := q.db.ExecContext(ctx, updateReleasesRecordedAt, releasesRecordedAt)
+	return err
+}
@@ -6,22 +6,54 @@ package sqlcgen
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Artist struct {
-	ID   int32
-	Name string
-	Kana string
+	ID         int32
+	Name       string
+	Kana       string
+	SearchText string
+	CreatedAt  time.Time
 }
 
 type Chart struct {
-	ID             int32
-	SongID         sql.NullInt32
-	DifficultyType sql.NullInt32
-	Level          sql.NullInt32
-	ChartViewLink  sql.NullString
+	ID              int32
+	SongID          sql.NullInt32
+	DifficultyType  sql.NullInt32
+	Level           sql.NullInt32
+	ChartViewLink   sql.NullString
+	NoteCount       sql.NullInt32
+	BpmMin          sql.NullInt32
+	BpmMax          sql.NullInt32
+	DurationSeconds sql.NullInt32
+	Constant        sql.NullFloat64
+	CreatedAt       time.Time
+}
+
+type ChartLevelHistory struct {
+	ID            int32
+	ChartID       int32
+	Level         int32
+	EffectiveFrom time.Time
+	CreatedAt     time.Time
+}
+
+type MasterChangeLog struct {
+	ID        int64
+	Revision  int64
+	Kind      string
+	EntityID  int32
+	Operation string
+	CreatedAt time.Time
+}
+
+type MasterRevision struct {
+	ID                 int32
+	Revision           int64
+	ReleasesRecordedAt time.Time
 }
 
 type MyList struct {
@@ -53,21 +85,29 @@ type MyListChartAttachment struct {
 }
 
 type Singer struct {
-	ID   int32
-	Name string
+	ID        int32
+	Name      string
+	CreatedAt time.Time
 }
 
 type Song struct {
 	ID            int32
 	Name          string
 	Kana          string
-	LyricsID      sql.NullInt32
-	MusicID       sql.NullInt32
-	ArrangementID sql.NullInt32
 	Thumbnail     sql.NullString
 	OriginalVideo sql.NullString
 	ReleaseTime   sql.NullTime
 	Deleted       sql.NullBool
+	SearchText    string
+	CreatedAt     time.Time
+}
+
+type SongCredit struct {
+	ID       int32
+	SongID   int32
+	ArtistID int32
+	Role     int32
+	Position int32
 }
 
 type SongMusicVideoType struct {
@@ -83,8 +123,16 @@ type SongUnit struct {
 }
 
 type Unit struct {
-	ID   int32
-	Name string
+	ID        int32
+	Name      string
+	CreatedAt time.Time
+}
+
+type UnitSinger struct {
+	ID       int32
+	UnitID   int32
+	SingerID int32
+	Position int32
 }
 
 type User struct {
@@ -45,6 +45,19 @@ func (q *Queries) ExistsMyListChart(ctx context.Context, id int32) (bool, error)
 	return exists, err
 }
 
+const existsMyListChartByChartID = `-- name: ExistsMyListChartByChartID :one
+SELECT EXISTS (
+    SELECT 1 FROM my_list_charts WHERE chart_id = $1
+) AS exists
+`
+
+func (q *Queries) ExistsMyListChartByChartID(ctx context.Context, chartID sql.NullInt32) (bool, error) {
+	row := q.db.QueryRowContext(ctx, existsMyListChartByChartID, chartID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const existsMyListChartByMyListIDAndChartID = `-- name: ExistsMyListChartByMyListIDAndChartID :one
 SELECT EXISTS (
     SELECT 1 FROM my_list_charts WHERE my_list_id = $1 AND chart_id = $2
@@ -63,6 +76,22 @@ func (q *Queries) ExistsMyListChartByMyListIDAndChartID(ctx context.Context, arg
 	return exists, err
 }
 
+const existsMyListChartBySongID = `-- name: ExistsMyListChartBySongID :one
+SELECT EXISTS (
+    SELECT 1
+    FROM my_list_charts mlc
+    JOIN charts c ON mlc.chart_id = c.id
+    WHERE c.song_id = $1
+) AS exists
+`
+
+func (q *Queries) ExistsMyListChartBySongID(ctx context.Context, songID sql.NullInt32) (bool, error) {
+	row := q.db.QueryRowContext(ctx, existsMyListChartBySongID, songID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const getMyListChartByID = `-- name: GetMyListChartByID :one
 SELECT id, my_list_id, chart_id, clear_type, memo, created_at, updated_at FROM my_list_charts WHERE id = $1
 `
@@ -22,32 +22,49 @@ func (q *Queries) ExistsSinger(ctx context.Context, id int32) (bool, error) {
 	return exists, err
 }
 
+const findSingerByNameKey = `-- name: FindSingerByNameKey :one
+SELECT id, name, created_at
+FROM singers
+WHERE lower(btrim(regexp_replace(normalize(name, NFKC), '\s+', ' ', 'g')))
+    = lower(btrim(regexp_replace(normalize($1::text, NFKC), '\s+', ' ', 'g')))
+ORDER BY id
+LIMIT 1
+`
+
+// singers_name_keyと同じ式で比較する
+func (q *Queries) FindSingerByNameKey(ctx context.Context, name string) (Singer, error) {
+	row := q.db.QueryRowContext(ctx, findSingerByNameKey, name)
+	var i Singer
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	return i, err
+}
+
 const getSingerByID = `-- name: GetSingerByID :one
-SELECT id, name FROM singers WHERE id = $1
+SELECT id, name, created_at FROM singers WHERE id = $1
 `
 
 func (q *Queries) GetSingerByID(ctx context.Context, id int32) (Singer, error) {
 	row := q.db.QueryRowContext(ctx, getSingerByID, id)
 	var i Singer
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
 	return i, err
 }
 
 const insertSinger = `-- name: InsertSinger :one
 INSERT INTO singers (name)
 VALUES ($1)
-RETURNING id, name
+RETURNING id, name, created_at
 `
 
 func (q *Queries) InsertSinger(ctx context.Context, name string) (Singer, error) {
 	row := q.db.QueryRowContext(ctx, insertSinger, name)
 	var i Singer
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
 	return i, err
 }
 
 const listSingers = `-- name: ListSingers :many
-SELECT id, name FROM singers ORDER BY id
+SELECT id, name, created_at FROM singers ORDER BY id
 `
 
 func (q *Queries) ListSingers(ctx context.Context) ([]Singer, error) {
@@ -59,7 +76,7 @@ func (q *Queries) ListSingers(ctx context.Context) ([]Singer, error) {
 	var items []Singer
 	for rows.Next() {
 		var i Singer
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		if err := rows.Scan(&i.ID, &i.Name, &i.CreatedAt); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -72,3 +89,19 @@ func (q *Queries) ListSingers(ctx context.Context) ([]Singer, error) {
 	}
 	return items, nil
 }
+
+const updateSinger = `-- name: UpdateSinger :exec
+UPDATE singers
+SET name = $1
+WHERE id = $2
+`
+
+type UpdateSingerParams struct {
+	Name string
+	ID   int32
+}
+
+func (q *Queries) UpdateSinger(ctx context.Context, arg UpdateSingerParams) error {
+	_, err := q.db.ExecContext(ctx, updateSinger, arg.Name, arg.ID)
+	return err
+}
@@ -8,8 +8,22 @@ package sqlcgen
 import (
 	"context"
 	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
 )
 
+const deleteSong = `-- name: DeleteSong :exec
+DELETE
+FROM songs
+WHERE id = $1
+`
+
+func (q *Queries) DeleteSong(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteSong, id)
+	return err
+}
+
 const existsSong = `-- name: ExistsSong :one
 SELECT EXISTS (
   SELECT 1 FROM songs WHERE id = $1
@@ -23,284 +37,232 @@ func (q *Queries) ExistsSong(ctx context.Context, id int32) (bool, error) {
 	return exists, err
 }
 
-const getSongDetailsByID = `-- name: GetSongDetailsByID :many
-SELECT
-    s.id AS id,
-    s.name,
-    s.kana,
-
-    l.id AS lyrics_artist_id,
-    l.name AS lyrics_artist_name,
-    l.kana AS lyrics_artist_kana,
-
-    m.id AS music_artist_id,
-    m.name AS music_artist_name,
-    m.kana AS music_artist_kana,
-
-    a.id AS arrangement_artist_id,
-    a.name AS arrangement_artist_name,
-    a.kana AS arrangement_artist_kana,
-
-    s.thumbnail,
-    s.original_video,
-    s.release_time,
-    s.deleted,
-
-    vp.id AS vocal_pattern_id,
-    vp.name AS vocal_pattern_name,
-
-    vps.singer_id,
-    si.name AS singer_name,
-    vps.position AS singer_order,
-
-    su.unit_id,
-    u.name AS unit_name,
-
-    smvt.music_video_type AS music_video_type_id
-
-FROM songs s
-LEFT JOIN artists l ON s.lyrics_id = l.id
-LEFT JOIN artists m ON s.music_id = m.id
-LEFT JOIN artists a ON s.arrangement_id = a.id
-LEFT JOIN vocal_patterns vp ON vp.song_id = s.id
-LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
-LEFT JOIN singers si ON vps.singer_id = si.id
-LEFT JOIN song_units su ON su.song_id = s.id
-LEFT JOIN units u ON su.unit_id = u.id
-LEFT JOIN song_music_video_types smvt ON smvt.song_id = s.id
-WHERE s.id = $1
+const existsSongByArtistID = `-- name: ExistsSongByArtistID :one
+SELECT EXISTS (
+  SELECT 1 FROM song_credits WHERE artist_id = $1
+) AS exists
 `
 
-type GetSongDetailsByIDRow struct {
-	ID                    int32
-	Name                  string
-	Kana                  string
-	LyricsArtistID        sql.NullInt32
-	LyricsArtistName      sql.NullString
-	LyricsArtistKana      sql.NullString
-	MusicArtistID         sql.NullInt32
-	MusicArtistName       sql.NullString
-	MusicArtistKana       sql.NullString
-	ArrangementArtistID   sql.NullInt32
-	ArrangementArtistName sql.NullString
-	ArrangementArtistKana sql.NullString
-	Thumbnail             sql.NullString
-	OriginalVideo         sql.NullString
-	ReleaseTime           sql.NullTime
-	Deleted               sql.NullBool
-	VocalPatternID        sql.NullInt32
-	VocalPatternName      sql.NullString
-	SingerID              sql.NullInt32
-	SingerName            sql.NullString
-	SingerOrder           sql.NullInt32
-	UnitID                sql.NullInt32
-	UnitName              sql.NullString
-	MusicVideoTypeID      sql.NullInt32
-}
-
-func (q *Queries) GetSongDetailsByID(ctx context.Context, id int32) ([]GetSongDetailsByIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, getSongDetailsByID, id)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetSongDetailsByIDRow
-	for rows.Next() {
-		var i GetSongDetailsByIDRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Kana,
-			&i.LyricsArtistID,
-			&i.LyricsArtistName,
-			&i.LyricsArtistKana,
-			&i.MusicArtistID,
-			&i.MusicArtistName,
-			&i.MusicArtistKana,
-			&i.ArrangementArtistID,
-			&i.ArrangementArtistName,
-			&i.ArrangementArtistKana,
-			&i.Thumbnail,
-			&i.OriginalVideo,
-			&i.ReleaseTime,
-			&i.Deleted,
-			&i.VocalPatternID,
-			&i.VocalPatternName,
-			&i.SingerID,
-			&i.SingerName,
-			&i.SingerOrder,
-			&i.UnitID,
-			&i.UnitName,
-			&i.MusicVideoTypeID,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) ExistsSongByArtistID(ctx context.Context, artistID int32) (bool, error) {
+	row := q.db.QueryRowContext(ctx, existsSongByArtistID, artistID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
 }
 
 const insertSong = `-- name: InsertSong :one
-INSERT INTO songs (name, kana, lyrics_id, music_id, arrangement_id, thumbnail, original_video, release_time, deleted)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-RETURNING id, name, kana, lyrics_id, music_id, arrangement_id, thumbnail, original_video, release_time, deleted
+INSERT INTO songs (name, kana, thumbnail, original_video, release_time, deleted, search_text)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, kana, thumbnail, original_video, release_time, deleted, search_text, created_at
 `
 
 type InsertSongParams struct {
 	Name          string
 	Kana          string
-	LyricsID      sql.NullInt32
-	MusicID       sql.NullInt32
-	ArrangementID sql.NullInt32
 	Thumbnail     sql.NullString
 	OriginalVideo sql.NullString
 	ReleaseTime   sql.NullTime
 	Deleted       sql.NullBool
+	SearchText    string
 }
 
 func (q *Queries) InsertSong(ctx context.Context, arg InsertSongParams) (Song, error) {
 	row := q.db.QueryRowContext(ctx, insertSong,
 		arg.Name,
 		arg.Kana,
-		arg.LyricsID,
-		arg.MusicID,
-		arg.ArrangementID,
 		arg.Thumbnail,
 		arg.OriginalVideo,
 		arg.ReleaseTime,
 		arg.Deleted,
+		arg.SearchText,
 	)
 	var i Song
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Kana,
-		&i.LyricsID,
-		&i.MusicID,
-		&i.ArrangementID,
 		&i.Thumbnail,
 		&i.OriginalVideo,
 		&i.ReleaseTime,
 		&i.Deleted,
+		&i.SearchText,
+		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const listSongWithArtists = `-- name: ListSongWithArtists :many
-SELECT
-    s.id AS id,
-    s.name,
-    s.kana,
+const listSongIDs = `-- name: ListSongIDs :many
+SELECT id FROM songs ORDER BY id
+`
 
-    l.id AS lyrics_artist_id,
-    l.name AS lyrics_artist_name,
-    l.kana AS lyrics_artist_kana,
+func (q *Queries) ListSongIDs(ctx context.Context) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, listSongIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
 
-    m.id AS music_artist_id,
-    m.name AS music_artist_name,
-    m.kana AS music_artist_kana,
+const listSongIDsReleasedBetween = `-- name: ListSongIDsReleasedBetween :many
+SELECT id FROM songs
+WHERE release_time > $1::timestamp
+  AND release_time <= $2::timestamp
+ORDER BY id
+`
 
-    a.id AS arrangement_artist_id,
-    a.name AS arrangement_artist_name,
-    a.kana AS arrangement_artist_kana,
+type ListSongIDsReleasedBetweenParams struct {
+	ReleasedAfter time.Time
+	ReleasedUntil time.Time
+}
 
-    s.thumbnail,
-    s.original_video,
-    s.release_time,
-    s.deleted,
+func (q *Queries) ListSongIDsReleasedBetween(ctx context.Context, arg ListSongIDsReleasedBetweenParams) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, listSongIDsReleasedBetween, arg.ReleasedAfter, arg.ReleasedUntil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSongNames = `-- name: ListSongNames :many
+SELECT id, name, kana FROM songs ORDER BY id
+`
+
+type ListSongNamesRow struct {
+	ID   int32
+	Name string
+	Kana string
+}
 
-    vp.id AS vocal_pattern_id,
-    vp.name AS vocal_pattern_name,
+func (q *Queries) ListSongNames(ctx context.Context) ([]ListSongNamesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSongNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSongNamesRow
+	for rows.Next() {
+		var i ListSongNamesRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Kana); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
 
-    vps.singer_id,
-    si.name AS singer_name,
-    vps.position AS singer_order,
+const listSongNamesWithoutSearchText = `-- name: ListSongNamesWithoutSearchText :many
+SELECT id, name, kana FROM songs WHERE search_text = '' ORDER BY id
+`
 
-    su.unit_id,
-    u.name AS unit_name,
+type ListSongNamesWithoutSearchTextRow struct {
+	ID   int32
+	Name string
+	Kana string
+}
 
-    smvt.music_video_type AS music_video_type_id
+func (q *Queries) ListSongNamesWithoutSearchText(ctx context.Context) ([]ListSongNamesWithoutSearchTextRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSongNamesWithoutSearchText)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSongNamesWithoutSearchTextRow
+	for rows.Next() {
+		var i ListSongNamesWithoutSearchTextRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Kana); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
 
+const listSongsByIDs = `-- name: ListSongsByIDs :many
+SELECT
+    s.id,
+    s.name,
+    s.kana,
+    s.thumbnail,
+    s.original_video,
+    s.release_time,
+    s.deleted,
+    s.created_at
 FROM songs s
-LEFT JOIN artists l ON s.lyrics_id = l.id
-LEFT JOIN artists m ON s.music_id = m.id
-LEFT JOIN artists a ON s.arrangement_id = a.id
-LEFT JOIN vocal_patterns vp ON vp.song_id = s.id
-LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
-LEFT JOIN singers si ON vps.singer_id = si.id
-LEFT JOIN song_units su ON su.song_id = s.id
-LEFT JOIN units u ON su.unit_id = u.id
-LEFT JOIN song_music_video_types smvt ON smvt.song_id = s.id
+WHERE s.id = ANY($1::int[])
 ORDER BY s.id
 `
 
-type ListSongWithArtistsRow struct {
-	ID                    int32
-	Name                  string
-	Kana                  string
-	LyricsArtistID        sql.NullInt32
-	LyricsArtistName      sql.NullString
-	LyricsArtistKana      sql.NullString
-	MusicArtistID         sql.NullInt32
-	MusicArtistName       sql.NullString
-	MusicArtistKana       sql.NullString
-	ArrangementArtistID   sql.NullInt32
-	ArrangementArtistName sql.NullString
-	ArrangementArtistKana sql.NullString
-	Thumbnail             sql.NullString
-	OriginalVideo         sql.NullString
-	ReleaseTime           sql.NullTime
-	Deleted               sql.NullBool
-	VocalPatternID        sql.NullInt32
-	VocalPatternName      sql.NullString
-	SingerID              sql.NullInt32
-	SingerName            sql.NullString
-	SingerOrder           sql.NullInt32
-	UnitID                sql.NullInt32
-	UnitName              sql.NullString
-	MusicVideoTypeID      sql.NullInt32
+type ListSongsByIDsRow struct {
+	ID            int32
+	Name          string
+	Kana          string
+	Thumbnail     sql.NullString
+	OriginalVideo sql.NullString
+	ReleaseTime   sql.NullTime
+	Deleted       sql.NullBool
+	CreatedAt     time.Time
 }
 
-func (q *Queries) ListSongWithArtists(ctx context.Context) ([]ListSongWithArtistsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listSongWithArtists)
+func (q *Queries) ListSongsByIDs(ctx context.Context, ids []int32) ([]ListSongsByIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSongsByIDs, pq.Array(ids))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListSongWithArtistsRow
+	var items []ListSongsByIDsRow
 	for rows.Next() {
-		var i ListSongWithArtistsRow
+		var i ListSongsByIDsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
 			&i.Kana,
-			&i.LyricsArtistID,
-			&i.LyricsArtistName,
-			&i.LyricsArtistKana,
-			&i.MusicArtistID,
-			&i.MusicArtistName,
-			&i.MusicArtistKana,
-			&i.ArrangementArtistID,
-			&i.ArrangementArtistName,
-			&i.ArrangementArtistKana,
 			&i.Thumbnail,
 			&i.OriginalVideo,
 			&i.ReleaseTime,
 			&i.Deleted,
-			&i.VocalPatternID,
-			&i.VocalPatternName,
-			&i.SingerID,
-			&i.SingerName,
-			&i.SingerOrder,
-			&i.UnitID,
-			&i.UnitName,
-			&i.MusicVideoTypeID,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -314,3 +276,162 @@ func (q *Queries) ListSongWithArtists(ctx context.Context) ([]ListSongWithArtist
 	}
 	return items, nil
 }
+
+const searchSongIDs = `-- name: SearchSongIDs :many
+SELECT s.id
+FROM songs s
+WHERE s.id > $1::int
+  AND ($2::text IS NULL OR s.search_text LIKE '%' || $2::text || '%')
+  AND (
+    $3::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM song_credits sc
+      WHERE sc.song_id = s.id AND sc.role = 1 AND sc.artist_id = $3::int
+    )
+  )
+  AND (
+    $4::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM song_credits sc
+      WHERE sc.song_id = s.id AND sc.role = 2 AND sc.artist_id = $4::int
+    )
+  )
+  AND (
+    $5::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM song_credits sc
+      WHERE sc.song_id = s.id AND sc.role = 3 AND sc.artist_id = $5::int
+    )
+  )
+  AND (
+    $6::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM song_units su
+      WHERE su.song_id = s.id AND su.unit_id = $6::int
+    )
+  )
+  AND (
+    $7::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM vocal_patterns vp
+      JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+      WHERE vp.song_id = s.id AND vps.singer_id = $7::int
+    )
+  )
+  AND (
+    $8::int IS NULL
+    OR EXISTS (
+      SELECT 1 FROM song_music_video_types smvt
+      WHERE smvt.song_id = s.id AND smvt.music_video_type = $8::int
+    )
+  )
+  AND ($9::timestamp IS NULL OR s.release_time >= $9::timestamp)
+  AND ($10::timestamp IS NULL OR s.release_time < $10::timestamp)
+  AND ($11::boolean OR NOT COALESCE(s.deleted, FALSE))
+ORDER BY s.id
+LIMIT $12::int
+`
+
+type SearchSongIDsParams struct {
+	AfterID        int32
+	Query          sql.NullString
+	LyricsID       sql.NullInt32
+	MusicID        sql.NullInt32
+	ArrangementID  sql.NullInt32
+	UnitID         sql.NullInt32
+	SingerID       sql.NullInt32
+	MusicVideoType sql.NullInt32
+	ReleaseFrom    sql.NullTime
+	ReleaseTo      sql.NullTime
+	IncludeDeleted bool
+	PageLimit      int32
+}
+
+func (q *Queries) SearchSongIDs(ctx context.Context, arg SearchSongIDsParams) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, searchSongIDs,
+		arg.AfterID,
+		arg.Query,
+		arg.LyricsID,
+		arg.MusicID,
+		arg.ArrangementID,
+		arg.UnitID,
+		arg.SingerID,
+		arg.MusicVideoType,
+		arg.ReleaseFrom,
+		arg.ReleaseTo,
+		arg.IncludeDeleted,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateSong = `-- name: UpdateSong :exec
+UPDATE songs
+SET name = $1,
+    kana = $2,
+    thumbnail = $3,
+    original_video = $4,
+    release_time = $5,
+    deleted = $6,
+    search_text = $7
+WHERE id = $8
+`
+
+type UpdateSongParams struct {
+	Name          string
+	Kana          string
+	Thumbnail     sql.NullString
+	OriginalVideo sql.NullString
+	ReleaseTime   sql.NullTime
+	Deleted       sql.NullBool
+	SearchText    string
+	ID            int32
+}
+
+func (q *Queries) UpdateSong(ctx context.Context, arg UpdateSongParams) error {
+	_, err := q.db.ExecContext(ctx, updateSong,
+		arg.Name,
+		arg.Kana,
+		arg.Thumbnail,
+		arg.OriginalVideo,
+		arg.ReleaseTime,
+		arg.Deleted,
+		arg.SearchText,
+		arg.ID,
+	)
+	return err
+}
+
+const updateSongSearchText = `-- name: UpdateSongSearchText :exec
+UPDATE songs
+SET search_text = $1
+WHERE id = $2
+`
+
+type UpdateSongSearchTextParams struct {
+	SearchText string
+	ID         int32
+}
+
+func (q *Queries) UpdateSongSearchText(ctx context.Context, arg UpdateSongSearchTextParams) error {
+	_, err := q.db.ExecContext(ctx, updateSongSearchText, arg.SearchText, arg.ID)
+	return err
+}
@@ -0,0 +1,143 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: song_credit.sql
+
+package sqlcgen
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+const deleteDuplicateSongCreditsForMerge = `-- name: DeleteDuplicateSongCreditsForMerge :exec
+DELETE FROM song_credits sc
+WHERE sc.artist_id = $1
+  AND EXISTS (
+    SELECT 1 FROM song_credits t
+    WHERE t.song_id = sc.song_id AND t.role = sc.role AND t.artist_id = $2
+  )
+`
+
+type DeleteDuplicateSongCreditsForMergeParams struct {
+	SourceID int32
+	TargetID int32
+}
+
+// 統合先が同じ曲・同じ役割で既にクレジットされている行は付け替えずに消す
+func (q *Queries) DeleteDuplicateSongCreditsForMerge(ctx context.Context, arg DeleteDuplicateSongCreditsForMergeParams) error {
+	_, err := q.db.ExecContext(ctx, deleteDuplicateSongCreditsForMerge, arg.SourceID, arg.TargetID)
+	return err
+}
+
+const deleteSongCreditsBySongID = `-- name: DeleteSongCreditsBySongID :exec
+DELETE
+FROM song_credits
+WHERE song_id = $1
+`
+
+func (q *Queries) DeleteSongCreditsBySongID(ctx context.Context, songID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteSongCreditsBySongID, songID)
+	return err
+}
+
+const insertSongCredit = `-- name: InsertSongCredit :one
+INSERT INTO song_credits (song_id, artist_id, role, position)
+VALUES ($1, $2, $3, $4)
+RETURNING id, song_id, artist_id, role, position
+`
+
+type InsertSongCreditParams struct {
+	SongID   int32
+	ArtistID int32
+	Role     int32
+	Position int32
+}
+
+func (q *Queries) InsertSongCredit(ctx context.Context, arg InsertSongCreditParams) (SongCredit, error) {
+	row := q.db.QueryRowContext(ctx, insertSongCredit,
+		arg.SongID,
+		arg.ArtistID,
+		arg.Role,
+		arg.Position,
+	)
+	var i SongCredit
+	err := row.Scan(
+		&i.ID,
+		&i.SongID,
+		&i.ArtistID,
+		&i.Role,
+		&i.Position,
+	)
+	return i, err
+}
+
+const listSongCreditsBySongIDs = `-- name: ListSongCreditsBySongIDs :many
+SELECT
+    sc.song_id,
+    sc.role,
+    sc.position,
+    a.id,
+    a.name,
+    a.kana
+FROM song_credits sc
+JOIN artists a ON sc.artist_id = a.id
+WHERE sc.song_id = ANY($1::int[])
+ORDER BY sc.song_id, sc.role, sc.position, sc.id
+`
+
+type ListSongCreditsBySongIDsRow struct {
+	SongID   int32
+	Role     int32
+	Position int32
+	ID       int32
+	Name     string
+	Kana     string
+}
+
+func (q *Queries) ListSongCreditsBySongIDs(ctx context.Context, songIds []int32) ([]ListSongCreditsBySongIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSongCreditsBySongIDs, pq.Array(songIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSongCreditsBySongIDsRow
+	for rows.Next() {
+		var i ListSongCreditsBySongIDsRow
+		if err := rows.Scan(
+			&i.SongID,
+			&i.Role,
+			&i.Position,
+			&i.ID,
+			&i.Name,
+			&i.Kana,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reassignSongCredits = `-- name: ReassignSongCredits :exec
+UPDATE song_credits
+SET artist_id = $1
+WHERE artist_id = $2
+`
+
+type ReassignSongCreditsParams struct {
+	TargetID int32
+	SourceID int32
+}
+
+func (q *Queries) ReassignSongCredits(ctx context.Context, arg ReassignSongCreditsParams) error {
+	_, err := q.db.ExecContext(ctx, reassignSongCredits, arg.TargetID, arg.SourceID)
+	return err
+}
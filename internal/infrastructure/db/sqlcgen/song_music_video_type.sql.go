@@ -8,8 +8,21 @@ package sqlcgen
 import (
 	"context"
 	"database/sql"
+
+	"github.com/lib/pq"
 )
 
+const deleteSongMusicVideoTypesBySongID = `-- name: DeleteSongMusicVideoTypesBySongID :exec
+DELETE
+FROM song_music_video_types
+WHERE song_id = $1
+`
+
+func (q *Queries) DeleteSongMusicVideoTypesBySongID(ctx context.Context, songID sql.NullInt32) error {
+	_, err := q.db.ExecContext(ctx, deleteSongMusicVideoTypesBySongID, songID)
+	return err
+}
+
 const insertSongMusicVideoType = `-- name: InsertSongMusicVideoType :one
 INSERT INTO song_music_video_types (song_id, music_video_type)
 VALUES ($1, $2)
@@ -27,3 +40,33 @@ func (q *Queries) InsertSongMusicVideoType(ctx context.Context, arg InsertSongMu
 	err := row.Scan(&i.ID, &i.SongID, &i.MusicVideoType)
 	return i, err
 }
+
+const listSongMusicVideoTypesBySongIDs = `-- name: ListSongMusicVideoTypesBySongIDs :many
+SELECT id, song_id, music_video_type
+FROM song_music_video_types
+WHERE song_id = ANY($1::int[])
+ORDER BY id
+`
+
+func (q *Queries) ListSongMusicVideoTypesBySongIDs(ctx context.Context, songIds []int32) ([]SongMusicVideoType, error) {
+	rows, err := q.db.QueryContext(ctx, listSongMusicVideoTypesBySongIDs, pq.Array(songIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SongMusicVideoType
+	for rows.Next() {
+		var i SongMusicVideoType
+		if err := rows.Scan(&i.ID, &i.SongID, &i.MusicVideoType); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -8,8 +8,21 @@ package sqlcgen
 import (
 	"context"
 	"database/sql"
+
+	"github.com/lib/pq"
 )
 
+const deleteSongUnitsBySongID = `-- name: DeleteSongUnitsBySongID :exec
+DELETE
+FROM song_units
+WHERE song_id = $1
+`
+
+func (q *Queries) DeleteSongUnitsBySongID(ctx context.Context, songID sql.NullInt32) error {
+	_, err := q.db.ExecContext(ctx, deleteSongUnitsBySongID, songID)
+	return err
+}
+
 const insertSongUnit = `-- name: InsertSongUnit :one
 INSERT INTO song_units (song_id, unit_id)
 VALUES ($1, $2)
@@ -27,3 +40,43 @@ func (q *Queries) InsertSongUnit(ctx context.Context, arg InsertSongUnitParams)
 	err := row.Scan(&i.ID, &i.SongID, &i.UnitID)
 	return i, err
 }
+
+const listUnitsBySongIDs = `-- name: ListUnitsBySongIDs :many
+SELECT
+    su.song_id,
+    u.id,
+    u.name
+FROM song_units su
+JOIN units u ON su.unit_id = u.id
+WHERE su.song_id = ANY($1::int[])
+ORDER BY su.id
+`
+
+type ListUnitsBySongIDsRow struct {
+	SongID sql.NullInt32
+	ID     int32
+	Name   string
+}
+
+func (q *Queries) ListUnitsBySongIDs(ctx context.Context, songIds []int32) ([]ListUnitsBySongIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUnitsBySongIDs, pq.Array(songIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnitsBySongIDsRow
+	for rows.Next() {
+		var i ListUnitsBySongIDsRow
+		if err := rows.Scan(&i.SongID, &i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
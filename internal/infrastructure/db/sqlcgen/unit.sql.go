@@ -22,32 +22,49 @@ func (q *Queries) ExistsUnit(ctx context.Context, id int32) (bool, error) {
 	return exists, err
 }
 
+const findUnitByNameKey = `-- name: FindUnitByNameKey :one
+SELECT id, name, created_at
+FROM units
+WHERE lower(btrim(regexp_replace(normalize(name, NFKC), '\s+', ' ', 'g')))
+    = lower(btrim(regexp_replace(normalize($1::text, NFKC), '\s+', ' ', 'g')))
+ORDER BY id
+LIMIT 1
+`
+
+// units_name_keyと同じ式で比較する
+func (q *Queries) FindUnitByNameKey(ctx context.Context, name string) (Unit, error) {
+	row := q.db.QueryRowContext(ctx, findUnitByNameKey, name)
+	var i Unit
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
+	return i, err
+}
+
 const getUnitByID = `-- name: GetUnitByID :one
-SELECT id, name FROM units WHERE id = $1
+SELECT id, name, created_at FROM units WHERE id = $1
 `
 
 func (q *Queries) GetUnitByID(ctx context.Context, id int32) (Unit, error) {
 	row := q.db.QueryRowContext(ctx, getUnitByID, id)
 	var i Unit
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
 	return i, err
 }
 
 const insertUnit = `-- name: InsertUnit :one
 INSERT INTO units (name)
 VALUES ($1)
-RETURNING id, name
+RETURNING id, name, created_at
 `
 
 func (q *Queries) InsertUnit(ctx context.Context, name string) (Unit, error) {
 	row := q.db.QueryRowContext(ctx, insertUnit, name)
 	var i Unit
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(&i.ID, &i.Name, &i.CreatedAt)
 	return i, err
 }
 
 const listUnits = `-- name: ListUnits :many
-SELECT id, name FROM units ORDER BY id
+SELECT id, name, created_at FROM units ORDER BY id
 `
 
 func (q *Queries) ListUnits(ctx context.Context) ([]Unit, error) {
@@ -59,7 +76,7 @@ func (q *Queries) ListUnits(ctx context.Context) ([]Unit, error) {
 	var items []Unit
 	for rows.Next() {
 		var i Unit
-		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+		if err := rows.Scan(&i.ID, &i.Name, &i.CreatedAt); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -72,3 +89,19 @@ func (q *Queries) ListUnits(ctx context.Context) ([]Unit, error) {
 	}
 	return items, nil
 }
+
+const updateUnit = `-- name: UpdateUnit :exec
+UPDATE units
+SET name = $1
+WHERE id = $2
+`
+
+type UpdateUnitParams struct {
+	Name string
+	ID   int32
+}
+
+func (q *Queries) UpdateUnit(ctx context.Context, arg UpdateUnitParams) error {
+	_, err := q.db.ExecContext(ctx, updateUnit, arg.Name, arg.ID)
+	return err
+}
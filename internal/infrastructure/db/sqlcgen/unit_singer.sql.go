@@ -0,0 +1,154 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: unit_singer.sql
+
+package sqlcgen
+
+import (
+	"context"
+	"database/sql"
+)
+
+const deleteUnitSinger = `-- name: DeleteUnitSinger :exec
+DELETE
+FROM unit_singers
+WHERE unit_id = $1 AND singer_id = $2
+`
+
+type DeleteUnitSingerParams struct {
+	UnitID   int32
+	SingerID int32
+}
+
+func (q *Queries) DeleteUnitSinger(ctx context.Context, arg DeleteUnitSingerParams) error {
+	_, err := q.db.ExecContext(ctx, deleteUnitSinger, arg.UnitID, arg.SingerID)
+	return err
+}
+
+const deleteUnitSingersByUnitID = `-- name: DeleteUnitSingersByUnitID :exec
+DELETE
+FROM unit_singers
+WHERE unit_id = $1
+`
+
+func (q *Queries) DeleteUnitSingersByUnitID(ctx context.Context, unitID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteUnitSingersByUnitID, unitID)
+	return err
+}
+
+const existsUnitSinger = `-- name: ExistsUnitSinger :one
+SELECT EXISTS (
+  SELECT 1 FROM unit_singers WHERE unit_id = $1 AND singer_id = $2
+) AS exists
+`
+
+type ExistsUnitSingerParams struct {
+	UnitID   int32
+	SingerID int32
+}
+
+func (q *Queries) ExistsUnitSinger(ctx context.Context, arg ExistsUnitSingerParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, existsUnitSinger, arg.UnitID, arg.SingerID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const insertUnitSinger = `-- name: InsertUnitSinger :one
+INSERT INTO unit_singers (unit_id, singer_id, position)
+VALUES ($1, $2, $3)
+RETURNING id, unit_id, singer_id, position
+`
+
+type InsertUnitSingerParams struct {
+	UnitID   int32
+	SingerID int32
+	Position int32
+}
+
+func (q *Queries) InsertUnitSinger(ctx context.Context, arg InsertUnitSingerParams) (UnitSinger, error) {
+	row := q.db.QueryRowContext(ctx, insertUnitSinger, arg.UnitID, arg.SingerID, arg.Position)
+	var i UnitSinger
+	err := row.Scan(
+		&i.ID,
+		&i.UnitID,
+		&i.SingerID,
+		&i.Position,
+	)
+	return i, err
+}
+
+const listUnitSingers = `-- name: ListUnitSingers :many
+SELECT
+    us.unit_id,
+    u.name AS unit_name,
+    us.singer_id,
+    si.name AS singer_name,
+    us.position
+FROM unit_singers us
+JOIN units u ON us.unit_id = u.id
+JOIN singers si ON us.singer_id = si.id
+WHERE ($1::int IS NULL OR us.unit_id = $1::int)
+  AND ($2::int IS NULL OR us.singer_id = $2::int)
+ORDER BY us.unit_id, us.position, us.id
+`
+
+type ListUnitSingersParams struct {
+	UnitID   sql.NullInt32
+	SingerID sql.NullInt32
+}
+
+type ListUnitSingersRow struct {
+	UnitID     int32
+	UnitName   string
+	SingerID   int32
+	SingerName string
+	Position   int32
+}
+
+func (q *Queries) ListUnitSingers(ctx context.Context, arg ListUnitSingersParams) ([]ListUnitSingersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUnitSingers, arg.UnitID, arg.SingerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnitSingersRow
+	for rows.Next() {
+		var i ListUnitSingersRow
+		if err := rows.Scan(
+			&i.UnitID,
+			&i.UnitName,
+			&i.SingerID,
+			&i.SingerName,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateUnitSinger = `-- name: UpdateUnitSinger :exec
+UPDATE unit_singers
+SET position = $1
+WHERE unit_id = $2 AND singer_id = $3
+`
+
+type UpdateUnitSingerParams struct {
+	Position int32
+	UnitID   int32
+	SingerID int32
+}
+
+func (q *Queries) UpdateUnitSinger(ctx context.Context, arg UpdateUnitSingerParams) error {
+	_, err := q.db.ExecContext(ctx, updateUnitSinger, arg.Position, arg.UnitID, arg.SingerID)
+	return err
+}
@@ -8,8 +8,99 @@ package sqlcgen
 import (
 	"context"
 	"database/sql"
+
+	"github.com/lib/pq"
 )
 
+const deleteVocalPattern = `-- name: DeleteVocalPattern :exec
+DELETE
+FROM vocal_patterns
+WHERE id = $1
+`
+
+func (q *Queries) DeleteVocalPattern(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteVocalPattern, id)
+	return err
+}
+
+const deleteVocalPatternsBySongID = `-- name: DeleteVocalPatternsBySongID :exec
+DELETE
+FROM vocal_patterns
+WHERE song_id = $1
+`
+
+func (q *Queries) DeleteVocalPatternsBySongID(ctx context.Context, songID sql.NullInt32) error {
+	_, err := q.db.ExecContext(ctx, deleteVocalPatternsBySongID, songID)
+	return err
+}
+
+const existsVocalPattern = `-- name: ExistsVocalPattern :one
+SELECT EXISTS (
+  SELECT 1 FROM vocal_patterns WHERE id = $1
+) AS exists
+`
+
+func (q *Queries) ExistsVocalPattern(ctx context.Context, id int32) (bool, error) {
+	row := q.db.QueryRowContext(ctx, existsVocalPattern, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const getVocalPatternWithSingersByID = `-- name: GetVocalPatternWithSingersByID :many
+SELECT
+    vp.id,
+    vp.song_id,
+    vp.name,
+    vps.singer_id,
+    si.name AS singer_name,
+    vps.position AS singer_position
+FROM vocal_patterns vp
+LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+LEFT JOIN singers si ON vps.singer_id = si.id
+WHERE vp.id = $1
+ORDER BY vps.position
+`
+
+type GetVocalPatternWithSingersByIDRow struct {
+	ID             int32
+	SongID         sql.NullInt32
+	Name           sql.NullString
+	SingerID       sql.NullInt32
+	SingerName     sql.NullString
+	SingerPosition sql.NullInt32
+}
+
+func (q *Queries) GetVocalPatternWithSingersByID(ctx context.Context, id int32) ([]GetVocalPatternWithSingersByIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getVocalPatternWithSingersByID, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetVocalPatternWithSingersByIDRow
+	for rows.Next() {
+		var i GetVocalPatternWithSingersByIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SongID,
+			&i.Name,
+			&i.SingerID,
+			&i.SingerName,
+			&i.SingerPosition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertVocalPattern = `-- name: InsertVocalPattern :one
 INSERT INTO vocal_patterns (song_id, name)
 VALUES ($1, $2)
@@ -27,3 +118,127 @@ func (q *Queries) InsertVocalPattern(ctx context.Context, arg InsertVocalPattern
 	err := row.Scan(&i.ID, &i.SongID, &i.Name)
 	return i, err
 }
+
+const listVocalPatternsWithSingers = `-- name: ListVocalPatternsWithSingers :many
+SELECT
+    vp.id,
+    vp.song_id,
+    vp.name,
+    vps.singer_id,
+    si.name AS singer_name,
+    vps.position AS singer_position
+FROM vocal_patterns vp
+LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+LEFT JOIN singers si ON vps.singer_id = si.id
+WHERE $1::int IS NULL OR vp.song_id = $1::int
+ORDER BY vp.id, vps.position
+`
+
+type ListVocalPatternsWithSingersRow struct {
+	ID             int32
+	SongID         sql.NullInt32
+	Name           sql.NullString
+	SingerID       sql.NullInt32
+	SingerName     sql.NullString
+	SingerPosition sql.NullInt32
+}
+
+func (q *Queries) ListVocalPatternsWithSingers(ctx context.Context, songID sql.NullInt32) ([]ListVocalPatternsWithSingersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listVocalPatternsWithSingers, songID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListVocalPatternsWithSingersRow
+	for rows.Next() {
+		var i ListVocalPatternsWithSingersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SongID,
+			&i.Name,
+			&i.SingerID,
+			&i.SingerName,
+			&i.SingerPosition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVocalPatternsWithSingersBySongIDs = `-- name: ListVocalPatternsWithSingersBySongIDs :many
+SELECT
+    vp.id,
+    vp.song_id,
+    vp.name,
+    vps.singer_id,
+    si.name AS singer_name,
+    vps.position AS singer_position
+FROM vocal_patterns vp
+LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+LEFT JOIN singers si ON vps.singer_id = si.id
+WHERE vp.song_id = ANY($1::int[])
+ORDER BY vp.id, vps.position
+`
+
+type ListVocalPatternsWithSingersBySongIDsRow struct {
+	ID             int32
+	SongID         sql.NullInt32
+	Name           sql.NullString
+	SingerID       sql.NullInt32
+	SingerName     sql.NullString
+	SingerPosition sql.NullInt32
+}
+
+func (q *Queries) ListVocalPatternsWithSingersBySongIDs(ctx context.Context, songIds []int32) ([]ListVocalPatternsWithSingersBySongIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listVocalPatternsWithSingersBySongIDs, pq.Array(songIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListVocalPatternsWithSingersBySongIDsRow
+	for rows.Next() {
+		var i ListVocalPatternsWithSingersBySongIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SongID,
+			&i.Name,
+			&i.SingerID,
+			&i.SingerName,
+			&i.SingerPosition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateVocalPattern = `-- name: UpdateVocalPattern :exec
+UPDATE vocal_patterns
+SET name = $1
+WHERE id = $2
+`
+
+type UpdateVocalPatternParams struct {
+	Name sql.NullString
+	ID   int32
+}
+
+func (q *Queries) UpdateVocalPattern(ctx context.Context, arg UpdateVocalPatternParams) error {
+	_, err := q.db.ExecContext(ctx, updateVocalPattern, arg.Name, arg.ID)
+	return err
+}
@@ -10,6 +10,30 @@ import (
 	"database/sql"
 )
 
+const deleteVocalPatternSingersBySongID = `-- name: DeleteVocalPatternSingersBySongID :exec
+DELETE
+FROM vocal_pattern_singers
+WHERE vocal_pattern_id IN (
+    SELECT id FROM vocal_patterns WHERE song_id = $1
+)
+`
+
+func (q *Queries) DeleteVocalPatternSingersBySongID(ctx context.Context, songID sql.NullInt32) error {
+	_, err := q.db.ExecContext(ctx, deleteVocalPatternSingersBySongID, songID)
+	return err
+}
+
+const deleteVocalPatternSingersByVocalPatternID = `-- name: DeleteVocalPatternSingersByVocalPatternID :exec
+DELETE
+FROM vocal_pattern_singers
+WHERE vocal_pattern_id = $1
+`
+
+func (q *Queries) DeleteVocalPatternSingersByVocalPatternID(ctx context.Context, vocalPatternID sql.NullInt32) error {
+	_, err := q.db.ExecContext(ctx, deleteVocalPatternSingersByVocalPatternID, vocalPatternID)
+	return err
+}
+
 const insertVocalPatternSinger = `-- name: InsertVocalPatternSinger :one
 INSERT INTO vocal_pattern_singers (vocal_pattern_id, singer_id, position)
 VALUES ($1, $2, $3)
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/cockroachdb/errors"
+)
+
+type CacheHandler struct {
+	localMasterCache repository.LocalMasterCacheRepository
+}
+
+func NewCacheHandler(localMasterCache repository.LocalMasterCacheRepository) *CacheHandler {
+	return &CacheHandler{
+		localMasterCache: localMasterCache,
+	}
+}
+
+// このサーバーのメモリ上のマスタのキャッシュのヒット・ミスの回数
+func (h *CacheHandler) GetMasterCacheStatsHandler(w http.ResponseWriter, r *http.Request) {
+	stats := h.localMasterCache.Stats()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]any{
+		"hits":    stats.Hits,
+		"misses":  stats.Misses,
+		"entries": stats.Entries,
+	}); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		http.Error(w, "failed to write response", http.StatusInternalServerError)
+	}
+}
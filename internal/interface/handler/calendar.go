@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/gen/enums"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/ical"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/usecase"
+	"github.com/cockroachdb/errors"
+)
+
+type CalendarHandler struct {
+	masterUsecase usecase.MasterUsecase
+}
+
+func NewCalendarHandler(masterUsecase usecase.MasterUsecase) *CalendarHandler {
+	return &CalendarHandler{
+		masterUsecase: masterUsecase,
+	}
+}
+
+// 曲の公開日時のiCalendar 公開予定の曲も含める
+// unit_idを指定するとそのユニットの曲だけにする
+func (h *CalendarHandler) GetCalendarHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var unitID int32
+	if v := r.URL.Query().Get("unit_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || id < 1 {
+			err := errors.Newf("invalid unit_id parameter: %s", v)
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		unitID = int32(id)
+	}
+
+	months, err := h.masterUsecase.ListSongReleases(ctx, unitID, true)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		if errors.Is(err, repository.ErrNotFound) {
+			http.Error(w, "unit not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	cal := &ical.Calendar{
+		ProductID: "-//sekai-songs-mylist//releases//JA",
+		Name:      "楽曲公開日",
+	}
+	for _, month := range months {
+		for _, song := range month.Songs {
+			cal.Events = append(cal.Events, &ical.Event{
+				UID:         fmt.Sprintf("song-%d@sekai-songs-mylist", song.ID),
+				Summary:     song.Name,
+				Description: songReleaseDescription(song),
+				URL:         song.OriginalVideo,
+				Start:       song.ReleaseTime,
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := cal.Encode(&buf, time.Now()); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="calendar.ics"`)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+	}
+}
+
+// ユニットとクレジットを並べる
+func songReleaseDescription(song *entity.Song) string {
+	var lines []string
+	var units []string
+	for _, u := range song.Units {
+		if u == nil {
+			continue
+		}
+		units = append(units, u.Name)
+	}
+	if len(units) > 0 {
+		lines = append(lines, "ユニット: "+strings.Join(units, ", "))
+	}
+	for _, role := range []struct {
+		role  enums.CreditRole
+		label string
+	}{
+		{enums.CreditRole_CREDIT_ROLE_LYRICS, "作詞"},
+		{enums.CreditRole_CREDIT_ROLE_MUSIC, "作曲"},
+		{enums.CreditRole_CREDIT_ROLE_ARRANGEMENT, "編曲"},
+	} {
+		var names []string
+		for _, c := range song.Credits {
+			if c != nil && c.Role == role.role {
+				names = append(names, c.Artist.Name)
+			}
+		}
+		if len(names) > 0 {
+			lines = append(lines, role.label+": "+strings.Join(names, ", "))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/atom"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/masterbundle"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/usecase"
+	"github.com/cockroachdb/errors"
+)
+
+// フィードに出す件数
+const masterFeedLimit = 50
+
+type FeedHandler struct {
+	masterUsecase usecase.MasterUsecase
+}
+
+func NewFeedHandler(masterUsecase usecase.MasterUsecase) *FeedHandler {
+	return &FeedHandler{
+		masterUsecase: masterUsecase,
+	}
+}
+
+// 新しく追加された曲と譜面のAtomフィード
+func (h *FeedHandler) GetMasterAtomHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	additions, err := h.masterUsecase.ListRecentAdditions(ctx, masterFeedLimit)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	baseURL := os.Getenv("BACK_END_URL")
+	feedURL := baseURL + "/feeds/master.atom"
+	// エントリが無い時はフィードを作った日時にする
+	updated := time.Now()
+	if len(additions) > 0 {
+		updated = additions[0].CreatedAt
+	}
+	feed := &atom.Feed{
+		ID:      feedURL,
+		Title:   "sekai-songs-mylist 追加された曲・譜面",
+		Updated: atom.FormatTime(updated),
+		Author:  &atom.Person{Name: "sekai-songs-mylist"},
+		Links: []*atom.Link{
+			{Href: feedURL, Rel: "self", Type: "application/atom+xml"},
+		},
+	}
+	for _, a := range additions {
+		feed.Entries = append(feed.Entries, toAtomEntry(a, feedURL, baseURL))
+	}
+
+	var buf bytes.Buffer
+	if err := feed.Encode(&buf); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+	}
+}
+
+func toAtomEntry(a *entity.MasterAddition, feedURL, baseURL string) *atom.Entry {
+	var song *entity.Song
+	var id, title, link string
+	switch a.Kind {
+	case entity.MasterKindChart:
+		song = &a.Chart.Song
+		id = fmt.Sprintf("%s#chart-%d", feedURL, a.Chart.ID)
+		title = fmt.Sprintf("譜面追加: %s %s Lv.%d", song.Name, masterbundle.FormatDifficultyType(a.Chart.DifficultyType), a.Chart.Level)
+		link = a.Chart.ChartViewLink
+	default:
+		song = a.Song
+		id = fmt.Sprintf("%s#song-%d", feedURL, a.Song.ID)
+		title = "曲追加: " + song.Name
+		link = song.OriginalVideo
+	}
+
+	entry := &atom.Entry{
+		ID:        id,
+		Title:     title,
+		Updated:   atom.FormatTime(a.CreatedAt),
+		Published: atom.FormatTime(a.CreatedAt),
+	}
+	if link != "" {
+		entry.Links = append(entry.Links, &atom.Link{Href: link, Rel: "alternate"})
+	}
+
+	var content strings.Builder
+	if thumbnail := thumbnailProxyURL(baseURL, song.Thumbnail); thumbnail != "" {
+		entry.Links = append(entry.Links, &atom.Link{Href: thumbnail, Rel: "enclosure"})
+		fmt.Fprintf(&content, `<p><img src="%s" alt="%s"/></p>`, html.EscapeString(thumbnail), html.EscapeString(song.Name))
+	}
+	if description := songReleaseDescription(song); description != "" {
+		fmt.Fprintf(&content, "<p>%s</p>", strings.ReplaceAll(html.EscapeString(description), "\n", "<br/>"))
+	}
+	if content.Len() > 0 {
+		entry.Content = &atom.Text{Type: "html", Body: content.String()}
+	}
+
+	return entry
+}
+
+// サムネイルは/imageのプロキシ経由のURLにする
+// アップロード時に保存した/image?id=のURL、id付きのURL、ファイルIDのどれでもよい
+func thumbnailProxyURL(baseURL, thumbnail string) string {
+	if thumbnail == "" {
+		return ""
+	}
+	fileID := thumbnail
+	if u, err := url.Parse(thumbnail); err == nil && u.Scheme != "" {
+		fileID = u.Query().Get("id")
+		if fileID == "" {
+			// ファイルIDが分からないのでそのまま使う
+			return thumbnail
+		}
+	}
+	return baseURL + "/image?id=" + url.QueryEscape(fileID)
+}
@@ -1,16 +1,22 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"log"
+	"slices"
 	"sort"
+	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/gen/enums"
 	proto_master "github.com/Shakkuuu/sekai-songs-mylist/internal/gen/master"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/auth"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/masterbundle"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/usecase"
 	"github.com/cockroachdb/errors"
 )
@@ -102,6 +108,9 @@ func (h *MasterHandler) CreateArtist(ctx context.Context, req *connect.Request[p
 	}
 
 	if err := h.masterUsecase.CreateArtist(ctx, req.Msg.GetName(), req.Msg.GetKana()); err != nil {
+		if errors.Is(err, usecase.ErrAlreadyExists) {
+			return nil, alreadyExistsError(err)
+		}
 		cerr := errors.WithStack(err)
 		log.Printf("%+v\n", cerr)
 		return nil, connect.NewError(connect.CodeInternal, cerr)
@@ -109,6 +118,140 @@ func (h *MasterHandler) CreateArtist(ctx context.Context, req *connect.Request[p
 
 	return connect.NewResponse(&proto_master.CreateArtistResponse{}), nil
 }
+func (h *MasterHandler) UpdateArtist(ctx context.Context, req *connect.Request[proto_master.UpdateArtistRequest]) (*connect.Response[proto_master.UpdateArtistResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.UpdateArtist(ctx, req.Msg.GetId(), req.Msg.GetName(), req.Msg.GetKana()); err != nil {
+		if errors.Is(err, usecase.ErrAlreadyExists) {
+			return nil, alreadyExistsError(err)
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.UpdateArtistResponse{}), nil
+}
+
+func (h *MasterHandler) DeleteArtist(ctx context.Context, req *connect.Request[proto_master.DeleteArtistRequest]) (*connect.Response[proto_master.DeleteArtistResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.DeleteArtist(ctx, req.Msg.GetId()); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		if errors.Is(err, usecase.ErrMasterInUse) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeFailedPrecondition, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.DeleteArtistResponse{}), nil
+}
+func (h *MasterHandler) MergeArtists(ctx context.Context, req *connect.Request[proto_master.MergeArtistsRequest]) (*connect.Response[proto_master.MergeArtistsResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.MergeArtists(ctx, req.Msg.GetSourceArtistId(), req.Msg.GetTargetArtistId()); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.MergeArtistsResponse{}), nil
+}
 
 // Singer
 func (h *MasterHandler) GetSingers(ctx context.Context, req *connect.Request[proto_master.GetSingersRequest]) (*connect.Response[proto_master.GetSingersResponse], error) {
@@ -122,8 +265,9 @@ func (h *MasterHandler) GetSingers(ctx context.Context, req *connect.Request[pro
 	protoSingers := make([]*proto_master.Singer, len(singers))
 	for i, singer := range singers {
 		protoSingers[i] = &proto_master.Singer{
-			Id:   singer.ID,
-			Name: singer.Name,
+			Id:    singer.ID,
+			Name:  singer.Name,
+			Units: toProtoSingerUnits(singer),
 		}
 	}
 
@@ -147,8 +291,9 @@ func (h *MasterHandler) GetSinger(ctx context.Context, req *connect.Request[prot
 
 	return connect.NewResponse(&proto_master.GetSingerResponse{
 		Singer: &proto_master.Singer{
-			Id:   int32(singer.ID),
-			Name: singer.Name,
+			Id:    int32(singer.ID),
+			Name:  singer.Name,
+			Units: toProtoSingerUnits(singer),
 		},
 	}), nil
 }
@@ -181,6 +326,9 @@ func (h *MasterHandler) CreateSinger(ctx context.Context, req *connect.Request[p
 	}
 
 	if err := h.masterUsecase.CreateSinger(ctx, req.Msg.GetName()); err != nil {
+		if errors.Is(err, usecase.ErrAlreadyExists) {
+			return nil, alreadyExistsError(err)
+		}
 		cerr := errors.WithStack(err)
 		log.Printf("%+v\n", cerr)
 		return nil, connect.NewError(connect.CodeInternal, cerr)
@@ -188,6 +336,60 @@ func (h *MasterHandler) CreateSinger(ctx context.Context, req *connect.Request[p
 
 	return connect.NewResponse(&proto_master.CreateSingerResponse{}), nil
 }
+func (h *MasterHandler) UpdateSinger(ctx context.Context, req *connect.Request[proto_master.UpdateSingerRequest]) (*connect.Response[proto_master.UpdateSingerResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.UpdateSinger(ctx, req.Msg.GetId(), req.Msg.GetName()); err != nil {
+		if errors.Is(err, usecase.ErrAlreadyExists) {
+			return nil, alreadyExistsError(err)
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.UpdateSingerResponse{}), nil
+}
+
+func toProtoSingerUnits(singer *entity.Singer) []*proto_master.Unit {
+	units := make([]*proto_master.Unit, len(singer.Units))
+	for i, unit := range singer.Units {
+		units[i] = &proto_master.Unit{
+			Id:   unit.ID,
+			Name: unit.Name,
+		}
+	}
+	return units
+}
 
 // Unit
 func (h *MasterHandler) GetUnits(ctx context.Context, req *connect.Request[proto_master.GetUnitsRequest]) (*connect.Response[proto_master.GetUnitsResponse], error) {
@@ -201,8 +403,9 @@ func (h *MasterHandler) GetUnits(ctx context.Context, req *connect.Request[proto
 	protoUnits := make([]*proto_master.Unit, len(units))
 	for i, unit := range units {
 		protoUnits[i] = &proto_master.Unit{
-			Id:   unit.ID,
-			Name: unit.Name,
+			Id:      unit.ID,
+			Name:    unit.Name,
+			Members: toProtoUnitMembers(unit),
 		}
 	}
 
@@ -226,8 +429,9 @@ func (h *MasterHandler) GetUnit(ctx context.Context, req *connect.Request[proto_
 
 	return connect.NewResponse(&proto_master.GetUnitResponse{
 		Unit: &proto_master.Unit{
-			Id:   int32(unit.ID),
-			Name: unit.Name,
+			Id:      int32(unit.ID),
+			Name:    unit.Name,
+			Members: toProtoUnitMembers(unit),
 		},
 	}), nil
 }
@@ -260,6 +464,9 @@ func (h *MasterHandler) CreateUnit(ctx context.Context, req *connect.Request[pro
 	}
 
 	if err := h.masterUsecase.CreateUnit(ctx, req.Msg.GetName()); err != nil {
+		if errors.Is(err, usecase.ErrAlreadyExists) {
+			return nil, alreadyExistsError(err)
+		}
 		cerr := errors.WithStack(err)
 		log.Printf("%+v\n", cerr)
 		return nil, connect.NewError(connect.CodeInternal, cerr)
@@ -267,9 +474,7 @@ func (h *MasterHandler) CreateUnit(ctx context.Context, req *connect.Request[pro
 
 	return connect.NewResponse(&proto_master.CreateUnitResponse{}), nil
 }
-
-// VocalPattern
-func (h *MasterHandler) CreateVocalPattern(ctx context.Context, req *connect.Request[proto_master.CreateVocalPatternRequest]) (*connect.Response[proto_master.CreateVocalPatternResponse], error) {
+func (h *MasterHandler) UpdateUnit(ctx context.Context, req *connect.Request[proto_master.UpdateUnitRequest]) (*connect.Response[proto_master.UpdateUnitResponse], error) {
 	id, ok := ctx.Value(auth.UserIDKey).(string)
 	if !ok {
 		err := errors.New("user id not found in context")
@@ -296,31 +501,109 @@ func (h *MasterHandler) CreateVocalPattern(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
 	}
 
-	if len(req.Msg.GetSingerIds()) != len(req.Msg.GetSingerPositions()) {
-		err := errors.New("singerIDsとsingerPositionの長さが一致していない")
+	if err := h.masterUsecase.UpdateUnit(ctx, req.Msg.GetId(), req.Msg.GetName()); err != nil {
+		if errors.Is(err, usecase.ErrAlreadyExists) {
+			return nil, alreadyExistsError(err)
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.UpdateUnitResponse{}), nil
+}
+
+func toProtoUnitMembers(unit *entity.Unit) []*proto_master.UnitMember {
+	members := make([]*proto_master.UnitMember, len(unit.Members))
+	for i, member := range unit.Members {
+		members[i] = &proto_master.UnitMember{
+			Id:       member.ID,
+			Name:     member.Name,
+			Position: member.Position,
+		}
+	}
+	return members
+}
+
+// UnitSinger
+func (h *MasterHandler) CreateUnitSinger(ctx context.Context, req *connect.Request[proto_master.CreateUnitSingerRequest]) (*connect.Response[proto_master.CreateUnitSingerResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
 		cerr := errors.WithStack(err)
 		log.Printf("%+v\n", cerr)
 		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
 	}
 
-	if err := h.masterUsecase.CreateVocalPattern(ctx, req.Msg.GetSongId(), req.Msg.GetName(), req.Msg.GetSingerIds(), req.Msg.GetSingerPositions()); err != nil {
-		if errors.Is(err, usecase.ErrInvalidArgument) {
+	if err := h.masterUsecase.CreateUnitSinger(ctx, req.Msg.GetUnitId(), req.Msg.GetSingerId(), req.Msg.GetPosition()); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
 			cerr := errors.WithStack(err)
 			log.Printf("%+v\n", cerr)
-			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		if errors.Is(err, usecase.ErrAlreadyExists) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeAlreadyExists, cerr)
 		}
 		cerr := errors.WithStack(err)
 		log.Printf("%+v\n", cerr)
 		return nil, connect.NewError(connect.CodeInternal, cerr)
 	}
 
-	return connect.NewResponse(&proto_master.CreateVocalPatternResponse{}), nil
+	return connect.NewResponse(&proto_master.CreateUnitSingerResponse{}), nil
 }
-
-// Song
-func (h *MasterHandler) GetSongs(ctx context.Context, req *connect.Request[proto_master.GetSongsRequest]) (*connect.Response[proto_master.GetSongsResponse], error) {
-	songs, err := h.masterUsecase.ListSongs(ctx)
+func (h *MasterHandler) UpdateUnitSinger(ctx context.Context, req *connect.Request[proto_master.UpdateUnitSingerRequest]) (*connect.Response[proto_master.UpdateUnitSingerResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
 	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.UpdateUnitSinger(ctx, req.Msg.GetUnitId(), req.Msg.GetSingerId(), req.Msg.GetPosition()); err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			cerr := errors.WithStack(err)
 			log.Printf("%+v\n", cerr)
@@ -331,154 +614,9 @@ func (h *MasterHandler) GetSongs(ctx context.Context, req *connect.Request[proto
 		return nil, connect.NewError(connect.CodeInternal, cerr)
 	}
 
-	var protoSongs []*proto_master.Song
-	for _, song := range songs {
-		var protoVocalPatterns []*proto_master.VocalPattern
-		for _, vp := range song.VocalPatterns {
-			if vp == nil {
-				continue
-			}
-			sort.Slice(vp.Singers, func(i, j int) bool {
-				return vp.Singers[i].Position < vp.Singers[j].Position
-			})
-			var protoSingers []*proto_master.Singer
-			for _, s := range vp.Singers {
-				if s == nil {
-					continue
-				}
-				protoSingers = append(protoSingers, &proto_master.Singer{
-					Id:   s.ID,
-					Name: s.Name,
-				})
-			}
-			protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
-				Id:      vp.ID,
-				Name:    vp.Name,
-				Singers: protoSingers,
-			})
-		}
-		var protoUnits []*proto_master.Unit
-		for _, u := range song.Units {
-			if u == nil {
-				continue
-			}
-			protoUnits = append(protoUnits, &proto_master.Unit{
-				Id:   u.ID,
-				Name: u.Name,
-			})
-		}
-
-		protoSongs = append(protoSongs, &proto_master.Song{
-			Id:   song.ID,
-			Name: song.Name,
-			Kana: song.Kana,
-			Lyrics: &proto_master.Artist{
-				Id:   song.Lyrics.ID,
-				Name: song.Lyrics.Name,
-				Kana: song.Lyrics.Kana,
-			},
-			Music: &proto_master.Artist{
-				Id:   song.Music.ID,
-				Name: song.Music.Name,
-				Kana: song.Music.Kana,
-			},
-			Arrangement: &proto_master.Artist{
-				Id:   song.Arrangement.ID,
-				Name: song.Arrangement.Name,
-				Kana: song.Arrangement.Kana,
-			},
-			Thumbnail:       song.Thumbnail,
-			OriginalVideo:   song.OriginalVideo,
-			ReleaseTime:     timestamppb.New(song.ReleaseTime),
-			Deleted:         song.Deleted,
-			VocalPatterns:   protoVocalPatterns,
-			Units:           protoUnits,
-			MusicVideoTypes: song.MusicVideoTypes,
-		})
-	}
-
-	return connect.NewResponse(&proto_master.GetSongsResponse{
-		Songs: protoSongs,
-	}), nil
-}
-
-func (h *MasterHandler) GetSong(ctx context.Context, req *connect.Request[proto_master.GetSongRequest]) (*connect.Response[proto_master.GetSongResponse], error) {
-	song, err := h.masterUsecase.GetSongByID(ctx, req.Msg.GetId())
-	if err != nil {
-		cerr := errors.WithStack(err)
-		log.Printf("%+v\n", cerr)
-		return nil, connect.NewError(connect.CodeInternal, cerr)
-	}
-
-	var protoSong *proto_master.Song
-	var protoVocalPatterns []*proto_master.VocalPattern
-	for _, vp := range song.VocalPatterns {
-		if vp == nil {
-			continue
-		}
-		sort.Slice(vp.Singers, func(i, j int) bool {
-			return vp.Singers[i].Position < vp.Singers[j].Position
-		})
-		var protoSingers []*proto_master.Singer
-		for _, s := range vp.Singers {
-			if s == nil {
-				continue
-			}
-			protoSingers = append(protoSingers, &proto_master.Singer{
-				Id:   s.ID,
-				Name: s.Name,
-			})
-		}
-		protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
-			Id:      vp.ID,
-			Name:    vp.Name,
-			Singers: protoSingers,
-		})
-	}
-	var protoUnits []*proto_master.Unit
-	for _, u := range song.Units {
-		if u == nil {
-			continue
-		}
-		protoUnits = append(protoUnits, &proto_master.Unit{
-			Id:   u.ID,
-			Name: u.Name,
-		})
-	}
-	protoSong = &proto_master.Song{
-		Id:   song.ID,
-		Name: song.Name,
-		Kana: song.Kana,
-		Lyrics: &proto_master.Artist{
-			Id:   song.Lyrics.ID,
-			Name: song.Lyrics.Name,
-			Kana: song.Lyrics.Kana,
-		},
-		Music: &proto_master.Artist{
-			Id:   song.Music.ID,
-			Name: song.Music.Name,
-			Kana: song.Music.Kana,
-		},
-		Arrangement: &proto_master.Artist{
-			Id:   song.Arrangement.ID,
-			Name: song.Arrangement.Name,
-			Kana: song.Arrangement.Kana,
-		},
-		Thumbnail:       song.Thumbnail,
-		OriginalVideo:   song.OriginalVideo,
-		ReleaseTime:     timestamppb.New(song.ReleaseTime),
-		Deleted:         song.Deleted,
-		VocalPatterns:   protoVocalPatterns,
-		Units:           protoUnits,
-		MusicVideoTypes: song.MusicVideoTypes,
-	}
-
-	return connect.NewResponse(&proto_master.GetSongResponse{
-		Song: protoSong,
-	}), nil
+	return connect.NewResponse(&proto_master.UpdateUnitSingerResponse{}), nil
 }
-
-func (h *MasterHandler) CreateSong(ctx context.Context, req *connect.Request[proto_master.CreateSongRequest]) (*connect.Response[proto_master.CreateSongResponse], error) {
+func (h *MasterHandler) DeleteUnitSinger(ctx context.Context, req *connect.Request[proto_master.DeleteUnitSingerRequest]) (*connect.Response[proto_master.DeleteUnitSingerResponse], error) {
 	id, ok := ctx.Value(auth.UserIDKey).(string)
 	if !ok {
 		err := errors.New("user id not found in context")
@@ -505,122 +643,47 @@ func (h *MasterHandler) CreateSong(ctx context.Context, req *connect.Request[pro
 		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
 	}
 
-	if req.Msg.GetReleaseTime() == nil {
-		err := errors.New("ReleaseTime can not nil")
-		cerr := errors.WithStack(err)
-		log.Printf("%+v\n", cerr)
-		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
-	}
-
-	if err := h.masterUsecase.CreateSong(
-		ctx, req.Msg.GetName(), req.Msg.GetKana(),
-		req.Msg.GetLyricsId(), req.Msg.GetMusicId(), req.Msg.GetArrangementId(),
-		req.Msg.GetThumbnail(), req.Msg.GetOriginalVideo(), req.Msg.GetReleaseTime().AsTime(), req.Msg.GetDeleted(),
-		req.Msg.GetUnitIds(),
-		req.Msg.GetMusicVideoTypes(),
-	); err != nil {
-		if errors.Is(err, usecase.ErrInvalidArgument) {
+	if err := h.masterUsecase.DeleteUnitSinger(ctx, req.Msg.GetUnitId(), req.Msg.GetSingerId()); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
 			cerr := errors.WithStack(err)
 			log.Printf("%+v\n", cerr)
-			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
 		}
 		cerr := errors.WithStack(err)
 		log.Printf("%+v\n", cerr)
 		return nil, connect.NewError(connect.CodeInternal, cerr)
 	}
 
-	return connect.NewResponse(&proto_master.CreateSongResponse{}), nil
+	return connect.NewResponse(&proto_master.DeleteUnitSingerResponse{}), nil
 }
 
-// Chart
-func (h *MasterHandler) GetCharts(ctx context.Context, req *connect.Request[proto_master.GetChartsRequest]) (*connect.Response[proto_master.GetChartsResponse], error) {
-	charts, err := h.masterUsecase.ListCharts(ctx)
+// VocalPattern
+func (h *MasterHandler) GetVocalPatterns(ctx context.Context, req *connect.Request[proto_master.GetVocalPatternsRequest]) (*connect.Response[proto_master.GetVocalPatternsResponse], error) {
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	vps, err := h.masterUsecase.ListVocalPatterns(ctx, req.Msg.GetSongId())
 	if err != nil {
 		cerr := errors.WithStack(err)
 		log.Printf("%+v\n", cerr)
 		return nil, connect.NewError(connect.CodeInternal, cerr)
 	}
 
-	var protoCharts []*proto_master.Chart
-	for _, chart := range charts {
-		var protoVocalPatterns []*proto_master.VocalPattern
-		for _, vp := range chart.Song.VocalPatterns {
-			if vp == nil {
-				continue
-			}
-			sort.Slice(vp.Singers, func(i, j int) bool {
-				return vp.Singers[i].Position < vp.Singers[j].Position
-			})
-			var protoSingers []*proto_master.Singer
-			for _, s := range vp.Singers {
-				if s == nil {
-					continue
-				}
-				protoSingers = append(protoSingers, &proto_master.Singer{
-					Id:   s.ID,
-					Name: s.Name,
-				})
-			}
-			protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
-				Id:      vp.ID,
-				Name:    vp.Name,
-				Singers: protoSingers,
-			})
-		}
-
-		var protoUnits []*proto_master.Unit
-		for _, u := range chart.Song.Units {
-			if u == nil {
-				continue
-			}
-			protoUnits = append(protoUnits, &proto_master.Unit{
-				Id:   u.ID,
-				Name: u.Name,
-			})
-		}
-		protoSong := proto_master.Song{
-			Id:   chart.Song.ID,
-			Name: chart.Song.Name,
-			Kana: chart.Song.Kana,
-			Lyrics: &proto_master.Artist{
-				Id:   chart.Song.Lyrics.ID,
-				Name: chart.Song.Lyrics.Name,
-				Kana: chart.Song.Lyrics.Kana,
-			},
-			Music: &proto_master.Artist{
-				Id:   chart.Song.Music.ID,
-				Name: chart.Song.Music.Name,
-				Kana: chart.Song.Music.Kana,
-			},
-			Arrangement: &proto_master.Artist{
-				Id:   chart.Song.Arrangement.ID,
-				Name: chart.Song.Arrangement.Name,
-				Kana: chart.Song.Arrangement.Kana,
-			},
-			Thumbnail:       chart.Song.Thumbnail,
-			OriginalVideo:   chart.Song.OriginalVideo,
-			ReleaseTime:     timestamppb.New(chart.Song.ReleaseTime),
-			Deleted:         chart.Song.Deleted,
-			VocalPatterns:   protoVocalPatterns,
-			Units:           protoUnits,
-			MusicVideoTypes: chart.Song.MusicVideoTypes,
-		}
-
-		protoCharts = append(protoCharts, &proto_master.Chart{
-			Id:             chart.ID,
-			Song:           &protoSong,
-			DifficultyType: chart.DifficultyType,
-			Level:          chart.Level,
-			ChartViewLink:  chart.ChartViewLink,
-		})
+	protoVocalPatterns := make([]*proto_master.VocalPattern, len(vps))
+	for i, vp := range vps {
+		protoVocalPatterns[i] = toProtoVocalPattern(vp)
 	}
 
-	return connect.NewResponse(&proto_master.GetChartsResponse{
-		Charts: protoCharts,
+	return connect.NewResponse(&proto_master.GetVocalPatternsResponse{
+		VocalPatterns: protoVocalPatterns,
 	}), nil
 }
-func (h *MasterHandler) GetChart(ctx context.Context, req *connect.Request[proto_master.GetChartRequest]) (*connect.Response[proto_master.GetChartResponse], error) {
-	chart, err := h.masterUsecase.GetChartByID(ctx, req.Msg.GetId())
+
+func (h *MasterHandler) GetVocalPattern(ctx context.Context, req *connect.Request[proto_master.GetVocalPatternRequest]) (*connect.Response[proto_master.GetVocalPatternResponse], error) {
+	vp, err := h.masterUsecase.GetVocalPatternByID(ctx, req.Msg.GetId())
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			cerr := errors.WithStack(err)
@@ -632,83 +695,12 @@ func (h *MasterHandler) GetChart(ctx context.Context, req *connect.Request[proto
 		return nil, connect.NewError(connect.CodeInternal, cerr)
 	}
 
-	var protoChart *proto_master.Chart
-	var protoVocalPatterns []*proto_master.VocalPattern
-	for _, vp := range chart.Song.VocalPatterns {
-		if vp == nil {
-			continue
-		}
-		sort.Slice(vp.Singers, func(i, j int) bool {
-			return vp.Singers[i].Position < vp.Singers[j].Position
-		})
-		var protoSingers []*proto_master.Singer
-		for _, s := range vp.Singers {
-			if s == nil {
-				continue
-			}
-			protoSingers = append(protoSingers, &proto_master.Singer{
-				Id:   s.ID,
-				Name: s.Name,
-			})
-		}
-		protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
-			Id:      vp.ID,
-			Name:    vp.Name,
-			Singers: protoSingers,
-		})
-	}
-
-	var protoUnits []*proto_master.Unit
-	for _, u := range chart.Song.Units {
-		if u == nil {
-			continue
-		}
-		protoUnits = append(protoUnits, &proto_master.Unit{
-			Id:   u.ID,
-			Name: u.Name,
-		})
-	}
-	protoSong := proto_master.Song{
-		Id:   chart.Song.ID,
-		Name: chart.Song.Name,
-		Kana: chart.Song.Kana,
-		Lyrics: &proto_master.Artist{
-			Id:   chart.Song.Lyrics.ID,
-			Name: chart.Song.Lyrics.Name,
-			Kana: chart.Song.Lyrics.Kana,
-		},
-		Music: &proto_master.Artist{
-			Id:   chart.Song.Music.ID,
-			Name: chart.Song.Music.Name,
-			Kana: chart.Song.Music.Kana,
-		},
-		Arrangement: &proto_master.Artist{
-			Id:   chart.Song.Arrangement.ID,
-			Name: chart.Song.Arrangement.Name,
-			Kana: chart.Song.Arrangement.Kana,
-		},
-		Thumbnail:       chart.Song.Thumbnail,
-		OriginalVideo:   chart.Song.OriginalVideo,
-		ReleaseTime:     timestamppb.New(chart.Song.ReleaseTime),
-		Deleted:         chart.Song.Deleted,
-		VocalPatterns:   protoVocalPatterns,
-		Units:           protoUnits,
-		MusicVideoTypes: chart.Song.MusicVideoTypes,
-	}
-
-	protoChart = &proto_master.Chart{
-		Id:             chart.ID,
-		Song:           &protoSong,
-		DifficultyType: chart.DifficultyType,
-		Level:          chart.Level,
-		ChartViewLink:  chart.ChartViewLink,
-	}
-
-	return connect.NewResponse(&proto_master.GetChartResponse{
-		Chart: protoChart,
+	return connect.NewResponse(&proto_master.GetVocalPatternResponse{
+		VocalPattern: toProtoVocalPattern(vp),
 	}), nil
 }
-func (h *MasterHandler) CreateChart(ctx context.Context, req *connect.Request[proto_master.CreateChartRequest]) (*connect.Response[proto_master.CreateChartResponse], error) {
+
+func (h *MasterHandler) CreateVocalPattern(ctx context.Context, req *connect.Request[proto_master.CreateVocalPatternRequest]) (*connect.Response[proto_master.CreateVocalPatternResponse], error) {
 	id, ok := ctx.Value(auth.UserIDKey).(string)
 	if !ok {
 		err := errors.New("user id not found in context")
@@ -735,9 +727,14 @@ func (h *MasterHandler) CreateChart(ctx context.Context, req *connect.Request[pr
 		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
 	}
 
-	if err := h.masterUsecase.CreateChart(
-		ctx, req.Msg.GetSongId(), int32(req.Msg.GetDifficultyType()), req.Msg.GetLevel(), req.Msg.GetChartViewLink(),
-	); err != nil {
+	if len(req.Msg.GetSingerIds()) != len(req.Msg.GetSingerPositions()) {
+		err := errors.New("singerIDsとsingerPositionの長さが一致していない")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.CreateVocalPattern(ctx, req.Msg.GetSongId(), req.Msg.GetName(), req.Msg.GetSingerIds(), req.Msg.GetSingerPositions()); err != nil {
 		if errors.Is(err, usecase.ErrInvalidArgument) {
 			cerr := errors.WithStack(err)
 			log.Printf("%+v\n", cerr)
@@ -748,5 +745,1453 @@ func (h *MasterHandler) CreateChart(ctx context.Context, req *connect.Request[pr
 		return nil, connect.NewError(connect.CodeInternal, cerr)
 	}
 
-	return connect.NewResponse(&proto_master.CreateChartResponse{}), nil
+	return connect.NewResponse(&proto_master.CreateVocalPatternResponse{}), nil
+}
+func (h *MasterHandler) UpdateVocalPattern(ctx context.Context, req *connect.Request[proto_master.UpdateVocalPatternRequest]) (*connect.Response[proto_master.UpdateVocalPatternResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if len(req.Msg.GetSingerIds()) != len(req.Msg.GetSingerPositions()) {
+		err := errors.New("singerIDsとsingerPositionの長さが一致していない")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.UpdateVocalPattern(ctx, req.Msg.GetId(), req.Msg.GetName(), req.Msg.GetSingerIds(), req.Msg.GetSingerPositions()); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.UpdateVocalPatternResponse{}), nil
+}
+
+func (h *MasterHandler) DeleteVocalPattern(ctx context.Context, req *connect.Request[proto_master.DeleteVocalPatternRequest]) (*connect.Response[proto_master.DeleteVocalPatternResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.DeleteVocalPattern(ctx, req.Msg.GetId()); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.DeleteVocalPatternResponse{}), nil
+}
+
+// 歌手をPositionの順にする キャッシュの中身を並べ替えないようにコピーしてから並べる
+func singersByPosition(singers []*entity.Singer) []*entity.Singer {
+	sorted := slices.Clone(singers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+	return sorted
+}
+
+func toProtoVocalPattern(vp *entity.VocalPattern) *proto_master.VocalPattern {
+	protoSingers := make([]*proto_master.Singer, 0, len(vp.Singers))
+	for _, s := range singersByPosition(vp.Singers) {
+		if s == nil {
+			continue
+		}
+		protoSingers = append(protoSingers, &proto_master.Singer{
+			Id:   s.ID,
+			Name: s.Name,
+		})
+	}
+
+	return &proto_master.VocalPattern{
+		Id:      vp.ID,
+		SongId:  vp.SongID,
+		Name:    vp.Name,
+		Singers: protoSingers,
+	}
+}
+func toProtoSong(song *entity.Song) *proto_master.Song {
+	var protoVocalPatterns []*proto_master.VocalPattern
+	for _, vp := range song.VocalPatterns {
+		if vp == nil {
+			continue
+		}
+		protoVocalPatterns = append(protoVocalPatterns, toProtoVocalPattern(vp))
+	}
+	var protoUnits []*proto_master.Unit
+	for _, u := range song.Units {
+		if u == nil {
+			continue
+		}
+		protoUnits = append(protoUnits, &proto_master.Unit{
+			Id:   u.ID,
+			Name: u.Name,
+		})
+	}
+
+	return &proto_master.Song{
+		Id:              song.ID,
+		Name:            song.Name,
+		Kana:            song.Kana,
+		Lyrics:          toProtoFirstCredit(song, enums.CreditRole_CREDIT_ROLE_LYRICS),
+		Music:           toProtoFirstCredit(song, enums.CreditRole_CREDIT_ROLE_MUSIC),
+		Arrangement:     toProtoFirstCredit(song, enums.CreditRole_CREDIT_ROLE_ARRANGEMENT),
+		Thumbnail:       song.Thumbnail,
+		OriginalVideo:   song.OriginalVideo,
+		ReleaseTime:     timestamppb.New(song.ReleaseTime),
+		Deleted:         song.Deleted,
+		VocalPatterns:   protoVocalPatterns,
+		Units:           protoUnits,
+		MusicVideoTypes: song.MusicVideoTypes,
+		Credits:         toProtoSongCredits(song),
+	}
+}
+
+// 以前のlyrics, music, arrangementとの互換のため、各役割の先頭のクレジットを返す
+func toProtoFirstCredit(song *entity.Song, role enums.CreditRole) *proto_master.Artist {
+	for _, c := range song.Credits {
+		if c.Role == role {
+			return &proto_master.Artist{
+				Id:   c.Artist.ID,
+				Name: c.Artist.Name,
+				Kana: c.Artist.Kana,
+			}
+		}
+	}
+	return &proto_master.Artist{}
+}
+
+func toProtoSongCredits(song *entity.Song) []*proto_master.SongCredit {
+	credits := make([]*proto_master.SongCredit, len(song.Credits))
+	for i, c := range song.Credits {
+		credits[i] = &proto_master.SongCredit{
+			Artist: &proto_master.Artist{
+				Id:   c.Artist.ID,
+				Name: c.Artist.Name,
+				Kana: c.Artist.Kana,
+			},
+			Role:     c.Role,
+			Position: c.Position,
+		}
+	}
+	return credits
+}
+
+func fromProtoSongCredits(inputs []*proto_master.SongCreditInput) []*entity.SongCredit {
+	credits := make([]*entity.SongCredit, len(inputs))
+	for i, in := range inputs {
+		credits[i] = &entity.SongCredit{
+			Artist: entity.Artist{ID: in.GetArtistId()},
+			Role:   in.GetRole(),
+		}
+	}
+	return credits
+}
+
+// include_deletedなど管理者だけが指定できる項目の確認
+func (h *MasterHandler) requireAdmin(ctx context.Context) error {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	return nil
+}
+
+// 公開前の曲を見せてよいかの確認 未ログインは管理者ではない扱い
+func (h *MasterHandler) isAdmin(ctx context.Context) (bool, error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		return false, nil
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return false, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return isAdmin, nil
+}
+
+// 管理者以外には公開前の曲・譜面は無いものとして返す
+func (h *MasterHandler) hideUnreleased(ctx context.Context, releaseTime time.Time) error {
+	if usecase.IsReleased(releaseTime, time.Now()) {
+		return nil
+	}
+	isAdmin, err := h.isAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		err := errors.New("not released yet")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return connect.NewError(connect.CodeNotFound, cerr)
+	}
+
+	return nil
+}
+
+// Song
+func (h *MasterHandler) GetSongs(ctx context.Context, req *connect.Request[proto_master.GetSongsRequest]) (*connect.Response[proto_master.GetSongsResponse], error) {
+	if req.Msg.GetIncludeDeleted() {
+		if err := h.requireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	isAdmin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	songs, err := h.masterUsecase.ListSongs(ctx, req.Msg.GetIncludeDeleted(), isAdmin)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	var protoSongs []*proto_master.Song
+	for _, song := range songs {
+		var protoVocalPatterns []*proto_master.VocalPattern
+		for _, vp := range song.VocalPatterns {
+			if vp == nil {
+				continue
+			}
+			var protoSingers []*proto_master.Singer
+			for _, s := range singersByPosition(vp.Singers) {
+				if s == nil {
+					continue
+				}
+				protoSingers = append(protoSingers, &proto_master.Singer{
+					Id:   s.ID,
+					Name: s.Name,
+				})
+			}
+			protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
+				Id:      vp.ID,
+				SongId:  vp.SongID,
+				Name:    vp.Name,
+				Singers: protoSingers,
+			})
+		}
+		var protoUnits []*proto_master.Unit
+		for _, u := range song.Units {
+			if u == nil {
+				continue
+			}
+			protoUnits = append(protoUnits, &proto_master.Unit{
+				Id:   u.ID,
+				Name: u.Name,
+			})
+		}
+
+		protoSongs = append(protoSongs, &proto_master.Song{
+			Id:              song.ID,
+			Name:            song.Name,
+			Kana:            song.Kana,
+			Lyrics:          toProtoFirstCredit(song, enums.CreditRole_CREDIT_ROLE_LYRICS),
+			Music:           toProtoFirstCredit(song, enums.CreditRole_CREDIT_ROLE_MUSIC),
+			Arrangement:     toProtoFirstCredit(song, enums.CreditRole_CREDIT_ROLE_ARRANGEMENT),
+			Thumbnail:       song.Thumbnail,
+			OriginalVideo:   song.OriginalVideo,
+			ReleaseTime:     timestamppb.New(song.ReleaseTime),
+			Deleted:         song.Deleted,
+			VocalPatterns:   protoVocalPatterns,
+			Units:           protoUnits,
+			MusicVideoTypes: song.MusicVideoTypes,
+			Credits:         toProtoSongCredits(song),
+		})
+	}
+
+	return connect.NewResponse(&proto_master.GetSongsResponse{
+		Songs: protoSongs,
+	}), nil
+}
+
+func (h *MasterHandler) GetSong(ctx context.Context, req *connect.Request[proto_master.GetSongRequest]) (*connect.Response[proto_master.GetSongResponse], error) {
+	song, err := h.masterUsecase.GetSongByID(ctx, req.Msg.GetId())
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if err := h.hideUnreleased(ctx, song.ReleaseTime); err != nil {
+		return nil, err
+	}
+
+	var protoSong *proto_master.Song
+	var protoVocalPatterns []*proto_master.VocalPattern
+	for _, vp := range song.VocalPatterns {
+		if vp == nil {
+			continue
+		}
+		var protoSingers []*proto_master.Singer
+		for _, s := range singersByPosition(vp.Singers) {
+			if s == nil {
+				continue
+			}
+			protoSingers = append(protoSingers, &proto_master.Singer{
+				Id:   s.ID,
+				Name: s.Name,
+			})
+		}
+		protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
+			Id:      vp.ID,
+			SongId:  vp.SongID,
+			Name:    vp.Name,
+			Singers: protoSingers,
+		})
+	}
+	var protoUnits []*proto_master.Unit
+	for _, u := range song.Units {
+		if u == nil {
+			continue
+		}
+		protoUnits = append(protoUnits, &proto_master.Unit{
+			Id:   u.ID,
+			Name: u.Name,
+		})
+	}
+	protoSong = &proto_master.Song{
+		Id:              song.ID,
+		Name:            song.Name,
+		Kana:            song.Kana,
+		Lyrics:          toProtoFirstCredit(song, enums.CreditRole_CREDIT_ROLE_LYRICS),
+		Music:           toProtoFirstCredit(song, enums.CreditRole_CREDIT_ROLE_MUSIC),
+		Arrangement:     toProtoFirstCredit(song, enums.CreditRole_CREDIT_ROLE_ARRANGEMENT),
+		Thumbnail:       song.Thumbnail,
+		OriginalVideo:   song.OriginalVideo,
+		ReleaseTime:     timestamppb.New(song.ReleaseTime),
+		Deleted:         song.Deleted,
+		VocalPatterns:   protoVocalPatterns,
+		Units:           protoUnits,
+		MusicVideoTypes: song.MusicVideoTypes,
+		Credits:         toProtoSongCredits(song),
+	}
+
+	return connect.NewResponse(&proto_master.GetSongResponse{
+		Song: protoSong,
+	}), nil
+}
+
+func (h *MasterHandler) CreateSong(ctx context.Context, req *connect.Request[proto_master.CreateSongRequest]) (*connect.Response[proto_master.CreateSongResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if req.Msg.GetReleaseTime() == nil {
+		err := errors.New("ReleaseTime can not nil")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.CreateSong(
+		ctx, req.Msg.GetName(), req.Msg.GetKana(),
+		fromProtoSongCredits(req.Msg.GetCredits()),
+		req.Msg.GetThumbnail(), req.Msg.GetOriginalVideo(), req.Msg.GetReleaseTime().AsTime(), req.Msg.GetDeleted(),
+		req.Msg.GetUnitIds(),
+		req.Msg.GetMusicVideoTypes(),
+	); err != nil {
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.CreateSongResponse{}), nil
+}
+func (h *MasterHandler) UpdateSong(ctx context.Context, req *connect.Request[proto_master.UpdateSongRequest]) (*connect.Response[proto_master.UpdateSongResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if req.Msg.GetReleaseTime() == nil {
+		err := errors.New("ReleaseTime can not nil")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.UpdateSong(
+		ctx, req.Msg.GetId(), req.Msg.GetName(), req.Msg.GetKana(),
+		fromProtoSongCredits(req.Msg.GetCredits()),
+		req.Msg.GetThumbnail(), req.Msg.GetOriginalVideo(), req.Msg.GetReleaseTime().AsTime(), req.Msg.GetDeleted(),
+		req.Msg.GetUnitIds(),
+		req.Msg.GetMusicVideoTypes(),
+	); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.UpdateSongResponse{}), nil
+}
+
+func (h *MasterHandler) DeleteSong(ctx context.Context, req *connect.Request[proto_master.DeleteSongRequest]) (*connect.Response[proto_master.DeleteSongResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.DeleteSong(ctx, req.Msg.GetId()); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		if errors.Is(err, usecase.ErrMasterInUse) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeFailedPrecondition, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.DeleteSongResponse{}), nil
+}
+func (h *MasterHandler) SearchSongs(ctx context.Context, req *connect.Request[proto_master.SearchSongsRequest]) (*connect.Response[proto_master.SearchSongsResponse], error) {
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+	if req.Msg.GetIncludeDeleted() {
+		if err := h.requireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	isAdmin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := repository.SongSearchCondition{
+		Query:             req.Msg.GetQuery(),
+		LyricsID:          req.Msg.GetLyricsId(),
+		MusicID:           req.Msg.GetMusicId(),
+		ArrangementID:     req.Msg.GetArrangementId(),
+		UnitID:            req.Msg.GetUnitId(),
+		SingerID:          req.Msg.GetSingerId(),
+		MusicVideoType:    req.Msg.GetMusicVideoType(),
+		IncludeDeleted:    req.Msg.GetIncludeDeleted(),
+		IncludeUnreleased: isAdmin,
+	}
+	if req.Msg.GetReleaseFrom() != nil {
+		cond.ReleaseFrom = req.Msg.GetReleaseFrom().AsTime()
+	}
+	if req.Msg.GetReleaseTo() != nil {
+		cond.ReleaseTo = req.Msg.GetReleaseTo().AsTime()
+	}
+
+	songs, nextPageToken, err := h.masterUsecase.SearchSongs(ctx, cond, req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	protoSongs := make([]*proto_master.Song, len(songs))
+	for i, song := range songs {
+		protoSongs[i] = toProtoSong(song)
+	}
+
+	return connect.NewResponse(&proto_master.SearchSongsResponse{
+		Songs:         protoSongs,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+func (h *MasterHandler) ListSongReleases(ctx context.Context, req *connect.Request[proto_master.ListSongReleasesRequest]) (*connect.Response[proto_master.ListSongReleasesResponse], error) {
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	isAdmin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	months, err := h.masterUsecase.ListSongReleases(ctx, req.Msg.GetUnitId(), isAdmin)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	protoMonths := make([]*proto_master.SongReleaseMonth, len(months))
+	for i, month := range months {
+		protoSongs := make([]*proto_master.Song, len(month.Songs))
+		for j, song := range month.Songs {
+			protoSongs[j] = toProtoSong(song)
+		}
+		protoMonths[i] = &proto_master.SongReleaseMonth{
+			Year:  month.Year,
+			Month: month.Month,
+			Songs: protoSongs,
+		}
+	}
+
+	return connect.NewResponse(&proto_master.ListSongReleasesResponse{
+		Months: protoMonths,
+	}), nil
+}
+
+// Chart
+func (h *MasterHandler) GetCharts(ctx context.Context, req *connect.Request[proto_master.GetChartsRequest]) (*connect.Response[proto_master.GetChartsResponse], error) {
+	if req.Msg.GetIncludeDeleted() {
+		if err := h.requireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	isAdmin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	charts, err := h.masterUsecase.ListCharts(ctx, usecase.ChartListCondition{
+		IncludeDeleted:     req.Msg.GetIncludeDeleted(),
+		IncludeUnreleased:  isAdmin,
+		MinNoteCount:       req.Msg.GetMinNoteCount(),
+		MaxNoteCount:       req.Msg.GetMaxNoteCount(),
+		MinBPM:             req.Msg.GetMinBpm(),
+		MaxBPM:             req.Msg.GetMaxBpm(),
+		MinDurationSeconds: req.Msg.GetMinDurationSeconds(),
+		MaxDurationSeconds: req.Msg.GetMaxDurationSeconds(),
+		MinConstant:        req.Msg.GetMinConstant(),
+		MaxConstant:        req.Msg.GetMaxConstant(),
+		SortKey:            req.Msg.GetSortKey(),
+		Descending:         req.Msg.GetDescending(),
+	})
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	var protoCharts []*proto_master.Chart
+	for _, chart := range charts {
+		var protoVocalPatterns []*proto_master.VocalPattern
+		for _, vp := range chart.Song.VocalPatterns {
+			if vp == nil {
+				continue
+			}
+			var protoSingers []*proto_master.Singer
+			for _, s := range singersByPosition(vp.Singers) {
+				if s == nil {
+					continue
+				}
+				protoSingers = append(protoSingers, &proto_master.Singer{
+					Id:   s.ID,
+					Name: s.Name,
+				})
+			}
+			protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
+				Id:      vp.ID,
+				SongId:  vp.SongID,
+				Name:    vp.Name,
+				Singers: protoSingers,
+			})
+		}
+
+		var protoUnits []*proto_master.Unit
+		for _, u := range chart.Song.Units {
+			if u == nil {
+				continue
+			}
+			protoUnits = append(protoUnits, &proto_master.Unit{
+				Id:   u.ID,
+				Name: u.Name,
+			})
+		}
+		protoSong := proto_master.Song{
+			Id:              chart.Song.ID,
+			Name:            chart.Song.Name,
+			Kana:            chart.Song.Kana,
+			Lyrics:          toProtoFirstCredit(&chart.Song, enums.CreditRole_CREDIT_ROLE_LYRICS),
+			Music:           toProtoFirstCredit(&chart.Song, enums.CreditRole_CREDIT_ROLE_MUSIC),
+			Arrangement:     toProtoFirstCredit(&chart.Song, enums.CreditRole_CREDIT_ROLE_ARRANGEMENT),
+			Thumbnail:       chart.Song.Thumbnail,
+			OriginalVideo:   chart.Song.OriginalVideo,
+			ReleaseTime:     timestamppb.New(chart.Song.ReleaseTime),
+			Deleted:         chart.Song.Deleted,
+			VocalPatterns:   protoVocalPatterns,
+			Units:           protoUnits,
+			MusicVideoTypes: chart.Song.MusicVideoTypes,
+			Credits:         toProtoSongCredits(&chart.Song),
+		}
+
+		protoCharts = append(protoCharts, &proto_master.Chart{
+			Id:              chart.ID,
+			Song:            &protoSong,
+			DifficultyType:  chart.DifficultyType,
+			Level:           chart.Level,
+			ChartViewLink:   chart.ChartViewLink,
+			NoteCount:       chart.Metadata.NoteCount,
+			BpmMin:          chart.Metadata.BPMMin,
+			BpmMax:          chart.Metadata.BPMMax,
+			DurationSeconds: chart.Metadata.DurationSeconds,
+			Constant:        chart.Metadata.Constant,
+		})
+	}
+
+	return connect.NewResponse(&proto_master.GetChartsResponse{
+		Charts: protoCharts,
+	}), nil
+}
+func (h *MasterHandler) GetChart(ctx context.Context, req *connect.Request[proto_master.GetChartRequest]) (*connect.Response[proto_master.GetChartResponse], error) {
+	var chart *entity.Chart
+	var err error
+	if req.Msg.GetAsOf() != nil {
+		chart, err = h.masterUsecase.GetChartByIDAsOf(ctx, req.Msg.GetId(), req.Msg.GetAsOf().AsTime())
+	} else {
+		chart, err = h.masterUsecase.GetChartByID(ctx, req.Msg.GetId())
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if err := h.hideUnreleased(ctx, chart.Song.ReleaseTime); err != nil {
+		return nil, err
+	}
+
+	var protoChart *proto_master.Chart
+	var protoVocalPatterns []*proto_master.VocalPattern
+	for _, vp := range chart.Song.VocalPatterns {
+		if vp == nil {
+			continue
+		}
+		var protoSingers []*proto_master.Singer
+		for _, s := range singersByPosition(vp.Singers) {
+			if s == nil {
+				continue
+			}
+			protoSingers = append(protoSingers, &proto_master.Singer{
+				Id:   s.ID,
+				Name: s.Name,
+			})
+		}
+		protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
+			Id:      vp.ID,
+			SongId:  vp.SongID,
+			Name:    vp.Name,
+			Singers: protoSingers,
+		})
+	}
+
+	var protoUnits []*proto_master.Unit
+	for _, u := range chart.Song.Units {
+		if u == nil {
+			continue
+		}
+		protoUnits = append(protoUnits, &proto_master.Unit{
+			Id:   u.ID,
+			Name: u.Name,
+		})
+	}
+	protoSong := proto_master.Song{
+		Id:              chart.Song.ID,
+		Name:            chart.Song.Name,
+		Kana:            chart.Song.Kana,
+		Lyrics:          toProtoFirstCredit(&chart.Song, enums.CreditRole_CREDIT_ROLE_LYRICS),
+		Music:           toProtoFirstCredit(&chart.Song, enums.CreditRole_CREDIT_ROLE_MUSIC),
+		Arrangement:     toProtoFirstCredit(&chart.Song, enums.CreditRole_CREDIT_ROLE_ARRANGEMENT),
+		Thumbnail:       chart.Song.Thumbnail,
+		OriginalVideo:   chart.Song.OriginalVideo,
+		ReleaseTime:     timestamppb.New(chart.Song.ReleaseTime),
+		Deleted:         chart.Song.Deleted,
+		VocalPatterns:   protoVocalPatterns,
+		Units:           protoUnits,
+		MusicVideoTypes: chart.Song.MusicVideoTypes,
+		Credits:         toProtoSongCredits(&chart.Song),
+	}
+
+	protoChart = &proto_master.Chart{
+		Id:              chart.ID,
+		Song:            &protoSong,
+		DifficultyType:  chart.DifficultyType,
+		Level:           chart.Level,
+		ChartViewLink:   chart.ChartViewLink,
+		NoteCount:       chart.Metadata.NoteCount,
+		BpmMin:          chart.Metadata.BPMMin,
+		BpmMax:          chart.Metadata.BPMMax,
+		DurationSeconds: chart.Metadata.DurationSeconds,
+		Constant:        chart.Metadata.Constant,
+	}
+
+	return connect.NewResponse(&proto_master.GetChartResponse{
+		Chart: protoChart,
+	}), nil
+}
+func (h *MasterHandler) CreateChart(ctx context.Context, req *connect.Request[proto_master.CreateChartRequest]) (*connect.Response[proto_master.CreateChartResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.CreateChart(
+		ctx, req.Msg.GetSongId(), int32(req.Msg.GetDifficultyType()), req.Msg.GetLevel(), req.Msg.GetChartViewLink(),
+		entity.ChartMetadata{
+			NoteCount:       req.Msg.GetNoteCount(),
+			BPMMin:          req.Msg.GetBpmMin(),
+			BPMMax:          req.Msg.GetBpmMax(),
+			DurationSeconds: req.Msg.GetDurationSeconds(),
+			Constant:        req.Msg.GetConstant(),
+		},
+	); err != nil {
+		if errors.Is(err, usecase.ErrAlreadyExists) {
+			return nil, alreadyExistsError(err)
+		}
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.CreateChartResponse{}), nil
+}
+func (h *MasterHandler) UpdateChart(ctx context.Context, req *connect.Request[proto_master.UpdateChartRequest]) (*connect.Response[proto_master.UpdateChartResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	var levelEffectiveFrom time.Time
+	if req.Msg.GetLevelEffectiveFrom() != nil {
+		levelEffectiveFrom = req.Msg.GetLevelEffectiveFrom().AsTime()
+	}
+
+	if err := h.masterUsecase.UpdateChart(
+		ctx, req.Msg.GetId(), req.Msg.GetSongId(), int32(req.Msg.GetDifficultyType()), req.Msg.GetLevel(), req.Msg.GetChartViewLink(),
+		entity.ChartMetadata{
+			NoteCount:       req.Msg.GetNoteCount(),
+			BPMMin:          req.Msg.GetBpmMin(),
+			BPMMax:          req.Msg.GetBpmMax(),
+			DurationSeconds: req.Msg.GetDurationSeconds(),
+			Constant:        req.Msg.GetConstant(),
+		},
+		levelEffectiveFrom,
+	); err != nil {
+		if errors.Is(err, usecase.ErrAlreadyExists) {
+			return nil, alreadyExistsError(err)
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.UpdateChartResponse{}), nil
+}
+
+func (h *MasterHandler) DeleteChart(ctx context.Context, req *connect.Request[proto_master.DeleteChartRequest]) (*connect.Response[proto_master.DeleteChartResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if err := h.masterUsecase.DeleteChart(ctx, req.Msg.GetId()); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		if errors.Is(err, usecase.ErrMasterInUse) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeFailedPrecondition, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.DeleteChartResponse{}), nil
+}
+func (h *MasterHandler) SearchCharts(ctx context.Context, req *connect.Request[proto_master.SearchChartsRequest]) (*connect.Response[proto_master.SearchChartsResponse], error) {
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+	if req.Msg.GetIncludeDeleted() {
+		if err := h.requireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	isAdmin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cond := repository.ChartSearchCondition{
+		Query:             req.Msg.GetQuery(),
+		DifficultyTypes:   req.Msg.GetDifficultyTypes(),
+		MinLevel:          req.Msg.GetMinLevel(),
+		MaxLevel:          req.Msg.GetMaxLevel(),
+		UnitIDs:           req.Msg.GetUnitIds(),
+		SingerIDs:         req.Msg.GetSingerIds(),
+		IncludeDeleted:    req.Msg.GetIncludeDeleted(),
+		IncludeUnreleased: isAdmin,
+	}
+	if req.Msg.GetReleaseFrom() != nil {
+		cond.ReleaseFrom = req.Msg.GetReleaseFrom().AsTime()
+	}
+	if req.Msg.GetReleaseTo() != nil {
+		cond.ReleaseTo = req.Msg.GetReleaseTo().AsTime()
+	}
+
+	charts, nextPageToken, totalCount, err := h.masterUsecase.SearchCharts(ctx, cond, req.Msg.GetPageSize(), req.Msg.GetPageToken())
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	protoCharts := make([]*proto_master.Chart, len(charts))
+	for i, chart := range charts {
+		protoCharts[i] = &proto_master.Chart{
+			Id:              chart.ID,
+			Song:            toProtoSong(&chart.Song),
+			DifficultyType:  chart.DifficultyType,
+			Level:           chart.Level,
+			ChartViewLink:   chart.ChartViewLink,
+			NoteCount:       chart.Metadata.NoteCount,
+			BpmMin:          chart.Metadata.BPMMin,
+			BpmMax:          chart.Metadata.BPMMax,
+			DurationSeconds: chart.Metadata.DurationSeconds,
+			Constant:        chart.Metadata.Constant,
+		}
+	}
+
+	return connect.NewResponse(&proto_master.SearchChartsResponse{
+		Charts:        protoCharts,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}), nil
+}
+func (h *MasterHandler) GetChartHistory(ctx context.Context, req *connect.Request[proto_master.GetChartHistoryRequest]) (*connect.Response[proto_master.GetChartHistoryResponse], error) {
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	histories, err := h.masterUsecase.ListChartLevelHistory(ctx, req.Msg.GetChartId())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	protoHistories := make([]*proto_master.ChartLevelHistory, len(histories))
+	for i, history := range histories {
+		protoHistories[i] = &proto_master.ChartLevelHistory{
+			Level:         history.Level,
+			EffectiveFrom: timestamppb.New(history.EffectiveFrom),
+			CreatedAt:     timestamppb.New(history.CreatedAt),
+		}
+	}
+
+	return connect.NewResponse(&proto_master.GetChartHistoryResponse{
+		Histories: protoHistories,
+	}), nil
+}
+
+// MasterImport
+func (h *MasterHandler) ImportMaster(ctx context.Context, req *connect.Request[proto_master.ImportMasterRequest]) (*connect.Response[proto_master.ImportMasterResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	var bundle *masterbundle.Bundle
+	switch req.Msg.GetFormat() {
+	case enums.MasterBundleFormat_MASTER_BUNDLE_FORMAT_JSON:
+		bundle, err = masterbundle.DecodeJSON(bytes.NewReader(req.Msg.GetData()))
+	case enums.MasterBundleFormat_MASTER_BUNDLE_FORMAT_CSV_ZIP:
+		bundle, err = masterbundle.DecodeCSVZip(req.Msg.GetData())
+	}
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	changes, err := h.masterUsecase.ImportMaster(ctx, bundle, req.Msg.GetDryRun())
+	if err != nil {
+		if errors.Is(err, usecase.ErrAlreadyExists) {
+			return nil, alreadyExistsError(err)
+		}
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	protoChanges := make([]*proto_master.MasterChange, len(changes))
+	for i, change := range changes {
+		protoChanges[i] = toProtoMasterChange(change)
+	}
+
+	return connect.NewResponse(&proto_master.ImportMasterResponse{
+		Changes: protoChanges,
+		Applied: !req.Msg.GetDryRun() && len(changes) > 0,
+	}), nil
+}
+
+func (h *MasterHandler) ExportMaster(ctx context.Context, req *connect.Request[proto_master.ExportMasterRequest]) (*connect.Response[proto_master.ExportMasterResponse], error) {
+	id, ok := ctx.Value(auth.UserIDKey).(string)
+	if !ok {
+		err := errors.New("user id not found in context")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeUnauthenticated, cerr)
+	}
+	isAdmin, err := h.userUsecase.IsAdmin(ctx, id)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+	if !isAdmin {
+		err := errors.New("permission denied: not admin")
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodePermissionDenied, cerr)
+	}
+
+	bundle, err := h.masterUsecase.ExportMaster(ctx)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	var buf bytes.Buffer
+	if err := masterbundle.EncodeJSON(&buf, bundle); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.ExportMasterResponse{
+		Data: buf.Bytes(),
+	}), nil
+}
+
+func toProtoMasterChange(change *entity.MasterChange) *proto_master.MasterChange {
+	return &proto_master.MasterChange{
+		Kind:      change.Kind,
+		Key:       change.Key,
+		Operation: toProtoMasterChangeOperation(change.Operation),
+		Fields:    change.Fields,
+	}
+}
+
+func toProtoMasterChangeOperation(operation entity.MasterChangeOperation) enums.MasterChangeOperation {
+	switch operation {
+	case entity.MasterChangeOperationInsert:
+		return enums.MasterChangeOperation_MASTER_CHANGE_OPERATION_INSERT
+	case entity.MasterChangeOperationUpdate:
+		return enums.MasterChangeOperation_MASTER_CHANGE_OPERATION_UPDATE
+	case entity.MasterChangeOperationDelete:
+		return enums.MasterChangeOperation_MASTER_CHANGE_OPERATION_DELETE
+	}
+	return enums.MasterChangeOperation_MASTER_CHANGE_OPERATION_UNSPECIFIED
+}
+
+// MasterRevision
+func (h *MasterHandler) GetMasterVersion(ctx context.Context, req *connect.Request[proto_master.GetMasterVersionRequest]) (*connect.Response[proto_master.GetMasterVersionResponse], error) {
+	revision, err := h.masterUsecase.GetMasterVersion(ctx)
+	if err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	return connect.NewResponse(&proto_master.GetMasterVersionResponse{
+		Revision: revision,
+	}), nil
+}
+
+func (h *MasterHandler) GetMasterDelta(ctx context.Context, req *connect.Request[proto_master.GetMasterDeltaRequest]) (*connect.Response[proto_master.GetMasterDeltaResponse], error) {
+	if err := req.Msg.Validate(); err != nil {
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+	}
+
+	if req.Msg.GetIncludeDeleted() {
+		if err := h.requireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	isAdmin, err := h.isAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delta, err := h.masterUsecase.GetMasterDelta(ctx, req.Msg.GetSinceRevision(), req.Msg.GetIncludeDeleted(), isAdmin)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidArgument) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
+		}
+		cerr := errors.WithStack(err)
+		log.Printf("%+v\n", cerr)
+		return nil, connect.NewError(connect.CodeInternal, cerr)
+	}
+
+	protoArtists := make([]*proto_master.Artist, len(delta.Artists))
+	for i, artist := range delta.Artists {
+		protoArtists[i] = &proto_master.Artist{
+			Id:   artist.ID,
+			Name: artist.Name,
+			Kana: artist.Kana,
+		}
+	}
+	protoSingers := make([]*proto_master.Singer, len(delta.Singers))
+	for i, singer := range delta.Singers {
+		protoSingers[i] = &proto_master.Singer{
+			Id:    singer.ID,
+			Name:  singer.Name,
+			Units: toProtoSingerUnits(singer),
+		}
+	}
+	protoUnits := make([]*proto_master.Unit, len(delta.Units))
+	for i, unit := range delta.Units {
+		protoUnits[i] = &proto_master.Unit{
+			Id:      unit.ID,
+			Name:    unit.Name,
+			Members: toProtoUnitMembers(unit),
+		}
+	}
+	protoSongs := make([]*proto_master.Song, len(delta.Songs))
+	for i, song := range delta.Songs {
+		protoSongs[i] = toProtoSong(song)
+	}
+	protoCharts := make([]*proto_master.Chart, len(delta.Charts))
+	for i, chart := range delta.Charts {
+		protoCharts[i] = &proto_master.Chart{
+			Id:              chart.ID,
+			Song:            toProtoSong(&chart.Song),
+			DifficultyType:  chart.DifficultyType,
+			Level:           chart.Level,
+			ChartViewLink:   chart.ChartViewLink,
+			NoteCount:       chart.Metadata.NoteCount,
+			BpmMin:          chart.Metadata.BPMMin,
+			BpmMax:          chart.Metadata.BPMMax,
+			DurationSeconds: chart.Metadata.DurationSeconds,
+			Constant:        chart.Metadata.Constant,
+		}
+	}
+
+	return connect.NewResponse(&proto_master.GetMasterDeltaResponse{
+		Revision:         delta.Revision,
+		Artists:          protoArtists,
+		Singers:          protoSingers,
+		Units:            protoUnits,
+		Songs:            protoSongs,
+		Charts:           protoCharts,
+		RemovedArtistIds: delta.RemovedArtistIDs,
+		RemovedSingerIds: delta.RemovedSingerIDs,
+		RemovedUnitIds:   delta.RemovedUnitIDs,
+		RemovedSongIds:   delta.RemovedSongIDs,
+		RemovedChartIds:  delta.RemovedChartIDs,
+	}), nil
+}
+
+// MasterEvent
+func (h *MasterHandler) WatchMaster(ctx context.Context, req *connect.Request[proto_master.WatchMasterRequest], stream *connect.ServerStream[proto_master.WatchMasterResponse]) error {
+	if req.Msg.GetIncludeDeleted() {
+		if err := h.requireAdmin(ctx); err != nil {
+			return err
+		}
+	}
+
+	isAdmin, err := h.isAdmin(ctx)
+	if err != nil {
+		return err
+	}
+
+	changes := h.masterUsecase.WatchMaster(ctx, req.Msg.GetIncludeDeleted(), isAdmin)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case logs, ok := <-changes:
+			if !ok {
+				// 通知が追いつかなくなった 再接続してGetMasterDeltaで取り直してもらう
+				cerr := errors.New("master events overflowed")
+				log.Printf("%+v\n", cerr)
+				return connect.NewError(connect.CodeUnavailable, cerr)
+			}
+			if len(logs) == 0 {
+				continue
+			}
+
+			protoEvents := make([]*proto_master.MasterEvent, len(logs))
+			for i, l := range logs {
+				protoEvents[i] = &proto_master.MasterEvent{
+					Kind:      toProtoMasterKind(l.Kind),
+					Id:        l.EntityID,
+					Operation: toProtoMasterChangeOperation(l.Operation),
+				}
+			}
+			if err := stream.Send(&proto_master.WatchMasterResponse{
+				Revision: logs[0].Revision,
+				Events:   protoEvents,
+			}); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+	}
+}
+
+// 衝突した既存のマスタをエラーの詳細に付けて返す
+func alreadyExistsError(err error) *connect.Error {
+	cerr := errors.WithStack(err)
+	log.Printf("%+v\n", cerr)
+	connectErr := connect.NewError(connect.CodeAlreadyExists, cerr)
+
+	var existsErr *usecase.AlreadyExistsError
+	if errors.As(err, &existsErr) {
+		detail, derr := connect.NewErrorDetail(&proto_master.MasterConflict{
+			Kind: toProtoMasterKind(existsErr.Kind),
+			Id:   existsErr.ID,
+			Name: existsErr.Name,
+		})
+		if derr == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+
+	return connectErr
+}
+
+func toProtoMasterKind(kind string) enums.MasterKind {
+	switch kind {
+	case entity.MasterKindArtist:
+		return enums.MasterKind_MASTER_KIND_ARTIST
+	case entity.MasterKindSinger:
+		return enums.MasterKind_MASTER_KIND_SINGER
+	case entity.MasterKindUnit:
+		return enums.MasterKind_MASTER_KIND_UNIT
+	case entity.MasterKindSong:
+		return enums.MasterKind_MASTER_KIND_SONG
+	case entity.MasterKindVocalPattern:
+		return enums.MasterKind_MASTER_KIND_VOCAL_PATTERN
+	case entity.MasterKindChart:
+		return enums.MasterKind_MASTER_KIND_CHART
+	}
+	return enums.MasterKind_MASTER_KIND_UNSPECIFIED
 }
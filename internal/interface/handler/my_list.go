@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"sort"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/gen/enums"
 	proto_master "github.com/Shakkuuu/sekai-songs-mylist/internal/gen/master"
 	proto_my_list "github.com/Shakkuuu/sekai-songs-mylist/internal/gen/mylist/v1"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/auth"
@@ -161,11 +161,8 @@ func (h *MyListHandler) GetMyListChartsByMyListID(ctx context.Context, req *conn
 			if vp == nil {
 				continue
 			}
-			sort.Slice(vp.Singers, func(i, j int) bool {
-				return vp.Singers[i].Position < vp.Singers[j].Position
-			})
 			var protoSingers []*proto_master.Singer
-			for _, s := range vp.Singers {
+			for _, s := range singersByPosition(vp.Singers) {
 				if s == nil {
 					continue
 				}
@@ -176,6 +173,7 @@ func (h *MyListHandler) GetMyListChartsByMyListID(ctx context.Context, req *conn
 			}
 			protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
 				Id:      vp.ID,
+				SongId:  vp.SongID,
 				Name:    vp.Name,
 				Singers: protoSingers,
 			})
@@ -197,24 +195,12 @@ func (h *MyListHandler) GetMyListChartsByMyListID(ctx context.Context, req *conn
 			Chart: &proto_master.Chart{
 				Id: myListChart.Chart.ID,
 				Song: &proto_master.Song{
-					Id:   myListChart.Chart.Song.ID,
-					Name: myListChart.Chart.Song.Name,
-					Kana: myListChart.Chart.Song.Kana,
-					Lyrics: &proto_master.Artist{
-						Id:   myListChart.Chart.Song.Lyrics.ID,
-						Name: myListChart.Chart.Song.Lyrics.Name,
-						Kana: myListChart.Chart.Song.Lyrics.Kana,
-					},
-					Music: &proto_master.Artist{
-						Id:   myListChart.Chart.Song.Music.ID,
-						Name: myListChart.Chart.Song.Music.Name,
-						Kana: myListChart.Chart.Song.Music.Kana,
-					},
-					Arrangement: &proto_master.Artist{
-						Id:   myListChart.Chart.Song.Arrangement.ID,
-						Name: myListChart.Chart.Song.Arrangement.Name,
-						Kana: myListChart.Chart.Song.Arrangement.Kana,
-					},
+					Id:              myListChart.Chart.Song.ID,
+					Name:            myListChart.Chart.Song.Name,
+					Kana:            myListChart.Chart.Song.Kana,
+					Lyrics:          toProtoFirstCredit(&myListChart.Chart.Song, enums.CreditRole_CREDIT_ROLE_LYRICS),
+					Music:           toProtoFirstCredit(&myListChart.Chart.Song, enums.CreditRole_CREDIT_ROLE_MUSIC),
+					Arrangement:     toProtoFirstCredit(&myListChart.Chart.Song, enums.CreditRole_CREDIT_ROLE_ARRANGEMENT),
 					Thumbnail:       myListChart.Chart.Song.Thumbnail,
 					OriginalVideo:   myListChart.Chart.Song.OriginalVideo,
 					ReleaseTime:     timestamppb.New(myListChart.Chart.Song.ReleaseTime),
@@ -222,15 +208,22 @@ func (h *MyListHandler) GetMyListChartsByMyListID(ctx context.Context, req *conn
 					VocalPatterns:   protoVocalPatterns,
 					Units:           protoUnits,
 					MusicVideoTypes: myListChart.Chart.Song.MusicVideoTypes,
+					Credits:         toProtoSongCredits(&myListChart.Chart.Song),
 				},
-				DifficultyType: myListChart.Chart.DifficultyType,
-				Level:          myListChart.Chart.Level,
-				ChartViewLink:  myListChart.Chart.ChartViewLink,
+				DifficultyType:  myListChart.Chart.DifficultyType,
+				Level:           myListChart.Chart.Level,
+				ChartViewLink:   myListChart.Chart.ChartViewLink,
+				NoteCount:       myListChart.Chart.Metadata.NoteCount,
+				BpmMin:          myListChart.Chart.Metadata.BPMMin,
+				BpmMax:          myListChart.Chart.Metadata.BPMMax,
+				DurationSeconds: myListChart.Chart.Metadata.DurationSeconds,
+				Constant:        myListChart.Chart.Metadata.Constant,
 			},
-			ClearType: myListChart.ClearType,
-			Memo:      myListChart.Memo,
-			CreatedAt: timestamppb.New(myListChart.CreatedAt),
-			UpdatedAt: timestamppb.New(myListChart.UpdatedAt),
+			ClearType:   myListChart.ClearType,
+			Memo:        myListChart.Memo,
+			CreatedAt:   timestamppb.New(myListChart.CreatedAt),
+			UpdatedAt:   timestamppb.New(myListChart.UpdatedAt),
+			SongDeleted: myListChart.Chart.Song.Deleted,
 		}
 	}
 
@@ -267,11 +260,8 @@ func (h *MyListHandler) GetMyListChartByID(ctx context.Context, req *connect.Req
 		if vp == nil {
 			continue
 		}
-		sort.Slice(vp.Singers, func(i, j int) bool {
-			return vp.Singers[i].Position < vp.Singers[j].Position
-		})
 		var protoSingers []*proto_master.Singer
-		for _, s := range vp.Singers {
+		for _, s := range singersByPosition(vp.Singers) {
 			if s == nil {
 				continue
 			}
@@ -282,6 +272,7 @@ func (h *MyListHandler) GetMyListChartByID(ctx context.Context, req *connect.Req
 		}
 		protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
 			Id:      vp.ID,
+			SongId:  vp.SongID,
 			Name:    vp.Name,
 			Singers: protoSingers,
 		})
@@ -304,24 +295,12 @@ func (h *MyListHandler) GetMyListChartByID(ctx context.Context, req *connect.Req
 		Chart: &proto_master.Chart{
 			Id: myListChart.Chart.ID,
 			Song: &proto_master.Song{
-				Id:   myListChart.Chart.Song.ID,
-				Name: myListChart.Chart.Song.Name,
-				Kana: myListChart.Chart.Song.Kana,
-				Lyrics: &proto_master.Artist{
-					Id:   myListChart.Chart.Song.Lyrics.ID,
-					Name: myListChart.Chart.Song.Lyrics.Name,
-					Kana: myListChart.Chart.Song.Lyrics.Kana,
-				},
-				Music: &proto_master.Artist{
-					Id:   myListChart.Chart.Song.Music.ID,
-					Name: myListChart.Chart.Song.Music.Name,
-					Kana: myListChart.Chart.Song.Music.Kana,
-				},
-				Arrangement: &proto_master.Artist{
-					Id:   myListChart.Chart.Song.Arrangement.ID,
-					Name: myListChart.Chart.Song.Arrangement.Name,
-					Kana: myListChart.Chart.Song.Arrangement.Kana,
-				},
+				Id:              myListChart.Chart.Song.ID,
+				Name:            myListChart.Chart.Song.Name,
+				Kana:            myListChart.Chart.Song.Kana,
+				Lyrics:          toProtoFirstCredit(&myListChart.Chart.Song, enums.CreditRole_CREDIT_ROLE_LYRICS),
+				Music:           toProtoFirstCredit(&myListChart.Chart.Song, enums.CreditRole_CREDIT_ROLE_MUSIC),
+				Arrangement:     toProtoFirstCredit(&myListChart.Chart.Song, enums.CreditRole_CREDIT_ROLE_ARRANGEMENT),
 				Thumbnail:       myListChart.Chart.Song.Thumbnail,
 				OriginalVideo:   myListChart.Chart.Song.OriginalVideo,
 				ReleaseTime:     timestamppb.New(myListChart.Chart.Song.ReleaseTime),
@@ -329,15 +308,22 @@ func (h *MyListHandler) GetMyListChartByID(ctx context.Context, req *connect.Req
 				VocalPatterns:   protoVocalPatterns,
 				Units:           protoUnits,
 				MusicVideoTypes: myListChart.Chart.Song.MusicVideoTypes,
+				Credits:         toProtoSongCredits(&myListChart.Chart.Song),
 			},
-			DifficultyType: myListChart.Chart.DifficultyType,
-			Level:          myListChart.Chart.Level,
-			ChartViewLink:  myListChart.Chart.ChartViewLink,
+			DifficultyType:  myListChart.Chart.DifficultyType,
+			Level:           myListChart.Chart.Level,
+			ChartViewLink:   myListChart.Chart.ChartViewLink,
+			NoteCount:       myListChart.Chart.Metadata.NoteCount,
+			BpmMin:          myListChart.Chart.Metadata.BPMMin,
+			BpmMax:          myListChart.Chart.Metadata.BPMMax,
+			DurationSeconds: myListChart.Chart.Metadata.DurationSeconds,
+			Constant:        myListChart.Chart.Metadata.Constant,
 		},
-		ClearType: myListChart.ClearType,
-		Memo:      myListChart.Memo,
-		CreatedAt: timestamppb.New(myListChart.CreatedAt),
-		UpdatedAt: timestamppb.New(myListChart.UpdatedAt),
+		ClearType:   myListChart.ClearType,
+		Memo:        myListChart.Memo,
+		CreatedAt:   timestamppb.New(myListChart.CreatedAt),
+		UpdatedAt:   timestamppb.New(myListChart.UpdatedAt),
+		SongDeleted: myListChart.Chart.Song.Deleted,
 	}
 	return connect.NewResponse(&proto_my_list.GetMyListChartByIDResponse{
 		MyListChart: protoMyListChart,
@@ -357,6 +343,16 @@ func (h *MyListHandler) AddMyListChart(ctx context.Context, req *connect.Request
 			log.Printf("%+v\n", cerr)
 			return nil, connect.NewError(connect.CodeInvalidArgument, cerr)
 		}
+		if errors.Is(err, repository.ErrNotFound) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeNotFound, cerr)
+		}
+		if errors.Is(err, usecase.ErrDeletedSongChart) {
+			cerr := errors.WithStack(err)
+			log.Printf("%+v\n", cerr)
+			return nil, connect.NewError(connect.CodeFailedPrecondition, cerr)
+		}
 		cerr := errors.WithStack(err)
 		log.Printf("%+v\n", cerr)
 		return nil, connect.NewError(connect.CodeInternal, cerr)
@@ -430,11 +426,8 @@ func (h *MyListHandler) GetMyListChartAttachmentsByMyListChartID(ctx context.Con
 		if vp == nil {
 			continue
 		}
-		sort.Slice(vp.Singers, func(i, j int) bool {
-			return vp.Singers[i].Position < vp.Singers[j].Position
-		})
 		var protoSingers []*proto_master.Singer
-		for _, s := range vp.Singers {
+		for _, s := range singersByPosition(vp.Singers) {
 			if s == nil {
 				continue
 			}
@@ -445,6 +438,7 @@ func (h *MyListHandler) GetMyListChartAttachmentsByMyListChartID(ctx context.Con
 		}
 		protoVocalPatterns = append(protoVocalPatterns, &proto_master.VocalPattern{
 			Id:      vp.ID,
+			SongId:  vp.SongID,
 			Name:    vp.Name,
 			Singers: protoSingers,
 		})
@@ -467,24 +461,12 @@ func (h *MyListHandler) GetMyListChartAttachmentsByMyListChartID(ctx context.Con
 		Chart: &proto_master.Chart{
 			Id: myListChart.Chart.ID,
 			Song: &proto_master.Song{
-				Id:   myListChart.Chart.Song.ID,
-				Name: myListChart.Chart.Song.Name,
-				Kana: myListChart.Chart.Song.Kana,
-				Lyrics: &proto_master.Artist{
-					Id:   myListChart.Chart.Song.Lyrics.ID,
-					Name: myListChart.Chart.Song.Lyrics.Name,
-					Kana: myListChart.Chart.Song.Lyrics.Kana,
-				},
-				Music: &proto_master.Artist{
-					Id:   myListChart.Chart.Song.Music.ID,
-					Name: myListChart.Chart.Song.Music.Name,
-					Kana: myListChart.Chart.Song.Music.Kana,
-				},
-				Arrangement: &proto_master.Artist{
-					Id:   myListChart.Chart.Song.Arrangement.ID,
-					Name: myListChart.Chart.Song.Arrangement.Name,
-					Kana: myListChart.Chart.Song.Arrangement.Kana,
-				},
+				Id:              myListChart.Chart.Song.ID,
+				Name:            myListChart.Chart.Song.Name,
+				Kana:            myListChart.Chart.Song.Kana,
+				Lyrics:          toProtoFirstCredit(&myListChart.Chart.Song, enums.CreditRole_CREDIT_ROLE_LYRICS),
+				Music:           toProtoFirstCredit(&myListChart.Chart.Song, enums.CreditRole_CREDIT_ROLE_MUSIC),
+				Arrangement:     toProtoFirstCredit(&myListChart.Chart.Song, enums.CreditRole_CREDIT_ROLE_ARRANGEMENT),
 				Thumbnail:       myListChart.Chart.Song.Thumbnail,
 				OriginalVideo:   myListChart.Chart.Song.OriginalVideo,
 				ReleaseTime:     timestamppb.New(myListChart.Chart.Song.ReleaseTime),
@@ -492,15 +474,22 @@ func (h *MyListHandler) GetMyListChartAttachmentsByMyListChartID(ctx context.Con
 				VocalPatterns:   protoVocalPatterns,
 				Units:           protoUnits,
 				MusicVideoTypes: myListChart.Chart.Song.MusicVideoTypes,
+				Credits:         toProtoSongCredits(&myListChart.Chart.Song),
 			},
-			DifficultyType: myListChart.Chart.DifficultyType,
-			Level:          myListChart.Chart.Level,
-			ChartViewLink:  myListChart.Chart.ChartViewLink,
+			DifficultyType:  myListChart.Chart.DifficultyType,
+			Level:           myListChart.Chart.Level,
+			ChartViewLink:   myListChart.Chart.ChartViewLink,
+			NoteCount:       myListChart.Chart.Metadata.NoteCount,
+			BpmMin:          myListChart.Chart.Metadata.BPMMin,
+			BpmMax:          myListChart.Chart.Metadata.BPMMax,
+			DurationSeconds: myListChart.Chart.Metadata.DurationSeconds,
+			Constant:        myListChart.Chart.Metadata.Constant,
 		},
-		ClearType: myListChart.ClearType,
-		Memo:      myListChart.Memo,
-		CreatedAt: timestamppb.New(myListChart.CreatedAt),
-		UpdatedAt: timestamppb.New(myListChart.UpdatedAt),
+		ClearType:   myListChart.ClearType,
+		Memo:        myListChart.Memo,
+		CreatedAt:   timestamppb.New(myListChart.CreatedAt),
+		UpdatedAt:   timestamppb.New(myListChart.UpdatedAt),
+		SongDeleted: myListChart.Chart.Song.Deleted,
 	}
 
 	myListChartAttachments, err := h.myListUsecase.GetMyListChartAttachmentsByMyListChartID(ctx, req.Msg.GetMyListChartId())
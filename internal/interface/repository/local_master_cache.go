@@ -0,0 +1,424 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/circuitbreaker"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/lru"
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redisのキャッシュをデコードした値のままメモリに持つ
+// 書き換えた時はRedisのpub/subで他のサーバーに知らせ、そのキーを破棄させる
+// 依存しているキャッシュまで破棄するDelete<マスタ>は、どのキーが消えたか分からないので全て破棄させる
+// 通知が届かなかった場合に備えて、maxAgeを過ぎたものは取り直す
+// Redisを直接呼ぶ期限の確認と通知は、キャッシュと同じbreakerが開いている間は呼ばない
+type localMasterCacheRepository struct {
+	next    repository.RedisMasterCacheRepository
+	rc      *redis.Client
+	breaker *circuitbreaker.Breaker
+	cache   *lru.Cache
+	maxAge  time.Duration
+	// 自分が送った通知を区別する
+	instanceID string
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// pub/subで送る破棄の通知
+type localCacheInvalidation struct {
+	Sender string
+	Keys   []string
+	All    bool
+}
+
+func NewLocalMasterCacheRepository(next repository.RedisMasterCacheRepository, rc *redis.Client, breaker *circuitbreaker.Breaker, size int, maxAge time.Duration) repository.LocalMasterCacheRepository {
+	r := &localMasterCacheRepository{
+		next:       next,
+		rc:         rc,
+		breaker:    breaker,
+		cache:      lru.New(size),
+		maxAge:     maxAge,
+		instanceID: uuid.NewString(),
+	}
+	go r.receive()
+	return r
+}
+
+func (r *localMasterCacheRepository) Stats() repository.MasterCacheStats {
+	return repository.MasterCacheStats{
+		Hits:    r.hits.Load(),
+		Misses:  r.misses.Load(),
+		Entries: r.cache.Len(),
+	}
+}
+
+// Artist
+func (r *localMasterCacheRepository) SetArtist(ctx context.Context, id int32, data *entity.Artist) error {
+	if err := r.next.SetArtist(ctx, id, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, artistKey(id), data, 0)
+}
+func (r *localMasterCacheRepository) SetArtists(ctx context.Context, data []*entity.Artist) error {
+	if err := r.next.SetArtists(ctx, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, allKey(repository.ARTIST_REDIS_KEY), data, 0)
+}
+func (r *localMasterCacheRepository) GetArtistByID(ctx context.Context, id int32) (*entity.Artist, error) {
+	return getLocal(ctx, r, artistKey(id), func(ctx context.Context) (*entity.Artist, error) {
+		return r.next.GetArtistByID(ctx, id)
+	})
+}
+func (r *localMasterCacheRepository) GetArtists(ctx context.Context) ([]*entity.Artist, error) {
+	return getLocal(ctx, r, allKey(repository.ARTIST_REDIS_KEY), r.next.GetArtists)
+}
+func (r *localMasterCacheRepository) DeleteArtist(ctx context.Context, id int32) error {
+	if err := r.next.DeleteArtist(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.purge(ctx)
+}
+
+// Singer
+func (r *localMasterCacheRepository) SetSinger(ctx context.Context, id int32, data *entity.Singer) error {
+	if err := r.next.SetSinger(ctx, id, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, singerKey(id), data, 0)
+}
+func (r *localMasterCacheRepository) SetSingers(ctx context.Context, data []*entity.Singer) error {
+	if err := r.next.SetSingers(ctx, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, allKey(repository.SINGER_REDIS_KEY), data, 0)
+}
+func (r *localMasterCacheRepository) GetSingerByID(ctx context.Context, id int32) (*entity.Singer, error) {
+	return getLocal(ctx, r, singerKey(id), func(ctx context.Context) (*entity.Singer, error) {
+		return r.next.GetSingerByID(ctx, id)
+	})
+}
+func (r *localMasterCacheRepository) GetSingers(ctx context.Context) ([]*entity.Singer, error) {
+	return getLocal(ctx, r, allKey(repository.SINGER_REDIS_KEY), r.next.GetSingers)
+}
+func (r *localMasterCacheRepository) DeleteSinger(ctx context.Context, id int32) error {
+	if err := r.next.DeleteSinger(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.purge(ctx)
+}
+
+// Unit
+func (r *localMasterCacheRepository) SetUnit(ctx context.Context, id int32, data *entity.Unit) error {
+	if err := r.next.SetUnit(ctx, id, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, unitKey(id), data, 0)
+}
+func (r *localMasterCacheRepository) SetUnits(ctx context.Context, data []*entity.Unit) error {
+	if err := r.next.SetUnits(ctx, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, allKey(repository.UNIT_REDIS_KEY), data, 0)
+}
+func (r *localMasterCacheRepository) GetUnitByID(ctx context.Context, id int32) (*entity.Unit, error) {
+	return getLocal(ctx, r, unitKey(id), func(ctx context.Context) (*entity.Unit, error) {
+		return r.next.GetUnitByID(ctx, id)
+	})
+}
+func (r *localMasterCacheRepository) GetUnits(ctx context.Context) ([]*entity.Unit, error) {
+	return getLocal(ctx, r, allKey(repository.UNIT_REDIS_KEY), r.next.GetUnits)
+}
+func (r *localMasterCacheRepository) DeleteUnit(ctx context.Context, id int32) error {
+	if err := r.next.DeleteUnit(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.purge(ctx)
+}
+
+// Song
+func (r *localMasterCacheRepository) SetSong(ctx context.Context, id int32, data *entity.Song) error {
+	if err := r.next.SetSong(ctx, id, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, songKey(id), data, 0)
+}
+func (r *localMasterCacheRepository) SetSongs(ctx context.Context, data []*entity.Song) error {
+	if err := r.next.SetSongs(ctx, data); err != nil {
+		return errors.WithStack(err)
+	}
+	// Redisと同じく公開済みの一覧も破棄する
+	return r.store(ctx, allKey(repository.SONG_REDIS_KEY), data, 0, releasedKey(repository.SONG_REDIS_KEY))
+}
+func (r *localMasterCacheRepository) GetSongByID(ctx context.Context, id int32) (*entity.Song, error) {
+	return getLocal(ctx, r, songKey(id), func(ctx context.Context) (*entity.Song, error) {
+		return r.next.GetSongByID(ctx, id)
+	})
+}
+func (r *localMasterCacheRepository) GetSongs(ctx context.Context) ([]*entity.Song, error) {
+	return getLocal(ctx, r, allKey(repository.SONG_REDIS_KEY), r.next.GetSongs)
+}
+func (r *localMasterCacheRepository) DeleteSong(ctx context.Context, id int32) error {
+	if err := r.next.DeleteSong(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.purge(ctx)
+}
+func (r *localMasterCacheRepository) SetReleasedSongs(ctx context.Context, data []*entity.Song, expiration time.Duration) error {
+	if err := r.next.SetReleasedSongs(ctx, data, expiration); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, releasedKey(repository.SONG_REDIS_KEY), data, expiration)
+}
+func (r *localMasterCacheRepository) GetReleasedSongs(ctx context.Context) ([]*entity.Song, error) {
+	return getLocalWithTTL(ctx, r, releasedKey(repository.SONG_REDIS_KEY), r.next.GetReleasedSongs)
+}
+
+// Chart
+func (r *localMasterCacheRepository) SetChart(ctx context.Context, id int32, data *entity.Chart) error {
+	if err := r.next.SetChart(ctx, id, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, chartKey(id), data, 0)
+}
+func (r *localMasterCacheRepository) SetChartsByID(ctx context.Context, data []*entity.Chart) error {
+	if err := r.next.SetChartsByID(ctx, data); err != nil {
+		return errors.WithStack(err)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	keys := make([]string, len(data))
+	for i, chart := range data {
+		keys[i] = chartKey(chart.ID)
+		r.cache.Set(keys[i], chart, r.expiresAt(0))
+	}
+	return r.publish(ctx, &localCacheInvalidation{Keys: keys})
+}
+func (r *localMasterCacheRepository) SetCharts(ctx context.Context, data []*entity.Chart) error {
+	if err := r.next.SetCharts(ctx, data); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, allKey(repository.CHART_REDIS_KEY), data, 0, releasedKey(repository.CHART_REDIS_KEY))
+}
+func (r *localMasterCacheRepository) GetChartByID(ctx context.Context, id int32) (*entity.Chart, error) {
+	return getLocal(ctx, r, chartKey(id), func(ctx context.Context) (*entity.Chart, error) {
+		return r.next.GetChartByID(ctx, id)
+	})
+}
+func (r *localMasterCacheRepository) GetChartsByIDs(ctx context.Context, ids []int32) ([]*entity.Chart, error) {
+	// メモリに無いものだけまとめてRedisから取る
+	charts := make([]*entity.Chart, len(ids))
+	var missIndexes []int
+	var missIDs []int32
+	now := time.Now()
+	for i, id := range ids {
+		if v, ok := r.cache.Get(chartKey(id), now); ok {
+			r.hits.Add(1)
+			charts[i] = v.(*entity.Chart)
+			continue
+		}
+		r.misses.Add(1)
+		missIndexes = append(missIndexes, i)
+		missIDs = append(missIDs, id)
+	}
+	if len(missIDs) == 0 {
+		return charts, nil
+	}
+
+	generation := r.cache.Generation()
+	fetched, err := r.next.GetChartsByIDs(ctx, missIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for j, chart := range fetched {
+		if chart == nil {
+			continue
+		}
+		charts[missIndexes[j]] = chart
+		r.cache.SetIfGeneration(chartKey(missIDs[j]), chart, r.expiresAt(0), generation)
+	}
+
+	return charts, nil
+}
+func (r *localMasterCacheRepository) GetCharts(ctx context.Context) ([]*entity.Chart, error) {
+	return getLocal(ctx, r, allKey(repository.CHART_REDIS_KEY), r.next.GetCharts)
+}
+func (r *localMasterCacheRepository) DeleteChart(ctx context.Context, id int32) error {
+	if err := r.next.DeleteChart(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.purge(ctx)
+}
+func (r *localMasterCacheRepository) SetReleasedCharts(ctx context.Context, data []*entity.Chart, expiration time.Duration) error {
+	if err := r.next.SetReleasedCharts(ctx, data, expiration); err != nil {
+		return errors.WithStack(err)
+	}
+	return r.store(ctx, releasedKey(repository.CHART_REDIS_KEY), data, expiration)
+}
+func (r *localMasterCacheRepository) GetReleasedCharts(ctx context.Context) ([]*entity.Chart, error) {
+	return getLocalWithTTL(ctx, r, releasedKey(repository.CHART_REDIS_KEY), r.next.GetReleasedCharts)
+}
+
+func allKey(kind string) string {
+	return kind + ":all"
+}
+func releasedKey(kind string) string {
+	return kind + ":released"
+}
+
+// メモリに無ければRedisから取ってメモリに入れる redis.Nilなどのエラーはそのまま返す
+func getLocal[T any](ctx context.Context, r *localMasterCacheRepository, key string, fetch func(context.Context) (T, error)) (T, error) {
+	if v, ok := r.cache.Get(key, time.Now()); ok {
+		r.hits.Add(1)
+		return v.(T), nil
+	}
+	r.misses.Add(1)
+
+	generation := r.cache.Generation()
+	v, err := fetch(ctx)
+	if err != nil {
+		var zero T
+		return zero, errors.WithStack(err)
+	}
+	r.cache.SetIfGeneration(key, v, r.expiresAt(0), generation)
+
+	return v, nil
+}
+
+// 期限付きのキーはRedisに残っている時間より長くメモリに持たない
+func getLocalWithTTL[T any](ctx context.Context, r *localMasterCacheRepository, key string, fetch func(context.Context) (T, error)) (T, error) {
+	if v, ok := r.cache.Get(key, time.Now()); ok {
+		r.hits.Add(1)
+		return v.(T), nil
+	}
+	r.misses.Add(1)
+
+	generation := r.cache.Generation()
+	v, err := fetch(ctx)
+	if err != nil {
+		var zero T
+		return zero, errors.WithStack(err)
+	}
+	var ttl time.Duration
+	err = r.callRedis(func() error {
+		var err error
+		ttl, err = r.rc.PTTL(ctx, key).Result()
+		return err
+	})
+	if errors.Is(err, repository.ErrMasterCacheUnavailable) {
+		// Redisを呼んでいないのでmaxAgeまで持つ
+		r.cache.SetIfGeneration(key, v, r.expiresAt(0), generation)
+		return v, nil
+	}
+	if err != nil {
+		// 期限が分からないのでメモリには入れない
+		log.Printf("%+v\n", errors.WithStack(err))
+		return v, nil
+	}
+	// -2はもう無い、-1は期限なし
+	if ttl == -2 {
+		return v, nil
+	}
+	if ttl < 0 {
+		ttl = 0
+	}
+	r.cache.SetIfGeneration(key, v, r.expiresAt(ttl), generation)
+
+	return v, nil
+}
+
+// ttlとmaxAgeの短い方で切れるようにする ttlが0なら期限なしとしてmaxAgeにする
+func (r *localMasterCacheRepository) expiresAt(ttl time.Duration) time.Time {
+	age := r.maxAge
+	if ttl > 0 && (age <= 0 || ttl < age) {
+		age = ttl
+	}
+	if age <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(age)
+}
+
+// 自分のメモリに入れ、他のサーバーにはkeyとalsoDropを破棄させる
+func (r *localMasterCacheRepository) store(ctx context.Context, key string, data any, ttl time.Duration, alsoDrop ...string) error {
+	if len(alsoDrop) > 0 {
+		r.cache.Delete(alsoDrop...)
+	}
+	r.cache.Set(key, data, r.expiresAt(ttl))
+	return r.publish(ctx, &localCacheInvalidation{Keys: append([]string{key}, alsoDrop...)})
+}
+
+func (r *localMasterCacheRepository) purge(ctx context.Context) error {
+	r.cache.Purge()
+	return r.publish(ctx, &localCacheInvalidation{All: true})
+}
+
+func (r *localMasterCacheRepository) publish(ctx context.Context, msg *localCacheInvalidation) error {
+	msg.Sender = r.instanceID
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// 届かなくても他のサーバーはmaxAgeで取り直すので、書き込みは失敗にしない
+	if err := r.callRedis(func() error {
+		return r.rc.Publish(ctx, repository.MASTER_CACHE_INVALIDATE_REDIS_CHANNEL, jsonBytes).Err()
+	}); err != nil {
+		log.Printf("%+v\n", errors.WithStack(err))
+	}
+	return nil
+}
+
+// breakerが開いている間は呼ばずにErrMasterCacheUnavailableを返す 呼んだ結果はbreakerに知らせる
+func (r *localMasterCacheRepository) callRedis(fn func() error) error {
+	if !r.breaker.Allow(time.Now()) {
+		return errors.WithStack(repository.ErrMasterCacheUnavailable)
+	}
+	err := fn()
+	if err != nil && isRedisUnavailable(err) {
+		r.breaker.Failure(time.Now())
+	} else {
+		r.breaker.Success()
+	}
+	return err
+}
+
+// 切断されてもgo-redisが再接続するので、サーバーが動いている間は受け取り続ける
+// 切断中の通知は届かないので、再接続した時は全て破棄する
+func (r *localMasterCacheRepository) receive() {
+	pubsub := r.rc.Subscribe(context.Background(), repository.MASTER_CACHE_INVALIDATE_REDIS_CHANNEL)
+	for msg := range pubsub.ChannelWithSubscriptions() {
+		if _, ok := msg.(*redis.Subscription); ok {
+			r.cache.Purge()
+			continue
+		}
+		m, ok := msg.(*redis.Message)
+		if !ok {
+			continue
+		}
+
+		var inv localCacheInvalidation
+		if err := json.Unmarshal([]byte(m.Payload), &inv); err != nil {
+			log.Printf("%+v\n", errors.WithStack(err))
+			continue
+		}
+		if inv.Sender == r.instanceID {
+			continue
+		}
+		if inv.All {
+			r.cache.Purge()
+			continue
+		}
+		r.cache.Delete(inv.Keys...)
+	}
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/gen/enums"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/infrastructure/db/sqlcgen"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/normalize"
 	"github.com/cockroachdb/errors"
+	"github.com/lib/pq"
 )
 
 //go:generate gotests -w -all $GOFILE
@@ -19,13 +22,23 @@ type masterRepository struct {
 	queries *sqlcgen.Queries
 }
 
+// 一意制約違反(23505)はrepository.ErrAlreadyExistsとしてマークする
+// 事前チェックと書き込みの間に同じ名前が作られた場合もAlreadyExistsとして返すため
+func wrapUniqueViolation(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return errors.Mark(errors.WithStack(err), repository.ErrAlreadyExists)
+	}
+	return errors.WithStack(err)
+}
+
 func NewMasterRepository(queries *sqlcgen.Queries) repository.MasterRepository {
 	return &masterRepository{queries: queries}
 }
 
 // Artists
 func (r *masterRepository) ListArtists(ctx context.Context) ([]*entity.Artist, error) {
-	artists, err := r.queries.ListArtists(ctx)
+	artists, err := getQueries(ctx, r.queries).ListArtists(ctx)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -39,7 +52,19 @@ func (r *masterRepository) ListArtists(ctx context.Context) ([]*entity.Artist, e
 }
 
 func (r *masterRepository) GetArtistByID(ctx context.Context, id int32) (*entity.Artist, error) {
-	artist, err := r.queries.GetArtistByID(ctx, id)
+	artist, err := getQueries(ctx, r.queries).GetArtistByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(repository.ErrNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return sqlToDomainArtist(&artist), nil
+}
+
+func (r *masterRepository) FindArtistByNameKey(ctx context.Context, name string) (*entity.Artist, error) {
+	artist, err := getQueries(ctx, r.queries).FindArtistByNameKey(ctx, name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.WithStack(repository.ErrNotFound)
@@ -52,19 +77,20 @@ func (r *masterRepository) GetArtistByID(ctx context.Context, id int32) (*entity
 
 func (r *masterRepository) CreateArtist(ctx context.Context, name, kana string) (*entity.Artist, error) {
 	sqlArtist := sqlcgen.InsertArtistParams{
-		Name: name,
-		Kana: kana,
+		Name:       name,
+		Kana:       kana,
+		SearchText: normalize.SearchText(name, kana),
 	}
-	a, err := r.queries.InsertArtist(ctx, sqlArtist)
+	a, err := getQueries(ctx, r.queries).InsertArtist(ctx, sqlArtist)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, wrapUniqueViolation(err)
 	}
 
 	return sqlToDomainArtist(&a), nil
 }
 
 func (r *masterRepository) ExistsArtist(ctx context.Context, id int32) (bool, error) {
-	exist, err := r.queries.ExistsArtist(ctx, id)
+	exist, err := getQueries(ctx, r.queries).ExistsArtist(ctx, id)
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
@@ -72,6 +98,28 @@ func (r *masterRepository) ExistsArtist(ctx context.Context, id int32) (bool, er
 	return exist, nil
 }
 
+func (r *masterRepository) UpdateArtist(ctx context.Context, id int32, name, kana string) error {
+	arg := sqlcgen.UpdateArtistParams{
+		Name:       name,
+		Kana:       kana,
+		SearchText: normalize.SearchText(name, kana),
+		ID:         id,
+	}
+
+	if err := getQueries(ctx, r.queries).UpdateArtist(ctx, arg); err != nil {
+		return wrapUniqueViolation(err)
+	}
+
+	return nil
+}
+
+func (r *masterRepository) DeleteArtist(ctx context.Context, id int32) error {
+	if err := getQueries(ctx, r.queries).DeleteArtist(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
 func sqlToDomainArtist(sqlArtist *sqlcgen.Artist) *entity.Artist {
 	return &entity.Artist{
 		ID:   sqlArtist.ID,
@@ -82,7 +130,7 @@ func sqlToDomainArtist(sqlArtist *sqlcgen.Artist) *entity.Artist {
 
 // Singer
 func (r *masterRepository) ListSingers(ctx context.Context) ([]*entity.Singer, error) {
-	singers, err := r.queries.ListSingers(ctx)
+	singers, err := getQueries(ctx, r.queries).ListSingers(ctx)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -91,12 +139,33 @@ func (r *masterRepository) ListSingers(ctx context.Context) ([]*entity.Singer, e
 	for i := range singers {
 		singerPointers[i] = sqlToDomainSinger(&singers[i])
 	}
+	if err := r.attachSingerUnits(ctx, sql.NullInt32{}, singerPointers); err != nil {
+		return nil, errors.WithStack(err)
+	}
 
 	return singerPointers, nil
 }
 
 func (r *masterRepository) GetSingerByID(ctx context.Context, id int32) (*entity.Singer, error) {
-	singer, err := r.queries.GetSingerByID(ctx, id)
+	singer, err := getQueries(ctx, r.queries).GetSingerByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(repository.ErrNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	s := sqlToDomainSinger(&singer)
+	if err := r.attachSingerUnits(ctx, sql.NullInt32{Int32: id, Valid: true}, []*entity.Singer{s}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return s, nil
+}
+
+// 重複の確認に使うので所属ユニットは付けない
+func (r *masterRepository) FindSingerByNameKey(ctx context.Context, name string) (*entity.Singer, error) {
+	singer, err := getQueries(ctx, r.queries).FindSingerByNameKey(ctx, name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.WithStack(repository.ErrNotFound)
@@ -108,16 +177,16 @@ func (r *masterRepository) GetSingerByID(ctx context.Context, id int32) (*entity
 }
 
 func (r *masterRepository) CreateSinger(ctx context.Context, name string) (*entity.Singer, error) {
-	s, err := r.queries.InsertSinger(ctx, name)
+	s, err := getQueries(ctx, r.queries).InsertSinger(ctx, name)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, wrapUniqueViolation(err)
 	}
 
 	return sqlToDomainSinger(&s), nil
 }
 
 func (r *masterRepository) ExistsSinger(ctx context.Context, id int32) (bool, error) {
-	exist, err := r.queries.ExistsSinger(ctx, id)
+	exist, err := getQueries(ctx, r.queries).ExistsSinger(ctx, id)
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
@@ -125,16 +194,53 @@ func (r *masterRepository) ExistsSinger(ctx context.Context, id int32) (bool, er
 	return exist, nil
 }
 
+func (r *masterRepository) UpdateSinger(ctx context.Context, id int32, name string) error {
+	arg := sqlcgen.UpdateSingerParams{
+		Name: name,
+		ID:   id,
+	}
+
+	if err := getQueries(ctx, r.queries).UpdateSinger(ctx, arg); err != nil {
+		return wrapUniqueViolation(err)
+	}
+
+	return nil
+}
+
 func sqlToDomainSinger(sqlSinger *sqlcgen.Singer) *entity.Singer {
 	return &entity.Singer{
-		ID:   sqlSinger.ID,
-		Name: sqlSinger.Name,
+		ID:    sqlSinger.ID,
+		Name:  sqlSinger.Name,
+		Units: []*entity.Unit{},
 	}
 }
 
+// 歌手に所属しているユニットを詰める singerIDが無効な場合は全件から探す
+func (r *masterRepository) attachSingerUnits(ctx context.Context, singerID sql.NullInt32, singers []*entity.Singer) error {
+	rows, err := getQueries(ctx, r.queries).ListUnitSingers(ctx, sqlcgen.ListUnitSingersParams{SingerID: singerID})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	singerMap := make(map[int32]*entity.Singer, len(singers))
+	for _, s := range singers {
+		singerMap[s.ID] = s
+	}
+	for _, row := range rows {
+		if s, ok := singerMap[row.SingerID]; ok {
+			s.Units = append(s.Units, &entity.Unit{
+				ID:   row.UnitID,
+				Name: row.UnitName,
+			})
+		}
+	}
+
+	return nil
+}
+
 // Unit
 func (r *masterRepository) ListUnits(ctx context.Context) ([]*entity.Unit, error) {
-	units, err := r.queries.ListUnits(ctx)
+	units, err := getQueries(ctx, r.queries).ListUnits(ctx)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -143,13 +249,37 @@ func (r *masterRepository) ListUnits(ctx context.Context) ([]*entity.Unit, error
 	for i := range units {
 		unitPointers[i] = sqlToDomainUnit(&units[i])
 	}
+	if err := r.attachUnitMembers(ctx, sql.NullInt32{}, unitPointers); err != nil {
+		return nil, errors.WithStack(err)
+	}
 
 	return unitPointers, nil
 }
 
 func (r *masterRepository) GetUnitByID(ctx context.Context, id int32) (*entity.Unit, error) {
-	unit, err := r.queries.GetUnitByID(ctx, id)
+	unit, err := getQueries(ctx, r.queries).GetUnitByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(repository.ErrNotFound)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	u := sqlToDomainUnit(&unit)
+	if err := r.attachUnitMembers(ctx, sql.NullInt32{Int32: id, Valid: true}, []*entity.Unit{u}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return u, nil
+}
+
+// 重複の確認に使うので所属歌手は付けない
+func (r *masterRepository) FindUnitByNameKey(ctx context.Context, name string) (*entity.Unit, error) {
+	unit, err := getQueries(ctx, r.queries).FindUnitByNameKey(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithStack(repository.ErrNotFound)
+		}
 		return nil, errors.WithStack(err)
 	}
 
@@ -157,16 +287,16 @@ func (r *masterRepository) GetUnitByID(ctx context.Context, id int32) (*entity.U
 }
 
 func (r *masterRepository) CreateUnit(ctx context.Context, name string) (*entity.Unit, error) {
-	u, err := r.queries.InsertUnit(ctx, name)
+	u, err := getQueries(ctx, r.queries).InsertUnit(ctx, name)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, wrapUniqueViolation(err)
 	}
 
 	return sqlToDomainUnit(&u), nil
 }
 
 func (r *masterRepository) ExistsUnit(ctx context.Context, id int32) (bool, error) {
-	exist, err := r.queries.ExistsUnit(ctx, id)
+	exist, err := getQueries(ctx, r.queries).ExistsUnit(ctx, id)
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
@@ -174,20 +304,151 @@ func (r *masterRepository) ExistsUnit(ctx context.Context, id int32) (bool, erro
 	return exist, nil
 }
 
+func (r *masterRepository) UpdateUnit(ctx context.Context, id int32, name string) error {
+	arg := sqlcgen.UpdateUnitParams{
+		Name: name,
+		ID:   id,
+	}
+
+	if err := getQueries(ctx, r.queries).UpdateUnit(ctx, arg); err != nil {
+		return wrapUniqueViolation(err)
+	}
+
+	return nil
+}
+
 func sqlToDomainUnit(sqlUnit *sqlcgen.Unit) *entity.Unit {
 	return &entity.Unit{
-		ID:   sqlUnit.ID,
-		Name: sqlUnit.Name,
+		ID:      sqlUnit.ID,
+		Name:    sqlUnit.Name,
+		Members: []*entity.Singer{},
 	}
 }
 
+// ユニットに所属している歌手を詰める unitIDが無効な場合は全件から探す
+func (r *masterRepository) attachUnitMembers(ctx context.Context, unitID sql.NullInt32, units []*entity.Unit) error {
+	rows, err := getQueries(ctx, r.queries).ListUnitSingers(ctx, sqlcgen.ListUnitSingersParams{UnitID: unitID})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	unitMap := make(map[int32]*entity.Unit, len(units))
+	for _, u := range units {
+		unitMap[u.ID] = u
+	}
+	for _, row := range rows {
+		if u, ok := unitMap[row.UnitID]; ok {
+			u.Members = append(u.Members, &entity.Singer{
+				ID:       row.SingerID,
+				Name:     row.SingerName,
+				Position: row.Position,
+			})
+		}
+	}
+
+	return nil
+}
+
+// UnitSinger
+func (r *masterRepository) CreateUnitSinger(ctx context.Context, unitID, singerID, position int32) (*sqlcgen.UnitSinger, error) {
+	arg := sqlcgen.InsertUnitSingerParams{
+		UnitID:   unitID,
+		SingerID: singerID,
+		Position: position,
+	}
+
+	us, err := getQueries(ctx, r.queries).InsertUnitSinger(ctx, arg)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &us, nil
+}
+
+func (r *masterRepository) ExistsUnitSinger(ctx context.Context, unitID, singerID int32) (bool, error) {
+	arg := sqlcgen.ExistsUnitSingerParams{
+		UnitID:   unitID,
+		SingerID: singerID,
+	}
+
+	exist, err := getQueries(ctx, r.queries).ExistsUnitSinger(ctx, arg)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return exist, nil
+}
+
+func (r *masterRepository) UpdateUnitSinger(ctx context.Context, unitID, singerID, position int32) error {
+	arg := sqlcgen.UpdateUnitSingerParams{
+		Position: position,
+		UnitID:   unitID,
+		SingerID: singerID,
+	}
+
+	if err := getQueries(ctx, r.queries).UpdateUnitSinger(ctx, arg); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *masterRepository) DeleteUnitSinger(ctx context.Context, unitID, singerID int32) error {
+	arg := sqlcgen.DeleteUnitSingerParams{
+		UnitID:   unitID,
+		SingerID: singerID,
+	}
+
+	if err := getQueries(ctx, r.queries).DeleteUnitSinger(ctx, arg); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *masterRepository) DeleteUnitSingersByUnitID(ctx context.Context, unitID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteUnitSingersByUnitID(ctx, unitID); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
 // VocalPattern
+func (r *masterRepository) ListVocalPatterns(ctx context.Context, songID int32) ([]*entity.VocalPattern, error) {
+	// songIDが0の場合は全件
+	arg := sql.NullInt32{Int32: songID, Valid: songID != 0}
+	rows, err := getQueries(ctx, r.queries).ListVocalPatternsWithSingers(ctx, arg)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return sqlToDomainVocalPatterns(rows), nil
+}
+
+func (r *masterRepository) GetVocalPatternByID(ctx context.Context, id int32) (*entity.VocalPattern, error) {
+	rows, err := getQueries(ctx, r.queries).GetVocalPatternWithSingersByID(ctx, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(rows) == 0 {
+		return nil, errors.WithStack(repository.ErrNotFound)
+	}
+
+	listRows := make([]sqlcgen.ListVocalPatternsWithSingersRow, len(rows))
+	for i, row := range rows {
+		listRows[i] = sqlcgen.ListVocalPatternsWithSingersRow(row)
+	}
+
+	return sqlToDomainVocalPatterns(listRows)[0], nil
+}
+
 func (r *masterRepository) CreateVocalPattern(ctx context.Context, songID int32, name string) (*sqlcgen.VocalPattern, error) {
 	sqlVocalPattern := sqlcgen.InsertVocalPatternParams{
 		SongID: sql.NullInt32{Int32: songID, Valid: true},
 		Name:   sql.NullString{String: name, Valid: true},
 	}
-	vp, err := r.queries.InsertVocalPattern(ctx, sqlVocalPattern)
+	vp, err := getQueries(ctx, r.queries).InsertVocalPattern(ctx, sqlVocalPattern)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -195,6 +456,70 @@ func (r *masterRepository) CreateVocalPattern(ctx context.Context, songID int32,
 	return &vp, nil
 }
 
+func (r *masterRepository) ExistsVocalPattern(ctx context.Context, id int32) (bool, error) {
+	exist, err := getQueries(ctx, r.queries).ExistsVocalPattern(ctx, id)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return exist, nil
+}
+
+func (r *masterRepository) UpdateVocalPattern(ctx context.Context, id int32, name string) error {
+	arg := sqlcgen.UpdateVocalPatternParams{
+		Name: sql.NullString{String: name, Valid: true},
+		ID:   id,
+	}
+
+	if err := getQueries(ctx, r.queries).UpdateVocalPattern(ctx, arg); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *masterRepository) DeleteVocalPattern(ctx context.Context, id int32) error {
+	if err := getQueries(ctx, r.queries).DeleteVocalPattern(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (r *masterRepository) DeleteVocalPatternsBySongID(ctx context.Context, songID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteVocalPatternsBySongID(ctx, sql.NullInt32{Int32: songID, Valid: true}); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func sqlToDomainVocalPatterns(rows []sqlcgen.ListVocalPatternsWithSingersRow) []*entity.VocalPattern {
+	var vps []*entity.VocalPattern
+	for _, v := range rows {
+		vpIndex := slices.IndexFunc(vps, func(vp *entity.VocalPattern) bool {
+			return vp.ID == v.ID
+		})
+		if vpIndex == -1 {
+			vps = append(vps, &entity.VocalPattern{
+				ID:      v.ID,
+				SongID:  v.SongID.Int32,
+				Name:    v.Name.String,
+				Singers: []*entity.Singer{},
+			})
+			vpIndex = len(vps) - 1
+		}
+		// 歌手が未登録のパターンはLEFT JOINでNULLになる
+		if !v.SingerID.Valid {
+			continue
+		}
+		vps[vpIndex].Singers = append(vps[vpIndex].Singers, &entity.Singer{
+			ID:       v.SingerID.Int32,
+			Name:     v.SingerName.String,
+			Position: v.SingerPosition.Int32,
+		})
+	}
+	return vps
+}
+
 // VocalPatternSinger
 func (r *masterRepository) CreateVocalPatternSinger(ctx context.Context, vocalPatternID, singerID, position int32) (*sqlcgen.VocalPatternSinger, error) {
 	sqlVocalPatternSinger := sqlcgen.InsertVocalPatternSingerParams{
@@ -202,7 +527,7 @@ func (r *masterRepository) CreateVocalPatternSinger(ctx context.Context, vocalPa
 		SingerID:       sql.NullInt32{Int32: singerID, Valid: true},
 		Position:       sql.NullInt32{Int32: position, Valid: true},
 	}
-	vps, err := r.queries.InsertVocalPatternSinger(ctx, sqlVocalPatternSinger)
+	vps, err := getQueries(ctx, r.queries).InsertVocalPatternSinger(ctx, sqlVocalPatternSinger)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -210,13 +535,27 @@ func (r *masterRepository) CreateVocalPatternSinger(ctx context.Context, vocalPa
 	return &vps, nil
 }
 
+func (r *masterRepository) DeleteVocalPatternSingersByVocalPatternID(ctx context.Context, vocalPatternID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteVocalPatternSingersByVocalPatternID(ctx, sql.NullInt32{Int32: vocalPatternID, Valid: true}); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (r *masterRepository) DeleteVocalPatternSingersBySongID(ctx context.Context, songID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteVocalPatternSingersBySongID(ctx, sql.NullInt32{Int32: songID, Valid: true}); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
 // SongUnit
 func (r *masterRepository) CreateSongUnit(ctx context.Context, songID, unitID int32) (*sqlcgen.SongUnit, error) {
 	sqlSongUnit := sqlcgen.InsertSongUnitParams{
 		SongID: sql.NullInt32{Int32: songID, Valid: true},
 		UnitID: sql.NullInt32{Int32: unitID, Valid: true},
 	}
-	su, err := r.queries.InsertSongUnit(ctx, sqlSongUnit)
+	su, err := getQueries(ctx, r.queries).InsertSongUnit(ctx, sqlSongUnit)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -224,13 +563,20 @@ func (r *masterRepository) CreateSongUnit(ctx context.Context, songID, unitID in
 	return &su, nil
 }
 
+func (r *masterRepository) DeleteSongUnitsBySongID(ctx context.Context, songID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteSongUnitsBySongID(ctx, sql.NullInt32{Int32: songID, Valid: true}); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
 // SongMusicVideoType
 func (r *masterRepository) CreateSongMusicVideoType(ctx context.Context, songID int32, musicVideoType enums.MusicVideoType) (*sqlcgen.SongMusicVideoType, error) {
 	sqlSongMusicVideoType := sqlcgen.InsertSongMusicVideoTypeParams{
 		SongID:         sql.NullInt32{Int32: songID, Valid: true},
 		MusicVideoType: sql.NullInt32{Int32: int32(musicVideoType), Valid: true},
 	}
-	smvt, err := r.queries.InsertSongMusicVideoType(ctx, sqlSongMusicVideoType)
+	smvt, err := getQueries(ctx, r.queries).InsertSongMusicVideoType(ctx, sqlSongMusicVideoType)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -238,25 +584,73 @@ func (r *masterRepository) CreateSongMusicVideoType(ctx context.Context, songID
 	return &smvt, nil
 }
 
+func (r *masterRepository) DeleteSongMusicVideoTypesBySongID(ctx context.Context, songID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteSongMusicVideoTypesBySongID(ctx, sql.NullInt32{Int32: songID, Valid: true}); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// SongCredit
+func (r *masterRepository) CreateSongCredit(ctx context.Context, songID, artistID int32, role enums.CreditRole, position int32) (*sqlcgen.SongCredit, error) {
+	arg := sqlcgen.InsertSongCreditParams{
+		SongID:   songID,
+		ArtistID: artistID,
+		Role:     int32(role),
+		Position: position,
+	}
+
+	sc, err := getQueries(ctx, r.queries).InsertSongCredit(ctx, arg)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &sc, nil
+}
+
+func (r *masterRepository) DeleteSongCreditsBySongID(ctx context.Context, songID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteSongCreditsBySongID(ctx, songID); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (r *masterRepository) MergeSongCredits(ctx context.Context, sourceArtistID, targetArtistID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteDuplicateSongCreditsForMerge(ctx, sqlcgen.DeleteDuplicateSongCreditsForMergeParams{
+		SourceID: sourceArtistID,
+		TargetID: targetArtistID,
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := getQueries(ctx, r.queries).ReassignSongCredits(ctx, sqlcgen.ReassignSongCreditsParams{
+		TargetID: targetArtistID,
+		SourceID: sourceArtistID,
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
 // Song
 func (r *masterRepository) ListSongs(ctx context.Context) ([]*entity.Song, error) {
-	songs, err := r.queries.ListSongWithArtists(ctx)
+	ids, err := getQueries(ctx, r.queries).ListSongIDs(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	songs, err := r.ListSongsByIDs(ctx, ids)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	return sqlToDomainListSong(songs), nil
+	return songs, nil
 }
 
 func (r *masterRepository) GetSongByID(ctx context.Context, id int32) (*entity.Song, error) {
-	sqlSongs, err := r.queries.GetSongDetailsByID(ctx, id)
+	songs, err := r.ListSongsByIDs(ctx, []int32{id})
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) || len(sqlSongs) <= 0 {
-			return nil, errors.WithStack(repository.ErrNotFound)
-		}
 		return nil, errors.WithStack(err)
 	}
-	songs := sqlToDomainGetSong(sqlSongs)
 	if len(songs) == 0 {
 		return nil, errors.WithStack(repository.ErrNotFound)
 	}
@@ -267,22 +661,19 @@ func (r *masterRepository) GetSongByID(ctx context.Context, id int32) (*entity.S
 func (r *masterRepository) CreateSong(
 	ctx context.Context,
 	name, kana string,
-	lyrics_id, music_id, arrangement_id int32,
 	thumbnail, originalVideo string,
 	releaseTime time.Time, deleted bool,
 ) (*sqlcgen.Song, error) {
 	sqlSong := sqlcgen.InsertSongParams{
 		Name:          name,
 		Kana:          kana,
-		LyricsID:      sql.NullInt32{Int32: lyrics_id, Valid: true},
-		MusicID:       sql.NullInt32{Int32: music_id, Valid: true},
-		ArrangementID: sql.NullInt32{Int32: arrangement_id, Valid: true},
 		Thumbnail:     sql.NullString{String: thumbnail, Valid: true},
 		OriginalVideo: sql.NullString{String: originalVideo, Valid: true},
 		ReleaseTime:   sql.NullTime{Time: releaseTime, Valid: true},
 		Deleted:       sql.NullBool{Bool: deleted, Valid: true},
+		SearchText:    normalize.SearchText(name, kana),
 	}
-	s, err := r.queries.InsertSong(ctx, sqlSong)
+	s, err := getQueries(ctx, r.queries).InsertSong(ctx, sqlSong)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -291,7 +682,7 @@ func (r *masterRepository) CreateSong(
 }
 
 func (r *masterRepository) ExistsSong(ctx context.Context, id int32) (bool, error) {
-	exist, err := r.queries.ExistsSong(ctx, id)
+	exist, err := getQueries(ctx, r.queries).ExistsSong(ctx, id)
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
@@ -299,218 +690,247 @@ func (r *masterRepository) ExistsSong(ctx context.Context, id int32) (bool, erro
 	return exist, nil
 }
 
-func sqlToDomainListSong(sqlSongs []sqlcgen.ListSongWithArtistsRow) []*entity.Song {
-	var songs []*entity.Song
+func (r *masterRepository) ExistsSongByArtistID(ctx context.Context, artistID int32) (bool, error) {
+	exist, err := getQueries(ctx, r.queries).ExistsSongByArtistID(ctx, artistID)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return exist, nil
+}
+
+func (r *masterRepository) UpdateSong(
+	ctx context.Context,
+	id int32,
+	name, kana string,
+	thumbnail, originalVideo string,
+	releaseTime time.Time, deleted bool,
+) error {
+	arg := sqlcgen.UpdateSongParams{
+		Name:          name,
+		Kana:          kana,
+		Thumbnail:     sql.NullString{String: thumbnail, Valid: true},
+		OriginalVideo: sql.NullString{String: originalVideo, Valid: true},
+		ReleaseTime:   sql.NullTime{Time: releaseTime, Valid: true},
+		Deleted:       sql.NullBool{Bool: deleted, Valid: true},
+		SearchText:    normalize.SearchText(name, kana),
+		ID:            id,
+	}
+
+	if err := getQueries(ctx, r.queries).UpdateSong(ctx, arg); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *masterRepository) DeleteSong(ctx context.Context, id int32) error {
+	if err := getQueries(ctx, r.queries).DeleteSong(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (r *masterRepository) SearchSongIDs(ctx context.Context, cond repository.SongSearchCondition, afterID, limit int32) ([]int32, error) {
+	arg := sqlcgen.SearchSongIDsParams{
+		AfterID:        afterID,
+		Query:          sql.NullString{String: escapeLike(cond.Query), Valid: cond.Query != ""},
+		LyricsID:       sql.NullInt32{Int32: cond.LyricsID, Valid: cond.LyricsID != 0},
+		MusicID:        sql.NullInt32{Int32: cond.MusicID, Valid: cond.MusicID != 0},
+		ArrangementID:  sql.NullInt32{Int32: cond.ArrangementID, Valid: cond.ArrangementID != 0},
+		UnitID:         sql.NullInt32{Int32: cond.UnitID, Valid: cond.UnitID != 0},
+		SingerID:       sql.NullInt32{Int32: cond.SingerID, Valid: cond.SingerID != 0},
+		MusicVideoType: sql.NullInt32{Int32: int32(cond.MusicVideoType), Valid: cond.MusicVideoType != enums.MusicVideoType_MUSIC_VIDEO_TYPE_UNSPECIFIED},
+		ReleaseFrom:    sql.NullTime{Time: cond.ReleaseFrom, Valid: !cond.ReleaseFrom.IsZero()},
+		ReleaseTo:      releaseToParam(cond.ReleaseTo, cond.IncludeUnreleased),
+		IncludeDeleted: cond.IncludeDeleted,
+		PageLimit:      limit,
+	}
+
+	ids, err := getQueries(ctx, r.queries).SearchSongIDs(ctx, arg)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ids, nil
+}
+
+// 曲本体と関連テーブルを別々に取得して組み立てる
+func (r *masterRepository) ListSongsByIDs(ctx context.Context, ids []int32) ([]*entity.Song, error) {
+	if len(ids) == 0 {
+		return []*entity.Song{}, nil
+	}
+
+	sqlSongs, err := getQueries(ctx, r.queries).ListSongsByIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	songMap := make(map[int32]*entity.Song, len(sqlSongs))
 	for _, v := range sqlSongs {
-		songIndex := slices.IndexFunc(songs, func(song *entity.Song) bool {
-			return song.ID == v.ID
-		})
-		if songIndex == -1 {
-			songs = append(songs, &entity.Song{
-				ID:   v.ID,
-				Name: v.Name,
-				Kana: v.Kana,
-				Lyrics: entity.Artist{
-					ID:   v.LyricsArtistID.Int32,
-					Name: v.LyricsArtistName.String,
-					Kana: v.LyricsArtistKana.String,
-				},
-				Music: entity.Artist{
-					ID:   v.MusicArtistID.Int32,
-					Name: v.MusicArtistName.String,
-					Kana: v.MusicArtistKana.String,
-				},
-				Arrangement: entity.Artist{
-					ID:   v.ArrangementArtistID.Int32,
-					Name: v.ArrangementArtistName.String,
-					Kana: v.ArrangementArtistKana.String,
-				},
-				Thumbnail:     v.Thumbnail.String,
-				OriginalVideo: v.OriginalVideo.String,
-				ReleaseTime:   v.ReleaseTime.Time,
-				Deleted:       v.Deleted.Bool,
-				VocalPatterns: []*entity.VocalPattern{
-					{
-						ID:   v.VocalPatternID.Int32,
-						Name: v.VocalPatternName.String,
-						Singers: []*entity.Singer{
-							{
-								ID:       v.SingerID.Int32,
-								Name:     v.SingerName.String,
-								Position: v.SingerOrder.Int32,
-							},
-						},
-					},
-				},
-				Units: []*entity.Unit{
-					{
-						ID:   v.UnitID.Int32,
-						Name: v.UnitName.String,
-					},
-				},
-				MusicVideoTypes: []enums.MusicVideoType{
-					enums.MusicVideoType(v.MusicVideoTypeID.Int32),
+		songMap[v.ID] = &entity.Song{
+			ID:              v.ID,
+			Name:            v.Name,
+			Kana:            v.Kana,
+			Thumbnail:       v.Thumbnail.String,
+			OriginalVideo:   v.OriginalVideo.String,
+			ReleaseTime:     v.ReleaseTime.Time,
+			Deleted:         v.Deleted.Bool,
+			VocalPatterns:   []*entity.VocalPattern{},
+			Units:           []*entity.Unit{},
+			MusicVideoTypes: []enums.MusicVideoType{},
+			Credits:         []*entity.SongCredit{},
+			CreatedAt:       v.CreatedAt,
+		}
+	}
+
+	creditRows, err := getQueries(ctx, r.queries).ListSongCreditsBySongIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, v := range creditRows {
+		if song, ok := songMap[v.SongID]; ok {
+			song.Credits = append(song.Credits, &entity.SongCredit{
+				Artist: entity.Artist{
+					ID:   v.ID,
+					Name: v.Name,
+					Kana: v.Kana,
 				},
+				Role:     enums.CreditRole(v.Role),
+				Position: v.Position,
 			})
-		} else {
-			vpIndex := slices.IndexFunc(songs[songIndex].VocalPatterns, func(vp *entity.VocalPattern) bool {
-				return vp.ID == v.VocalPatternID.Int32
-			})
-			if vpIndex != -1 {
-				sgFind := slices.ContainsFunc(songs[songIndex].VocalPatterns[vpIndex].Singers, func(sg *entity.Singer) bool {
-					return sg.ID == v.SingerID.Int32
-				})
-				if !sgFind {
-					songs[songIndex].VocalPatterns[vpIndex].Singers = append(songs[songIndex].VocalPatterns[vpIndex].Singers, &entity.Singer{
-						ID:       v.SingerID.Int32,
-						Name:     v.SingerName.String,
-						Position: v.SingerOrder.Int32,
-					})
-				}
-			} else {
-				songs[songIndex].VocalPatterns = append(songs[songIndex].VocalPatterns, &entity.VocalPattern{
-					ID:      v.VocalPatternID.Int32,
-					Name:    v.VocalPatternName.String,
-					Singers: []*entity.Singer{{ID: v.SingerID.Int32, Name: v.SingerName.String, Position: v.SingerOrder.Int32}},
-				})
-			}
-
-			utFind := slices.ContainsFunc(songs[songIndex].Units, func(ut *entity.Unit) bool {
-				return ut.ID == v.UnitID.Int32
-			})
-			if !utFind {
-				songs[songIndex].Units = append(songs[songIndex].Units, &entity.Unit{
-					ID:   v.UnitID.Int32,
-					Name: v.UnitName.String,
-				})
-			}
-
-			mvtFind := slices.ContainsFunc(songs[songIndex].MusicVideoTypes, func(mvt enums.MusicVideoType) bool {
-				return mvt == enums.MusicVideoType(v.MusicVideoTypeID.Int32)
-			})
-			if !mvtFind {
-				songs[songIndex].MusicVideoTypes = append(songs[songIndex].MusicVideoTypes, enums.MusicVideoType(v.MusicVideoTypeID.Int32))
-			}
 		}
 	}
-	return songs
-}
-func sqlToDomainGetSong(sqlSongs []sqlcgen.GetSongDetailsByIDRow) []*entity.Song {
-	var songs []*entity.Song
-	for _, v := range sqlSongs {
-		songIndex := slices.IndexFunc(songs, func(song *entity.Song) bool {
-			return song.ID == v.ID
-		})
-		if songIndex == -1 {
-			songs = append(songs, &entity.Song{
+
+	vpRows, err := getQueries(ctx, r.queries).ListVocalPatternsWithSingersBySongIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	listVPRows := make([]sqlcgen.ListVocalPatternsWithSingersRow, len(vpRows))
+	for i, row := range vpRows {
+		listVPRows[i] = sqlcgen.ListVocalPatternsWithSingersRow(row)
+	}
+	for _, vp := range sqlToDomainVocalPatterns(listVPRows) {
+		if song, ok := songMap[vp.SongID]; ok {
+			song.VocalPatterns = append(song.VocalPatterns, vp)
+		}
+	}
+
+	unitRows, err := getQueries(ctx, r.queries).ListUnitsBySongIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, v := range unitRows {
+		if song, ok := songMap[v.SongID.Int32]; ok {
+			song.Units = append(song.Units, &entity.Unit{
 				ID:   v.ID,
 				Name: v.Name,
-				Kana: v.Kana,
-				Lyrics: entity.Artist{
-					ID:   v.LyricsArtistID.Int32,
-					Name: v.LyricsArtistName.String,
-					Kana: v.LyricsArtistKana.String,
-				},
-				Music: entity.Artist{
-					ID:   v.MusicArtistID.Int32,
-					Name: v.MusicArtistName.String,
-					Kana: v.MusicArtistKana.String,
-				},
-				Arrangement: entity.Artist{
-					ID:   v.ArrangementArtistID.Int32,
-					Name: v.ArrangementArtistName.String,
-					Kana: v.ArrangementArtistKana.String,
-				},
-				Thumbnail:     v.Thumbnail.String,
-				OriginalVideo: v.OriginalVideo.String,
-				ReleaseTime:   v.ReleaseTime.Time,
-				Deleted:       v.Deleted.Bool,
-				VocalPatterns: []*entity.VocalPattern{
-					{
-						ID:   v.VocalPatternID.Int32,
-						Name: v.VocalPatternName.String,
-						Singers: []*entity.Singer{
-							{
-								ID:       v.SingerID.Int32,
-								Name:     v.SingerName.String,
-								Position: v.SingerOrder.Int32,
-							},
-						},
-					},
-				},
-				Units: []*entity.Unit{
-					{
-						ID:   v.UnitID.Int32,
-						Name: v.UnitName.String,
-					},
-				},
-				MusicVideoTypes: []enums.MusicVideoType{
-					enums.MusicVideoType(v.MusicVideoTypeID.Int32),
-				},
-			})
-		} else {
-			vpIndex := slices.IndexFunc(songs[songIndex].VocalPatterns, func(vp *entity.VocalPattern) bool {
-				return vp.ID == v.VocalPatternID.Int32
-			})
-			if vpIndex != -1 {
-				sgFind := slices.ContainsFunc(songs[songIndex].VocalPatterns[vpIndex].Singers, func(sg *entity.Singer) bool {
-					return sg.ID == v.SingerID.Int32
-				})
-				if !sgFind {
-					songs[songIndex].VocalPatterns[vpIndex].Singers = append(songs[songIndex].VocalPatterns[vpIndex].Singers, &entity.Singer{
-						ID:       v.SingerID.Int32,
-						Name:     v.SingerName.String,
-						Position: v.SingerOrder.Int32,
-					})
-				}
-			} else {
-				songs[songIndex].VocalPatterns = append(songs[songIndex].VocalPatterns, &entity.VocalPattern{
-					ID:      v.VocalPatternID.Int32,
-					Name:    v.VocalPatternName.String,
-					Singers: []*entity.Singer{{ID: v.SingerID.Int32, Name: v.SingerName.String, Position: v.SingerOrder.Int32}},
-				})
-			}
-
-			utFind := slices.ContainsFunc(songs[songIndex].Units, func(ut *entity.Unit) bool {
-				return ut.ID == v.UnitID.Int32
-			})
-			if !utFind {
-				songs[songIndex].Units = append(songs[songIndex].Units, &entity.Unit{
-					ID:   v.UnitID.Int32,
-					Name: v.UnitName.String,
-				})
-			}
-
-			mvtFind := slices.ContainsFunc(songs[songIndex].MusicVideoTypes, func(mvt enums.MusicVideoType) bool {
-				return mvt == enums.MusicVideoType(v.MusicVideoTypeID.Int32)
 			})
-			if !mvtFind {
-				songs[songIndex].MusicVideoTypes = append(songs[songIndex].MusicVideoTypes, enums.MusicVideoType(v.MusicVideoTypeID.Int32))
-			}
 		}
 	}
-	return songs
+
+	mvtRows, err := getQueries(ctx, r.queries).ListSongMusicVideoTypesBySongIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, v := range mvtRows {
+		if song, ok := songMap[v.SongID.Int32]; ok {
+			song.MusicVideoTypes = append(song.MusicVideoTypes, enums.MusicVideoType(v.MusicVideoType.Int32))
+		}
+	}
+
+	// idsの順に並べる
+	songs := make([]*entity.Song, 0, len(ids))
+	for _, id := range ids {
+		if song, ok := songMap[id]; ok {
+			songs = append(songs, song)
+		}
+	}
+
+	return songs, nil
+}
+
+// 既存の行の検索用カラムを作り直す onlyMissingなら空の行だけ
+func (r *masterRepository) BackfillSearchText(ctx context.Context, onlyMissing bool) (int, int, error) {
+	var artists []sqlcgen.ListArtistNamesWithoutSearchTextRow
+	if onlyMissing {
+		rows, err := getQueries(ctx, r.queries).ListArtistNamesWithoutSearchText(ctx)
+		if err != nil {
+			return 0, 0, errors.WithStack(err)
+		}
+		artists = rows
+	} else {
+		rows, err := getQueries(ctx, r.queries).ListArtists(ctx)
+		if err != nil {
+			return 0, 0, errors.WithStack(err)
+		}
+		for _, a := range rows {
+			artists = append(artists, sqlcgen.ListArtistNamesWithoutSearchTextRow{ID: a.ID, Name: a.Name, Kana: a.Kana})
+		}
+	}
+	for _, a := range artists {
+		arg := sqlcgen.UpdateArtistSearchTextParams{
+			SearchText: normalize.SearchText(a.Name, a.Kana),
+			ID:         a.ID,
+		}
+		if err := getQueries(ctx, r.queries).UpdateArtistSearchText(ctx, arg); err != nil {
+			return 0, 0, errors.WithStack(err)
+		}
+	}
+
+	var songs []sqlcgen.ListSongNamesRow
+	if onlyMissing {
+		rows, err := getQueries(ctx, r.queries).ListSongNamesWithoutSearchText(ctx)
+		if err != nil {
+			return 0, 0, errors.WithStack(err)
+		}
+		for _, s := range rows {
+			songs = append(songs, sqlcgen.ListSongNamesRow(s))
+		}
+	} else {
+		rows, err := getQueries(ctx, r.queries).ListSongNames(ctx)
+		if err != nil {
+			return 0, 0, errors.WithStack(err)
+		}
+		songs = rows
+	}
+	for _, s := range songs {
+		arg := sqlcgen.UpdateSongSearchTextParams{
+			SearchText: normalize.SearchText(s.Name, s.Kana),
+			ID:         s.ID,
+		}
+		if err := getQueries(ctx, r.queries).UpdateSongSearchText(ctx, arg); err != nil {
+			return 0, 0, errors.WithStack(err)
+		}
+	}
+
+	return len(artists), len(songs), nil
+}
+
+// LIKE用に%と_をエスケープする
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
 }
 
 // Chart
 func (r *masterRepository) ListCharts(ctx context.Context) ([]*entity.Chart, error) {
-	charts, err := r.queries.ListChartWithSongWithArtists(ctx)
+	ids, err := getQueries(ctx, r.queries).ListChartIDs(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	charts, err := r.ListChartsByIDs(ctx, ids)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	return sqlToDomainListChart(charts), nil
+	return charts, nil
 }
 
 func (r *masterRepository) GetChartByID(ctx context.Context, id int32) (*entity.Chart, error) {
-	sqlCharts, err := r.queries.GetChartWithSongWithArtistsByID(ctx, id)
+	charts, err := r.ListChartsByIDs(ctx, []int32{id})
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) || len(sqlCharts) <= 0 {
-			return nil, errors.WithStack(repository.ErrNotFound)
-		}
 		return nil, errors.WithStack(err)
 	}
-	charts := sqlToDomainGetChart(sqlCharts)
 	if len(charts) == 0 {
 		return nil, errors.WithStack(repository.ErrNotFound)
 	}
@@ -518,23 +938,105 @@ func (r *masterRepository) GetChartByID(ctx context.Context, id int32) (*entity.
 	return charts[0], nil
 }
 
-func (r *masterRepository) CreateChart(ctx context.Context, songID, difficultyType, level int32, chartViewLink string) (*sqlcgen.Chart, error) {
+func (r *masterRepository) CreateChart(ctx context.Context, songID, difficultyType, level int32, chartViewLink string, metadata entity.ChartMetadata) (*sqlcgen.Chart, error) {
 	sqlChart := sqlcgen.InsertChartParams{
+		SongID:          sql.NullInt32{Int32: songID, Valid: true},
+		DifficultyType:  sql.NullInt32{Int32: difficultyType, Valid: true},
+		Level:           sql.NullInt32{Int32: level, Valid: true},
+		ChartViewLink:   sql.NullString{String: chartViewLink, Valid: true},
+		NoteCount:       optionalInt32(metadata.NoteCount),
+		BpmMin:          optionalInt32(metadata.BPMMin),
+		BpmMax:          optionalInt32(metadata.BPMMax),
+		DurationSeconds: optionalInt32(metadata.DurationSeconds),
+		Constant:        optionalFloat64(metadata.Constant),
+	}
+	c, err := getQueries(ctx, r.queries).InsertChart(ctx, sqlChart)
+	if err != nil {
+		return nil, wrapUniqueViolation(err)
+	}
+
+	return &c, nil
+}
+
+func (r *masterRepository) ExistsChart(ctx context.Context, id int32) (bool, error) {
+	exist, err := getQueries(ctx, r.queries).ExistsChart(ctx, id)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return exist, nil
+}
+
+func (r *masterRepository) FindChartIDBySongAndDifficulty(ctx context.Context, songID, difficultyType int32) (int32, error) {
+	id, err := getQueries(ctx, r.queries).FindChartIDBySongAndDifficulty(ctx, sqlcgen.FindChartIDBySongAndDifficultyParams{
 		SongID:         sql.NullInt32{Int32: songID, Valid: true},
 		DifficultyType: sql.NullInt32{Int32: difficultyType, Valid: true},
-		Level:          sql.NullInt32{Int32: level, Valid: true},
-		ChartViewLink:  sql.NullString{String: chartViewLink, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errors.WithStack(repository.ErrNotFound)
+		}
+		return 0, errors.WithStack(err)
 	}
-	c, err := r.queries.InsertChart(ctx, sqlChart)
+
+	return id, nil
+}
+
+func (r *masterRepository) ListChartIDsBySongID(ctx context.Context, songID int32) ([]int32, error) {
+	ids, err := getQueries(ctx, r.queries).ListChartIDsBySongID(ctx, sql.NullInt32{Int32: songID, Valid: true})
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	return &c, nil
+	return ids, nil
 }
 
-func (r *masterRepository) ExistsChart(ctx context.Context, id int32) (bool, error) {
-	exist, err := r.queries.ExistsChart(ctx, id)
+func (r *masterRepository) UpdateChart(ctx context.Context, id, songID, difficultyType, level int32, chartViewLink string, metadata entity.ChartMetadata) error {
+	arg := sqlcgen.UpdateChartParams{
+		SongID:          sql.NullInt32{Int32: songID, Valid: true},
+		DifficultyType:  sql.NullInt32{Int32: difficultyType, Valid: true},
+		Level:           sql.NullInt32{Int32: level, Valid: true},
+		ChartViewLink:   sql.NullString{String: chartViewLink, Valid: true},
+		NoteCount:       optionalInt32(metadata.NoteCount),
+		BpmMin:          optionalInt32(metadata.BPMMin),
+		BpmMax:          optionalInt32(metadata.BPMMax),
+		DurationSeconds: optionalInt32(metadata.DurationSeconds),
+		Constant:        optionalFloat64(metadata.Constant),
+		ID:              id,
+	}
+
+	if err := getQueries(ctx, r.queries).UpdateChart(ctx, arg); err != nil {
+		return wrapUniqueViolation(err)
+	}
+
+	return nil
+}
+
+// 譜面の追加情報はゼロ値をNULLとして保存する
+func optionalInt32(v int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: v, Valid: v != 0}
+}
+
+func optionalFloat64(v float64) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: v, Valid: v != 0}
+}
+
+func (r *masterRepository) DeleteChart(ctx context.Context, id int32) error {
+	if err := getQueries(ctx, r.queries).DeleteChart(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (r *masterRepository) DeleteChartsBySongID(ctx context.Context, songID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteChartsBySongID(ctx, sql.NullInt32{Int32: songID, Valid: true}); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (r *masterRepository) ExistsMyListChartByChartID(ctx context.Context, chartID int32) (bool, error) {
+	exist, err := getQueries(ctx, r.queries).ExistsMyListChartByChartID(ctx, sql.NullInt32{Int32: chartID, Valid: true})
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
@@ -542,207 +1044,282 @@ func (r *masterRepository) ExistsChart(ctx context.Context, id int32) (bool, err
 	return exist, nil
 }
 
-func sqlToDomainListChart(sqlCharts []sqlcgen.ListChartWithSongWithArtistsRow) []*entity.Chart {
-	var charts []*entity.Chart
-	for _, v := range sqlCharts {
-		chartIndex := slices.IndexFunc(charts, func(chart *entity.Chart) bool {
-			return chart.ID == v.ID
-		})
-		if chartIndex == -1 {
-			charts = append(charts, &entity.Chart{
-				ID: v.ID,
-				Song: entity.Song{
-					ID:   v.SongID.Int32,
-					Name: v.SongName.String,
-					Kana: v.SongKana.String,
-					Lyrics: entity.Artist{
-						ID:   v.LyricsArtistID.Int32,
-						Name: v.LyricsArtistName.String,
-						Kana: v.LyricsArtistKana.String,
-					},
-					Music: entity.Artist{
-						ID:   v.MusicArtistID.Int32,
-						Name: v.MusicArtistName.String,
-						Kana: v.MusicArtistKana.String,
-					},
-					Arrangement: entity.Artist{
-						ID:   v.ArrangementArtistID.Int32,
-						Name: v.ArrangementArtistName.String,
-						Kana: v.ArrangementArtistKana.String,
-					},
-					Thumbnail:     v.Thumbnail.String,
-					OriginalVideo: v.OriginalVideo.String,
-					ReleaseTime:   v.ReleaseTime.Time,
-					Deleted:       v.Deleted.Bool,
-					VocalPatterns: []*entity.VocalPattern{
-						{
-							ID:   v.VocalPatternID.Int32,
-							Name: v.VocalPatternName.String,
-							Singers: []*entity.Singer{
-								{
-									ID:       v.SingerID.Int32,
-									Name:     v.SingerName.String,
-									Position: v.SingerOrder.Int32,
-								},
-							},
-						},
-					},
-					Units: []*entity.Unit{
-						{
-							ID:   v.UnitID.Int32,
-							Name: v.UnitName.String,
-						},
-					},
-					MusicVideoTypes: []enums.MusicVideoType{
-						enums.MusicVideoType(v.MusicVideoTypeID.Int32),
-					},
-				},
-				DifficultyType: enums.DifficultyType(v.DifficultyType.Int32),
-				Level:          v.Level.Int32,
-				ChartViewLink:  v.ChartViewLink.String,
-			})
-		} else {
-			vpIndex := slices.IndexFunc(charts[chartIndex].Song.VocalPatterns, func(vp *entity.VocalPattern) bool {
-				return vp.ID == v.VocalPatternID.Int32
-			})
-			if vpIndex != -1 {
-				sgFind := slices.ContainsFunc(charts[chartIndex].Song.VocalPatterns[vpIndex].Singers, func(sg *entity.Singer) bool {
-					return sg.ID == v.SingerID.Int32
-				})
-				if !sgFind {
-					charts[chartIndex].Song.VocalPatterns[vpIndex].Singers = append(charts[chartIndex].Song.VocalPatterns[vpIndex].Singers, &entity.Singer{
-						ID:       v.SingerID.Int32,
-						Name:     v.SingerName.String,
-						Position: v.SingerOrder.Int32,
-					})
-				}
-			} else {
-				charts[chartIndex].Song.VocalPatterns = append(charts[chartIndex].Song.VocalPatterns, &entity.VocalPattern{
-					ID:      v.VocalPatternID.Int32,
-					Name:    v.VocalPatternName.String,
-					Singers: []*entity.Singer{{ID: v.SingerID.Int32, Name: v.SingerName.String, Position: v.SingerOrder.Int32}},
-				})
-			}
-
-			utFind := slices.ContainsFunc(charts[chartIndex].Song.Units, func(ut *entity.Unit) bool {
-				return ut.ID == v.UnitID.Int32
-			})
-			if !utFind {
-				charts[chartIndex].Song.Units = append(charts[chartIndex].Song.Units, &entity.Unit{
-					ID:   v.UnitID.Int32,
-					Name: v.UnitName.String,
-				})
-			}
-
-			mvtFind := slices.ContainsFunc(charts[chartIndex].Song.MusicVideoTypes, func(mvt enums.MusicVideoType) bool {
-				return mvt == enums.MusicVideoType(v.MusicVideoTypeID.Int32)
-			})
-			if !mvtFind {
-				charts[chartIndex].Song.MusicVideoTypes = append(charts[chartIndex].Song.MusicVideoTypes, enums.MusicVideoType(v.MusicArtistID.Int32))
-			}
+func (r *masterRepository) ExistsMyListChartBySongID(ctx context.Context, songID int32) (bool, error) {
+	exist, err := getQueries(ctx, r.queries).ExistsMyListChartBySongID(ctx, sql.NullInt32{Int32: songID, Valid: true})
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return exist, nil
+}
+
+func (r *masterRepository) SearchChartIDs(ctx context.Context, cond repository.ChartSearchCondition, afterID, limit int32) ([]int32, error) {
+	c := toSQLChartSearchCondition(cond)
+	arg := sqlcgen.SearchChartIDsParams{
+		AfterID:         afterID,
+		DifficultyTypes: c.DifficultyTypes,
+		MinLevel:        c.MinLevel,
+		MaxLevel:        c.MaxLevel,
+		UnitIds:         c.UnitIds,
+		SingerIds:       c.SingerIds,
+		ReleaseFrom:     c.ReleaseFrom,
+		ReleaseTo:       c.ReleaseTo,
+		Query:           c.Query,
+		IncludeDeleted:  c.IncludeDeleted,
+		PageLimit:       limit,
+	}
+
+	ids, err := getQueries(ctx, r.queries).SearchChartIDs(ctx, arg)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ids, nil
+}
+
+func (r *masterRepository) CountSearchCharts(ctx context.Context, cond repository.ChartSearchCondition) (int64, error) {
+	count, err := getQueries(ctx, r.queries).CountSearchCharts(ctx, toSQLChartSearchCondition(cond))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+func (r *masterRepository) ListChartsByIDs(ctx context.Context, ids []int32) ([]*entity.Chart, error) {
+	if len(ids) == 0 {
+		return []*entity.Chart{}, nil
+	}
+
+	sqlCharts, err := getQueries(ctx, r.queries).ListChartsByIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var songIDs []int32
+	for _, c := range sqlCharts {
+		if c.SongID.Valid && !slices.Contains(songIDs, c.SongID.Int32) {
+			songIDs = append(songIDs, c.SongID.Int32)
+		}
+	}
+	songs, err := r.ListSongsByIDs(ctx, songIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	songMap := make(map[int32]*entity.Song, len(songs))
+	for _, song := range songs {
+		songMap[song.ID] = song
+	}
+
+	chartMap := make(map[int32]*entity.Chart, len(sqlCharts))
+	for _, c := range sqlCharts {
+		chart := &entity.Chart{
+			ID:             c.ID,
+			DifficultyType: enums.DifficultyType(c.DifficultyType.Int32),
+			Level:          c.Level.Int32,
+			ChartViewLink:  c.ChartViewLink.String,
+			Metadata: entity.ChartMetadata{
+				NoteCount:       c.NoteCount.Int32,
+				BPMMin:          c.BpmMin.Int32,
+				BPMMax:          c.BpmMax.Int32,
+				DurationSeconds: c.DurationSeconds.Int32,
+				Constant:        c.Constant.Float64,
+			},
+			CreatedAt: c.CreatedAt,
+		}
+		if song, ok := songMap[c.SongID.Int32]; ok {
+			chart.Song = *song
+		}
+		chartMap[c.ID] = chart
+	}
+
+	// idsの順に並べる
+	charts := make([]*entity.Chart, 0, len(ids))
+	for _, id := range ids {
+		if chart, ok := chartMap[id]; ok {
+			charts = append(charts, chart)
+		}
+	}
+
+	return charts, nil
+}
+
+func toSQLChartSearchCondition(cond repository.ChartSearchCondition) sqlcgen.CountSearchChartsParams {
+	// nilのスライスはNULLになり絞り込みが効かなくなるので空スライスを渡す
+	difficultyTypes := make([]int32, len(cond.DifficultyTypes))
+	for i, d := range cond.DifficultyTypes {
+		difficultyTypes[i] = int32(d)
+	}
+	unitIDs := append([]int32{}, cond.UnitIDs...)
+	singerIDs := append([]int32{}, cond.SingerIDs...)
+
+	return sqlcgen.CountSearchChartsParams{
+		DifficultyTypes: difficultyTypes,
+		MinLevel:        sql.NullInt32{Int32: cond.MinLevel, Valid: cond.MinLevel != 0},
+		MaxLevel:        sql.NullInt32{Int32: cond.MaxLevel, Valid: cond.MaxLevel != 0},
+		UnitIds:         unitIDs,
+		SingerIds:       singerIDs,
+		ReleaseFrom:     sql.NullTime{Time: cond.ReleaseFrom, Valid: !cond.ReleaseFrom.IsZero()},
+		ReleaseTo:       releaseToParam(cond.ReleaseTo, cond.IncludeUnreleased),
+		Query:           sql.NullString{String: escapeLike(cond.Query), Valid: cond.Query != ""},
+		IncludeDeleted:  cond.IncludeDeleted,
+	}
+}
+
+// 公開前の曲を含めない時はrelease_toを現在時刻までに絞る
+// release_timeはUTCで保存しているのでUTCに揃えて渡す
+func releaseToParam(releaseTo time.Time, includeUnreleased bool) sql.NullTime {
+	if !includeUnreleased {
+		now := time.Now().UTC()
+		if releaseTo.IsZero() || releaseTo.After(now) {
+			releaseTo = now
+		}
+	}
+	return sql.NullTime{Time: releaseTo, Valid: !releaseTo.IsZero()}
+}
+
+// Revision
+func (r *masterRepository) GetMasterRevision(ctx context.Context) (int64, error) {
+	revision, err := getQueries(ctx, r.queries).GetMasterRevision(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return revision, nil
+}
+
+func (r *masterRepository) RecordMasterChanges(ctx context.Context, logs []*entity.MasterChangeLog) (int64, error) {
+	q := getQueries(ctx, r.queries)
+
+	revision, err := q.IncrementMasterRevision(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	for _, l := range logs {
+		arg := sqlcgen.InsertMasterChangeLogParams{
+			Revision:  revision,
+			Kind:      l.Kind,
+			EntityID:  l.EntityID,
+			Operation: string(l.Operation),
 		}
+		if err := q.InsertMasterChangeLog(ctx, arg); err != nil {
+			return 0, errors.WithStack(err)
+		}
+		l.Revision = revision
+	}
+
+	return revision, nil
+}
+
+func (r *masterRepository) ListLatestMasterChangeLogsSince(ctx context.Context, revision int64) ([]*entity.MasterChangeLog, error) {
+	rows, err := getQueries(ctx, r.queries).ListLatestMasterChangeLogsSince(ctx, revision)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	logs := make([]*entity.MasterChangeLog, len(rows))
+	for i, row := range rows {
+		logs[i] = &entity.MasterChangeLog{
+			Revision:  row.Revision,
+			Kind:      row.Kind,
+			EntityID:  row.EntityID,
+			Operation: entity.MasterChangeOperation(row.Operation),
+		}
+	}
+
+	return logs, nil
+}
+
+func (r *masterRepository) GetReleasesRecordedAtForUpdate(ctx context.Context) (time.Time, error) {
+	recordedAt, err := getQueries(ctx, r.queries).GetReleasesRecordedAtForUpdate(ctx)
+	if err != nil {
+		return time.Time{}, errors.WithStack(err)
 	}
-	return charts
+
+	return recordedAt, nil
 }
-func sqlToDomainGetChart(sqlCharts []sqlcgen.GetChartWithSongWithArtistsByIDRow) []*entity.Chart {
-	var charts []*entity.Chart
-	for _, v := range sqlCharts {
-		chartIndex := slices.IndexFunc(charts, func(chart *entity.Chart) bool {
-			return chart.ID == v.ID
+
+// release_timeと同じくUTCで持つ timestamp型はオフセットを無視するのでUTCに直して渡す
+func (r *masterRepository) UpdateReleasesRecordedAt(ctx context.Context, recordedAt time.Time) error {
+	if err := getQueries(ctx, r.queries).UpdateReleasesRecordedAt(ctx, recordedAt.UTC()); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *masterRepository) ListSongIDsReleasedBetween(ctx context.Context, after, until time.Time) ([]int32, error) {
+	arg := sqlcgen.ListSongIDsReleasedBetweenParams{
+		ReleasedAfter: after.UTC(),
+		ReleasedUntil: until.UTC(),
+	}
+	ids, err := getQueries(ctx, r.queries).ListSongIDsReleasedBetween(ctx, arg)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ids, nil
+}
+
+// ChartLevelHistory
+// effective_fromはrelease_timeと同じくUTCで持つ timestamp型はオフセットを無視するのでUTCに直して渡す
+func (r *masterRepository) UpsertChartLevelHistory(ctx context.Context, chartID, level int32, effectiveFrom time.Time) error {
+	arg := sqlcgen.UpsertChartLevelHistoryParams{
+		ChartID:       chartID,
+		Level:         level,
+		EffectiveFrom: effectiveFrom.UTC(),
+	}
+	if err := getQueries(ctx, r.queries).UpsertChartLevelHistory(ctx, arg); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (r *masterRepository) ListChartLevelHistory(ctx context.Context, chartID int32) ([]*entity.ChartLevelHistory, error) {
+	rows, err := getQueries(ctx, r.queries).ListChartLevelHistory(ctx, chartID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	histories := make([]*entity.ChartLevelHistory, 0, len(rows))
+	for _, row := range rows {
+		histories = append(histories, &entity.ChartLevelHistory{
+			ChartID:       row.ChartID,
+			Level:         row.Level,
+			EffectiveFrom: row.EffectiveFrom,
+			CreatedAt:     row.CreatedAt,
 		})
-		if chartIndex == -1 {
-			charts = append(charts, &entity.Chart{
-				ID: v.ID,
-				Song: entity.Song{
-					ID:   v.SongID.Int32,
-					Name: v.SongName.String,
-					Kana: v.SongKana.String,
-					Lyrics: entity.Artist{
-						ID:   v.LyricsArtistID.Int32,
-						Name: v.LyricsArtistName.String,
-						Kana: v.LyricsArtistKana.String,
-					},
-					Music: entity.Artist{
-						ID:   v.MusicArtistID.Int32,
-						Name: v.MusicArtistName.String,
-						Kana: v.MusicArtistKana.String,
-					},
-					Arrangement: entity.Artist{
-						ID:   v.ArrangementArtistID.Int32,
-						Name: v.ArrangementArtistName.String,
-						Kana: v.ArrangementArtistKana.String,
-					},
-					Thumbnail:     v.Thumbnail.String,
-					OriginalVideo: v.OriginalVideo.String,
-					ReleaseTime:   v.ReleaseTime.Time,
-					Deleted:       v.Deleted.Bool,
-					VocalPatterns: []*entity.VocalPattern{
-						{
-							ID:   v.VocalPatternID.Int32,
-							Name: v.VocalPatternName.String,
-							Singers: []*entity.Singer{
-								{
-									ID:       v.SingerID.Int32,
-									Name:     v.SingerName.String,
-									Position: v.SingerOrder.Int32,
-								},
-							},
-						},
-					},
-					Units: []*entity.Unit{
-						{
-							ID:   v.UnitID.Int32,
-							Name: v.UnitName.String,
-						},
-					},
-					MusicVideoTypes: []enums.MusicVideoType{
-						enums.MusicVideoType(v.MusicVideoTypeID.Int32),
-					},
-				},
-				DifficultyType: enums.DifficultyType(v.DifficultyType.Int32),
-				Level:          v.Level.Int32,
-				ChartViewLink:  v.ChartViewLink.String,
-			})
-		} else {
-			vpIndex := slices.IndexFunc(charts[chartIndex].Song.VocalPatterns, func(vp *entity.VocalPattern) bool {
-				return vp.ID == v.VocalPatternID.Int32
-			})
-			if vpIndex != -1 {
-				sgFind := slices.ContainsFunc(charts[chartIndex].Song.VocalPatterns[vpIndex].Singers, func(sg *entity.Singer) bool {
-					return sg.ID == v.SingerID.Int32
-				})
-				if !sgFind {
-					charts[chartIndex].Song.VocalPatterns[vpIndex].Singers = append(charts[chartIndex].Song.VocalPatterns[vpIndex].Singers, &entity.Singer{
-						ID:       v.SingerID.Int32,
-						Name:     v.SingerName.String,
-						Position: v.SingerOrder.Int32,
-					})
-				}
-			} else {
-				charts[chartIndex].Song.VocalPatterns = append(charts[chartIndex].Song.VocalPatterns, &entity.VocalPattern{
-					ID:      v.VocalPatternID.Int32,
-					Name:    v.VocalPatternName.String,
-					Singers: []*entity.Singer{{ID: v.SingerID.Int32, Name: v.SingerName.String, Position: v.SingerOrder.Int32}},
-				})
-			}
-
-			utFind := slices.ContainsFunc(charts[chartIndex].Song.Units, func(ut *entity.Unit) bool {
-				return ut.ID == v.UnitID.Int32
-			})
-			if !utFind {
-				charts[chartIndex].Song.Units = append(charts[chartIndex].Song.Units, &entity.Unit{
-					ID:   v.UnitID.Int32,
-					Name: v.UnitName.String,
-				})
-			}
-
-			mvtFind := slices.ContainsFunc(charts[chartIndex].Song.MusicVideoTypes, func(mvt enums.MusicVideoType) bool {
-				return mvt == enums.MusicVideoType(v.MusicVideoTypeID.Int32)
-			})
-			if !mvtFind {
-				charts[chartIndex].Song.MusicVideoTypes = append(charts[chartIndex].Song.MusicVideoTypes, enums.MusicVideoType(v.MusicVideoTypeID.Int32))
-			}
+	}
+
+	return histories, nil
+}
+
+func (r *masterRepository) GetChartLevelAsOf(ctx context.Context, chartID int32, asOf time.Time) (int32, error) {
+	level, err := getQueries(ctx, r.queries).GetChartLevelAsOf(ctx, sqlcgen.GetChartLevelAsOfParams{
+		ChartID:       chartID,
+		EffectiveFrom: asOf.UTC(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errors.WithStack(repository.ErrNotFound)
 		}
+		return 0, errors.WithStack(err)
+	}
+
+	return level, nil
+}
+
+func (r *masterRepository) DeleteChartLevelHistoryByChartID(ctx context.Context, chartID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteChartLevelHistoryByChartID(ctx, chartID); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (r *masterRepository) DeleteChartLevelHistoryBySongID(ctx context.Context, songID int32) error {
+	if err := getQueries(ctx, r.queries).DeleteChartLevelHistoryBySongID(ctx, sql.NullInt32{Int32: songID, Valid: true}); err != nil {
+		return errors.WithStack(err)
 	}
-	return charts
+	return nil
 }
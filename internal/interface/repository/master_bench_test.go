@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/infrastructure/db/sqlcgen"
+	_ "github.com/lib/pq"
+)
+
+// 曲一覧・譜面一覧の取得のベンチマーク BENCH_DB_DSNにベンチマーク用のDBを指定した時だけ動かす
+//
+//	BENCH_DB_DSN="host=localhost user=... password=... dbname=... sslmode=disable" go test -run '^$' -bench . ./internal/interface/repository/
+//
+// 以前のJOINクエリはsongs.lyrics_id等を使うが、v0.0.1_4_song-creditで削除したので同じDBでは比べられない
+// 以前のクエリはv0.0.1_3までのDB、関連テーブルごとの取得はそれをマイグレーションしたDBで別々に測る
+// 以前のクエリはそのスキーマのまま変えない
+
+// 以前の曲一覧取得クエリ
+// vocal_patterns × vocal_pattern_singers × song_units × song_music_video_types の直積になる
+const legacyListSongsQuery = `
+SELECT s.id, s.name, s.kana,
+    l.id, l.name, l.kana, m.id, m.name, m.kana, a.id, a.name, a.kana,
+    s.thumbnail, s.original_video, s.release_time, s.deleted,
+    vp.id, vp.name, vps.singer_id, si.name, vps.position,
+    su.unit_id, u.name, smvt.music_video_type
+FROM songs s
+LEFT JOIN artists l ON s.lyrics_id = l.id
+LEFT JOIN artists m ON s.music_id = m.id
+LEFT JOIN artists a ON s.arrangement_id = a.id
+LEFT JOIN vocal_patterns vp ON vp.song_id = s.id
+LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+LEFT JOIN singers si ON vps.singer_id = si.id
+LEFT JOIN song_units su ON su.song_id = s.id
+LEFT JOIN units u ON su.unit_id = u.id
+LEFT JOIN song_music_video_types smvt ON smvt.song_id = s.id
+ORDER BY s.id`
+
+// 以前の譜面一覧取得クエリ 譜面ごとに上記の直積が付く
+const legacyListChartsQuery = `
+SELECT c.id, s.id, s.name, s.kana,
+    la.id, la.name, la.kana, ma.id, ma.name, ma.kana, aa.id, aa.name, aa.kana,
+    s.thumbnail, s.original_video, s.release_time, s.deleted,
+    vp.id, vp.name, vps.singer_id, si.name, vps.position,
+    su.unit_id, u.name, smvt.music_video_type,
+    c.difficulty_type, c.level, c.chart_view_link
+FROM charts c
+LEFT JOIN songs s ON c.song_id = s.id
+LEFT JOIN artists la ON s.lyrics_id = la.id
+LEFT JOIN artists ma ON s.music_id = ma.id
+LEFT JOIN artists aa ON s.arrangement_id = aa.id
+LEFT JOIN vocal_patterns vp ON vp.song_id = s.id
+LEFT JOIN vocal_pattern_singers vps ON vps.vocal_pattern_id = vp.id
+LEFT JOIN singers si ON vps.singer_id = si.id
+LEFT JOIN song_units su ON su.song_id = s.id
+LEFT JOIN units u ON su.unit_id = u.id
+LEFT JOIN song_music_video_types smvt ON smvt.song_id = s.id
+ORDER BY c.id`
+
+func openBenchDB(b *testing.B) *sql.DB {
+	b.Helper()
+	dsn := os.Getenv("BENCH_DB_DSN")
+	if dsn == "" {
+		b.Skip("BENCH_DB_DSN is not set")
+	}
+	dbConn, err := sql.Open("postgres", dsn)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { dbConn.Close() })
+	return dbConn
+}
+
+// songs.lyrics_idが残っている(v0.0.1_3までの)DBか
+func hasLegacySongColumns(b *testing.B, dbConn *sql.DB) bool {
+	b.Helper()
+	var exists bool
+	err := dbConn.QueryRow(`SELECT EXISTS (
+    SELECT 1 FROM information_schema.columns WHERE table_name = 'songs' AND column_name = 'lyrics_id'
+)`).Scan(&exists)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return exists
+}
+
+func BenchmarkLegacyListSongs(b *testing.B) {
+	benchmarkLegacyQuery(b, legacyListSongsQuery)
+}
+
+func BenchmarkLegacyListCharts(b *testing.B) {
+	benchmarkLegacyQuery(b, legacyListChartsQuery)
+}
+
+// 以前のクエリの結果を全件読み捨てる (Goでの重複排除は含まない)
+func benchmarkLegacyQuery(b *testing.B, query string) {
+	dbConn := openBenchDB(b)
+	if !hasLegacySongColumns(b, dbConn) {
+		b.Skip("songs.lyrics_id was dropped by v0.0.1_4_song-credit; the legacy query needs a database at v0.0.1_3 or earlier")
+	}
+
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rows, err := dbConn.QueryContext(ctx, query)
+		if err != nil {
+			b.Fatal(err)
+		}
+		cols, err := rows.Columns()
+		if err != nil {
+			b.Fatal(err)
+		}
+		values := make([]any, len(cols))
+		for j := range values {
+			values[j] = new(sql.RawBytes)
+		}
+		for rows.Next() {
+			if err := rows.Scan(values...); err != nil {
+				b.Fatal(err)
+			}
+		}
+		if err := rows.Err(); err != nil {
+			b.Fatal(err)
+		}
+		rows.Close()
+	}
+}
+
+func BenchmarkListSongs(b *testing.B) {
+	dbConn := openBenchDB(b)
+	if hasLegacySongColumns(b, dbConn) {
+		b.Skip("song_credits does not exist before v0.0.1_4_song-credit; migrate the database first")
+	}
+	repo := NewMasterRepository(sqlcgen.New(dbConn))
+
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := repo.ListSongs(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkListCharts(b *testing.B) {
+	dbConn := openBenchDB(b)
+	if hasLegacySongColumns(b, dbConn) {
+		b.Skip("song_credits does not exist before v0.0.1_4_song-credit; migrate the database first")
+	}
+	repo := NewMasterRepository(sqlcgen.New(dbConn))
+
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := repo.ListCharts(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_escapeLike(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "そのまま", s: "abc", want: "abc"},
+		{name: "パーセント", s: "100%", want: `100\%`},
+		{name: "アンダースコア", s: "a_b", want: `a\_b`},
+		{name: "バックスラッシュは先にエスケープする", s: `a\%`, want: `a\\\%`},
+		{name: "空文字", s: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeLike(tt.s); got != tt.want {
+				t.Errorf("escapeLike(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_releaseToParam(t *testing.T) {
+	past := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	future := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name              string
+		releaseTo         time.Time
+		includeUnreleased bool
+		// trueなら呼んだ時の現在時刻(UTC)になる
+		wantNow   bool
+		want      time.Time
+		wantValid bool
+	}{
+		{name: "公開前も含めて指定なしなら絞らない", includeUnreleased: true, wantValid: false},
+		{name: "公開前も含めるなら未来もそのまま", releaseTo: future, includeUnreleased: true, want: future, wantValid: true},
+		{name: "公開前を含めないなら指定なしでも今まで", wantNow: true, wantValid: true},
+		{name: "公開前を含めないなら未来は今まで", releaseTo: future, wantNow: true, wantValid: true},
+		{name: "過去はそのまま", releaseTo: past, want: past, wantValid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := time.Now().UTC()
+			got := releaseToParam(tt.releaseTo, tt.includeUnreleased)
+			after := time.Now().UTC()
+
+			if got.Valid != tt.wantValid {
+				t.Fatalf("releaseToParam().Valid = %v, want %v", got.Valid, tt.wantValid)
+			}
+			if tt.wantNow {
+				if got.Time.Before(before) || got.Time.After(after) || got.Time.Location() != time.UTC {
+					t.Errorf("releaseToParam().Time = %v, want UTC now between %v and %v", got.Time, before, after)
+				}
+				return
+			}
+			if !got.Time.Equal(tt.want) {
+				t.Errorf("releaseToParam().Time = %v, want %v", got.Time, tt.want)
+			}
+		})
+	}
+}
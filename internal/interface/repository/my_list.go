@@ -25,7 +25,7 @@ func NewMyListRepository(queries *sqlcgen.Queries) repository.MyListRepository {
 
 // MyList
 func (r *myListRepository) GetMyListByID(ctx context.Context, id int32) (*entity.MyList, error) {
-	myList, err := r.queries.GetMyListByID(ctx, id)
+	myList, err := getQueries(ctx, r.queries).GetMyListByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.WithStack(repository.ErrNotFound)
@@ -37,7 +37,7 @@ func (r *myListRepository) GetMyListByID(ctx context.Context, id int32) (*entity
 }
 
 func (r *myListRepository) ListMyListsByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.MyList, error) {
-	myLists, err := r.queries.ListMyListsByUserID(ctx, uuid.NullUUID{UUID: userID, Valid: true})
+	myLists, err := getQueries(ctx, r.queries).ListMyListsByUserID(ctx, uuid.NullUUID{UUID: userID, Valid: true})
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -59,7 +59,7 @@ func (r *myListRepository) CreateMyList(ctx context.Context, userID uuid.UUID, n
 		UpdatedAt: sql.NullTime{Time: updatedAt, Valid: true},
 	}
 
-	l, err := r.queries.InsertMyList(ctx, sqlMyList)
+	l, err := getQueries(ctx, r.queries).InsertMyList(ctx, sqlMyList)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -68,7 +68,7 @@ func (r *myListRepository) CreateMyList(ctx context.Context, userID uuid.UUID, n
 }
 
 func (r *myListRepository) ExistsMyList(ctx context.Context, id int32) (bool, error) {
-	exist, err := r.queries.ExistsMyList(ctx, id)
+	exist, err := getQueries(ctx, r.queries).ExistsMyList(ctx, id)
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
@@ -83,7 +83,7 @@ func (r *myListRepository) UpdateMyListName(ctx context.Context, id int32, name
 		ID:        id,
 	}
 
-	if err := r.queries.UpdateMyListName(ctx, arg); err != nil {
+	if err := getQueries(ctx, r.queries).UpdateMyListName(ctx, arg); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -97,7 +97,7 @@ func (r *myListRepository) UpdateMyListPosition(ctx context.Context, id int32, p
 		ID:        id,
 	}
 
-	if err := r.queries.UpdateMyListPosition(ctx, arg); err != nil {
+	if err := getQueries(ctx, r.queries).UpdateMyListPosition(ctx, arg); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -105,7 +105,7 @@ func (r *myListRepository) UpdateMyListPosition(ctx context.Context, id int32, p
 }
 
 func (r *myListRepository) DeleteMyList(ctx context.Context, id int32) error {
-	if err := r.queries.DeleteMyList(ctx, id); err != nil {
+	if err := getQueries(ctx, r.queries).DeleteMyList(ctx, id); err != nil {
 		return errors.WithStack(err)
 	}
 	return nil
@@ -124,7 +124,7 @@ func sqlToDomainMyList(sqlMyList *sqlcgen.MyList) *entity.MyList {
 
 // MyListChart
 func (r *myListRepository) GetMyListChartByID(ctx context.Context, id int32) (*entity.MyListChart, error) {
-	myListChart, err := r.queries.GetMyListChartByID(ctx, id)
+	myListChart, err := getQueries(ctx, r.queries).GetMyListChartByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.WithStack(repository.ErrNotFound)
@@ -136,7 +136,7 @@ func (r *myListRepository) GetMyListChartByID(ctx context.Context, id int32) (*e
 }
 
 func (r *myListRepository) ListMyListChartsByMyListID(ctx context.Context, myListID int32) ([]*entity.MyListChart, error) {
-	myListCharts, err := r.queries.ListMyListChartsByMyListID(ctx, sql.NullInt32{Int32: myListID, Valid: true})
+	myListCharts, err := getQueries(ctx, r.queries).ListMyListChartsByMyListID(ctx, sql.NullInt32{Int32: myListID, Valid: true})
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -159,7 +159,7 @@ func (r *myListRepository) CreateMyListChart(ctx context.Context, myListID, char
 		UpdatedAt: sql.NullTime{Time: updatedAt, Valid: true},
 	}
 
-	l, err := r.queries.InsertMyListChart(ctx, sqlMyListChart)
+	l, err := getQueries(ctx, r.queries).InsertMyListChart(ctx, sqlMyListChart)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -168,7 +168,7 @@ func (r *myListRepository) CreateMyListChart(ctx context.Context, myListID, char
 }
 
 func (r *myListRepository) ExistsMyListChart(ctx context.Context, id int32) (bool, error) {
-	exist, err := r.queries.ExistsMyListChart(ctx, id)
+	exist, err := getQueries(ctx, r.queries).ExistsMyListChart(ctx, id)
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
@@ -181,7 +181,7 @@ func (r *myListRepository) ExistsMyListChartByMyListIDAndChartID(ctx context.Con
 		MyListID: sql.NullInt32{Int32: myListID, Valid: true},
 		ChartID:  sql.NullInt32{Int32: chartID, Valid: true},
 	}
-	exist, err := r.queries.ExistsMyListChartByMyListIDAndChartID(ctx, arg)
+	exist, err := getQueries(ctx, r.queries).ExistsMyListChartByMyListIDAndChartID(ctx, arg)
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
@@ -196,7 +196,7 @@ func (r *myListRepository) UpdateMyListChartClearType(ctx context.Context, id in
 		ID:        id,
 	}
 
-	if err := r.queries.UpdateMyListChartClearType(ctx, arg); err != nil {
+	if err := getQueries(ctx, r.queries).UpdateMyListChartClearType(ctx, arg); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -210,7 +210,7 @@ func (r *myListRepository) UpdateMyListChartMemo(ctx context.Context, id int32,
 		ID:        id,
 	}
 
-	if err := r.queries.UpdateMyListChartMemo(ctx, arg); err != nil {
+	if err := getQueries(ctx, r.queries).UpdateMyListChartMemo(ctx, arg); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -218,14 +218,14 @@ func (r *myListRepository) UpdateMyListChartMemo(ctx context.Context, id int32,
 }
 
 func (r *myListRepository) DeleteMyListChart(ctx context.Context, id int32) error {
-	if err := r.queries.DeleteMyListChart(ctx, id); err != nil {
+	if err := getQueries(ctx, r.queries).DeleteMyListChart(ctx, id); err != nil {
 		return errors.WithStack(err)
 	}
 	return nil
 }
 
 func (r *myListRepository) DeleteMyListChartByMyListID(ctx context.Context, myListID int32) error {
-	if err := r.queries.DeleteMyListChartByMyListID(ctx, sql.NullInt32{Int32: myListID, Valid: true}); err != nil {
+	if err := getQueries(ctx, r.queries).DeleteMyListChartByMyListID(ctx, sql.NullInt32{Int32: myListID, Valid: true}); err != nil {
 		return errors.WithStack(err)
 	}
 	return nil
@@ -245,7 +245,7 @@ func sqlToDomainMyListChart(sqlMyListChart *sqlcgen.MyListChart) *entity.MyListC
 
 // MyListChartAttachment
 func (r *myListRepository) GetMyListChartAttachmentByID(ctx context.Context, id int32) (*entity.MyListChartAttachment, error) {
-	myListChartAttachment, err := r.queries.GetMyListChartAttachmentByID(ctx, id)
+	myListChartAttachment, err := getQueries(ctx, r.queries).GetMyListChartAttachmentByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.WithStack(repository.ErrNotFound)
@@ -257,7 +257,7 @@ func (r *myListRepository) GetMyListChartAttachmentByID(ctx context.Context, id
 }
 
 func (r *myListRepository) ListMyListChartAttachmentsByMyListChartID(ctx context.Context, myListChartID int32) ([]*entity.MyListChartAttachment, error) {
-	myListChartAttachments, err := r.queries.ListMyListChartAttachmentsByMyListChartID(ctx, sql.NullInt32{Int32: myListChartID, Valid: true})
+	myListChartAttachments, err := getQueries(ctx, r.queries).ListMyListChartAttachmentsByMyListChartID(ctx, sql.NullInt32{Int32: myListChartID, Valid: true})
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -279,7 +279,7 @@ func (r *myListRepository) CreateMyListChartAttachment(ctx context.Context, myLi
 		CreatedAt:      sql.NullTime{Time: createdAt, Valid: true},
 	}
 
-	l, err := r.queries.InsertMyListChartAttachment(ctx, sqlMyListChartAttachment)
+	l, err := getQueries(ctx, r.queries).InsertMyListChartAttachment(ctx, sqlMyListChartAttachment)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -288,7 +288,7 @@ func (r *myListRepository) CreateMyListChartAttachment(ctx context.Context, myLi
 }
 
 func (r *myListRepository) ExistsMyListChartAttachment(ctx context.Context, id int32) (bool, error) {
-	exist, err := r.queries.ExistsMyListChartAttachment(ctx, id)
+	exist, err := getQueries(ctx, r.queries).ExistsMyListChartAttachment(ctx, id)
 	if err != nil {
 		return false, errors.WithStack(err)
 	}
@@ -297,14 +297,14 @@ func (r *myListRepository) ExistsMyListChartAttachment(ctx context.Context, id i
 }
 
 func (r *myListRepository) DeleteMyListChartAttachment(ctx context.Context, id int32) error {
-	if err := r.queries.DeleteMyListChartAttachment(ctx, id); err != nil {
+	if err := getQueries(ctx, r.queries).DeleteMyListChartAttachment(ctx, id); err != nil {
 		return errors.WithStack(err)
 	}
 	return nil
 }
 
 func (r *myListRepository) DeleteMyListChartAttachmentByMyListChartID(ctx context.Context, myListChartID int32) error {
-	if err := r.queries.DeleteMyListChartAttachmentByMyListChartID(ctx, sql.NullInt32{Int32: myListChartID, Valid: true}); err != nil {
+	if err := getQueries(ctx, r.queries).DeleteMyListChartAttachmentByMyListChartID(ctx, sql.NullInt32{Int32: myListChartID, Valid: true}); err != nil {
 		return errors.WithStack(err)
 	}
 	return nil
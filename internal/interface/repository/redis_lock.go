@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// 値が自分のtokenの時だけ消す
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type redisLockRepository struct {
+	rc *redis.Client
+}
+
+func NewRedisLockRepository(rc *redis.Client) repository.RedisLockRepository {
+	return &redisLockRepository{
+		rc: rc,
+	}
+}
+
+func lockKey(key string) string {
+	return repository.LOCK_REDIS_KEY + ":" + key
+}
+
+func (r *redisLockRepository) TryLock(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+	token := uuid.NewString()
+	ok, err := r.rc.SetNX(ctx, lockKey(key), token, ttl).Result()
+	if err != nil {
+		return "", false, errors.WithStack(err)
+	}
+	if !ok {
+		return "", false, nil
+	}
+	return token, true, nil
+}
+
+func (r *redisLockRepository) Unlock(ctx context.Context, key, token string) error {
+	if err := unlockScript.Run(ctx, r.rc, []string{lockKey(key)}, token).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
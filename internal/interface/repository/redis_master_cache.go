@@ -3,7 +3,8 @@ package repository
 import (
 	"context"
 	"encoding/json"
-	"strconv"
+	"math/rand/v2"
+	"time"
 
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
@@ -13,33 +14,56 @@ import (
 
 type redisMasterCacheRepository struct {
 	rc *redis.Client
+	// キャッシュの期限 0なら期限なし
+	ttl time.Duration
+	// 同時に入れたキャッシュが一斉に切れないように、期限に0〜ttlJitterの時間を足す
+	ttlJitter time.Duration
 }
 
-func NewRedisMasterCacheRepository(rc *redis.Client) repository.RedisMasterCacheRepository {
+func NewRedisMasterCacheRepository(rc *redis.Client, ttl, ttlJitter time.Duration) repository.RedisMasterCacheRepository {
 	return &redisMasterCacheRepository{
-		rc: rc,
+		rc:        rc,
+		ttl:       ttl,
+		ttlJitter: ttlJitter,
 	}
 }
 
+// ばらつかせたキャッシュの期限
+func (r *redisMasterCacheRepository) expiration() time.Duration {
+	if r.ttl <= 0 {
+		return 0
+	}
+	if r.ttlJitter <= 0 {
+		return r.ttl
+	}
+	return r.ttl + rand.N(r.ttlJitter)
+}
+
+// 公開日時で切れる期限とキャッシュの期限の短い方
+func (r *redisMasterCacheRepository) expirationUntil(expiration time.Duration) time.Duration {
+	ttl := r.expiration()
+	if expiration > 0 && (ttl <= 0 || expiration < ttl) {
+		return expiration
+	}
+	return ttl
+}
+
 // Artist
 func (r *redisMasterCacheRepository) SetArtist(ctx context.Context, id int32, data *entity.Artist) error {
-	jsonBytes, err := json.Marshal(data)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	r.rc.Set(ctx, repository.ARTIST_REDIS_KEY+":"+strconv.Itoa(int(id)), jsonBytes, 0)
-	return nil
+	return r.setWithDependencies(ctx, artistKey(id), data, nil)
 }
 func (r *redisMasterCacheRepository) SetArtists(ctx context.Context, data []*entity.Artist) error {
 	jsonBytes, err := json.Marshal(data)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	r.rc.Set(ctx, repository.ARTIST_REDIS_KEY+":all", jsonBytes, 0)
+	if err := r.rc.Set(ctx, repository.ARTIST_REDIS_KEY+":all", jsonBytes, r.expiration()).Err(); err != nil {
+		return errors.WithStack(err)
+	}
 	return nil
 }
 func (r *redisMasterCacheRepository) GetArtistByID(ctx context.Context, id int32) (*entity.Artist, error) {
-	data, err := r.rc.Get(ctx, repository.ARTIST_REDIS_KEY+":"+strconv.Itoa(int(id))).Bytes()
+	data, err := r.rc.Get(ctx, artistKey(id)).Bytes()
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -66,26 +90,26 @@ func (r *redisMasterCacheRepository) GetArtists(ctx context.Context) ([]*entity.
 
 	return artists, nil
 }
+func (r *redisMasterCacheRepository) DeleteArtist(ctx context.Context, id int32) error {
+	return r.invalidate(ctx, artistKey(id))
+}
 
 // Singer
 func (r *redisMasterCacheRepository) SetSinger(ctx context.Context, id int32, data *entity.Singer) error {
-	jsonBytes, err := json.Marshal(data)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	r.rc.Set(ctx, repository.SINGER_REDIS_KEY+":"+strconv.Itoa(int(id)), jsonBytes, 0)
-	return nil
+	return r.setWithDependencies(ctx, singerKey(id), data, singerDependencies(data))
 }
 func (r *redisMasterCacheRepository) SetSingers(ctx context.Context, data []*entity.Singer) error {
 	jsonBytes, err := json.Marshal(data)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	r.rc.Set(ctx, repository.SINGER_REDIS_KEY+":all", jsonBytes, 0)
+	if err := r.rc.Set(ctx, repository.SINGER_REDIS_KEY+":all", jsonBytes, r.expiration()).Err(); err != nil {
+		return errors.WithStack(err)
+	}
 	return nil
 }
 func (r *redisMasterCacheRepository) GetSingerByID(ctx context.Context, id int32) (*entity.Singer, error) {
-	data, err := r.rc.Get(ctx, repository.SINGER_REDIS_KEY+":"+strconv.Itoa(int(id))).Bytes()
+	data, err := r.rc.Get(ctx, singerKey(id)).Bytes()
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -112,26 +136,26 @@ func (r *redisMasterCacheRepository) GetSingers(ctx context.Context) ([]*entity.
 
 	return singers, nil
 }
+func (r *redisMasterCacheRepository) DeleteSinger(ctx context.Context, id int32) error {
+	return r.invalidate(ctx, singerKey(id))
+}
 
 // Unit
 func (r *redisMasterCacheRepository) SetUnit(ctx context.Context, id int32, data *entity.Unit) error {
-	jsonBytes, err := json.Marshal(data)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	r.rc.Set(ctx, repository.UNIT_REDIS_KEY+":"+strconv.Itoa(int(id)), jsonBytes, 0)
-	return nil
+	return r.setWithDependencies(ctx, unitKey(id), data, unitDependencies(data))
 }
 func (r *redisMasterCacheRepository) SetUnits(ctx context.Context, data []*entity.Unit) error {
 	jsonBytes, err := json.Marshal(data)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	r.rc.Set(ctx, repository.UNIT_REDIS_KEY+":all", jsonBytes, 0)
+	if err := r.rc.Set(ctx, repository.UNIT_REDIS_KEY+":all", jsonBytes, r.expiration()).Err(); err != nil {
+		return errors.WithStack(err)
+	}
 	return nil
 }
 func (r *redisMasterCacheRepository) GetUnitByID(ctx context.Context, id int32) (*entity.Unit, error) {
-	data, err := r.rc.Get(ctx, repository.UNIT_REDIS_KEY+":"+strconv.Itoa(int(id))).Bytes()
+	data, err := r.rc.Get(ctx, unitKey(id)).Bytes()
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -158,26 +182,30 @@ func (r *redisMasterCacheRepository) GetUnits(ctx context.Context) ([]*entity.Un
 
 	return units, nil
 }
+func (r *redisMasterCacheRepository) DeleteUnit(ctx context.Context, id int32) error {
+	return r.invalidate(ctx, unitKey(id))
+}
 
 // Song
 func (r *redisMasterCacheRepository) SetSong(ctx context.Context, id int32, data *entity.Song) error {
-	jsonBytes, err := json.Marshal(data)
-	if err != nil {
-		return errors.WithStack(err)
-	}
-	r.rc.Set(ctx, repository.SONG_REDIS_KEY+":"+strconv.Itoa(int(id)), jsonBytes, 0)
-	return nil
+	return r.setWithDependencies(ctx, songKey(id), data, songDependencies(data))
 }
 func (r *redisMasterCacheRepository) SetSongs(ctx context.Context, data []*entity.Song) error {
 	jsonBytes, err := json.Marshal(data)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	r.rc.Set(ctx, repository.SONG_REDIS_KEY+":all", jsonBytes, 0)
+	if err := r.rc.Set(ctx, repository.SONG_REDIS_KEY+":all", jsonBytes, r.expiration()).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	// 公開済みの一覧は全件から作り直すので破棄する
+	if err := r.rc.Del(ctx, repository.SONG_REDIS_KEY+":released").Err(); err != nil {
+		return errors.WithStack(err)
+	}
 	return nil
 }
 func (r *redisMasterCacheRepository) GetSongByID(ctx context.Context, id int32) (*entity.Song, error) {
-	data, err := r.rc.Get(ctx, repository.SONG_REDIS_KEY+":"+strconv.Itoa(int(id))).Bytes()
+	data, err := r.rc.Get(ctx, songKey(id)).Bytes()
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -204,14 +232,53 @@ func (r *redisMasterCacheRepository) GetSongs(ctx context.Context) ([]*entity.So
 
 	return songs, nil
 }
+func (r *redisMasterCacheRepository) DeleteSong(ctx context.Context, id int32) error {
+	return r.invalidate(ctx, songKey(id))
+}
+func (r *redisMasterCacheRepository) SetReleasedSongs(ctx context.Context, data []*entity.Song, expiration time.Duration) error {
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := r.rc.Set(ctx, repository.SONG_REDIS_KEY+":released", jsonBytes, r.expirationUntil(expiration)).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+func (r *redisMasterCacheRepository) GetReleasedSongs(ctx context.Context) ([]*entity.Song, error) {
+	data, err := r.rc.Get(ctx, repository.SONG_REDIS_KEY+":released").Bytes()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var songs []*entity.Song
+	err = json.Unmarshal(data, &songs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return songs, nil
+}
 
 // Chart
 func (r *redisMasterCacheRepository) SetChart(ctx context.Context, id int32, data *entity.Chart) error {
-	jsonBytes, err := json.Marshal(data)
-	if err != nil {
+	return r.setWithDependencies(ctx, chartKey(id), data, chartDependencies(data))
+}
+func (r *redisMasterCacheRepository) SetChartsByID(ctx context.Context, data []*entity.Chart) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	pipe := r.rc.TxPipeline()
+	for _, chart := range data {
+		if err := queueWithDependencies(ctx, pipe, chartKey(chart.ID), chart, r.expiration(), chartDependencies(chart)); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
 		return errors.WithStack(err)
 	}
-	r.rc.Set(ctx, repository.CHART_REDIS_KEY+":"+strconv.Itoa(int(id)), jsonBytes, 0)
+
 	return nil
 }
 func (r *redisMasterCacheRepository) SetCharts(ctx context.Context, data []*entity.Chart) error {
@@ -219,11 +286,17 @@ func (r *redisMasterCacheRepository) SetCharts(ctx context.Context, data []*enti
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	r.rc.Set(ctx, repository.CHART_REDIS_KEY+":all", jsonBytes, 0)
+	if err := r.rc.Set(ctx, repository.CHART_REDIS_KEY+":all", jsonBytes, r.expiration()).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	// 公開済みの一覧は全件から作り直すので破棄する
+	if err := r.rc.Del(ctx, repository.CHART_REDIS_KEY+":released").Err(); err != nil {
+		return errors.WithStack(err)
+	}
 	return nil
 }
 func (r *redisMasterCacheRepository) GetChartByID(ctx context.Context, id int32) (*entity.Chart, error) {
-	data, err := r.rc.Get(ctx, repository.CHART_REDIS_KEY+":"+strconv.Itoa(int(id))).Bytes()
+	data, err := r.rc.Get(ctx, chartKey(id)).Bytes()
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -236,6 +309,34 @@ func (r *redisMasterCacheRepository) GetChartByID(ctx context.Context, id int32)
 
 	return chart, nil
 }
+func (r *redisMasterCacheRepository) GetChartsByIDs(ctx context.Context, ids []int32) ([]*entity.Chart, error) {
+	charts := make([]*entity.Chart, len(ids))
+	if len(ids) == 0 {
+		return charts, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = chartKey(id)
+	}
+	values, err := r.rc.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for i, v := range values {
+		// 無いキーはnilが返る
+		data, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal([]byte(data), &charts[i]); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	return charts, nil
+}
 func (r *redisMasterCacheRepository) GetCharts(ctx context.Context) ([]*entity.Chart, error) {
 	data, err := r.rc.Get(ctx, repository.CHART_REDIS_KEY+":all").Bytes()
 	if err != nil {
@@ -250,3 +351,30 @@ func (r *redisMasterCacheRepository) GetCharts(ctx context.Context) ([]*entity.C
 
 	return charts, nil
 }
+func (r *redisMasterCacheRepository) DeleteChart(ctx context.Context, id int32) error {
+	return r.invalidate(ctx, chartKey(id))
+}
+func (r *redisMasterCacheRepository) SetReleasedCharts(ctx context.Context, data []*entity.Chart, expiration time.Duration) error {
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := r.rc.Set(ctx, repository.CHART_REDIS_KEY+":released", jsonBytes, r.expirationUntil(expiration)).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+func (r *redisMasterCacheRepository) GetReleasedCharts(ctx context.Context) ([]*entity.Chart, error) {
+	data, err := r.rc.Get(ctx, repository.CHART_REDIS_KEY+":released").Bytes()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var charts []*entity.Chart
+	err = json.Unmarshal(data, &charts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return charts, nil
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/cockroachdb/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// 個別のキャッシュは他のマスタのコピーを含むので、含んでいるマスタのキーごとに
+// 依存しているキーの集合(deps:<キー>)を持っておき、破棄する時にたどって一緒に消す
+// 例: 曲が作詞者を含む場合 deps:artist:1 に song:3、譜面はさらに deps:song:3 に chart:5
+//
+// 依存が無くなっても集合からは消さないので、余分に破棄されることはあるが消し漏れは起きない
+// 集合には期限を付けない キャッシュより先に切れると消し漏れが起きるため
+
+func artistKey(id int32) string {
+	return repository.ARTIST_REDIS_KEY + ":" + strconv.Itoa(int(id))
+}
+func singerKey(id int32) string {
+	return repository.SINGER_REDIS_KEY + ":" + strconv.Itoa(int(id))
+}
+func unitKey(id int32) string {
+	return repository.UNIT_REDIS_KEY + ":" + strconv.Itoa(int(id))
+}
+func songKey(id int32) string {
+	return repository.SONG_REDIS_KEY + ":" + strconv.Itoa(int(id))
+}
+func chartKey(id int32) string {
+	return repository.CHART_REDIS_KEY + ":" + strconv.Itoa(int(id))
+}
+
+func dependencyKey(key string) string {
+	return repository.DEPENDENCY_REDIS_KEY + ":" + key
+}
+
+// keyに値を入れ、dependsOnのそれぞれの依存集合にkeyを加える
+func (r *redisMasterCacheRepository) setWithDependencies(ctx context.Context, key string, data any, dependsOn []string) error {
+	pipe := r.rc.TxPipeline()
+	if err := queueWithDependencies(ctx, pipe, key, data, r.expiration(), dependsOn); err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// setWithDependenciesと同じことをpipeに積む まとめて入れる時に使う
+func queueWithDependencies(ctx context.Context, pipe redis.Pipeliner, key string, data any, expiration time.Duration, dependsOn []string) error {
+	jsonBytes, err := json.Marshal(data)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	pipe.Set(ctx, key, jsonBytes, expiration)
+	for _, dep := range dependsOn {
+		pipe.SAdd(ctx, dependencyKey(dep), key)
+	}
+
+	return nil
+}
+
+// keysから依存集合をたどり、キーと依存集合を全て消す
+// たどる途中で依存が加わって消し漏れることが無いよう、1つのスクリプトでまとめて行う
+// ARGV[1]は依存集合のキーの接頭辞
+var invalidateScript = redis.NewScript(`
+local queue = {}
+for _, key in ipairs(KEYS) do
+	table.insert(queue, key)
+end
+local visited = {}
+local i = 1
+while i <= #queue do
+	local key = queue[i]
+	i = i + 1
+	if not visited[key] then
+		visited[key] = true
+		local deps = ARGV[1] .. key
+		for _, d in ipairs(redis.call("SMEMBERS", deps)) do
+			if not visited[d] then
+				table.insert(queue, d)
+			end
+		end
+		redis.call("DEL", key, deps)
+	end
+end
+return 0
+`)
+
+// keysとそれに依存しているキーをたどって全て破棄する
+func (r *redisMasterCacheRepository) invalidate(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := invalidateScript.Run(ctx, r.rc, keys, dependencyKey("")).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// 歌手は所属しているユニット名を含む
+func singerDependencies(singer *entity.Singer) []string {
+	var deps []string
+	for _, u := range singer.Units {
+		if u != nil {
+			deps = append(deps, unitKey(u.ID))
+		}
+	}
+	return deps
+}
+
+// ユニットは所属している歌手名を含む
+func unitDependencies(unit *entity.Unit) []string {
+	var deps []string
+	for _, s := range unit.Members {
+		if s != nil {
+			deps = append(deps, singerKey(s.ID))
+		}
+	}
+	return deps
+}
+
+// 曲はクレジットのアーティスト、ユニット、ボーカルパターンの歌手を含む
+func songDependencies(song *entity.Song) []string {
+	var deps []string
+	for _, c := range song.Credits {
+		if c != nil {
+			deps = append(deps, artistKey(c.Artist.ID))
+		}
+	}
+	for _, u := range song.Units {
+		if u != nil {
+			deps = append(deps, unitKey(u.ID))
+		}
+	}
+	for _, vp := range song.VocalPatterns {
+		if vp == nil {
+			continue
+		}
+		for _, s := range vp.Singers {
+			if s != nil {
+				deps = append(deps, singerKey(s.ID))
+			}
+		}
+	}
+	return deps
+}
+
+// 譜面は曲を丸ごと含むので、曲と曲が含むマスタに依存する
+// 曲のキャッシュが無い時でも消せるように曲の依存先にも直接加える
+func chartDependencies(chart *entity.Chart) []string {
+	return append([]string{songKey(chart.Song.ID)}, songDependencies(&chart.Song)...)
+}
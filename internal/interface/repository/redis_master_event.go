@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/cockroachdb/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// 1つのサーバーで購読すると、受け取りの遅れで閉じるまでに溜められる通知の数
+const masterEventBufferSize = 64
+
+type redisMasterEventRepository struct {
+	rc *redis.Client
+
+	// Redisの購読はサーバーごとに1つだけにして、各購読者に配る
+	mu          sync.Mutex
+	started     bool
+	subscribers map[chan []*entity.MasterChangeLog]struct{}
+}
+
+func NewRedisMasterEventRepository(rc *redis.Client) repository.RedisMasterEventRepository {
+	return &redisMasterEventRepository{
+		rc:          rc,
+		subscribers: map[chan []*entity.MasterChangeLog]struct{}{},
+	}
+}
+
+func (r *redisMasterEventRepository) PublishMasterChanges(ctx context.Context, logs []*entity.MasterChangeLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	jsonBytes, err := json.Marshal(logs)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := r.rc.Publish(ctx, repository.MASTER_EVENT_REDIS_CHANNEL, jsonBytes).Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (r *redisMasterEventRepository) SubscribeMasterChanges(ctx context.Context) <-chan []*entity.MasterChangeLog {
+	ch := make(chan []*entity.MasterChangeLog, masterEventBufferSize)
+
+	r.mu.Lock()
+	r.subscribers[ch] = struct{}{}
+	if !r.started {
+		r.started = true
+		go r.receive()
+	}
+	r.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		r.unsubscribe(ch)
+	}()
+
+	return ch
+}
+
+func (r *redisMasterEventRepository) unsubscribe(ch chan []*entity.MasterChangeLog) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.subscribers[ch]; ok {
+		delete(r.subscribers, ch)
+		close(ch)
+	}
+}
+
+// 切断されてもgo-redisが再接続するので、サーバーが動いている間は受け取り続ける
+func (r *redisMasterEventRepository) receive() {
+	pubsub := r.rc.Subscribe(context.Background(), repository.MASTER_EVENT_REDIS_CHANNEL)
+	for msg := range pubsub.Channel() {
+		var logs []*entity.MasterChangeLog
+		if err := json.Unmarshal([]byte(msg.Payload), &logs); err != nil {
+			log.Printf("%+v\n", errors.WithStack(err))
+			continue
+		}
+
+		r.mu.Lock()
+		for ch := range r.subscribers {
+			select {
+			case ch <- logs:
+			default:
+				// 追いつかない購読者は閉じて、再接続と差分の取り直しをさせる
+				delete(r.subscribers, ch)
+				close(ch)
+			}
+		}
+		r.mu.Unlock()
+	}
+}
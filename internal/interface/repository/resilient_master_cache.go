@@ -0,0 +1,327 @@
+package repository
+
+import (
+	"context"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/circuitbreaker"
+	"github.com/cockroachdb/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redisが止まっていてもマスタを返せるように、キャッシュを無くてもよいものとして扱う
+// 取得でRedisにつながらない時はredis.Nilとしても扱われるErrMasterCacheUnavailableを返し、キャッシュに無い時と同じくDBから取らせる
+// 書き込み・破棄の失敗はログに出して成功扱いにする
+// 続けて失敗したらしばらくRedisを呼ばないようにする
+//
+// 書き込み・破棄を捨てるとRedisに古いキャッシュが残るので、つながるようになったらマスタのキャッシュを全て消してから使う
+type resilientMasterCacheRepository struct {
+	next    repository.RedisMasterCacheRepository
+	rc      *redis.Client
+	breaker *circuitbreaker.Breaker
+	// 書き込み・破棄を捨てたので消すまでRedisのキャッシュを信用できない
+	stale   atomic.Bool
+	flushMu sync.Mutex
+}
+
+// breakerはマスタの変更通知と共有する
+func NewResilientMasterCacheRepository(next repository.RedisMasterCacheRepository, rc *redis.Client, breaker *circuitbreaker.Breaker) repository.RedisMasterCacheRepository {
+	return &resilientMasterCacheRepository{
+		next:    next,
+		rc:      rc,
+		breaker: breaker,
+	}
+}
+
+// Artist
+func (r *resilientMasterCacheRepository) SetArtist(ctx context.Context, id int32, data *entity.Artist) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetArtist(ctx, id, data)
+	})
+}
+func (r *resilientMasterCacheRepository) SetArtists(ctx context.Context, data []*entity.Artist) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetArtists(ctx, data)
+	})
+}
+func (r *resilientMasterCacheRepository) GetArtistByID(ctx context.Context, id int32) (*entity.Artist, error) {
+	return readOrMiss(ctx, r, func(ctx context.Context) (*entity.Artist, error) {
+		return r.next.GetArtistByID(ctx, id)
+	})
+}
+func (r *resilientMasterCacheRepository) GetArtists(ctx context.Context) ([]*entity.Artist, error) {
+	return readOrMiss(ctx, r, r.next.GetArtists)
+}
+func (r *resilientMasterCacheRepository) DeleteArtist(ctx context.Context, id int32) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.DeleteArtist(ctx, id)
+	})
+}
+
+// Singer
+func (r *resilientMasterCacheRepository) SetSinger(ctx context.Context, id int32, data *entity.Singer) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetSinger(ctx, id, data)
+	})
+}
+func (r *resilientMasterCacheRepository) SetSingers(ctx context.Context, data []*entity.Singer) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetSingers(ctx, data)
+	})
+}
+func (r *resilientMasterCacheRepository) GetSingerByID(ctx context.Context, id int32) (*entity.Singer, error) {
+	return readOrMiss(ctx, r, func(ctx context.Context) (*entity.Singer, error) {
+		return r.next.GetSingerByID(ctx, id)
+	})
+}
+func (r *resilientMasterCacheRepository) GetSingers(ctx context.Context) ([]*entity.Singer, error) {
+	return readOrMiss(ctx, r, r.next.GetSingers)
+}
+func (r *resilientMasterCacheRepository) DeleteSinger(ctx context.Context, id int32) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.DeleteSinger(ctx, id)
+	})
+}
+
+// Unit
+func (r *resilientMasterCacheRepository) SetUnit(ctx context.Context, id int32, data *entity.Unit) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetUnit(ctx, id, data)
+	})
+}
+func (r *resilientMasterCacheRepository) SetUnits(ctx context.Context, data []*entity.Unit) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetUnits(ctx, data)
+	})
+}
+func (r *resilientMasterCacheRepository) GetUnitByID(ctx context.Context, id int32) (*entity.Unit, error) {
+	return readOrMiss(ctx, r, func(ctx context.Context) (*entity.Unit, error) {
+		return r.next.GetUnitByID(ctx, id)
+	})
+}
+func (r *resilientMasterCacheRepository) GetUnits(ctx context.Context) ([]*entity.Unit, error) {
+	return readOrMiss(ctx, r, r.next.GetUnits)
+}
+func (r *resilientMasterCacheRepository) DeleteUnit(ctx context.Context, id int32) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.DeleteUnit(ctx, id)
+	})
+}
+
+// Song
+func (r *resilientMasterCacheRepository) SetSong(ctx context.Context, id int32, data *entity.Song) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetSong(ctx, id, data)
+	})
+}
+func (r *resilientMasterCacheRepository) SetSongs(ctx context.Context, data []*entity.Song) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetSongs(ctx, data)
+	})
+}
+func (r *resilientMasterCacheRepository) GetSongByID(ctx context.Context, id int32) (*entity.Song, error) {
+	return readOrMiss(ctx, r, func(ctx context.Context) (*entity.Song, error) {
+		return r.next.GetSongByID(ctx, id)
+	})
+}
+func (r *resilientMasterCacheRepository) GetSongs(ctx context.Context) ([]*entity.Song, error) {
+	return readOrMiss(ctx, r, r.next.GetSongs)
+}
+func (r *resilientMasterCacheRepository) DeleteSong(ctx context.Context, id int32) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.DeleteSong(ctx, id)
+	})
+}
+func (r *resilientMasterCacheRepository) SetReleasedSongs(ctx context.Context, data []*entity.Song, expiration time.Duration) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetReleasedSongs(ctx, data, expiration)
+	})
+}
+func (r *resilientMasterCacheRepository) GetReleasedSongs(ctx context.Context) ([]*entity.Song, error) {
+	return readOrMiss(ctx, r, r.next.GetReleasedSongs)
+}
+
+// Chart
+func (r *resilientMasterCacheRepository) SetChart(ctx context.Context, id int32, data *entity.Chart) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetChart(ctx, id, data)
+	})
+}
+func (r *resilientMasterCacheRepository) SetChartsByID(ctx context.Context, data []*entity.Chart) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetChartsByID(ctx, data)
+	})
+}
+func (r *resilientMasterCacheRepository) SetCharts(ctx context.Context, data []*entity.Chart) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetCharts(ctx, data)
+	})
+}
+func (r *resilientMasterCacheRepository) GetChartByID(ctx context.Context, id int32) (*entity.Chart, error) {
+	return readOrMiss(ctx, r, func(ctx context.Context) (*entity.Chart, error) {
+		return r.next.GetChartByID(ctx, id)
+	})
+}
+func (r *resilientMasterCacheRepository) GetChartsByIDs(ctx context.Context, ids []int32) ([]*entity.Chart, error) {
+	charts, err := readOrMiss(ctx, r, func(ctx context.Context) ([]*entity.Chart, error) {
+		return r.next.GetChartsByIDs(ctx, ids)
+	})
+	// 使えない時は全てキャッシュに無いものとして返す
+	if errors.Is(err, repository.ErrMasterCacheUnavailable) {
+		return make([]*entity.Chart, len(ids)), nil
+	} else if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return charts, nil
+}
+func (r *resilientMasterCacheRepository) GetCharts(ctx context.Context) ([]*entity.Chart, error) {
+	return readOrMiss(ctx, r, r.next.GetCharts)
+}
+func (r *resilientMasterCacheRepository) DeleteChart(ctx context.Context, id int32) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.DeleteChart(ctx, id)
+	})
+}
+func (r *resilientMasterCacheRepository) SetReleasedCharts(ctx context.Context, data []*entity.Chart, expiration time.Duration) error {
+	return r.write(ctx, func(ctx context.Context) error {
+		return r.next.SetReleasedCharts(ctx, data, expiration)
+	})
+}
+func (r *resilientMasterCacheRepository) GetReleasedCharts(ctx context.Context) ([]*entity.Chart, error) {
+	return readOrMiss(ctx, r, r.next.GetReleasedCharts)
+}
+
+// Redisを呼んでよいか 古いキャッシュが残っているかもしれない時は先に消す
+func (r *resilientMasterCacheRepository) allow(ctx context.Context) bool {
+	if !r.breaker.Allow(time.Now()) {
+		return false
+	}
+	if !r.stale.Load() {
+		return true
+	}
+	if err := r.flush(ctx); err != nil {
+		r.fail(err)
+		return false
+	}
+	return true
+}
+
+// 呼んだ結果をbreakerに知らせる キャッシュに無い(redis.Nil)などRedisから返ってきたエラーは成功扱い
+func (r *resilientMasterCacheRepository) done(err error) {
+	if err != nil && isRedisUnavailable(err) {
+		r.fail(err)
+		return
+	}
+	r.breaker.Success()
+}
+
+func (r *resilientMasterCacheRepository) fail(err error) {
+	r.breaker.Failure(time.Now())
+	log.Printf("%+v\n", errors.WithStack(err))
+	if r.breaker.State() == circuitbreaker.StateOpen {
+		log.Println("master cache: redis is unavailable, falling back to database")
+	}
+}
+
+func readOrMiss[T any](ctx context.Context, r *resilientMasterCacheRepository, fetch func(context.Context) (T, error)) (T, error) {
+	var zero T
+	if !r.allow(ctx) {
+		return zero, errors.Mark(errors.WithStack(repository.ErrMasterCacheUnavailable), redis.Nil)
+	}
+
+	v, err := fetch(ctx)
+	r.done(err)
+	if err != nil {
+		if isRedisUnavailable(err) {
+			return zero, errors.Mark(errors.Mark(errors.WithStack(err), repository.ErrMasterCacheUnavailable), redis.Nil)
+		}
+		return zero, errors.WithStack(err)
+	}
+
+	return v, nil
+}
+
+// 失敗してもエラーにしない 捨てた分はRedisのキャッシュが古くなるので、次に使う前に消す
+func (r *resilientMasterCacheRepository) write(ctx context.Context, fn func(context.Context) error) error {
+	if !r.allow(ctx) {
+		r.stale.Store(true)
+		return nil
+	}
+
+	err := fn(ctx)
+	if err != nil && !isRedisUnavailable(err) {
+		log.Printf("%+v\n", errors.WithStack(err))
+	}
+	r.done(err)
+	if err != nil {
+		r.stale.Store(true)
+	}
+
+	return nil
+}
+
+// マスタのキャッシュと依存関係を全て消す
+func (r *resilientMasterCacheRepository) flush(ctx context.Context) error {
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+	// 他で消し終わっている
+	if !r.stale.CompareAndSwap(true, false) {
+		return nil
+	}
+
+	for _, prefix := range []string{
+		repository.ARTIST_REDIS_KEY,
+		repository.SINGER_REDIS_KEY,
+		repository.UNIT_REDIS_KEY,
+		repository.SONG_REDIS_KEY,
+		repository.CHART_REDIS_KEY,
+		repository.DEPENDENCY_REDIS_KEY,
+	} {
+		if err := r.deleteByPattern(ctx, prefix+":*"); err != nil {
+			r.stale.Store(true)
+			return errors.WithStack(err)
+		}
+	}
+	log.Println("master cache: redis is available again, flushed master cache")
+
+	return nil
+}
+
+func (r *resilientMasterCacheRepository) deleteByPattern(ctx context.Context, pattern string) error {
+	var cursor uint64
+	for {
+		keys, next, err := r.rc.Scan(ctx, cursor, pattern, 500).Result()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if len(keys) > 0 {
+			if err := r.rc.Del(ctx, keys...).Err(); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// Redisにつながらない時のエラー
+// リクエストのキャンセル・期限切れはRedisのせいではないので含めない
+func isRedisUnavailable(err error) bool {
+	if errors.Is(err, redis.Nil) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, redis.ErrClosed)
+}
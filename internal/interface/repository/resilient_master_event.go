@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/circuitbreaker"
+	"github.com/cockroachdb/errors"
+)
+
+// キャッシュと同じくRedisが止まっていてもマスタを書き換えられるように、通知を無くてもよいものとして扱う
+// breakerはキャッシュと共有し、Redisにつながらない間は通知を送らない
+// 送れなかった通知はログに出して捨てる 受け取る側はGetMasterVersionで版数を確かめれば取り直せる
+type resilientMasterEventRepository struct {
+	next    repository.RedisMasterEventRepository
+	breaker *circuitbreaker.Breaker
+}
+
+func NewResilientMasterEventRepository(next repository.RedisMasterEventRepository, breaker *circuitbreaker.Breaker) repository.RedisMasterEventRepository {
+	return &resilientMasterEventRepository{
+		next:    next,
+		breaker: breaker,
+	}
+}
+
+func (r *resilientMasterEventRepository) PublishMasterChanges(ctx context.Context, logs []*entity.MasterChangeLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	if !r.breaker.Allow(time.Now()) {
+		log.Printf("master event: redis is unavailable, dropped changes of revision %d\n", logs[0].Revision)
+		return nil
+	}
+	err := r.next.PublishMasterChanges(ctx, logs)
+	if err != nil && isRedisUnavailable(err) {
+		r.breaker.Failure(time.Now())
+	} else {
+		r.breaker.Success()
+	}
+	if err != nil {
+		log.Printf("%+v\n", errors.WithStack(err))
+	}
+	return nil
+}
+
+// 購読はgo-redisが再接続し続けるのでそのまま使う
+func (r *resilientMasterEventRepository) SubscribeMasterChanges(ctx context.Context) <-chan []*entity.MasterChangeLog {
+	return r.next.SubscribeMasterChanges(ctx)
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/infrastructure/db/sqlcgen"
+	"github.com/cockroachdb/errors"
+)
+
+type txQueriesKey struct{}
+
+type txHooksKey struct{}
+
+// コミット後に実行する処理
+type txHooks struct {
+	fns []func(ctx context.Context) error
+}
+
+type txManager struct {
+	db      *sql.DB
+	queries *sqlcgen.Queries
+}
+
+func NewTxManager(db *sql.DB, queries *sqlcgen.Queries) repository.TxManager {
+	return &txManager{db: db, queries: queries}
+}
+
+func (m *txManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	// 既にトランザクション中ならそのまま使う
+	if _, ok := ctx.Value(txQueriesKey{}).(*sqlcgen.Queries); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	hooks := &txHooks{}
+	txCtx := context.WithValue(ctx, txQueriesKey{}, m.queries.WithTx(tx))
+	txCtx = context.WithValue(txCtx, txHooksKey{}, hooks)
+	if err := fn(txCtx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return errors.WithStack(errors.CombineErrors(err, rerr))
+		}
+		return errors.WithStack(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// コミット済みなので、失敗してもエラーにせず残りの処理も実行する
+	// エラーを返すと書き込めたのに失敗として再実行されてしまう
+	for _, hook := range hooks.fns {
+		runAfterCommit(ctx, hook)
+	}
+
+	return nil
+}
+
+func (m *txManager) AfterCommit(ctx context.Context, fn func(ctx context.Context) error) error {
+	if hooks, ok := ctx.Value(txHooksKey{}).(*txHooks); ok {
+		hooks.fns = append(hooks.fns, fn)
+		return nil
+	}
+
+	// トランザクション外ならすぐに実行する
+	runAfterCommit(ctx, fn)
+	return nil
+}
+
+// コミット後の処理は失敗してもログに出すだけにする
+func runAfterCommit(ctx context.Context, fn func(ctx context.Context) error) {
+	if err := fn(ctx); err != nil {
+		log.Printf("%+v\n", errors.WithStack(err))
+	}
+}
+
+// トランザクション中ならそのQueriesを返す
+func getQueries(ctx context.Context, queries *sqlcgen.Queries) *sqlcgen.Queries {
+	if q, ok := ctx.Value(txQueriesKey{}).(*sqlcgen.Queries); ok {
+		return q
+	}
+	return queries
+}
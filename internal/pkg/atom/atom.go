@@ -0,0 +1,70 @@
+package atom
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const namespace = "http://www.w3.org/2005/Atom"
+
+// RFC 4287のフィード 必要な要素だけ
+type Feed struct {
+	XMLName xml.Name `xml:"feed"`
+	XMLNS   string   `xml:"xmlns,attr"`
+	ID      string   `xml:"id"`
+	Title   string   `xml:"title"`
+	Updated string   `xml:"updated"`
+	Author  *Person  `xml:"author,omitempty"`
+	Links   []*Link  `xml:"link"`
+	Entries []*Entry `xml:"entry"`
+}
+
+type Person struct {
+	Name string `xml:"name"`
+}
+
+type Link struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type Entry struct {
+	ID        string  `xml:"id"`
+	Title     string  `xml:"title"`
+	Updated   string  `xml:"updated"`
+	Published string  `xml:"published,omitempty"`
+	Links     []*Link `xml:"link"`
+	Summary   *Text   `xml:"summary,omitempty"`
+	Content   *Text   `xml:"content,omitempty"`
+}
+
+type Text struct {
+	// text か html
+	Type string `xml:"type,attr,omitempty"`
+	Body string `xml:",chardata"`
+}
+
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func (f *Feed) Encode(w io.Writer) error {
+	f.XMLNS = namespace
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return errors.WithStack(err)
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(f); err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -26,22 +26,79 @@ func AuthInterceptor() connect.UnaryInterceptorFunc {
 				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing bearer token"))
 			}
 
-			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-			token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-				return jwtSecret, nil
-			})
-
-			if err != nil || !token.Valid {
-				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid token"))
-			}
-
-			if claims, ok := token.Claims.(jwt.MapClaims); ok {
-				if sub, ok := claims["sub"].(string); ok {
-					ctx = context.WithValue(ctx, UserIDKey, sub)
-				}
+			ctx, err := withUserID(ctx, strings.TrimPrefix(authHeader, "Bearer "))
+			if err != nil {
+				return nil, connect.NewError(connect.CodeUnauthenticated, err)
 			}
 
 			return next(ctx, req)
 		}
 	}
 }
+
+// トークンがなくても通す トークンがある場合はAuthInterceptorと同じくユーザーIDを入れる
+// 未ログインでも使えるが、管理者だけが使える操作を含むサービス向け
+// WatchMasterのようなストリームでも管理者か判断できるように、ストリームにも入れる
+func OptionalAuthInterceptor() connect.Interceptor {
+	return &optionalAuthInterceptor{}
+}
+
+type optionalAuthInterceptor struct{}
+
+func (i *optionalAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := withOptionalUserID(ctx, req.Header().Get("Authorization"))
+		if err != nil {
+			return nil, err
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// サーバー側のインターセプターなのでクライアントのストリームはそのまま
+func (i *optionalAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *optionalAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := withOptionalUserID(ctx, conn.RequestHeader().Get("Authorization"))
+		if err != nil {
+			return err
+		}
+
+		return next(ctx, conn)
+	}
+}
+
+func withOptionalUserID(ctx context.Context, authHeader string) (context.Context, error) {
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return ctx, nil
+	}
+
+	ctx, err := withUserID(ctx, strings.TrimPrefix(authHeader, "Bearer "))
+	if err != nil {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	return ctx, nil
+}
+
+func withUserID(ctx context.Context, tokenStr string) (context.Context, error) {
+	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
+		return jwtSecret, nil
+	})
+
+	if err != nil || !token.Valid {
+		return ctx, errors.New("invalid token")
+	}
+
+	if claims, ok := token.Claims.(jwt.MapClaims); ok {
+		if sub, ok := claims["sub"].(string); ok {
+			ctx = context.WithValue(ctx, UserIDKey, sub)
+		}
+	}
+
+	return ctx, nil
+}
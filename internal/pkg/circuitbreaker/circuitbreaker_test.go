@@ -0,0 +1,120 @@
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	const cooldown = time.Minute
+
+	type step struct {
+		// allow, success, failure
+		op string
+		// 始めからの経過時間
+		at time.Duration
+		// allowの結果
+		allowed bool
+		state   State
+	}
+	tests := []struct {
+		name      string
+		threshold int
+		steps     []step
+	}{
+		{
+			name:      "threshold回続けて失敗すると開く",
+			threshold: 2,
+			steps: []step{
+				{op: "allow", allowed: true, state: StateClosed},
+				{op: "failure", state: StateClosed},
+				{op: "allow", allowed: true, state: StateClosed},
+				{op: "failure", state: StateOpen},
+				{op: "allow", at: cooldown - time.Second, allowed: false, state: StateOpen},
+			},
+		},
+		{
+			name:      "成功すると失敗の回数を数え直す",
+			threshold: 2,
+			steps: []step{
+				{op: "failure", state: StateClosed},
+				{op: "success", state: StateClosed},
+				{op: "failure", state: StateClosed},
+				{op: "failure", state: StateOpen},
+			},
+		},
+		{
+			name:      "cooldownを過ぎたら1回だけ試し、成功すれば閉じる",
+			threshold: 1,
+			steps: []step{
+				{op: "failure", state: StateOpen},
+				{op: "allow", at: cooldown, allowed: true, state: StateHalfOpen},
+				{op: "allow", at: cooldown, allowed: false, state: StateHalfOpen},
+				{op: "success", at: cooldown, state: StateClosed},
+				{op: "allow", at: cooldown, allowed: true, state: StateClosed},
+			},
+		},
+		{
+			name:      "試して失敗すればまたcooldownの間止める",
+			threshold: 3,
+			steps: []step{
+				{op: "failure", state: StateClosed},
+				{op: "failure", state: StateClosed},
+				{op: "failure", state: StateOpen},
+				{op: "allow", at: cooldown, allowed: true, state: StateHalfOpen},
+				{op: "failure", at: cooldown, state: StateOpen},
+				{op: "allow", at: 2*cooldown - time.Second, allowed: false, state: StateOpen},
+				{op: "allow", at: 2 * cooldown, allowed: true, state: StateHalfOpen},
+			},
+		},
+		{
+			name:      "thresholdが1未満なら1として扱う",
+			threshold: 0,
+			steps: []step{
+				{op: "failure", state: StateOpen},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			b := New(tt.threshold, cooldown)
+			for i, s := range tt.steps {
+				now := start.Add(s.at)
+				switch s.op {
+				case "allow":
+					if got := b.Allow(now); got != s.allowed {
+						t.Errorf("step %d: Allow() = %v, want %v", i, got, s.allowed)
+					}
+				case "success":
+					b.Success()
+				case "failure":
+					b.Failure(now)
+				default:
+					t.Fatalf("step %d: unknown op %q", i, s.op)
+				}
+				if got := b.State(); got != s.state {
+					t.Errorf("step %d: State() = %v, want %v", i, got, s.state)
+				}
+			}
+		})
+	}
+}
+
+func TestState_String(t *testing.T) {
+	tests := []struct {
+		s    State
+		want string
+	}{
+		{s: StateClosed, want: "closed"},
+		{s: StateOpen, want: "open"},
+		{s: StateHalfOpen, want: "half-open"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.s.String(); got != tt.want {
+				t.Errorf("State.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
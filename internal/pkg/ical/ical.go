@@ -0,0 +1,123 @@
+package ical
+
+import (
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+)
+
+// RFC 5545で1行は改行を除いて75オクテットまで
+const maxLineOctets = 75
+
+const dateTimeFormat = "20060102T150405Z"
+
+type Calendar struct {
+	// PRODID
+	ProductID string
+	// X-WR-CALNAME カレンダーアプリでの表示名
+	Name   string
+	Events []*Event
+}
+
+// 時刻を持つ予定 Endが無ければStartの時点の予定になる
+type Event struct {
+	// 同じ予定なら出力のたびに同じ値にする
+	UID         string
+	Summary     string
+	Description string
+	URL         string
+	Start       time.Time
+	End         time.Time
+}
+
+// stampはDTSTAMPに使う
+func (c *Calendar) Encode(w io.Writer, stamp time.Time) error {
+	lw := &lineWriter{w: w}
+	lw.line("BEGIN:VCALENDAR")
+	lw.line("VERSION:2.0")
+	lw.line("PRODID:" + escapeText(c.ProductID))
+	lw.line("CALSCALE:GREGORIAN")
+	lw.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		lw.line("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	for _, e := range c.Events {
+		lw.line("BEGIN:VEVENT")
+		lw.line("UID:" + escapeText(e.UID))
+		lw.line("DTSTAMP:" + formatDateTime(stamp))
+		lw.line("DTSTART:" + formatDateTime(e.Start))
+		if !e.End.IsZero() {
+			lw.line("DTEND:" + formatDateTime(e.End))
+		}
+		lw.line("SUMMARY:" + escapeText(e.Summary))
+		if e.Description != "" {
+			lw.line("DESCRIPTION:" + escapeText(e.Description))
+		}
+		if e.URL != "" {
+			// URLはTEXTではないのでエスケープしない
+			lw.line("URL:" + e.URL)
+		}
+		lw.line("END:VEVENT")
+	}
+	lw.line("END:VCALENDAR")
+
+	return lw.err
+}
+
+func formatDateTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
+
+// TEXTの値で特別な意味を持つ文字をエスケープする
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// 行末をCRLFにして、長い行は折り返す 最初に起きたエラーを持つ
+type lineWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (lw *lineWriter) line(s string) {
+	if lw.err != nil {
+		return
+	}
+	if _, err := io.WriteString(lw.w, fold(s)+"\r\n"); err != nil {
+		lw.err = errors.WithStack(err)
+	}
+}
+
+// 75オクテットごとにCRLFと空白を入れて折り返す 文字の途中では切らない
+// 続きの行は先頭の空白の分だけ短くする
+func fold(s string) string {
+	if len(s) <= maxLineOctets {
+		return s
+	}
+
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(s) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+		b.WriteString(s[:i])
+		b.WriteString("\r\n ")
+		s = s[i:]
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(s)
+
+	return b.String()
+}
@@ -0,0 +1,116 @@
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// 件数の上限を超えると最も使われていないものから捨てるキャッシュ
+// 期限(expiresAt)を過ぎたものは取得時に捨てる
+type Cache struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+	// Delete・Purgeのたびに増やす 取得中に破棄されたかの判定に使う
+	generation uint64
+}
+
+type entry struct {
+	key       string
+	value     any
+	expiresAt time.Time
+}
+
+func New(size int) *Cache {
+	return &Cache{
+		size:  size,
+		ll:    list.New(),
+		items: map[string]*list.Element{},
+	}
+}
+
+func (c *Cache) Get(key string, now time.Time) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if !e.expiresAt.IsZero() && !now.Before(e.expiresAt) {
+		c.ll.Remove(el)
+		delete(c.items, key)
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+
+	return e.value, true
+}
+
+// expiresAtがゼロ値なら期限なし
+func (c *Cache) Set(key string, value any, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(key, value, expiresAt)
+}
+
+// generationがGenerationで取得した時から変わっていなければ入れる
+// 取得している間に破棄された古い値を入れ直さないようにする
+func (c *Cache) SetIfGeneration(key string, value any, expiresAt time.Time, generation uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return false
+	}
+	c.set(key, value, expiresAt)
+	return true
+}
+
+func (c *Cache) set(key string, value any, expiresAt time.Time) {
+	if el, ok := c.items[key]; ok {
+		el.Value = &entry{key: key, value: value, expiresAt: expiresAt}
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&entry{key: key, value: value, expiresAt: expiresAt})
+	for c.ll.Len() > c.size {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry).key)
+	}
+}
+
+func (c *Cache) Delete(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for _, key := range keys {
+		if el, ok := c.items[key]; ok {
+			c.ll.Remove(el)
+			delete(c.items, key)
+		}
+	}
+}
+
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.ll.Init()
+	c.items = map[string]*list.Element{}
+}
+
+func (c *Cache) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
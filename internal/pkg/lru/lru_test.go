@@ -0,0 +1,122 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		size int
+		// キャッシュを操作する
+		run func(c *Cache)
+		// 残っているはずのキーと値 nilなら無いはず
+		want map[string]any
+		len  int
+	}{
+		{
+			name: "上限を超えると最も古いものを捨てる",
+			size: 2,
+			run: func(c *Cache) {
+				c.Set("a", 1, time.Time{})
+				c.Set("b", 2, time.Time{})
+				c.Set("c", 3, time.Time{})
+			},
+			want: map[string]any{"a": nil, "b": 2, "c": 3},
+			len:  2,
+		},
+		{
+			name: "取得したものは新しい扱いになる",
+			size: 2,
+			run: func(c *Cache) {
+				c.Set("a", 1, time.Time{})
+				c.Set("b", 2, time.Time{})
+				c.Get("a", now)
+				c.Set("c", 3, time.Time{})
+			},
+			want: map[string]any{"a": 1, "b": nil, "c": 3},
+			len:  2,
+		},
+		{
+			name: "同じキーは置き換えて件数は増えない",
+			size: 2,
+			run: func(c *Cache) {
+				c.Set("a", 1, time.Time{})
+				c.Set("b", 2, time.Time{})
+				c.Set("a", 10, time.Time{})
+				c.Set("c", 3, time.Time{})
+			},
+			want: map[string]any{"a": 10, "b": nil, "c": 3},
+			len:  2,
+		},
+		{
+			name: "期限ちょうどで切れる",
+			size: 3,
+			run: func(c *Cache) {
+				c.Set("a", 1, now)
+				c.Set("b", 2, now.Add(time.Second))
+				c.Set("c", 3, time.Time{})
+			},
+			want: map[string]any{"a": nil, "b": 2, "c": 3},
+			len:  2,
+		},
+		{
+			name: "Deleteは指定したキーだけ捨てる",
+			size: 3,
+			run: func(c *Cache) {
+				c.Set("a", 1, time.Time{})
+				c.Set("b", 2, time.Time{})
+				c.Delete("a", "x")
+			},
+			want: map[string]any{"a": nil, "b": 2},
+			len:  1,
+		},
+		{
+			name: "Purgeは全て捨てる",
+			size: 3,
+			run: func(c *Cache) {
+				c.Set("a", 1, time.Time{})
+				c.Set("b", 2, time.Time{})
+				c.Purge()
+			},
+			want: map[string]any{"a": nil, "b": nil},
+			len:  0,
+		},
+		{
+			name: "取得中に破棄された値は入れない",
+			size: 3,
+			run: func(c *Cache) {
+				generation := c.Generation()
+				c.Delete("a")
+				c.SetIfGeneration("a", 1, time.Time{}, generation)
+				c.SetIfGeneration("b", 2, time.Time{}, c.Generation())
+			},
+			want: map[string]any{"a": nil, "b": 2},
+			len:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(tt.size)
+			tt.run(c)
+			for key, want := range tt.want {
+				got, ok := c.Get(key, now)
+				if want == nil {
+					if ok {
+						t.Errorf("Get(%q) = %v, want none", key, got)
+					}
+					continue
+				}
+				if !ok || got != want {
+					t.Errorf("Get(%q) = %v, %v, want %v, true", key, got, ok, want)
+				}
+			}
+			if got := c.Len(); got != tt.len {
+				t.Errorf("Len() = %d, want %d", got, tt.len)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package masterbundle
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/gen/enums"
+	"github.com/cockroachdb/errors"
+)
+
+// マスタデータの一括投入・書き出し用の形式
+// IDは持たず、名前などの自然キーで関連を表す
+type Bundle struct {
+	Artists       []*Artist       `json:"artists"`
+	Singers       []*Singer       `json:"singers"`
+	Units         []*Unit         `json:"units"`
+	Songs         []*Song         `json:"songs"`
+	VocalPatterns []*VocalPattern `json:"vocal_patterns"`
+	Charts        []*Chart        `json:"charts"`
+}
+
+// キーはname
+type Artist struct {
+	Name string `json:"name"`
+	Kana string `json:"kana"`
+}
+
+// キーはname
+type Singer struct {
+	Name string `json:"name"`
+}
+
+// キーはname
+// membersは歌手名を並び順に並べたもの
+type Unit struct {
+	Name    string   `json:"name"`
+	Members []string `json:"members"`
+}
+
+// キーはname
+// lyrics, music, arrangementはアーティスト名をクレジットの順に並べたもの、unitsはユニット名
+// music_video_typesは3D, 2D, ORIGINAL
+type Song struct {
+	Name            string    `json:"name"`
+	Kana            string    `json:"kana"`
+	Lyrics          Names     `json:"lyrics"`
+	Music           Names     `json:"music"`
+	Arrangement     Names     `json:"arrangement"`
+	Thumbnail       string    `json:"thumbnail"`
+	OriginalVideo   string    `json:"original_video"`
+	ReleaseTime     time.Time `json:"release_time"`
+	Deleted         bool      `json:"deleted"`
+	Units           []string  `json:"units"`
+	MusicVideoTypes []string  `json:"music_video_types"`
+}
+
+// 曲の役割ごとのクレジット
+type Credit struct {
+	Role enums.CreditRole
+	// JSONとCSVでの項目名
+	Field string
+	Names Names
+}
+
+// 作詞・作曲・編曲の順に返す
+func (s *Song) Credits() []Credit {
+	return []Credit{
+		{Role: enums.CreditRole_CREDIT_ROLE_LYRICS, Field: "lyrics", Names: s.Lyrics},
+		{Role: enums.CreditRole_CREDIT_ROLE_MUSIC, Field: "music", Names: s.Music},
+		{Role: enums.CreditRole_CREDIT_ROLE_ARRANGEMENT, Field: "arrangement", Names: s.Arrangement},
+	}
+}
+
+// キーはsongとname
+// singersは歌手名を歌唱順に並べたもの
+type VocalPattern struct {
+	Song    string   `json:"song"`
+	Name    string   `json:"name"`
+	Singers []string `json:"singers"`
+}
+
+// キーはsongとdifficulty_type
+// difficulty_typeはEASY, NORMAL, HARD, EXPERT, MASTER, APPEND
+// note_count以降は任意 省略・0は未設定
+type Chart struct {
+	Song            string  `json:"song"`
+	DifficultyType  string  `json:"difficulty_type"`
+	Level           int32   `json:"level"`
+	ChartViewLink   string  `json:"chart_view_link"`
+	NoteCount       int32   `json:"note_count,omitempty"`
+	BPMMin          int32   `json:"bpm_min,omitempty"`
+	BPMMax          int32   `json:"bpm_max,omitempty"`
+	DurationSeconds int32   `json:"duration_seconds,omitempty"`
+	Constant        float64 `json:"constant,omitempty"`
+}
+
+const (
+	difficultyTypePrefix = "DIFFICULTY_TYPE_"
+	musicVideoTypePrefix = "MUSIC_VIDEO_TYPE_"
+)
+
+var ErrInvalidBundle = errors.New("invalid master bundle")
+
+func ParseDifficultyType(s string) (enums.DifficultyType, error) {
+	v, ok := enums.DifficultyType_value[difficultyTypePrefix+strings.ToUpper(s)]
+	if !ok || v == int32(enums.DifficultyType_DIFFICULTY_TYPE_UNSPECIFIED) {
+		return 0, errors.Wrapf(ErrInvalidBundle, "unknown difficulty_type %q", s)
+	}
+	return enums.DifficultyType(v), nil
+}
+
+func FormatDifficultyType(d enums.DifficultyType) string {
+	return strings.TrimPrefix(d.String(), difficultyTypePrefix)
+}
+
+func ParseMusicVideoType(s string) (enums.MusicVideoType, error) {
+	v, ok := enums.MusicVideoType_value[musicVideoTypePrefix+strings.ToUpper(s)]
+	if !ok || v == int32(enums.MusicVideoType_MUSIC_VIDEO_TYPE_UNSPECIFIED) {
+		return 0, errors.Wrapf(ErrInvalidBundle, "unknown music_video_type %q", s)
+	}
+	return enums.MusicVideoType(v), nil
+}
+
+func FormatMusicVideoType(m enums.MusicVideoType) string {
+	return strings.TrimPrefix(m.String(), musicVideoTypePrefix)
+}
+
+// 書き出し用に、nilのスライスを空にし、時刻をUTCに揃えたコピーを返す
+func (b *Bundle) normalized() *Bundle {
+	out := &Bundle{
+		Artists:       nonNil(b.Artists),
+		Singers:       nonNil(b.Singers),
+		Units:         make([]*Unit, len(b.Units)),
+		Songs:         make([]*Song, len(b.Songs)),
+		VocalPatterns: make([]*VocalPattern, len(b.VocalPatterns)),
+		Charts:        nonNil(b.Charts),
+	}
+	for i, u := range b.Units {
+		c := *u
+		c.Members = nonNil(u.Members)
+		out.Units[i] = &c
+	}
+	for i, s := range b.Songs {
+		c := *s
+		c.ReleaseTime = s.ReleaseTime.UTC()
+		c.Lyrics = nonNil(s.Lyrics)
+		c.Music = nonNil(s.Music)
+		c.Arrangement = nonNil(s.Arrangement)
+		c.Units = nonNil(s.Units)
+		c.MusicVideoTypes = nonNil(s.MusicVideoTypes)
+		out.Songs[i] = &c
+	}
+	for i, vp := range b.VocalPatterns {
+		c := *vp
+		c.Singers = nonNil(vp.Singers)
+		out.VocalPatterns[i] = &c
+	}
+
+	return out
+}
+
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+// 必須項目、列挙値、バンドル内でのキーの重複を確認する
+// 他のエンティティへの参照はDBの内容と合わせて確認するのでここでは見ない
+func (b *Bundle) Validate() error {
+	var problems []string
+	addf := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	seen := map[string]bool{}
+	checkKey := func(kind, key string) {
+		k := kind + "\x00" + key
+		if seen[k] {
+			addf("%s %q is duplicated", kind, key)
+		}
+		seen[k] = true
+	}
+
+	for i, a := range b.Artists {
+		if a.Name == "" || a.Kana == "" {
+			addf("artists[%d]: name and kana are required", i)
+		}
+		checkKey("artist", a.Name)
+	}
+	for i, s := range b.Singers {
+		if s.Name == "" {
+			addf("singers[%d]: name is required", i)
+		}
+		checkKey("singer", s.Name)
+	}
+	for i, u := range b.Units {
+		if u.Name == "" {
+			addf("units[%d]: name is required", i)
+		}
+		members := map[string]bool{}
+		for _, m := range u.Members {
+			if members[m] {
+				addf("units[%d]: member %q is duplicated", i, m)
+			}
+			members[m] = true
+		}
+		checkKey("unit", u.Name)
+	}
+	for i, s := range b.Songs {
+		if s.Name == "" || s.Kana == "" {
+			addf("songs[%d]: name and kana are required", i)
+		}
+		if len(s.Lyrics) == 0 || len(s.Music) == 0 || len(s.Arrangement) == 0 {
+			addf("songs[%d]: lyrics, music and arrangement are required", i)
+		}
+		for _, credit := range s.Credits() {
+			seen := map[string]bool{}
+			for _, n := range credit.Names {
+				if n == "" {
+					addf("songs[%d]: %s has an empty name", i, credit.Field)
+				} else if seen[n] {
+					addf("songs[%d]: %s %q is duplicated", i, credit.Field, n)
+				}
+				seen[n] = true
+			}
+		}
+		if s.ReleaseTime.IsZero() {
+			addf("songs[%d]: release_time is required", i)
+		}
+		for _, m := range s.MusicVideoTypes {
+			if _, err := ParseMusicVideoType(m); err != nil {
+				addf("songs[%d]: %v", i, err)
+			}
+		}
+		checkKey("song", s.Name)
+	}
+	for i, vp := range b.VocalPatterns {
+		if vp.Song == "" || vp.Name == "" {
+			addf("vocal_patterns[%d]: song and name are required", i)
+		}
+		checkKey("vocal_pattern", vp.Song+"/"+vp.Name)
+	}
+	for i, c := range b.Charts {
+		if c.Song == "" {
+			addf("charts[%d]: song is required", i)
+		}
+		if _, err := ParseDifficultyType(c.DifficultyType); err != nil {
+			addf("charts[%d]: %v", i, err)
+		}
+		if c.Level < 1 || c.Level > 99 {
+			addf("charts[%d]: level must be between 1 and 99", i)
+		}
+		if c.NoteCount < 0 || c.BPMMin < 0 || c.BPMMax < 0 || c.DurationSeconds < 0 || c.Constant < 0 {
+			addf("charts[%d]: note_count, bpm_min, bpm_max, duration_seconds and constant must not be negative", i)
+		}
+		if c.BPMMin != 0 && c.BPMMax != 0 && c.BPMMin > c.BPMMax {
+			addf("charts[%d]: bpm_min must not exceed bpm_max", i)
+		}
+		checkKey("chart", c.Song+"/"+strings.ToUpper(c.DifficultyType))
+	}
+
+	if len(problems) > 0 {
+		return errors.Wrap(ErrInvalidBundle, strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package masterbundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// CSV形式ではエンティティごとに1ファイルとし、1行目をヘッダとする
+// 1セルに複数の値を入れる場合は"|"で区切る
+const (
+	ArtistsCSV       = "artists.csv"
+	SingersCSV       = "singers.csv"
+	UnitsCSV         = "units.csv"
+	SongsCSV         = "songs.csv"
+	VocalPatternsCSV = "vocal_patterns.csv"
+	ChartsCSV        = "charts.csv"
+
+	listSeparator = "|"
+)
+
+// CSVファイルをまとめたzipを読み込む
+func DecodeCSVZip(data []byte) (*Bundle, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidBundle, err.Error())
+	}
+
+	files := make(map[string][]byte, len(zr.File))
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		files[filepath.Base(f.Name)] = b
+	}
+
+	return DecodeCSVFiles(files)
+}
+
+// CSVファイルを置いたディレクトリを読み込む
+func DecodeCSVDir(dir string) (*Bundle, error) {
+	files := map[string][]byte{}
+	for _, name := range []string{ArtistsCSV, SingersCSV, UnitsCSV, SongsCSV, VocalPatternsCSV, ChartsCSV} {
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		files[name] = b
+	}
+
+	return DecodeCSVFiles(files)
+}
+
+// ファイル名と内容の組から読み込む
+// 存在しないファイルは空として扱う
+func DecodeCSVFiles(files map[string][]byte) (*Bundle, error) {
+	var b Bundle
+
+	if err := eachCSVRecord(files, ArtistsCSV, func(r csvRecord) error {
+		b.Artists = append(b.Artists, &Artist{Name: r.get("name"), Kana: r.get("kana")})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := eachCSVRecord(files, SingersCSV, func(r csvRecord) error {
+		b.Singers = append(b.Singers, &Singer{Name: r.get("name")})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := eachCSVRecord(files, UnitsCSV, func(r csvRecord) error {
+		b.Units = append(b.Units, &Unit{Name: r.get("name"), Members: r.list("members")})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := eachCSVRecord(files, SongsCSV, func(r csvRecord) error {
+		var releaseTime time.Time
+		if v := r.get("release_time"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return r.errorf("release_time: %v", err)
+			}
+			releaseTime = t
+		}
+		var deleted bool
+		if v := r.get("deleted"); v != "" {
+			d, err := strconv.ParseBool(v)
+			if err != nil {
+				return r.errorf("deleted: %v", err)
+			}
+			deleted = d
+		}
+		b.Songs = append(b.Songs, &Song{
+			Name:            r.get("name"),
+			Kana:            r.get("kana"),
+			Lyrics:          r.list("lyrics"),
+			Music:           r.list("music"),
+			Arrangement:     r.list("arrangement"),
+			Thumbnail:       r.get("thumbnail"),
+			OriginalVideo:   r.get("original_video"),
+			ReleaseTime:     releaseTime,
+			Deleted:         deleted,
+			Units:           r.list("units"),
+			MusicVideoTypes: r.list("music_video_types"),
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := eachCSVRecord(files, VocalPatternsCSV, func(r csvRecord) error {
+		b.VocalPatterns = append(b.VocalPatterns, &VocalPattern{
+			Song:    r.get("song"),
+			Name:    r.get("name"),
+			Singers: r.list("singers"),
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := eachCSVRecord(files, ChartsCSV, func(r csvRecord) error {
+		level, err := strconv.ParseInt(r.get("level"), 10, 32)
+		if err != nil {
+			return r.errorf("level: %v", err)
+		}
+		c := &Chart{
+			Song:           r.get("song"),
+			DifficultyType: r.get("difficulty_type"),
+			Level:          int32(level),
+			ChartViewLink:  r.get("chart_view_link"),
+		}
+		// 任意の列は空なら未設定
+		for _, col := range []struct {
+			name string
+			dst  *int32
+		}{
+			{"note_count", &c.NoteCount},
+			{"bpm_min", &c.BPMMin},
+			{"bpm_max", &c.BPMMax},
+			{"duration_seconds", &c.DurationSeconds},
+		} {
+			if v := r.get(col.name); v != "" {
+				n, err := strconv.ParseInt(v, 10, 32)
+				if err != nil {
+					return r.errorf("%s: %v", col.name, err)
+				}
+				*col.dst = int32(n)
+			}
+		}
+		if v := r.get("constant"); v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return r.errorf("constant: %v", err)
+			}
+			c.Constant = f
+		}
+		b.Charts = append(b.Charts, c)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &b, nil
+}
+
+type csvRecord struct {
+	file   string
+	line   int
+	header map[string]int
+	fields []string
+}
+
+func (r csvRecord) get(column string) string {
+	i, ok := r.header[column]
+	if !ok || i >= len(r.fields) {
+		return ""
+	}
+	return strings.TrimSpace(r.fields[i])
+}
+
+func (r csvRecord) list(column string) []string {
+	v := r.get(column)
+	if v == "" {
+		return []string{}
+	}
+	parts := strings.Split(v, listSeparator)
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return parts
+}
+
+func (r csvRecord) errorf(format string, args ...any) error {
+	return errors.Wrapf(ErrInvalidBundle, "%s:%d: "+format, append([]any{r.file, r.line}, args...)...)
+}
+
+func eachCSVRecord(files map[string][]byte, name string, fn func(r csvRecord) error) error {
+	data, ok := files[name]
+	if !ok {
+		return nil
+	}
+
+	cr := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	cr.FieldsPerRecord = -1
+
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return errors.Wrapf(ErrInvalidBundle, "%s: %v", name, err)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	header := make(map[string]int, len(rows[0]))
+	for i, h := range rows[0] {
+		header[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+
+	for i, row := range rows[1:] {
+		if err := fn(csvRecord{file: name, line: i + 2, header: header, fields: row}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
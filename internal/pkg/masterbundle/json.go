@@ -0,0 +1,56 @@
+package masterbundle
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/cockroachdb/errors"
+)
+
+// 名前の一覧 以前の形式との互換のため、1人だけの場合は文字列でも受け付ける
+type Names []string
+
+func (n *Names) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = nil
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*n = Names{name}
+		return nil
+	}
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+	*n = names
+	return nil
+}
+
+func DecodeJSON(r io.Reader) (*Bundle, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	var b Bundle
+	if err := dec.Decode(&b); err != nil {
+		return nil, errors.Wrap(ErrInvalidBundle, err.Error())
+	}
+
+	return &b, nil
+}
+
+// 同じ内容なら同じバイト列になるように書き出す
+// 並び順は呼び出し側で決めたものをそのまま使う
+func EncodeJSON(w io.Writer, b *Bundle) error {
+	data, err := json.MarshalIndent(b.normalized(), "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	data = append(data, '\n')
+	if _, err := w.Write(data); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
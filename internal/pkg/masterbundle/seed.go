@@ -0,0 +1,145 @@
+package masterbundle
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/normalize"
+)
+
+// マスタデータのシードファイル v999.999.999_n_*-data.up.sql
+// IDは埋め込まず、参照先は名前で引くので、ファイルの順に流せば空のDBに入れられる
+type SeedFile struct {
+	Name string
+	Data []byte
+}
+
+const seedVersion = "v999.999.999"
+
+func SeedSQL(b *Bundle) ([]*SeedFile, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	b = b.normalized()
+
+	var files []*SeedFile
+	add := func(name string, write func(w *bytes.Buffer)) {
+		var buf bytes.Buffer
+		buf.WriteString("-- go run ./cmd/master export で生成\n")
+		write(&buf)
+		files = append(files, &SeedFile{
+			Name: fmt.Sprintf("%s_%d_%s-data.up.sql", seedVersion, len(files)+1, name),
+			Data: buf.Bytes(),
+		})
+	}
+
+	add("artist", func(w *bytes.Buffer) {
+		for _, a := range b.Artists {
+			fmt.Fprintf(w, "INSERT INTO artists (name, kana, search_text) VALUES (%s, %s, %s);\n",
+				quote(a.Name), quote(a.Kana), quote(normalize.SearchText(a.Name, a.Kana)))
+		}
+	})
+
+	add("singer", func(w *bytes.Buffer) {
+		for _, s := range b.Singers {
+			fmt.Fprintf(w, "INSERT INTO singers (name) VALUES (%s);\n", quote(s.Name))
+		}
+	})
+
+	add("unit", func(w *bytes.Buffer) {
+		for _, u := range b.Units {
+			fmt.Fprintf(w, "INSERT INTO units (name) VALUES (%s);\n", quote(u.Name))
+		}
+		for _, u := range b.Units {
+			for i, m := range u.Members {
+				fmt.Fprintf(w, "INSERT INTO unit_singers (unit_id, singer_id, position) VALUES (%s, %s, %d);\n",
+					idByName("units", u.Name), idByName("singers", m), i+1)
+			}
+		}
+	})
+
+	add("song", func(w *bytes.Buffer) {
+		for _, s := range b.Songs {
+			fmt.Fprintf(w, "INSERT INTO songs (name, kana, thumbnail, original_video, release_time, deleted, search_text) VALUES (%s, %s, %s, %s, %s, %t, %s);\n",
+				quote(s.Name), quote(s.Kana),
+				quote(s.Thumbnail), quote(s.OriginalVideo),
+				quote(s.ReleaseTime.Format("2006-01-02 15:04:05")), s.Deleted,
+				quote(normalize.SearchText(s.Name, s.Kana)))
+		}
+		for _, s := range b.Songs {
+			for _, credit := range s.Credits() {
+				for i, name := range credit.Names {
+					fmt.Fprintf(w, "INSERT INTO song_credits (song_id, artist_id, role, position) VALUES (%s, %s, %d, %d);\n",
+						idByName("songs", s.Name), idByName("artists", name), int32(credit.Role), i+1)
+				}
+			}
+		}
+		for _, s := range b.Songs {
+			for _, u := range s.Units {
+				fmt.Fprintf(w, "INSERT INTO song_units (song_id, unit_id) VALUES (%s, %s);\n",
+					idByName("songs", s.Name), idByName("units", u))
+			}
+		}
+		for _, s := range b.Songs {
+			for _, m := range s.MusicVideoTypes {
+				// Validate済みなのでエラーにはならない
+				mvt, _ := ParseMusicVideoType(m)
+				fmt.Fprintf(w, "INSERT INTO song_music_video_types (song_id, music_video_type) VALUES (%s, %d);\n",
+					idByName("songs", s.Name), int32(mvt))
+			}
+		}
+	})
+
+	add("vocal-pattern", func(w *bytes.Buffer) {
+		for _, vp := range b.VocalPatterns {
+			fmt.Fprintf(w, "INSERT INTO vocal_patterns (song_id, name) VALUES (%s, %s);\n",
+				idByName("songs", vp.Song), quote(vp.Name))
+		}
+		for _, vp := range b.VocalPatterns {
+			vpID := fmt.Sprintf("(SELECT vp.id FROM vocal_patterns vp JOIN songs s ON s.id = vp.song_id WHERE s.name = %s AND vp.name = %s ORDER BY vp.id LIMIT 1)",
+				quote(vp.Song), quote(vp.Name))
+			for i, singer := range vp.Singers {
+				fmt.Fprintf(w, "INSERT INTO vocal_pattern_singers (vocal_pattern_id, singer_id, position) VALUES (%s, %s, %d);\n",
+					vpID, idByName("singers", singer), i+1)
+			}
+		}
+	})
+
+	add("chart", func(w *bytes.Buffer) {
+		for _, c := range b.Charts {
+			d, _ := ParseDifficultyType(c.DifficultyType)
+			fmt.Fprintf(w, "INSERT INTO charts (song_id, difficulty_type, level, chart_view_link, note_count, bpm_min, bpm_max, duration_seconds, constant) VALUES (%s, %d, %d, %s, %s, %s, %s, %s, %s);\n",
+				idByName("songs", c.Song), int32(d), c.Level, quote(c.ChartViewLink),
+				nullIfZero(c.NoteCount), nullIfZero(c.BPMMin), nullIfZero(c.BPMMax), nullIfZero(c.DurationSeconds), nullIfZero(c.Constant))
+		}
+		// レベルの履歴は持たないので、今のレベルを曲の公開日時からのものとして入れる
+		if len(b.Charts) > 0 {
+			w.WriteString("INSERT INTO chart_level_history (chart_id, level, effective_from) SELECT c.id, c.level, LEAST(COALESCE(s.release_time, CURRENT_TIMESTAMP), CURRENT_TIMESTAMP) FROM charts c LEFT JOIN songs s ON c.song_id = s.id WHERE c.level IS NOT NULL ON CONFLICT (chart_id, effective_from) DO NOTHING;\n")
+		}
+	})
+
+	return files, nil
+}
+
+// 名前が重複している場合はIDが小さいものを使う (取り込み時と同じ)
+func idByName(table, name string) string {
+	return fmt.Sprintf("(SELECT id FROM %s WHERE name = %s ORDER BY id LIMIT 1)", table, quote(name))
+}
+
+// 未設定の任意項目はNULLにする
+func nullIfZero[T int32 | float64](v T) string {
+	if v == 0 {
+		return "NULL"
+	}
+	return fmt.Sprint(v)
+}
+
+// 改行を含む場合は1行に収まるようにエスケープ文字列にする
+func quote(s string) string {
+	if !strings.Contains(s, "\n") {
+		return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+	}
+	r := strings.NewReplacer(`\`, `\\`, "'", "''", "\n", `\n`, "\r", `\r`)
+	return "E'" + r.Replace(s) + "'"
+}
@@ -0,0 +1,82 @@
+package normalize
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 検索用カラムで複数の文字列をつなぐ区切り文字
+// 検索クエリには含まれないので区切りをまたいだ一致は起きない
+const searchTextSeparator = "\n"
+
+// 長音記号とその代わりに使われがちな文字
+var longVowelMarks = map[rune]bool{
+	'ー':      true,
+	'~':      true,
+	'\u2010': true, // ‐
+	'\u2011': true, // ‑
+	'\u2012': true, // ‒
+	'\u2013': true, // –
+	'\u2014': true, // —
+	'\u2015': true, // ―
+	'\u2212': true, // −
+	'\u301C': true, // 〜
+}
+
+// 検索用に文字列を正規化する
+// 全角・半角の統一(NFKC)、小文字化、カタカナをひらがなに変換、長音記号の除去、空白の整理を行う
+func String(s string) string {
+	s = norm.NFKC.String(s)
+	s = strings.ToLower(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	space := false
+	for _, r := range s {
+		if longVowelMarks[r] {
+			continue
+		}
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			space = b.Len() > 0
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(toHiragana(r))
+	}
+
+	return b.String()
+}
+
+// 名前の一意制約(artists_name_keyなど)と同じ式で名前を比べるためのキー
+// lower(btrim(regexp_replace(normalize(name, NFKC), '\s+', ' ', 'g')))
+func NameKey(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(norm.NFKC.String(s)), " "))
+}
+
+// 曲名と読みなどをまとめて検索用カラムの値にする
+func SearchText(parts ...string) string {
+	normalized := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if n := String(p); n != "" {
+			normalized = append(normalized, n)
+		}
+	}
+	return strings.Join(normalized, searchTextSeparator)
+}
+
+func toHiragana(r rune) rune {
+	switch {
+	// ァ-ヶ
+	case r >= 0x30A1 && r <= 0x30F6:
+		return r - 0x60
+	// ヽヾ
+	case r == 0x30FD || r == 0x30FE:
+		return r - 0x60
+	}
+	return r
+}
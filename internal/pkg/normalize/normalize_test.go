@@ -0,0 +1,73 @@
+package normalize
+
+import "testing"
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "空文字", s: "", want: ""},
+		{name: "全角英字は半角の小文字", s: "ＡＢＣ", want: "abc"},
+		{name: "カタカナはひらがな", s: "プロセカ", want: "ぷろせか"},
+		{name: "半角カタカナは濁点ごとひらがな", s: "ﾊﾟｽﾃﾙ", want: "ぱすてる"},
+		{name: "ヴと小書きの文字", s: "ヴァ", want: "ゔぁ"},
+		{name: "踊り字", s: "ヽヾ", want: "ゝゞ"},
+		{name: "長音記号は除く", s: "ニーゴ", want: "にご"},
+		{name: "波ダッシュは除く", s: "ワールド〜ワイド", want: "わるどわいど"},
+		{name: "全角チルダはNFKCの後に除く", s: "full～width", want: "fullwidth"},
+		{name: "ダッシュ類は除く", s: "a‐b–c—d−e", want: "abcde"},
+		{name: "前後の空白を除き連続する空白は1つにする", s: "  Hello \t\n World  ", want: "hello world"},
+		{name: "全角空白も空白", s: "Ａ　Ｂ", want: "a b"},
+		{name: "漢字はそのまま", s: "初音ミク", want: "初音みく"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := String(tt.s); got != tt.want {
+				t.Errorf("String(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameKey(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "空文字", s: "", want: ""},
+		{name: "全角と空白の整理", s: "  ＭＯＲＥ　ＭＯＲＥ  JUMP! ", want: "more more jump!"},
+		{name: "タブと改行も空白", s: "a\tb\nc", want: "a b c"},
+		{name: "カタカナと長音記号はそのまま", s: "ニーゴ", want: "ニーゴ"},
+		{name: "半角カタカナは全角", s: "ﾆｰｺﾞ", want: "ニーゴ"},
+		{name: "記号はそのまま", s: "Leo/need", want: "leo/need"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NameKey(tt.s); got != tt.want {
+				t.Errorf("NameKey(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchText(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []string
+		want  string
+	}{
+		{name: "なし", parts: nil, want: ""},
+		{name: "区切り文字でつなぐ", parts: []string{"セカイ", "せかい"}, want: "せかい\nせかい"},
+		{name: "空になるものは除く", parts: []string{"ー", "", "Ａ"}, want: "a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SearchText(tt.parts...); got != tt.want {
+				t.Errorf("SearchText(%q) = %q, want %q", tt.parts, got, tt.want)
+			}
+		})
+	}
+}
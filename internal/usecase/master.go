@@ -1,14 +1,19 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
+	"encoding/base64"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
 	"github.com/Shakkuuu/sekai-songs-mylist/internal/gen/enums"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/masterbundle"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/normalize"
 	"github.com/cockroachdb/errors"
-	"github.com/redis/go-redis/v9"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=../mock/$GOPACKAGE/$GOFILE -package=mock_$GOPACKAGE
@@ -16,74 +21,162 @@ import (
 
 var (
 	ErrInvalidArgument = errors.New("invalid argument")
+	ErrMasterInUse     = errors.New("this master is still in use")
+	// 事前チェックをすり抜けた一意制約違反もrepository側で同じエラーになる
+	ErrAlreadyExists = repository.ErrAlreadyExists
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// 譜面一覧の絞り込みと並び順 ゼロ値の項目は絞り込みに使わない
+type ChartListCondition struct {
+	// 削除済みの曲の譜面も含める
+	IncludeDeleted bool
+	// 公開前の曲の譜面も含める
+	IncludeUnreleased  bool
+	MinNoteCount       int32
+	MaxNoteCount       int32
+	MinBPM             int32
+	MaxBPM             int32
+	MinDurationSeconds int32
+	MaxDurationSeconds int32
+	MinConstant        float64
+	MaxConstant        float64
+	// UNSPECIFIEDはID順
+	SortKey    enums.ChartSortKey
+	Descending bool
+}
+
 type MasterUsecase interface {
 	// Artist
 	ListArtists(ctx context.Context) ([]*entity.Artist, error)
 	GetArtistByID(ctx context.Context, id int32) (*entity.Artist, error)
 	CreateArtist(ctx context.Context, name, kana string) error
+	UpdateArtist(ctx context.Context, id int32, name, kana string) error
+	DeleteArtist(ctx context.Context, id int32) error
+	MergeArtists(ctx context.Context, sourceID, targetID int32) error
 	// Singer
 	ListSingers(ctx context.Context) ([]*entity.Singer, error)
 	GetSingerByID(ctx context.Context, id int32) (*entity.Singer, error)
 	CreateSinger(ctx context.Context, name string) error
+	UpdateSinger(ctx context.Context, id int32, name string) error
 	// Unit
 	ListUnits(ctx context.Context) ([]*entity.Unit, error)
 	GetUnitByID(ctx context.Context, id int32) (*entity.Unit, error)
 	CreateUnit(ctx context.Context, name string) error
+	UpdateUnit(ctx context.Context, id int32, name string) error
+	// UnitSinger
+	CreateUnitSinger(ctx context.Context, unitID, singerID, position int32) error
+	UpdateUnitSinger(ctx context.Context, unitID, singerID, position int32) error
+	DeleteUnitSinger(ctx context.Context, unitID, singerID int32) error
 	// VocalPattern
+	ListVocalPatterns(ctx context.Context, songID int32) ([]*entity.VocalPattern, error)
+	GetVocalPatternByID(ctx context.Context, id int32) (*entity.VocalPattern, error)
 	CreateVocalPattern(ctx context.Context, songID int32, name string, singerIDs, singerPositions []int32) error
+	UpdateVocalPattern(ctx context.Context, id int32, name string, singerIDs, singerPositions []int32) error
+	DeleteVocalPattern(ctx context.Context, id int32) error
 	// Song
-	ListSongs(ctx context.Context) ([]*entity.Song, error)
+	ListSongs(ctx context.Context, includeDeleted, includeUnreleased bool) ([]*entity.Song, error)
 	GetSongByID(ctx context.Context, id int32) (*entity.Song, error)
 	CreateSong(
 		ctx context.Context,
 		name, kana string,
-		lyrics_id, music_id, arrangement_id int32,
+		credits []*entity.SongCredit,
+		thumbnail, originalVideo string,
+		releaseTime time.Time, deleted bool,
+		unitIDs []int32,
+		musicVideoTypes []enums.MusicVideoType,
+	) error
+	UpdateSong(
+		ctx context.Context,
+		id int32,
+		name, kana string,
+		credits []*entity.SongCredit,
 		thumbnail, originalVideo string,
 		releaseTime time.Time, deleted bool,
 		unitIDs []int32,
 		musicVideoTypes []enums.MusicVideoType,
 	) error
+	DeleteSong(ctx context.Context, id int32) error
+	SearchSongs(ctx context.Context, cond repository.SongSearchCondition, pageSize int32, pageToken string) ([]*entity.Song, string, error)
+	// onlyMissingなら検索用カラムが空の行だけ埋める
+	BackfillSearchText(ctx context.Context, onlyMissing bool) (artistCount, songCount int, err error)
+	// 公開月(日本時間)ごとにまとめる unitIDが0なら絞り込まない
+	ListSongReleases(ctx context.Context, unitID int32, includeUnreleased bool) ([]*entity.SongReleaseMonth, error)
 	// Chart
-	ListCharts(ctx context.Context) ([]*entity.Chart, error)
+	ListCharts(ctx context.Context, cond ChartListCondition) ([]*entity.Chart, error)
 	GetChartByID(ctx context.Context, id int32) (*entity.Chart, error)
+	// levelをasOf時点のものにして返す
+	GetChartByIDAsOf(ctx context.Context, id int32, asOf time.Time) (*entity.Chart, error)
+	ListChartLevelHistory(ctx context.Context, chartID int32) ([]*entity.ChartLevelHistory, error)
 	CreateChart(
 		ctx context.Context,
 		songID, difficultyType, level int32,
 		chartViewLink string,
+		metadata entity.ChartMetadata,
+	) error
+	UpdateChart(
+		ctx context.Context,
+		id, songID, difficultyType, level int32,
+		chartViewLink string,
+		metadata entity.ChartMetadata,
+		levelEffectiveFrom time.Time,
 	) error
+	DeleteChart(ctx context.Context, id int32) error
+	SearchCharts(ctx context.Context, cond repository.ChartSearchCondition, pageSize int32, pageToken string) ([]*entity.Chart, string, int64, error)
+	// Feed
+	// 追加日時の新しい順に曲と譜面を合わせてlimit件 削除済み・公開前のものは除く
+	ListRecentAdditions(ctx context.Context, limit int) ([]*entity.MasterAddition, error)
+	// Revision
+	GetMasterVersion(ctx context.Context) (int64, error)
+	GetMasterDelta(ctx context.Context, sinceRevision int64, includeDeleted, includeUnreleased bool) (*entity.MasterDelta, error)
+	// 前回から公開日時を過ぎた曲を更新として記録する 記録した曲の数を返す
+	RecordSongReleases(ctx context.Context) (int, error)
+	WatchMaster(ctx context.Context, includeDeleted, includeUnreleased bool) <-chan []*entity.MasterChangeLog
+	// Import / Export
+	ImportMaster(ctx context.Context, bundle *masterbundle.Bundle, dryRun bool) ([]*entity.MasterChange, error)
+	ExportMaster(ctx context.Context) (*masterbundle.Bundle, error)
 }
 
 type masterUsecase struct {
 	masterRepo           repository.MasterRepository
 	redisMasterCacheRepo repository.RedisMasterCacheRepository
+	redisMasterEventRepo repository.RedisMasterEventRepository
+	txManager            repository.TxManager
+	cacheFiller          masterCacheFiller
 }
 
-func NewMasterUsecase(repo repository.MasterRepository, redisMasterCacheRepo repository.RedisMasterCacheRepository) MasterUsecase {
+// redisLockRepoがnilならキャッシュを埋める処理はサーバーの中でだけまとめる
+func NewMasterUsecase(repo repository.MasterRepository, redisMasterCacheRepo repository.RedisMasterCacheRepository, redisMasterEventRepo repository.RedisMasterEventRepository, redisLockRepo repository.RedisLockRepository, txManager repository.TxManager) MasterUsecase {
 	return &masterUsecase{
 		masterRepo:           repo,
 		redisMasterCacheRepo: redisMasterCacheRepo,
+		redisMasterEventRepo: redisMasterEventRepo,
+		txManager:            txManager,
+		cacheFiller:          masterCacheFiller{lockRepo: redisLockRepo},
 	}
 }
 
 // Artist
 func (u *masterUsecase) ListArtists(ctx context.Context) ([]*entity.Artist, error) {
-	artists, err := u.redisMasterCacheRepo.GetArtists(ctx)
-	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			artists, err := u.masterRepo.ListArtists(ctx)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if artists == nil {
-				return []*entity.Artist{}, nil
-			}
-			if err := u.redisMasterCacheRepo.SetArtists(ctx, artists); err != nil {
-				return nil, errors.WithStack(err)
-			}
-			return artists, nil
+	artists, err := fillMasterCache(ctx, &u.cacheFiller, listCacheKey(repository.ARTIST_REDIS_KEY), u.redisMasterCacheRepo.GetArtists, func(ctx context.Context) ([]*entity.Artist, error) {
+		artists, err := u.masterRepo.ListArtists(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
+		// 空の一覧もキャッシュに入れる 入れないと毎回DBを読みに行き、埋める処理もまとまらない
+		if artists == nil {
+			artists = []*entity.Artist{}
+		}
+		if err := u.redisMasterCacheRepo.SetArtists(ctx, artists); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return artists, nil
+	})
+	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
@@ -91,18 +184,19 @@ func (u *masterUsecase) ListArtists(ctx context.Context) ([]*entity.Artist, erro
 }
 
 func (u *masterUsecase) GetArtistByID(ctx context.Context, id int32) (*entity.Artist, error) {
-	artist, err := u.redisMasterCacheRepo.GetArtistByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			artist, err := u.masterRepo.GetArtistByID(ctx, id)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if err := u.redisMasterCacheRepo.SetArtist(ctx, id, artist); err != nil {
-				return nil, errors.WithStack(err)
-			}
-			return artist, nil
+	artist, err := fillMasterCache(ctx, &u.cacheFiller, idCacheKey(repository.ARTIST_REDIS_KEY, id), func(ctx context.Context) (*entity.Artist, error) {
+		return u.redisMasterCacheRepo.GetArtistByID(ctx, id)
+	}, func(ctx context.Context) (*entity.Artist, error) {
+		artist, err := u.masterRepo.GetArtistByID(ctx, id)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
+		if err := u.redisMasterCacheRepo.SetArtist(ctx, id, artist); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return artist, nil
+	})
+	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
@@ -110,17 +204,126 @@ func (u *masterUsecase) GetArtistByID(ctx context.Context, id int32) (*entity.Ar
 }
 
 func (u *masterUsecase) CreateArtist(ctx context.Context, name, kana string) error {
-	_, err := u.masterRepo.CreateArtist(ctx, name, kana)
+	if err := u.checkArtistNameConflict(ctx, name, 0); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		artist, err := u.masterRepo.CreateArtist(ctx, name, kana)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordMasterChanges(ctx, masterChangeLog(entity.MasterKindArtist, artist.ID, entity.MasterChangeOperationInsert))
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	artists, err := u.masterRepo.ListArtists(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if artists == nil {
+		artists = []*entity.Artist{}
+	}
+	if err := u.redisMasterCacheRepo.SetArtists(ctx, artists); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (u *masterUsecase) UpdateArtist(ctx context.Context, id int32, name, kana string) error {
+	exist, err := u.masterRepo.ExistsArtist(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !exist {
+		return errors.WithStack(repository.ErrNotFound)
+	}
+	if err := u.checkArtistNameConflict(ctx, name, id); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.UpdateArtist(ctx, id, name, kana); err != nil {
+			return errors.WithStack(err)
+		}
+		songLogs, err := u.songChangeLogs(ctx, func(s *entity.Song) bool {
+			return songHasArtist(s, id)
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordMasterChanges(ctx, append(songLogs, masterChangeLog(entity.MasterKindArtist, id, entity.MasterChangeOperationUpdate))...)
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// 作詞・作曲・編曲に含まれる曲と譜面のキャッシュも一緒に破棄される
+	if err := u.redisMasterCacheRepo.DeleteArtist(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	artist, err := u.masterRepo.GetArtistByID(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := u.redisMasterCacheRepo.SetArtist(ctx, id, artist); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := u.refreshArtistsCache(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := u.refreshSongListCaches(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (u *masterUsecase) DeleteArtist(ctx context.Context, id int32) error {
+	exist, err := u.masterRepo.ExistsArtist(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !exist {
+		return errors.WithStack(repository.ErrNotFound)
+	}
+
+	// 曲から参照されている場合は削除できない
+	used, err := u.masterRepo.ExistsSongByArtistID(ctx, id)
 	if err != nil {
 		return errors.WithStack(err)
 	}
+	if used {
+		return errors.WithStack(ErrMasterInUse)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.DeleteArtist(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordMasterChanges(ctx, masterChangeLog(entity.MasterKindArtist, id, entity.MasterChangeOperationDelete))
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.redisMasterCacheRepo.DeleteArtist(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := u.refreshArtistsCache(ctx); err != nil {
+		return errors.WithStack(err)
+	}
 
+	return nil
+}
+
+func (u *masterUsecase) refreshArtistsCache(ctx context.Context) error {
 	artists, err := u.masterRepo.ListArtists(ctx)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	if artists == nil {
-		return nil
+		artists = []*entity.Artist{}
 	}
 	if err := u.redisMasterCacheRepo.SetArtists(ctx, artists); err != nil {
 		return errors.WithStack(err)
@@ -131,21 +334,20 @@ func (u *masterUsecase) CreateArtist(ctx context.Context, name, kana string) err
 
 // Singer
 func (u *masterUsecase) ListSingers(ctx context.Context) ([]*entity.Singer, error) {
-	singers, err := u.redisMasterCacheRepo.GetSingers(ctx)
-	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			singers, err := u.masterRepo.ListSingers(ctx)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if singers == nil {
-				return []*entity.Singer{}, nil
-			}
-			if err := u.redisMasterCacheRepo.SetSingers(ctx, singers); err != nil {
-				return nil, errors.WithStack(err)
-			}
-			return singers, nil
+	singers, err := fillMasterCache(ctx, &u.cacheFiller, listCacheKey(repository.SINGER_REDIS_KEY), u.redisMasterCacheRepo.GetSingers, func(ctx context.Context) ([]*entity.Singer, error) {
+		singers, err := u.masterRepo.ListSingers(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if singers == nil {
+			singers = []*entity.Singer{}
 		}
+		if err := u.redisMasterCacheRepo.SetSingers(ctx, singers); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return singers, nil
+	})
+	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
@@ -153,18 +355,19 @@ func (u *masterUsecase) ListSingers(ctx context.Context) ([]*entity.Singer, erro
 }
 
 func (u *masterUsecase) GetSingerByID(ctx context.Context, id int32) (*entity.Singer, error) {
-	singer, err := u.redisMasterCacheRepo.GetSingerByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			singer, err := u.masterRepo.GetSingerByID(ctx, id)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if err := u.redisMasterCacheRepo.SetSinger(ctx, id, singer); err != nil {
-				return nil, errors.WithStack(err)
-			}
-			return singer, nil
+	singer, err := fillMasterCache(ctx, &u.cacheFiller, idCacheKey(repository.SINGER_REDIS_KEY, id), func(ctx context.Context) (*entity.Singer, error) {
+		return u.redisMasterCacheRepo.GetSingerByID(ctx, id)
+	}, func(ctx context.Context) (*entity.Singer, error) {
+		singer, err := u.masterRepo.GetSingerByID(ctx, id)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if err := u.redisMasterCacheRepo.SetSinger(ctx, id, singer); err != nil {
+			return nil, errors.WithStack(err)
 		}
+		return singer, nil
+	})
+	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
@@ -172,8 +375,17 @@ func (u *masterUsecase) GetSingerByID(ctx context.Context, id int32) (*entity.Si
 }
 
 func (u *masterUsecase) CreateSinger(ctx context.Context, name string) error {
-	_, err := u.masterRepo.CreateSinger(ctx, name)
-	if err != nil {
+	if err := u.checkSingerNameConflict(ctx, name, 0); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		singer, err := u.masterRepo.CreateSinger(ctx, name)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordMasterChanges(ctx, masterChangeLog(entity.MasterKindSinger, singer.ID, entity.MasterChangeOperationInsert))
+	}); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -182,7 +394,7 @@ func (u *masterUsecase) CreateSinger(ctx context.Context, name string) error {
 		return errors.WithStack(err)
 	}
 	if singers == nil {
-		return nil
+		singers = []*entity.Singer{}
 	}
 	if err := u.redisMasterCacheRepo.SetSingers(ctx, singers); err != nil {
 		return errors.WithStack(err)
@@ -191,23 +403,69 @@ func (u *masterUsecase) CreateSinger(ctx context.Context, name string) error {
 	return nil
 }
 
+func (u *masterUsecase) UpdateSinger(ctx context.Context, id int32, name string) error {
+	singer, err := u.masterRepo.GetSingerByID(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := u.checkSingerNameConflict(ctx, name, id); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.UpdateSinger(ctx, id, name); err != nil {
+			return errors.WithStack(err)
+		}
+		songLogs, err := u.songChangeLogs(ctx, func(s *entity.Song) bool {
+			return songHasSinger(s, id)
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		logs := append(songLogs, masterChangeLog(entity.MasterKindSinger, id, entity.MasterChangeOperationUpdate))
+		// 所属しているユニットは歌手名を含むので更新扱いにする
+		for _, unit := range singer.Units {
+			logs = append(logs, masterChangeLog(entity.MasterKindUnit, unit.ID, entity.MasterChangeOperationUpdate))
+		}
+		return u.recordMasterChanges(ctx, logs...)
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// 所属しているユニットとボーカルパターンに含まれる曲・譜面のキャッシュも一緒に破棄される
+	if err := u.redisMasterCacheRepo.DeleteSinger(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	unitIDs := make([]int32, len(singer.Units))
+	for i, unit := range singer.Units {
+		unitIDs[i] = unit.ID
+	}
+	if err := u.refreshUnitSingerCaches(ctx, unitIDs, []int32{id}); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := u.refreshSongListCaches(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
 // Unit
 func (u *masterUsecase) ListUnits(ctx context.Context) ([]*entity.Unit, error) {
-	units, err := u.redisMasterCacheRepo.GetUnits(ctx)
-	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			units, err := u.masterRepo.ListUnits(ctx)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if units == nil {
-				return []*entity.Unit{}, nil
-			}
-			if err := u.redisMasterCacheRepo.SetUnits(ctx, units); err != nil {
-				return nil, errors.WithStack(err)
-			}
-			return units, nil
+	units, err := fillMasterCache(ctx, &u.cacheFiller, listCacheKey(repository.UNIT_REDIS_KEY), u.redisMasterCacheRepo.GetUnits, func(ctx context.Context) ([]*entity.Unit, error) {
+		units, err := u.masterRepo.ListUnits(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if units == nil {
+			units = []*entity.Unit{}
+		}
+		if err := u.redisMasterCacheRepo.SetUnits(ctx, units); err != nil {
+			return nil, errors.WithStack(err)
 		}
+		return units, nil
+	})
+	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
@@ -215,18 +473,19 @@ func (u *masterUsecase) ListUnits(ctx context.Context) ([]*entity.Unit, error) {
 }
 
 func (u *masterUsecase) GetUnitByID(ctx context.Context, id int32) (*entity.Unit, error) {
-	unit, err := u.redisMasterCacheRepo.GetUnitByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			unit, err := u.masterRepo.GetUnitByID(ctx, id)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if err := u.redisMasterCacheRepo.SetUnit(ctx, id, unit); err != nil {
-				return nil, errors.WithStack(err)
-			}
-			return unit, nil
+	unit, err := fillMasterCache(ctx, &u.cacheFiller, idCacheKey(repository.UNIT_REDIS_KEY, id), func(ctx context.Context) (*entity.Unit, error) {
+		return u.redisMasterCacheRepo.GetUnitByID(ctx, id)
+	}, func(ctx context.Context) (*entity.Unit, error) {
+		unit, err := u.masterRepo.GetUnitByID(ctx, id)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
+		if err := u.redisMasterCacheRepo.SetUnit(ctx, id, unit); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return unit, nil
+	})
+	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
@@ -234,8 +493,17 @@ func (u *masterUsecase) GetUnitByID(ctx context.Context, id int32) (*entity.Unit
 }
 
 func (u *masterUsecase) CreateUnit(ctx context.Context, name string) error {
-	_, err := u.masterRepo.CreateUnit(ctx, name)
-	if err != nil {
+	if err := u.checkUnitNameConflict(ctx, name, 0); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		unit, err := u.masterRepo.CreateUnit(ctx, name)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordMasterChanges(ctx, masterChangeLog(entity.MasterKindUnit, unit.ID, entity.MasterChangeOperationInsert))
+	}); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -244,7 +512,7 @@ func (u *masterUsecase) CreateUnit(ctx context.Context, name string) error {
 		return errors.WithStack(err)
 	}
 	if units == nil {
-		return nil
+		units = []*entity.Unit{}
 	}
 	if err := u.redisMasterCacheRepo.SetUnits(ctx, units); err != nil {
 		return errors.WithStack(err)
@@ -253,137 +521,416 @@ func (u *masterUsecase) CreateUnit(ctx context.Context, name string) error {
 	return nil
 }
 
-// VocalPattern
-func (u *masterUsecase) CreateVocalPattern(ctx context.Context, songID int32, name string, singerIDs, singerPositions []int32) error {
-	exist, err := u.masterRepo.ExistsSong(ctx, songID)
+func (u *masterUsecase) UpdateUnit(ctx context.Context, id int32, name string) error {
+	unit, err := u.masterRepo.GetUnitByID(ctx, id)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	if !exist {
-		return errors.WithStack(ErrInvalidArgument)
+	if err := u.checkUnitNameConflict(ctx, name, id); err != nil {
+		return errors.WithStack(err)
 	}
 
-	for _, id := range singerIDs {
-		exist, err := u.masterRepo.ExistsSinger(ctx, id)
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.UpdateUnit(ctx, id, name); err != nil {
+			return errors.WithStack(err)
+		}
+		songLogs, err := u.songChangeLogs(ctx, func(s *entity.Song) bool {
+			return songHasUnit(s, id)
+		})
 		if err != nil {
 			return errors.WithStack(err)
 		}
-		if !exist {
-			return errors.WithStack(ErrInvalidArgument)
+		logs := append(songLogs, masterChangeLog(entity.MasterKindUnit, id, entity.MasterChangeOperationUpdate))
+		// 所属している歌手はユニット名を含むので更新扱いにする
+		for _, member := range unit.Members {
+			logs = append(logs, masterChangeLog(entity.MasterKindSinger, member.ID, entity.MasterChangeOperationUpdate))
 		}
+		return u.recordMasterChanges(ctx, logs...)
+	}); err != nil {
+		return errors.WithStack(err)
 	}
 
-	vp, err := u.masterRepo.CreateVocalPattern(ctx, songID, name)
-	if err != nil {
+	// 所属している歌手とユニットに含まれる曲・譜面のキャッシュも一緒に破棄される
+	if err := u.redisMasterCacheRepo.DeleteUnit(ctx, id); err != nil {
 		return errors.WithStack(err)
 	}
-
-	for i := range len(singerIDs) {
-		_, err := u.masterRepo.CreateVocalPatternSinger(ctx, vp.ID, singerIDs[i], singerPositions[i])
-		if err != nil {
-			return errors.WithStack(err)
-		}
+	singerIDs := make([]int32, len(unit.Members))
+	for i, member := range unit.Members {
+		singerIDs[i] = member.ID
 	}
-
-	songs, err := u.masterRepo.ListSongs(ctx)
-	if err != nil {
+	if err := u.refreshUnitSingerCaches(ctx, []int32{id}, singerIDs); err != nil {
 		return errors.WithStack(err)
 	}
-	if songs == nil {
-		return nil
-	}
-	if err := u.redisMasterCacheRepo.SetSongs(ctx, songs); err != nil {
+	if err := u.refreshSongListCaches(ctx); err != nil {
 		return errors.WithStack(err)
 	}
 
-	charts, err := u.masterRepo.ListCharts(ctx)
+	return nil
+}
+
+// UnitSinger
+func (u *masterUsecase) CreateUnitSinger(ctx context.Context, unitID, singerID, position int32) error {
+	if err := u.checkUnitSinger(ctx, unitID, singerID); err != nil {
+		return errors.WithStack(err)
+	}
+	exist, err := u.masterRepo.ExistsUnitSinger(ctx, unitID, singerID)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	if charts == nil {
-		return nil
+	if exist {
+		return errors.WithStack(ErrAlreadyExists)
 	}
-	if err := u.redisMasterCacheRepo.SetCharts(ctx, charts); err != nil {
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if _, err := u.masterRepo.CreateUnitSinger(ctx, unitID, singerID, position); err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordUnitSingerChange(ctx, unitID, singerID)
+	}); err != nil {
 		return errors.WithStack(err)
 	}
 
-	return nil
+	return u.refreshUnitSingerCaches(ctx, []int32{unitID}, []int32{singerID})
 }
 
-// Song
-func (u *masterUsecase) ListSongs(ctx context.Context) ([]*entity.Song, error) {
-	songs, err := u.redisMasterCacheRepo.GetSongs(ctx)
+func (u *masterUsecase) UpdateUnitSinger(ctx context.Context, unitID, singerID, position int32) error {
+	exist, err := u.masterRepo.ExistsUnitSinger(ctx, unitID, singerID)
 	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			songs, err := u.masterRepo.ListSongs(ctx)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if songs == nil {
-				return []*entity.Song{}, nil
-			}
-			if err := u.redisMasterCacheRepo.SetSongs(ctx, songs); err != nil {
-				return nil, errors.WithStack(err)
-			}
-			return songs, nil
-		}
-		return nil, errors.WithStack(err)
+		return errors.WithStack(err)
+	}
+	if !exist {
+		return errors.WithStack(repository.ErrNotFound)
 	}
 
-	return songs, nil
-}
-
-func (u *masterUsecase) GetSongByID(ctx context.Context, id int32) (*entity.Song, error) {
-	song, err := u.redisMasterCacheRepo.GetSongByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			song, err := u.masterRepo.GetSongByID(ctx, id)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if err := u.redisMasterCacheRepo.SetSong(ctx, id, song); err != nil {
-				return nil, errors.WithStack(err)
-			}
-			return song, nil
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.UpdateUnitSinger(ctx, unitID, singerID, position); err != nil {
+			return errors.WithStack(err)
 		}
-		return nil, errors.WithStack(err)
+		return u.recordUnitSingerChange(ctx, unitID, singerID)
+	}); err != nil {
+		return errors.WithStack(err)
 	}
 
-	return song, nil
+	return u.refreshUnitSingerCaches(ctx, []int32{unitID}, []int32{singerID})
 }
 
-func (u *masterUsecase) CreateSong(
-	ctx context.Context,
-	name, kana string,
-	lyrics_id, music_id, arrangement_id int32,
-	thumbnail, originalVideo string,
-	releaseTime time.Time, deleted bool,
-	unitIDs []int32,
-	musicVideoTypes []enums.MusicVideoType,
-) error {
-	lyricsExist, err := u.masterRepo.ExistsArtist(ctx, lyrics_id)
+func (u *masterUsecase) DeleteUnitSinger(ctx context.Context, unitID, singerID int32) error {
+	exist, err := u.masterRepo.ExistsUnitSinger(ctx, unitID, singerID)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	if !lyricsExist {
-		return errors.WithStack(ErrInvalidArgument)
+	if !exist {
+		return errors.WithStack(repository.ErrNotFound)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.DeleteUnitSinger(ctx, unitID, singerID); err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordUnitSingerChange(ctx, unitID, singerID)
+	}); err != nil {
+		return errors.WithStack(err)
 	}
-	musicExist, err := u.masterRepo.ExistsArtist(ctx, music_id)
+
+	return u.refreshUnitSingerCaches(ctx, []int32{unitID}, []int32{singerID})
+}
+
+func (u *masterUsecase) checkUnitSinger(ctx context.Context, unitID, singerID int32) error {
+	exist, err := u.masterRepo.ExistsUnit(ctx, unitID)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	if !musicExist {
-		return errors.WithStack(ErrInvalidArgument)
+	if !exist {
+		return errors.WithStack(repository.ErrNotFound)
 	}
-	arrangementExist, err := u.masterRepo.ExistsArtist(ctx, arrangement_id)
+	exist, err = u.masterRepo.ExistsSinger(ctx, singerID)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	if !arrangementExist {
-		return errors.WithStack(ErrInvalidArgument)
+	if !exist {
+		return errors.WithStack(repository.ErrNotFound)
 	}
 
-	for _, id := range unitIDs {
+	return nil
+}
+
+// 所属はユニットと歌手の両方に含まれるので、両方の更新を記録する
+func (u *masterUsecase) recordUnitSingerChange(ctx context.Context, unitID, singerID int32) error {
+	return u.recordMasterChanges(ctx,
+		masterChangeLog(entity.MasterKindUnit, unitID, entity.MasterChangeOperationUpdate),
+		masterChangeLog(entity.MasterKindSinger, singerID, entity.MasterChangeOperationUpdate),
+	)
+}
+
+// 指定したユニットと歌手、それぞれの一覧のキャッシュを更新する
+func (u *masterUsecase) refreshUnitSingerCaches(ctx context.Context, unitIDs, singerIDs []int32) error {
+	for _, id := range unitIDs {
+		unit, err := u.masterRepo.GetUnitByID(ctx, id)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.redisMasterCacheRepo.SetUnit(ctx, id, unit); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if len(unitIDs) > 0 {
+		units, err := u.masterRepo.ListUnits(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if units == nil {
+			units = []*entity.Unit{}
+		}
+		if err := u.redisMasterCacheRepo.SetUnits(ctx, units); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	for _, id := range singerIDs {
+		singer, err := u.masterRepo.GetSingerByID(ctx, id)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.redisMasterCacheRepo.SetSinger(ctx, id, singer); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if len(singerIDs) > 0 {
+		singers, err := u.masterRepo.ListSingers(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if singers == nil {
+			singers = []*entity.Singer{}
+		}
+		if err := u.redisMasterCacheRepo.SetSingers(ctx, singers); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// VocalPattern
+func (u *masterUsecase) ListVocalPatterns(ctx context.Context, songID int32) ([]*entity.VocalPattern, error) {
+	vps, err := u.masterRepo.ListVocalPatterns(ctx, songID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if vps == nil {
+		return []*entity.VocalPattern{}, nil
+	}
+
+	return vps, nil
+}
+
+func (u *masterUsecase) GetVocalPatternByID(ctx context.Context, id int32) (*entity.VocalPattern, error) {
+	vp, err := u.masterRepo.GetVocalPatternByID(ctx, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return vp, nil
+}
+
+func (u *masterUsecase) CreateVocalPattern(ctx context.Context, songID int32, name string, singerIDs, singerPositions []int32) error {
+	exist, err := u.masterRepo.ExistsSong(ctx, songID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !exist {
+		return errors.WithStack(ErrInvalidArgument)
+	}
+
+	for _, id := range singerIDs {
+		exist, err := u.masterRepo.ExistsSinger(ctx, id)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !exist {
+			return errors.WithStack(ErrInvalidArgument)
+		}
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		vp, err := u.masterRepo.CreateVocalPattern(ctx, songID, name)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		for i := range len(singerIDs) {
+			_, err := u.masterRepo.CreateVocalPatternSinger(ctx, vp.ID, singerIDs[i], singerPositions[i])
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		// ボーカルパターンは曲に含めて返すので曲の更新として記録する
+		return u.recordSongUpdate(ctx, songID)
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.refreshSongCache(ctx, songID); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (u *masterUsecase) UpdateVocalPattern(ctx context.Context, id int32, name string, singerIDs, singerPositions []int32) error {
+	vp, err := u.masterRepo.GetVocalPatternByID(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, singerID := range singerIDs {
+		exist, err := u.masterRepo.ExistsSinger(ctx, singerID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !exist {
+			return errors.WithStack(ErrInvalidArgument)
+		}
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.UpdateVocalPattern(ctx, id, name); err != nil {
+			return errors.WithStack(err)
+		}
+
+		// 歌手と順番は丸ごと入れ替え
+		if err := u.masterRepo.DeleteVocalPatternSingersByVocalPatternID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		for i := range len(singerIDs) {
+			_, err := u.masterRepo.CreateVocalPatternSinger(ctx, id, singerIDs[i], singerPositions[i])
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		return u.recordSongUpdate(ctx, vp.SongID)
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.refreshSongCache(ctx, vp.SongID); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (u *masterUsecase) DeleteVocalPattern(ctx context.Context, id int32) error {
+	vp, err := u.masterRepo.GetVocalPatternByID(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.DeleteVocalPatternSingersByVocalPatternID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.DeleteVocalPattern(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return u.recordSongUpdate(ctx, vp.SongID)
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.refreshSongCache(ctx, vp.SongID); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// Song
+// キャッシュには削除済みの曲も含めて入れておき、返す時に除く
+// 公開前の曲はincludeUnreleasedでなければ公開済みだけのキャッシュから返す
+func (u *masterUsecase) ListSongs(ctx context.Context, includeDeleted, includeUnreleased bool) ([]*entity.Song, error) {
+	var songs []*entity.Song
+	var err error
+	if includeUnreleased {
+		songs, err = u.listAllSongs(ctx)
+	} else {
+		songs, err = u.listReleasedSongs(ctx)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if includeDeleted {
+		return songs, nil
+	}
+
+	activeSongs := make([]*entity.Song, 0, len(songs))
+	for _, song := range songs {
+		if !song.Deleted {
+			activeSongs = append(activeSongs, song)
+		}
+	}
+
+	return activeSongs, nil
+}
+
+func (u *masterUsecase) listAllSongs(ctx context.Context) ([]*entity.Song, error) {
+	songs, err := fillMasterCache(ctx, &u.cacheFiller, listCacheKey(repository.SONG_REDIS_KEY), u.redisMasterCacheRepo.GetSongs, func(ctx context.Context) ([]*entity.Song, error) {
+		songs, err := u.masterRepo.ListSongs(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if songs == nil {
+			songs = []*entity.Song{}
+		}
+		if err := u.redisMasterCacheRepo.SetSongs(ctx, songs); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return songs, nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return songs, nil
+}
+
+func (u *masterUsecase) GetSongByID(ctx context.Context, id int32) (*entity.Song, error) {
+	song, err := fillMasterCache(ctx, &u.cacheFiller, idCacheKey(repository.SONG_REDIS_KEY, id), func(ctx context.Context) (*entity.Song, error) {
+		return u.redisMasterCacheRepo.GetSongByID(ctx, id)
+	}, func(ctx context.Context) (*entity.Song, error) {
+		song, err := u.masterRepo.GetSongByID(ctx, id)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if err := u.redisMasterCacheRepo.SetSong(ctx, id, song); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return song, nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return song, nil
+}
+
+func (u *masterUsecase) CreateSong(
+	ctx context.Context,
+	name, kana string,
+	credits []*entity.SongCredit,
+	thumbnail, originalVideo string,
+	releaseTime time.Time, deleted bool,
+	unitIDs []int32,
+	musicVideoTypes []enums.MusicVideoType,
+) error {
+	if err := u.checkSongCredits(ctx, credits); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, id := range unitIDs {
 		exist, err := u.masterRepo.ExistsUnit(ctx, id)
 		if err != nil {
 			return errors.WithStack(err)
@@ -393,56 +940,451 @@ func (u *masterUsecase) CreateSong(
 		}
 	}
 
-	s, err := u.masterRepo.CreateSong(ctx, name, kana, lyrics_id, music_id, arrangement_id, thumbnail, originalVideo, releaseTime, deleted)
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		s, err := u.masterRepo.CreateSong(ctx, name, kana, thumbnail, originalVideo, releaseTime, deleted)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if err := u.createSongCredits(ctx, s.ID, credits); err != nil {
+			return errors.WithStack(err)
+		}
+
+		for _, unitID := range unitIDs {
+			_, err := u.masterRepo.CreateSongUnit(ctx, s.ID, unitID)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		for _, musicVideoType := range musicVideoTypes {
+			_, err := u.masterRepo.CreateSongMusicVideoType(ctx, s.ID, musicVideoType)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		return u.recordMasterChanges(ctx, masterChangeLog(entity.MasterKindSong, s.ID, entity.MasterChangeOperationInsert))
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	songs, err := u.masterRepo.ListSongs(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if songs == nil {
+		songs = []*entity.Song{}
+	}
+	if err := u.redisMasterCacheRepo.SetSongs(ctx, songs); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (u *masterUsecase) UpdateSong(
+	ctx context.Context,
+	id int32,
+	name, kana string,
+	credits []*entity.SongCredit,
+	thumbnail, originalVideo string,
+	releaseTime time.Time, deleted bool,
+	unitIDs []int32,
+	musicVideoTypes []enums.MusicVideoType,
+) error {
+	exist, err := u.masterRepo.ExistsSong(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !exist {
+		return errors.WithStack(repository.ErrNotFound)
+	}
+
+	if err := u.checkSongCredits(ctx, credits); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, unitID := range unitIDs {
+		exist, err := u.masterRepo.ExistsUnit(ctx, unitID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !exist {
+			return errors.WithStack(ErrInvalidArgument)
+		}
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.UpdateSong(ctx, id, name, kana, thumbnail, originalVideo, releaseTime, deleted); err != nil {
+			return errors.WithStack(err)
+		}
+
+		// クレジット、ユニット、MVの種類は入れ替え
+		if err := u.masterRepo.DeleteSongCreditsBySongID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.createSongCredits(ctx, id, credits); err != nil {
+			return errors.WithStack(err)
+		}
+
+		if err := u.masterRepo.DeleteSongUnitsBySongID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		for _, unitID := range unitIDs {
+			_, err := u.masterRepo.CreateSongUnit(ctx, id, unitID)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		if err := u.masterRepo.DeleteSongMusicVideoTypesBySongID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		for _, musicVideoType := range musicVideoTypes {
+			_, err := u.masterRepo.CreateSongMusicVideoType(ctx, id, musicVideoType)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		return u.recordSongUpdate(ctx, id)
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.refreshSongCache(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// 作詞・作曲・編曲がそれぞれ1人以上いて、同じ役割に同じアーティストが重複していないことを確認する
+func (u *masterUsecase) checkSongCredits(ctx context.Context, credits []*entity.SongCredit) error {
+	type creditKey struct {
+		role     enums.CreditRole
+		artistID int32
+	}
+	seen := map[creditKey]bool{}
+	roleCounts := map[enums.CreditRole]int{}
+	for _, c := range credits {
+		switch c.Role {
+		case enums.CreditRole_CREDIT_ROLE_LYRICS, enums.CreditRole_CREDIT_ROLE_MUSIC, enums.CreditRole_CREDIT_ROLE_ARRANGEMENT:
+		default:
+			return errors.WithStack(ErrInvalidArgument)
+		}
+		key := creditKey{role: c.Role, artistID: c.Artist.ID}
+		if seen[key] {
+			return errors.WithStack(ErrInvalidArgument)
+		}
+		seen[key] = true
+		roleCounts[c.Role]++
+
+		exist, err := u.masterRepo.ExistsArtist(ctx, c.Artist.ID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !exist {
+			return errors.WithStack(ErrInvalidArgument)
+		}
+	}
+	if roleCounts[enums.CreditRole_CREDIT_ROLE_LYRICS] == 0 || roleCounts[enums.CreditRole_CREDIT_ROLE_MUSIC] == 0 || roleCounts[enums.CreditRole_CREDIT_ROLE_ARRANGEMENT] == 0 {
+		return errors.WithStack(ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// 同じ役割の中で並んでいる順にPositionを振って追加する
+func (u *masterUsecase) createSongCredits(ctx context.Context, songID int32, credits []*entity.SongCredit) error {
+	positions := map[enums.CreditRole]int32{}
+	for _, c := range credits {
+		positions[c.Role]++
+		if _, err := u.masterRepo.CreateSongCredit(ctx, songID, c.Artist.ID, c.Role, positions[c.Role]); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+func (u *masterUsecase) DeleteSong(ctx context.Context, id int32) error {
+	exist, err := u.masterRepo.ExistsSong(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !exist {
+		return errors.WithStack(repository.ErrNotFound)
+	}
+
+	// マイリストに登録されている譜面がある場合は削除できない
+	used, err := u.masterRepo.ExistsMyListChartBySongID(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if used {
+		return errors.WithStack(ErrMasterInUse)
+	}
+
+	chartIDs, err := u.masterRepo.ListChartIDsBySongID(ctx, id)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
-	for _, unitID := range unitIDs {
-		_, err := u.masterRepo.CreateSongUnit(ctx, s.ID, unitID)
-		if err != nil {
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.DeleteVocalPatternSingersBySongID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.DeleteVocalPatternsBySongID(ctx, id); err != nil {
 			return errors.WithStack(err)
 		}
+		if err := u.masterRepo.DeleteSongUnitsBySongID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.DeleteSongMusicVideoTypesBySongID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.DeleteSongCreditsBySongID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.DeleteChartLevelHistoryBySongID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.DeleteChartsBySongID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.DeleteSong(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+
+		logs := []*entity.MasterChangeLog{masterChangeLog(entity.MasterKindSong, id, entity.MasterChangeOperationDelete)}
+		for _, chartID := range chartIDs {
+			logs = append(logs, masterChangeLog(entity.MasterKindChart, chartID, entity.MasterChangeOperationDelete))
+		}
+		return u.recordMasterChanges(ctx, logs...)
+	}); err != nil {
+		return errors.WithStack(err)
 	}
 
-	for _, musicVideoType := range musicVideoTypes {
-		_, err := u.masterRepo.CreateSongMusicVideoType(ctx, s.ID, musicVideoType)
-		if err != nil {
+	if err := u.redisMasterCacheRepo.DeleteSong(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, chartID := range chartIDs {
+		if err := u.redisMasterCacheRepo.DeleteChart(ctx, chartID); err != nil {
 			return errors.WithStack(err)
 		}
 	}
+	if err := u.refreshSongListCaches(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (u *masterUsecase) SearchSongs(ctx context.Context, cond repository.SongSearchCondition, pageSize int32, pageToken string) ([]*entity.Song, string, error) {
+	// 検索用カラムと同じ正規化をかけてから比較する
+	cond.Query = normalize.String(cond.Query)
+	afterID, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", errors.WithStack(err)
+	}
+	limit := normalizePageSize(pageSize)
+
+	// 次のページの有無を知るために1件多く取得する
+	ids, err := u.masterRepo.SearchSongIDs(ctx, cond, afterID, limit+1)
+	if err != nil {
+		return nil, "", errors.WithStack(err)
+	}
+	nextPageToken := ""
+	if len(ids) > int(limit) {
+		ids = ids[:limit]
+		nextPageToken = encodePageToken(ids[len(ids)-1])
+	}
+
+	songs, err := u.masterRepo.ListSongsByIDs(ctx, ids)
+	if err != nil {
+		return nil, "", errors.WithStack(err)
+	}
+
+	return songs, nextPageToken, nil
+}
+
+func (u *masterUsecase) BackfillSearchText(ctx context.Context, onlyMissing bool) (int, int, error) {
+	artistCount, songCount, err := u.masterRepo.BackfillSearchText(ctx, onlyMissing)
+	if err != nil {
+		return 0, 0, errors.WithStack(err)
+	}
+
+	return artistCount, songCount, nil
+}
+
+// 曲の個別キャッシュとそれを含む譜面のキャッシュを破棄し、一覧を作り直す
+func (u *masterUsecase) refreshSongCache(ctx context.Context, id int32) error {
+	if err := u.redisMasterCacheRepo.DeleteSong(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	return u.refreshSongListCaches(ctx)
+}
 
+// 曲一覧・譜面一覧のキャッシュを作り直す
+func (u *masterUsecase) refreshSongListCaches(ctx context.Context) error {
 	songs, err := u.masterRepo.ListSongs(ctx)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	if songs == nil {
-		return nil
+		songs = []*entity.Song{}
 	}
 	if err := u.redisMasterCacheRepo.SetSongs(ctx, songs); err != nil {
 		return errors.WithStack(err)
 	}
 
-	return nil
+	return u.refreshChartsCache(ctx)
 }
 
 // Chart
-func (u *masterUsecase) ListCharts(ctx context.Context) ([]*entity.Chart, error) {
-	charts, err := u.redisMasterCacheRepo.GetCharts(ctx)
+// 削除済みの曲・公開前の曲の譜面は曲と同じく除く
+// 絞り込みと並べ替えはキャッシュした全件に対して行う
+func (u *masterUsecase) ListCharts(ctx context.Context, cond ChartListCondition) ([]*entity.Chart, error) {
+	if outOfOrder(cond.MinNoteCount, cond.MaxNoteCount) ||
+		outOfOrder(cond.MinBPM, cond.MaxBPM) ||
+		outOfOrder(cond.MinDurationSeconds, cond.MaxDurationSeconds) ||
+		outOfOrder(cond.MinConstant, cond.MaxConstant) {
+		return nil, errors.WithStack(ErrInvalidArgument)
+	}
+
+	var charts []*entity.Chart
+	var err error
+	if cond.IncludeUnreleased {
+		charts, err = u.listAllCharts(ctx)
+	} else {
+		charts, err = u.listReleasedCharts(ctx)
+	}
 	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			charts, err := u.masterRepo.ListCharts(ctx)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if charts == nil {
-				return []*entity.Chart{}, nil
-			}
-			if err := u.redisMasterCacheRepo.SetCharts(ctx, charts); err != nil {
-				return nil, errors.WithStack(err)
+		return nil, errors.WithStack(err)
+	}
+
+	filtered := make([]*entity.Chart, 0, len(charts))
+	for _, chart := range charts {
+		if chart.Song.Deleted && !cond.IncludeDeleted {
+			continue
+		}
+		if !matchChartListCondition(chart.Metadata, cond) {
+			continue
+		}
+		filtered = append(filtered, chart)
+	}
+	sortCharts(filtered, cond.SortKey, cond.Descending)
+
+	return filtered, nil
+}
+
+// 両方指定されていて最小が最大を超えている
+func outOfOrder[T int32 | float64](minValue, maxValue T) bool {
+	return minValue != 0 && maxValue != 0 && minValue > maxValue
+}
+
+// 範囲を指定した項目が未設定の譜面は除く
+func inRange[T int32 | float64](v, minValue, maxValue T) bool {
+	if minValue == 0 && maxValue == 0 {
+		return true
+	}
+	if v == 0 {
+		return false
+	}
+	return (minValue == 0 || v >= minValue) && (maxValue == 0 || v <= maxValue)
+}
+
+func matchChartListCondition(m entity.ChartMetadata, cond ChartListCondition) bool {
+	if !inRange(m.NoteCount, cond.MinNoteCount, cond.MaxNoteCount) ||
+		!inRange(m.DurationSeconds, cond.MinDurationSeconds, cond.MaxDurationSeconds) ||
+		!inRange(m.Constant, cond.MinConstant, cond.MaxConstant) {
+		return false
+	}
+	// BPMは範囲が重なれば含める
+	if cond.MinBPM != 0 || cond.MaxBPM != 0 {
+		if m.BPMMax == 0 {
+			return false
+		}
+		if cond.MinBPM != 0 && m.BPMMax < cond.MinBPM {
+			return false
+		}
+		if cond.MaxBPM != 0 && m.BPMMin > cond.MaxBPM {
+			return false
+		}
+	}
+	return true
+}
+
+// 並べる項目が同じ譜面はID順 未設定の譜面は昇順・降順とも最後にする
+func sortCharts(charts []*entity.Chart, key enums.ChartSortKey, descending bool) {
+	value := func(c *entity.Chart) float64 {
+		switch key {
+		case enums.ChartSortKey_CHART_SORT_KEY_LEVEL:
+			return float64(c.Level)
+		case enums.ChartSortKey_CHART_SORT_KEY_NOTE_COUNT:
+			return float64(c.Metadata.NoteCount)
+		case enums.ChartSortKey_CHART_SORT_KEY_BPM:
+			return float64(c.Metadata.BPMMax)
+		case enums.ChartSortKey_CHART_SORT_KEY_DURATION:
+			return float64(c.Metadata.DurationSeconds)
+		case enums.ChartSortKey_CHART_SORT_KEY_CONSTANT:
+			return c.Metadata.Constant
+		}
+		return 0
+	}
+
+	slices.SortStableFunc(charts, func(a, b *entity.Chart) int {
+		va, vb := value(a), value(b)
+		if va != vb {
+			switch {
+			case va == 0:
+				return 1
+			case vb == 0:
+				return -1
+			case descending:
+				return cmp.Compare(vb, va)
+			default:
+				return cmp.Compare(va, vb)
 			}
-			return charts, nil
 		}
+		if descending && key == enums.ChartSortKey_CHART_SORT_KEY_UNSPECIFIED {
+			return cmp.Compare(b.ID, a.ID)
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+}
+
+// BPMが片方だけの場合は一定とみなしてもう片方にも入れる
+func normalizeChartMetadata(m entity.ChartMetadata) (entity.ChartMetadata, error) {
+	if m.BPMMin == 0 {
+		m.BPMMin = m.BPMMax
+	}
+	if m.BPMMax == 0 {
+		m.BPMMax = m.BPMMin
+	}
+	if m.BPMMin > m.BPMMax {
+		return m, errors.WithStack(ErrInvalidArgument)
+	}
+	return m, nil
+}
+
+func (u *masterUsecase) listAllCharts(ctx context.Context) ([]*entity.Chart, error) {
+	charts, err := fillMasterCache(ctx, &u.cacheFiller, listCacheKey(repository.CHART_REDIS_KEY), u.redisMasterCacheRepo.GetCharts, func(ctx context.Context) ([]*entity.Chart, error) {
+		charts, err := u.masterRepo.ListCharts(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if charts == nil {
+			charts = []*entity.Chart{}
+		}
+		if err := u.redisMasterCacheRepo.SetCharts(ctx, charts); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return charts, nil
+	})
+	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
@@ -450,18 +1392,19 @@ func (u *masterUsecase) ListCharts(ctx context.Context) ([]*entity.Chart, error)
 }
 
 func (u *masterUsecase) GetChartByID(ctx context.Context, id int32) (*entity.Chart, error) {
-	chart, err := u.redisMasterCacheRepo.GetChartByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, redis.Nil) {
-			chart, err := u.masterRepo.GetChartByID(ctx, id)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if err := u.redisMasterCacheRepo.SetChart(ctx, id, chart); err != nil {
-				return nil, errors.WithStack(err)
-			}
-			return chart, nil
+	chart, err := fillMasterCache(ctx, &u.cacheFiller, idCacheKey(repository.CHART_REDIS_KEY, id), func(ctx context.Context) (*entity.Chart, error) {
+		return u.redisMasterCacheRepo.GetChartByID(ctx, id)
+	}, func(ctx context.Context) (*entity.Chart, error) {
+		chart, err := u.masterRepo.GetChartByID(ctx, id)
+		if err != nil {
+			return nil, errors.WithStack(err)
 		}
+		if err := u.redisMasterCacheRepo.SetChart(ctx, id, chart); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return chart, nil
+	})
+	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
@@ -472,25 +1415,245 @@ func (u *masterUsecase) CreateChart(
 	ctx context.Context,
 	songID, difficultyType, level int32,
 	chartViewLink string,
+	metadata entity.ChartMetadata,
 ) error {
-	exist, err := u.masterRepo.ExistsSong(ctx, songID)
+	metadata, err := normalizeChartMetadata(metadata)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	if !exist {
+
+	song, err := u.masterRepo.GetSongByID(ctx, songID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return errors.WithStack(ErrInvalidArgument)
+		}
+		return errors.WithStack(err)
+	}
+	if err := u.checkChartConflict(ctx, song, difficultyType, 0); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		chart, err := u.masterRepo.CreateChart(ctx, songID, difficultyType, level, chartViewLink, metadata)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.UpsertChartLevelHistory(ctx, chart.ID, level, initialLevelEffectiveFrom(song.ReleaseTime, time.Now())); err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordMasterChanges(ctx, masterChangeLog(entity.MasterKindChart, chart.ID, entity.MasterChangeOperationInsert))
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	charts, err := u.masterRepo.ListCharts(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if charts == nil {
+		charts = []*entity.Chart{}
+	}
+	if err := u.redisMasterCacheRepo.SetCharts(ctx, charts); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (u *masterUsecase) UpdateChart(
+	ctx context.Context,
+	id, songID, difficultyType, level int32,
+	chartViewLink string,
+	metadata entity.ChartMetadata,
+	levelEffectiveFrom time.Time,
+) error {
+	metadata, err := normalizeChartMetadata(metadata)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// 未来の日時を入れるとcharts.levelと最新の履歴が食い違うので受け付けない
+	now := time.Now()
+	if levelEffectiveFrom.After(now) {
 		return errors.WithStack(ErrInvalidArgument)
 	}
 
-	if _, err := u.masterRepo.CreateChart(ctx, songID, difficultyType, level, chartViewLink); err != nil {
+	cur, err := u.masterRepo.GetChartByID(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	song, err := u.masterRepo.GetSongByID(ctx, songID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return errors.WithStack(ErrInvalidArgument)
+		}
+		return errors.WithStack(err)
+	}
+	if err := u.checkChartConflict(ctx, song, difficultyType, id); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		// レベルが変わった場合か適用日時を指定した場合は履歴に残す
+		// 過去の日時を指定した場合は、それより後の履歴のレベルが今のレベルになる
+		if level != cur.Level || !levelEffectiveFrom.IsZero() {
+			effectiveFrom := levelEffectiveFrom
+			if effectiveFrom.IsZero() {
+				effectiveFrom = now
+			}
+			if err := u.masterRepo.UpsertChartLevelHistory(ctx, id, level, effectiveFrom); err != nil {
+				return errors.WithStack(err)
+			}
+			current, err := u.masterRepo.GetChartLevelAsOf(ctx, id, now)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			level = current
+		}
+		if err := u.masterRepo.UpdateChart(ctx, id, songID, difficultyType, level, chartViewLink, metadata); err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordMasterChanges(ctx, masterChangeLog(entity.MasterKindChart, id, entity.MasterChangeOperationUpdate))
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.redisMasterCacheRepo.DeleteChart(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := u.refreshChartsCache(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (u *masterUsecase) DeleteChart(ctx context.Context, id int32) error {
+	exist, err := u.masterRepo.ExistsChart(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !exist {
+		return errors.WithStack(repository.ErrNotFound)
+	}
+
+	// マイリストに登録されている場合は削除できない
+	used, err := u.masterRepo.ExistsMyListChartByChartID(ctx, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if used {
+		return errors.WithStack(ErrMasterInUse)
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		if err := u.masterRepo.DeleteChartLevelHistoryByChartID(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.DeleteChart(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordMasterChanges(ctx, masterChangeLog(entity.MasterKindChart, id, entity.MasterChangeOperationDelete))
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.redisMasterCacheRepo.DeleteChart(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := u.refreshChartsCache(ctx); err != nil {
 		return errors.WithStack(err)
 	}
 
+	return nil
+}
+
+func (u *masterUsecase) SearchCharts(ctx context.Context, cond repository.ChartSearchCondition, pageSize int32, pageToken string) ([]*entity.Chart, string, int64, error) {
+	if cond.MinLevel != 0 && cond.MaxLevel != 0 && cond.MinLevel > cond.MaxLevel {
+		return nil, "", 0, errors.WithStack(ErrInvalidArgument)
+	}
+	// 検索用カラムと同じ正規化をかけてから比較する
+	cond.Query = normalize.String(cond.Query)
+	afterID, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", 0, errors.WithStack(err)
+	}
+	limit := normalizePageSize(pageSize)
+
+	totalCount, err := u.masterRepo.CountSearchCharts(ctx, cond)
+	if err != nil {
+		return nil, "", 0, errors.WithStack(err)
+	}
+
+	// 次のページの有無を知るために1件多く取得する
+	ids, err := u.masterRepo.SearchChartIDs(ctx, cond, afterID, limit+1)
+	if err != nil {
+		return nil, "", 0, errors.WithStack(err)
+	}
+	nextPageToken := ""
+	if len(ids) > int(limit) {
+		ids = ids[:limit]
+		nextPageToken = encodePageToken(ids[len(ids)-1])
+	}
+
+	charts, err := u.masterRepo.ListChartsByIDs(ctx, ids)
+	if err != nil {
+		return nil, "", 0, errors.WithStack(err)
+	}
+
+	return charts, nextPageToken, totalCount, nil
+}
+
+func (u *masterUsecase) GetChartByIDAsOf(ctx context.Context, id int32, asOf time.Time) (*entity.Chart, error) {
+	chart, err := u.GetChartByID(ctx, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// まだレベルが付いていない時点ならErrNotFound
+	level, err := u.masterRepo.GetChartLevelAsOf(ctx, id, asOf)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// キャッシュの中身を書き換えないようにコピーする
+	c := *chart
+	c.Level = level
+
+	return &c, nil
+}
+
+func (u *masterUsecase) ListChartLevelHistory(ctx context.Context, chartID int32) ([]*entity.ChartLevelHistory, error) {
+	exist, err := u.masterRepo.ExistsChart(ctx, chartID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !exist {
+		return nil, errors.WithStack(repository.ErrNotFound)
+	}
+
+	histories, err := u.masterRepo.ListChartLevelHistory(ctx, chartID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return histories, nil
+}
+
+// 最初のレベルは曲の公開日時から適用する 公開前の曲は作成した日時から
+func initialLevelEffectiveFrom(releaseTime, now time.Time) time.Time {
+	if releaseTime.IsZero() || releaseTime.After(now) {
+		return now
+	}
+	return releaseTime
+}
+
+func (u *masterUsecase) refreshChartsCache(ctx context.Context) error {
 	charts, err := u.masterRepo.ListCharts(ctx)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 	if charts == nil {
-		return nil
+		charts = []*entity.Chart{}
 	}
 	if err := u.redisMasterCacheRepo.SetCharts(ctx, charts); err != nil {
 		return errors.WithStack(err)
@@ -498,3 +1661,34 @@ func (u *masterUsecase) CreateChart(
 
 	return nil
 }
+
+// Pagination
+func normalizePageSize(pageSize int32) int32 {
+	if pageSize <= 0 {
+		return defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		return maxPageSize
+	}
+	return pageSize
+}
+
+// ページトークンは前のページの最後のIDをエンコードしたもの
+func encodePageToken(id int32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(int(id))))
+}
+
+func decodePageToken(token string) (int32, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.WithStack(ErrInvalidArgument)
+	}
+	id, err := strconv.ParseInt(string(b), 10, 32)
+	if err != nil || id < 0 {
+		return 0, errors.WithStack(ErrInvalidArgument)
+	}
+	return int32(id), nil
+}
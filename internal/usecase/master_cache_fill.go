@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/cockroachdb/errors"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// 埋める処理がロックを持ち続ける上限 過ぎたら他のサーバーも埋めに行く
+	masterCacheFillLockTTL = 10 * time.Second
+	// 他のサーバーが埋めるのを待つ間、キャッシュとロックを見に行く間隔
+	masterCacheFillPollInterval = 100 * time.Millisecond
+)
+
+// キャッシュに無い時にDBから取ってキャッシュに入れる処理が、同時にいくつも走らないようにする
+// 同じサーバーの中では同じキーの処理をsingleflightで1つにまとめる
+// lockRepoがあれば、Redisのロックを取れたサーバーだけが埋め、他のサーバーはキャッシュに入るのを待つ
+type masterCacheFiller struct {
+	group    singleflight.Group
+	lockRepo repository.RedisLockRepository
+}
+
+func listCacheKey(kind string) string {
+	return kind + ":all"
+}
+
+func releasedCacheKey(kind string) string {
+	return kind + ":released"
+}
+
+func idCacheKey(kind string, id int32) string {
+	return kind + ":" + strconv.Itoa(int(id))
+}
+
+// getでキャッシュから取り、無ければfillでDBから取ってキャッシュに入れる
+// 同時に呼ばれた場合は最初の1つの結果を共有するので、返した値は書き換えない
+func fillMasterCache[T any](ctx context.Context, f *masterCacheFiller, key string, get, fill func(context.Context) (T, error)) (T, error) {
+	var zero T
+	v, err := get(ctx)
+	if err == nil {
+		return v, nil
+	}
+	if !errors.Is(err, redis.Nil) {
+		return zero, errors.WithStack(err)
+	}
+
+	res, err, _ := f.group.Do(key, func() (any, error) {
+		// 待っている他のリクエストもあるので、最初のリクエストがキャンセルされても続ける
+		ctx := context.WithoutCancel(ctx)
+
+		// 前の処理が埋め終わった直後に来た場合は、もう入っている
+		v, err := get(ctx)
+		if err == nil {
+			return v, nil
+		}
+		if !errors.Is(err, redis.Nil) {
+			return nil, errors.WithStack(err)
+		}
+		// Redisが使えない時はロックも取れないのでそのままDBから取る
+		if f.lockRepo == nil || errors.Is(err, repository.ErrMasterCacheUnavailable) {
+			return fill(ctx)
+		}
+		return fillMasterCacheWithLock(ctx, f.lockRepo, key, get, fill)
+	})
+	if err != nil {
+		return zero, errors.WithStack(err)
+	}
+
+	return res.(T), nil
+}
+
+// ロックを取れたら埋める 取れなければ他のサーバーが埋めるのを待つ
+// ロックの期限が過ぎた場合は、ロックを取り直して自分で埋める
+// ロックを持ったサーバーが落ちて取り直しも続けて失敗する場合に待ち続けないよう、ロック1つ分の期限を過ぎたらロック無しでDBから取る
+func fillMasterCacheWithLock[T any](ctx context.Context, lockRepo repository.RedisLockRepository, key string, get, fill func(context.Context) (T, error)) (T, error) {
+	var zero T
+	deadline := time.Now().Add(masterCacheFillLockTTL)
+	ticker := time.NewTicker(masterCacheFillPollInterval)
+	defer ticker.Stop()
+	for {
+		token, ok, err := lockRepo.TryLock(ctx, key, masterCacheFillLockTTL)
+		if err != nil {
+			log.Printf("%+v\n", errors.WithStack(err))
+			return fill(ctx)
+		}
+		if ok {
+			defer func() {
+				if err := lockRepo.Unlock(ctx, key, token); err != nil {
+					log.Printf("%+v\n", errors.WithStack(err))
+				}
+			}()
+			// 待っている間に他のサーバーが埋め終わっているかもしれない
+			if v, err := get(ctx); err == nil {
+				return v, nil
+			}
+			return fill(ctx)
+		}
+
+		<-ticker.C
+		v, err := get(ctx)
+		if err == nil {
+			return v, nil
+		}
+		if !errors.Is(err, redis.Nil) {
+			return zero, errors.WithStack(err)
+		}
+		if errors.Is(err, repository.ErrMasterCacheUnavailable) {
+			return fill(ctx)
+		}
+		if time.Now().After(deadline) {
+			log.Printf("master cache: gave up waiting for %s to be filled, reading from database\n", key)
+			return fill(ctx)
+		}
+	}
+}
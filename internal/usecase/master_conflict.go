@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/gen/enums"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/masterbundle"
+	"github.com/cockroachdb/errors"
+)
+
+// 一意制約に反する場合のエラー 衝突した既存のマスタを持つ
+// errors.Is(err, ErrAlreadyExists)で判定できる
+type AlreadyExistsError struct {
+	// entity.MasterKind*
+	Kind string
+	ID   int32
+	Name string
+}
+
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("%s %q (id=%d) already exists", e.Kind, e.Name, e.ID)
+}
+
+func (e *AlreadyExistsError) Is(target error) bool {
+	return target == ErrAlreadyExists
+}
+
+// 正規化した名前が同じアーティストがid以外にあればAlreadyExistsErrorを返す
+// 作成時はidに0を渡す
+func (u *masterUsecase) checkArtistNameConflict(ctx context.Context, name string, id int32) error {
+	artist, err := u.masterRepo.FindArtistByNameKey(ctx, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+	if artist.ID == id {
+		return nil
+	}
+
+	return errors.WithStack(&AlreadyExistsError{Kind: entity.MasterKindArtist, ID: artist.ID, Name: artist.Name})
+}
+
+func (u *masterUsecase) checkSingerNameConflict(ctx context.Context, name string, id int32) error {
+	singer, err := u.masterRepo.FindSingerByNameKey(ctx, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+	if singer.ID == id {
+		return nil
+	}
+
+	return errors.WithStack(&AlreadyExistsError{Kind: entity.MasterKindSinger, ID: singer.ID, Name: singer.Name})
+}
+
+func (u *masterUsecase) checkUnitNameConflict(ctx context.Context, name string, id int32) error {
+	unit, err := u.masterRepo.FindUnitByNameKey(ctx, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+	if unit.ID == id {
+		return nil
+	}
+
+	return errors.WithStack(&AlreadyExistsError{Kind: entity.MasterKindUnit, ID: unit.ID, Name: unit.Name})
+}
+
+// 同じ曲・同じ難易度の譜面がid以外にあればAlreadyExistsErrorを返す
+// 譜面の名前は取り込みのキーと同じく"曲名/難易度"にする
+func (u *masterUsecase) checkChartConflict(ctx context.Context, song *entity.Song, difficultyType, id int32) error {
+	chartID, err := u.masterRepo.FindChartIDBySongAndDifficulty(ctx, song.ID, difficultyType)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+	if chartID == id {
+		return nil
+	}
+
+	name := fmt.Sprintf("%s/%s", song.Name, masterbundle.FormatDifficultyType(enums.DifficultyType(difficultyType)))
+	return errors.WithStack(&AlreadyExistsError{Kind: entity.MasterKindChart, ID: chartID, Name: name})
+}
+
+// sourceの作詞・作曲・編曲をtargetに付け替えて、sourceを削除する
+func (u *masterUsecase) MergeArtists(ctx context.Context, sourceID, targetID int32) error {
+	if sourceID == targetID {
+		return errors.WithStack(ErrInvalidArgument)
+	}
+	for _, id := range []int32{sourceID, targetID} {
+		exist, err := u.masterRepo.ExistsArtist(ctx, id)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !exist {
+			return errors.WithStack(repository.ErrNotFound)
+		}
+	}
+
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		// 付け替える前に、sourceを含む曲と譜面を更新扱いにしておく
+		songLogs, err := u.songChangeLogs(ctx, func(s *entity.Song) bool {
+			return songHasArtist(s, sourceID)
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.MergeSongCredits(ctx, sourceID, targetID); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.masterRepo.DeleteArtist(ctx, sourceID); err != nil {
+			return errors.WithStack(err)
+		}
+		return u.recordMasterChanges(ctx, append(songLogs, masterChangeLog(entity.MasterKindArtist, sourceID, entity.MasterChangeOperationDelete))...)
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// sourceを含んでいた曲と譜面のキャッシュも一緒に破棄される
+	if err := u.redisMasterCacheRepo.DeleteArtist(ctx, sourceID); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := u.refreshArtistsCache(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := u.refreshSongListCaches(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/gen/enums"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/masterbundle"
+	"github.com/cockroachdb/errors"
+)
+
+// 全てのマスタをバンドルにまとめる
+// 並び順はID順なので、同じDBからは同じ内容が得られる
+func (u *masterUsecase) ExportMaster(ctx context.Context) (*masterbundle.Bundle, error) {
+	var (
+		artists       []*entity.Artist
+		singers       []*entity.Singer
+		units         []*entity.Unit
+		songs         []*entity.Song
+		vocalPatterns []*entity.VocalPattern
+		charts        []*entity.Chart
+	)
+	// 途中で更新されても食い違わないように1トランザクションで読む
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		if artists, err = u.masterRepo.ListArtists(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if singers, err = u.masterRepo.ListSingers(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if units, err = u.masterRepo.ListUnits(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if songs, err = u.masterRepo.ListSongs(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		if vocalPatterns, err = u.masterRepo.ListVocalPatterns(ctx, 0); err != nil {
+			return errors.WithStack(err)
+		}
+		if charts, err = u.masterRepo.ListCharts(ctx); err != nil {
+			return errors.WithStack(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	b := &masterbundle.Bundle{
+		Artists:       make([]*masterbundle.Artist, 0, len(artists)),
+		Singers:       make([]*masterbundle.Singer, 0, len(singers)),
+		Units:         make([]*masterbundle.Unit, 0, len(units)),
+		Songs:         make([]*masterbundle.Song, 0, len(songs)),
+		VocalPatterns: make([]*masterbundle.VocalPattern, 0, len(vocalPatterns)),
+		Charts:        make([]*masterbundle.Chart, 0, len(charts)),
+	}
+	for _, a := range artists {
+		b.Artists = append(b.Artists, &masterbundle.Artist{Name: a.Name, Kana: a.Kana})
+	}
+	for _, s := range singers {
+		b.Singers = append(b.Singers, &masterbundle.Singer{Name: s.Name})
+	}
+	for _, un := range units {
+		members := make([]string, len(un.Members))
+		for i, member := range un.Members {
+			members[i] = member.Name
+		}
+		b.Units = append(b.Units, &masterbundle.Unit{Name: un.Name, Members: members})
+	}
+
+	songNames := make(map[int32]string, len(songs))
+	for _, s := range songs {
+		songNames[s.ID] = s.Name
+		unitNames := make([]string, 0, len(s.Units))
+		for _, un := range s.Units {
+			unitNames = append(unitNames, un.Name)
+		}
+		musicVideoTypes := make([]string, 0, len(s.MusicVideoTypes))
+		for _, m := range s.MusicVideoTypes {
+			musicVideoTypes = append(musicVideoTypes, masterbundle.FormatMusicVideoType(m))
+		}
+		credits := map[enums.CreditRole]masterbundle.Names{}
+		for _, c := range s.Credits {
+			credits[c.Role] = append(credits[c.Role], c.Artist.Name)
+		}
+		b.Songs = append(b.Songs, &masterbundle.Song{
+			Name:            s.Name,
+			Kana:            s.Kana,
+			Lyrics:          credits[enums.CreditRole_CREDIT_ROLE_LYRICS],
+			Music:           credits[enums.CreditRole_CREDIT_ROLE_MUSIC],
+			Arrangement:     credits[enums.CreditRole_CREDIT_ROLE_ARRANGEMENT],
+			Thumbnail:       s.Thumbnail,
+			OriginalVideo:   s.OriginalVideo,
+			ReleaseTime:     s.ReleaseTime,
+			Deleted:         s.Deleted,
+			Units:           unitNames,
+			MusicVideoTypes: musicVideoTypes,
+		})
+	}
+
+	for _, vp := range vocalPatterns {
+		singers := slices.Clone(vp.Singers)
+		sort.SliceStable(singers, func(i, j int) bool {
+			return singers[i].Position < singers[j].Position
+		})
+		singerNames := make([]string, 0, len(singers))
+		for _, s := range singers {
+			singerNames = append(singerNames, s.Name)
+		}
+		b.VocalPatterns = append(b.VocalPatterns, &masterbundle.VocalPattern{
+			Song:    songNames[vp.SongID],
+			Name:    vp.Name,
+			Singers: singerNames,
+		})
+	}
+
+	for _, c := range charts {
+		b.Charts = append(b.Charts, &masterbundle.Chart{
+			Song:            c.Song.Name,
+			DifficultyType:  masterbundle.FormatDifficultyType(c.DifficultyType),
+			Level:           c.Level,
+			ChartViewLink:   c.ChartViewLink,
+			NoteCount:       c.Metadata.NoteCount,
+			BPMMin:          c.Metadata.BPMMin,
+			BPMMax:          c.Metadata.BPMMax,
+			DurationSeconds: c.Metadata.DurationSeconds,
+			Constant:        c.Metadata.Constant,
+		})
+	}
+
+	return b, nil
+}
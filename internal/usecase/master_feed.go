@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/cockroachdb/errors"
+)
+
+func (u *masterUsecase) ListRecentAdditions(ctx context.Context, limit int) ([]*entity.MasterAddition, error) {
+	songs, err := u.ListSongs(ctx, false, false)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	charts, err := u.ListCharts(ctx, ChartListCondition{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	additions := make([]*entity.MasterAddition, 0, len(songs)+len(charts))
+	for _, song := range songs {
+		additions = append(additions, &entity.MasterAddition{
+			Kind:      entity.MasterKindSong,
+			Song:      song,
+			CreatedAt: song.CreatedAt,
+		})
+	}
+	for _, chart := range charts {
+		additions = append(additions, &entity.MasterAddition{
+			Kind:      entity.MasterKindChart,
+			Chart:     chart,
+			CreatedAt: chart.CreatedAt,
+		})
+	}
+	// 同じ日時なら曲を先にして、その中ではIDの大きい順
+	sort.SliceStable(additions, func(i, j int) bool {
+		a, b := additions[i], additions[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		if a.Kind != b.Kind {
+			return a.Kind == entity.MasterKindSong
+		}
+		return additionID(a) > additionID(b)
+	})
+	if limit > 0 && len(additions) > limit {
+		additions = additions[:limit]
+	}
+
+	return additions, nil
+}
+
+func additionID(a *entity.MasterAddition) int32 {
+	if a.Chart != nil {
+		return a.Chart.ID
+	}
+	return a.Song.ID
+}
@@ -0,0 +1,716 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/gen/enums"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/masterbundle"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/pkg/normalize"
+	"github.com/cockroachdb/errors"
+)
+
+// ドライラン時にトランザクションをロールバックさせるためのエラー
+var errImportDryRun = errors.New("dry run")
+
+// バンドルの内容を自然キーで既存のマスタと突き合わせ、追加・更新を1トランザクションで反映する
+// バンドルに含まれないマスタは削除しない
+// dryRunの場合も同じ処理を行った上でロールバックし、差分だけを返す
+func (u *masterUsecase) ImportMaster(ctx context.Context, bundle *masterbundle.Bundle, dryRun bool) ([]*entity.MasterChange, error) {
+	if err := bundle.Validate(); err != nil {
+		return nil, errors.WithStack(errors.Mark(err, ErrInvalidArgument))
+	}
+
+	var changes []*entity.MasterChange
+	err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		im, err := u.newMasterImporter(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := im.run(ctx, bundle); err != nil {
+			return errors.WithStack(err)
+		}
+		changes = im.changes
+
+		if dryRun {
+			return errImportDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errImportDryRun) {
+		return nil, errors.WithStack(err)
+	}
+
+	if dryRun || len(changes) == 0 {
+		return changes, nil
+	}
+
+	if err := u.refreshAllMasterCaches(ctx); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return changes, nil
+}
+
+// 一覧と個別のキャッシュを全て作り直す
+func (u *masterUsecase) refreshAllMasterCaches(ctx context.Context) error {
+	if err := u.refreshArtistsCache(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+	artists, err := u.masterRepo.ListArtists(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, artist := range artists {
+		if err := u.redisMasterCacheRepo.SetArtist(ctx, artist.ID, artist); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	singers, err := u.masterRepo.ListSingers(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if singers == nil {
+		singers = []*entity.Singer{}
+	}
+	if err := u.redisMasterCacheRepo.SetSingers(ctx, singers); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, singer := range singers {
+		if err := u.redisMasterCacheRepo.SetSinger(ctx, singer.ID, singer); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	units, err := u.masterRepo.ListUnits(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if units == nil {
+		units = []*entity.Unit{}
+	}
+	if err := u.redisMasterCacheRepo.SetUnits(ctx, units); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, unit := range units {
+		if err := u.redisMasterCacheRepo.SetUnit(ctx, unit.ID, unit); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	songs, err := u.masterRepo.ListSongs(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if songs == nil {
+		songs = []*entity.Song{}
+	}
+	if err := u.redisMasterCacheRepo.SetSongs(ctx, songs); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, song := range songs {
+		if err := u.redisMasterCacheRepo.SetSong(ctx, song.ID, song); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	charts, err := u.masterRepo.ListCharts(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if charts == nil {
+		charts = []*entity.Chart{}
+	}
+	if err := u.redisMasterCacheRepo.SetCharts(ctx, charts); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, chart := range charts {
+		if err := u.redisMasterCacheRepo.SetChart(ctx, chart.ID, chart); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+type vocalPatternKey struct {
+	songID int32
+	name   string
+}
+
+type chartKey struct {
+	songID         int32
+	difficultyType enums.DifficultyType
+}
+
+// 自然キーから既存のマスタを引くための索引
+// アーティスト・歌手・ユニットは一意制約と同じくnormalize.NameKeyで正規化した名前で引く
+// DB側で名前が重複している場合はIDが小さいものを使う
+type masterImporter struct {
+	u             *masterUsecase
+	artists       map[string]*entity.Artist
+	singers       map[string]*entity.Singer
+	units         map[string]*entity.Unit
+	songs         map[string]*entity.Song
+	vocalPatterns map[vocalPatternKey]*entity.VocalPattern
+	charts        map[chartKey]*entity.Chart
+	changes       []*entity.MasterChange
+	// 版数の変更履歴 曲はアーティストなどを埋め込むので、依存する曲と譜面はまとめて後で記録する
+	logs           []*entity.MasterChangeLog
+	updatedArtists map[int32]bool
+	updatedSongs   map[int32]bool
+}
+
+func (u *masterUsecase) newMasterImporter(ctx context.Context) (*masterImporter, error) {
+	im := &masterImporter{
+		u:             u,
+		artists:       map[string]*entity.Artist{},
+		singers:       map[string]*entity.Singer{},
+		units:         map[string]*entity.Unit{},
+		songs:         map[string]*entity.Song{},
+		vocalPatterns: map[vocalPatternKey]*entity.VocalPattern{},
+		charts:        map[chartKey]*entity.Chart{},
+
+		updatedArtists: map[int32]bool{},
+		updatedSongs:   map[int32]bool{},
+	}
+
+	artists, err := u.masterRepo.ListArtists(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, a := range artists {
+		key := normalize.NameKey(a.Name)
+		if cur, ok := im.artists[key]; !ok || a.ID < cur.ID {
+			im.artists[key] = a
+		}
+	}
+
+	singers, err := u.masterRepo.ListSingers(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, s := range singers {
+		key := normalize.NameKey(s.Name)
+		if cur, ok := im.singers[key]; !ok || s.ID < cur.ID {
+			im.singers[key] = s
+		}
+	}
+
+	units, err := u.masterRepo.ListUnits(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, un := range units {
+		key := normalize.NameKey(un.Name)
+		if cur, ok := im.units[key]; !ok || un.ID < cur.ID {
+			im.units[key] = un
+		}
+	}
+
+	songs, err := u.masterRepo.ListSongs(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, s := range songs {
+		if cur, ok := im.songs[s.Name]; !ok || s.ID < cur.ID {
+			im.songs[s.Name] = s
+		}
+	}
+
+	vocalPatterns, err := u.masterRepo.ListVocalPatterns(ctx, 0)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, vp := range vocalPatterns {
+		key := vocalPatternKey{songID: vp.SongID, name: vp.Name}
+		if cur, ok := im.vocalPatterns[key]; !ok || vp.ID < cur.ID {
+			im.vocalPatterns[key] = vp
+		}
+	}
+
+	charts, err := u.masterRepo.ListCharts(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, c := range charts {
+		key := chartKey{songID: c.Song.ID, difficultyType: c.DifficultyType}
+		if cur, ok := im.charts[key]; !ok || c.ID < cur.ID {
+			im.charts[key] = c
+		}
+	}
+
+	return im, nil
+}
+
+func (im *masterImporter) record(kind, key string, op entity.MasterChangeOperation, fields ...string) {
+	im.changes = append(im.changes, &entity.MasterChange{
+		Kind:      kind,
+		Key:       key,
+		Operation: op,
+		Fields:    fields,
+	})
+}
+
+// 参照先は同じバンドル内で先に追加されたものも使えるように、依存される側から順に反映する
+func (im *masterImporter) run(ctx context.Context, b *masterbundle.Bundle) error {
+	for _, a := range b.Artists {
+		if err := im.importArtist(ctx, a); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	for _, s := range b.Singers {
+		if err := im.importSinger(ctx, s); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	for _, un := range b.Units {
+		if err := im.importUnit(ctx, un); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	for _, s := range b.Songs {
+		if err := im.importSong(ctx, s); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	for _, vp := range b.VocalPatterns {
+		if err := im.importVocalPattern(ctx, vp); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	for _, c := range b.Charts {
+		if err := im.importChart(ctx, c); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	if len(im.updatedArtists) > 0 || len(im.updatedSongs) > 0 {
+		songLogs, err := im.u.songChangeLogs(ctx, func(s *entity.Song) bool {
+			return im.updatedSongs[s.ID] || slices.ContainsFunc(s.Credits, func(c *entity.SongCredit) bool {
+				return im.updatedArtists[c.Artist.ID]
+			})
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		im.logs = append(im.logs, songLogs...)
+	}
+	if len(im.logs) > 0 {
+		if err := im.u.recordMasterChanges(ctx, im.logs...); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+func (im *masterImporter) importArtist(ctx context.Context, a *masterbundle.Artist) error {
+	cur, ok := im.artists[normalize.NameKey(a.Name)]
+	if !ok {
+		// 名前の表記揺れで別のアーティストとして入らないようにする
+		if err := im.u.checkArtistNameConflict(ctx, a.Name, 0); err != nil {
+			return errors.WithStack(err)
+		}
+		created, err := im.u.masterRepo.CreateArtist(ctx, a.Name, a.Kana)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		im.artists[normalize.NameKey(a.Name)] = created
+		im.record(entity.MasterKindArtist, a.Name, entity.MasterChangeOperationInsert)
+		im.logs = append(im.logs, masterChangeLog(entity.MasterKindArtist, created.ID, entity.MasterChangeOperationInsert))
+		return nil
+	}
+
+	if cur.Kana == a.Kana {
+		return nil
+	}
+	// 表記揺れで一致した場合も既存の名前は書き換えない
+	if err := im.u.masterRepo.UpdateArtist(ctx, cur.ID, cur.Name, a.Kana); err != nil {
+		return errors.WithStack(err)
+	}
+	cur.Kana = a.Kana
+	im.record(entity.MasterKindArtist, a.Name, entity.MasterChangeOperationUpdate, "kana")
+	im.logs = append(im.logs, masterChangeLog(entity.MasterKindArtist, cur.ID, entity.MasterChangeOperationUpdate))
+	im.updatedArtists[cur.ID] = true
+
+	return nil
+}
+
+func (im *masterImporter) importSinger(ctx context.Context, s *masterbundle.Singer) error {
+	if _, ok := im.singers[normalize.NameKey(s.Name)]; ok {
+		return nil
+	}
+	if err := im.u.checkSingerNameConflict(ctx, s.Name, 0); err != nil {
+		return errors.WithStack(err)
+	}
+	created, err := im.u.masterRepo.CreateSinger(ctx, s.Name)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	im.singers[normalize.NameKey(s.Name)] = created
+	im.record(entity.MasterKindSinger, s.Name, entity.MasterChangeOperationInsert)
+	im.logs = append(im.logs, masterChangeLog(entity.MasterKindSinger, created.ID, entity.MasterChangeOperationInsert))
+
+	return nil
+}
+
+func (im *masterImporter) importUnit(ctx context.Context, un *masterbundle.Unit) error {
+	memberIDs := make([]int32, 0, len(un.Members))
+	for _, name := range un.Members {
+		singer, ok := im.singers[normalize.NameKey(name)]
+		if !ok {
+			return errors.Wrapf(ErrInvalidArgument, "unit %q: unknown singer %q", un.Name, name)
+		}
+		memberIDs = append(memberIDs, singer.ID)
+	}
+
+	cur, ok := im.units[normalize.NameKey(un.Name)]
+	if !ok {
+		if err := im.u.checkUnitNameConflict(ctx, un.Name, 0); err != nil {
+			return errors.WithStack(err)
+		}
+		created, err := im.u.masterRepo.CreateUnit(ctx, un.Name)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := im.replaceUnitMembers(ctx, created.ID, nil, memberIDs); err != nil {
+			return errors.WithStack(err)
+		}
+		im.units[normalize.NameKey(un.Name)] = created
+		im.record(entity.MasterKindUnit, un.Name, entity.MasterChangeOperationInsert)
+		im.logs = append(im.logs, masterChangeLog(entity.MasterKindUnit, created.ID, entity.MasterChangeOperationInsert))
+		return nil
+	}
+
+	// 所属は並び順も含めて比べる
+	curMemberIDs := make([]int32, len(cur.Members))
+	for i, member := range cur.Members {
+		curMemberIDs[i] = member.ID
+	}
+	if slices.Equal(curMemberIDs, memberIDs) {
+		return nil
+	}
+	if err := im.u.masterRepo.DeleteUnitSingersByUnitID(ctx, cur.ID); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := im.replaceUnitMembers(ctx, cur.ID, curMemberIDs, memberIDs); err != nil {
+		return errors.WithStack(err)
+	}
+	im.record(entity.MasterKindUnit, un.Name, entity.MasterChangeOperationUpdate, "members")
+	im.logs = append(im.logs, masterChangeLog(entity.MasterKindUnit, cur.ID, entity.MasterChangeOperationUpdate))
+
+	return nil
+}
+
+// 所属を追加し、所属が変わる歌手の更新を記録する 既存の所属は呼び出し側で消しておく
+func (im *masterImporter) replaceUnitMembers(ctx context.Context, unitID int32, oldIDs, newIDs []int32) error {
+	for i, singerID := range newIDs {
+		if _, err := im.u.masterRepo.CreateUnitSinger(ctx, unitID, singerID, int32(i+1)); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	for _, singerID := range append(oldIDs, newIDs...) {
+		im.logs = append(im.logs, masterChangeLog(entity.MasterKindSinger, singerID, entity.MasterChangeOperationUpdate))
+	}
+
+	return nil
+}
+
+func (im *masterImporter) importSong(ctx context.Context, s *masterbundle.Song) error {
+	var credits []*entity.SongCredit
+	for _, credit := range s.Credits() {
+		for i, name := range credit.Names {
+			a, ok := im.artists[normalize.NameKey(name)]
+			if !ok {
+				return errors.Wrapf(ErrInvalidArgument, "song %q: unknown artist %q", s.Name, name)
+			}
+			credits = append(credits, &entity.SongCredit{Artist: *a, Role: credit.Role, Position: int32(i + 1)})
+		}
+	}
+
+	unitIDs := make([]int32, 0, len(s.Units))
+	for _, name := range s.Units {
+		un, ok := im.units[normalize.NameKey(name)]
+		if !ok {
+			return errors.Wrapf(ErrInvalidArgument, "song %q: unknown unit %q", s.Name, name)
+		}
+		unitIDs = append(unitIDs, un.ID)
+	}
+	musicVideoTypes := make([]enums.MusicVideoType, 0, len(s.MusicVideoTypes))
+	for _, name := range s.MusicVideoTypes {
+		m, err := masterbundle.ParseMusicVideoType(name)
+		if err != nil {
+			return errors.WithStack(errors.Mark(err, ErrInvalidArgument))
+		}
+		musicVideoTypes = append(musicVideoTypes, m)
+	}
+
+	cur, ok := im.songs[s.Name]
+	if !ok {
+		created, err := im.u.masterRepo.CreateSong(ctx, s.Name, s.Kana, s.Thumbnail, s.OriginalVideo, s.ReleaseTime, s.Deleted)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := im.replaceSongRelations(ctx, created.ID, credits, unitIDs, musicVideoTypes); err != nil {
+			return errors.WithStack(err)
+		}
+		im.songs[s.Name] = &entity.Song{ID: created.ID, Name: s.Name, ReleaseTime: s.ReleaseTime}
+		im.record(entity.MasterKindSong, s.Name, entity.MasterChangeOperationInsert)
+		im.logs = append(im.logs, masterChangeLog(entity.MasterKindSong, created.ID, entity.MasterChangeOperationInsert))
+		return nil
+	}
+
+	var fields []string
+	if cur.Kana != s.Kana {
+		fields = append(fields, "kana")
+	}
+	// クレジットは並び順も含めて比べる
+	for _, credit := range s.Credits() {
+		if !slices.Equal(creditArtistIDs(cur.Credits, credit.Role), creditArtistIDs(credits, credit.Role)) {
+			fields = append(fields, credit.Field)
+		}
+	}
+	if cur.Thumbnail != s.Thumbnail {
+		fields = append(fields, "thumbnail")
+	}
+	if cur.OriginalVideo != s.OriginalVideo {
+		fields = append(fields, "original_video")
+	}
+	if !cur.ReleaseTime.Equal(s.ReleaseTime) {
+		fields = append(fields, "release_time")
+	}
+	if cur.Deleted != s.Deleted {
+		fields = append(fields, "deleted")
+	}
+	curUnitIDs := make([]int32, 0, len(cur.Units))
+	for _, un := range cur.Units {
+		curUnitIDs = append(curUnitIDs, un.ID)
+	}
+	if !sameSet(curUnitIDs, unitIDs) {
+		fields = append(fields, "units")
+	}
+	if !sameSet(cur.MusicVideoTypes, musicVideoTypes) {
+		fields = append(fields, "music_video_types")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	if err := im.u.masterRepo.UpdateSong(ctx, cur.ID, s.Name, s.Kana, s.Thumbnail, s.OriginalVideo, s.ReleaseTime, s.Deleted); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := im.replaceSongRelations(ctx, cur.ID, credits, unitIDs, musicVideoTypes); err != nil {
+		return errors.WithStack(err)
+	}
+	im.record(entity.MasterKindSong, s.Name, entity.MasterChangeOperationUpdate, fields...)
+	im.updatedSongs[cur.ID] = true
+
+	return nil
+}
+
+// Positionの順に並んだ、指定した役割のアーティストのID
+func creditArtistIDs(credits []*entity.SongCredit, role enums.CreditRole) []int32 {
+	var ids []int32
+	for _, c := range credits {
+		if c.Role == role {
+			ids = append(ids, c.Artist.ID)
+		}
+	}
+	return ids
+}
+
+func (im *masterImporter) replaceSongRelations(ctx context.Context, songID int32, credits []*entity.SongCredit, unitIDs []int32, musicVideoTypes []enums.MusicVideoType) error {
+	if err := im.u.masterRepo.DeleteSongCreditsBySongID(ctx, songID); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := im.u.createSongCredits(ctx, songID, credits); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := im.u.masterRepo.DeleteSongUnitsBySongID(ctx, songID); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, unitID := range unitIDs {
+		if _, err := im.u.masterRepo.CreateSongUnit(ctx, songID, unitID); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	if err := im.u.masterRepo.DeleteSongMusicVideoTypesBySongID(ctx, songID); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, musicVideoType := range musicVideoTypes {
+		if _, err := im.u.masterRepo.CreateSongMusicVideoType(ctx, songID, musicVideoType); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+func (im *masterImporter) importVocalPattern(ctx context.Context, vp *masterbundle.VocalPattern) error {
+	song, ok := im.songs[vp.Song]
+	if !ok {
+		return errors.Wrapf(ErrInvalidArgument, "vocal pattern %q: unknown song %q", vp.Name, vp.Song)
+	}
+	singerIDs := make([]int32, 0, len(vp.Singers))
+	for _, name := range vp.Singers {
+		s, ok := im.singers[normalize.NameKey(name)]
+		if !ok {
+			return errors.Wrapf(ErrInvalidArgument, "vocal pattern %q: unknown singer %q", vp.Name, name)
+		}
+		singerIDs = append(singerIDs, s.ID)
+	}
+
+	key := fmt.Sprintf("%s/%s", vp.Song, vp.Name)
+	cur, ok := im.vocalPatterns[vocalPatternKey{songID: song.ID, name: vp.Name}]
+	if !ok {
+		created, err := im.u.masterRepo.CreateVocalPattern(ctx, song.ID, vp.Name)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := im.createVocalPatternSingers(ctx, created.ID, singerIDs); err != nil {
+			return errors.WithStack(err)
+		}
+		im.record(entity.MasterKindVocalPattern, key, entity.MasterChangeOperationInsert)
+		im.updatedSongs[song.ID] = true
+		return nil
+	}
+
+	// 歌唱順も含めて比較する
+	curSingers := slices.Clone(cur.Singers)
+	sort.SliceStable(curSingers, func(i, j int) bool {
+		return curSingers[i].Position < curSingers[j].Position
+	})
+	curSingerIDs := make([]int32, 0, len(curSingers))
+	for _, s := range curSingers {
+		curSingerIDs = append(curSingerIDs, s.ID)
+	}
+	if slices.Equal(curSingerIDs, singerIDs) {
+		return nil
+	}
+
+	if err := im.u.masterRepo.DeleteVocalPatternSingersByVocalPatternID(ctx, cur.ID); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := im.createVocalPatternSingers(ctx, cur.ID, singerIDs); err != nil {
+		return errors.WithStack(err)
+	}
+	im.record(entity.MasterKindVocalPattern, key, entity.MasterChangeOperationUpdate, "singers")
+	im.updatedSongs[song.ID] = true
+
+	return nil
+}
+
+func (im *masterImporter) createVocalPatternSingers(ctx context.Context, vocalPatternID int32, singerIDs []int32) error {
+	for i, singerID := range singerIDs {
+		if _, err := im.u.masterRepo.CreateVocalPatternSinger(ctx, vocalPatternID, singerID, int32(i+1)); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+func (im *masterImporter) importChart(ctx context.Context, c *masterbundle.Chart) error {
+	song, ok := im.songs[c.Song]
+	if !ok {
+		return errors.Wrapf(ErrInvalidArgument, "chart %s: unknown song %q", c.DifficultyType, c.Song)
+	}
+	difficultyType, err := masterbundle.ParseDifficultyType(c.DifficultyType)
+	if err != nil {
+		return errors.WithStack(errors.Mark(err, ErrInvalidArgument))
+	}
+	metadata, err := normalizeChartMetadata(entity.ChartMetadata{
+		NoteCount:       c.NoteCount,
+		BPMMin:          c.BPMMin,
+		BPMMax:          c.BPMMax,
+		DurationSeconds: c.DurationSeconds,
+		Constant:        c.Constant,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "chart %s/%s", c.Song, c.DifficultyType)
+	}
+
+	key := fmt.Sprintf("%s/%s", c.Song, masterbundle.FormatDifficultyType(difficultyType))
+	cur, ok := im.charts[chartKey{songID: song.ID, difficultyType: difficultyType}]
+	if !ok {
+		created, err := im.u.masterRepo.CreateChart(ctx, song.ID, int32(difficultyType), c.Level, c.ChartViewLink, metadata)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := im.u.masterRepo.UpsertChartLevelHistory(ctx, created.ID, c.Level, initialLevelEffectiveFrom(song.ReleaseTime, time.Now())); err != nil {
+			return errors.WithStack(err)
+		}
+		im.record(entity.MasterKindChart, key, entity.MasterChangeOperationInsert)
+		im.logs = append(im.logs, masterChangeLog(entity.MasterKindChart, created.ID, entity.MasterChangeOperationInsert))
+		return nil
+	}
+
+	var fields []string
+	if cur.Level != c.Level {
+		fields = append(fields, "level")
+	}
+	if cur.ChartViewLink != c.ChartViewLink {
+		fields = append(fields, "chart_view_link")
+	}
+	if cur.Metadata.NoteCount != metadata.NoteCount {
+		fields = append(fields, "note_count")
+	}
+	if cur.Metadata.BPMMin != metadata.BPMMin {
+		fields = append(fields, "bpm_min")
+	}
+	if cur.Metadata.BPMMax != metadata.BPMMax {
+		fields = append(fields, "bpm_max")
+	}
+	if cur.Metadata.DurationSeconds != metadata.DurationSeconds {
+		fields = append(fields, "duration_seconds")
+	}
+	if cur.Metadata.Constant != metadata.Constant {
+		fields = append(fields, "constant")
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	if cur.Level != c.Level {
+		if err := im.u.masterRepo.UpsertChartLevelHistory(ctx, cur.ID, c.Level, time.Now()); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if err := im.u.masterRepo.UpdateChart(ctx, cur.ID, song.ID, int32(difficultyType), c.Level, c.ChartViewLink, metadata); err != nil {
+		return errors.WithStack(err)
+	}
+	im.record(entity.MasterKindChart, key, entity.MasterChangeOperationUpdate, fields...)
+	im.logs = append(im.logs, masterChangeLog(entity.MasterKindChart, cur.ID, entity.MasterChangeOperationUpdate))
+
+	return nil
+}
+
+// 順序と重複を無視して同じ要素を持つか
+func sameSet[T comparable](a, b []T) bool {
+	am := make(map[T]struct{}, len(a))
+	for _, v := range a {
+		am[v] = struct{}{}
+	}
+	bm := make(map[T]struct{}, len(b))
+	for _, v := range b {
+		bm[v] = struct{}{}
+	}
+	if len(am) != len(bm) {
+		return false
+	}
+	for v := range am {
+		if _, ok := bm[v]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
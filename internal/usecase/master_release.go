@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/cockroachdb/errors"
+)
+
+// 公開日時を過ぎていれば公開済み 公開日時が未設定の曲も公開済みとする
+func IsReleased(releaseTime, now time.Time) bool {
+	return !releaseTime.After(now)
+}
+
+// 公開済みの曲だけの一覧
+// 次に公開される曲の公開日時でキャッシュが切れるようにして、公開されたら一覧に出るようにする
+func (u *masterUsecase) listReleasedSongs(ctx context.Context) ([]*entity.Song, error) {
+	songs, err := fillMasterCache(ctx, &u.cacheFiller, releasedCacheKey(repository.SONG_REDIS_KEY), u.redisMasterCacheRepo.GetReleasedSongs, func(ctx context.Context) ([]*entity.Song, error) {
+		allSongs, err := u.listAllSongs(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		now := time.Now()
+		var nextRelease time.Time
+		songs := make([]*entity.Song, 0, len(allSongs))
+		for _, song := range allSongs {
+			if !IsReleased(song.ReleaseTime, now) {
+				nextRelease = earlierRelease(nextRelease, song.ReleaseTime)
+				continue
+			}
+			songs = append(songs, song)
+		}
+		if err := u.redisMasterCacheRepo.SetReleasedSongs(ctx, songs, untilRelease(nextRelease, now)); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return songs, nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return songs, nil
+}
+
+// 公開済みの曲の譜面だけの一覧 キャッシュの期限は曲と同じ
+func (u *masterUsecase) listReleasedCharts(ctx context.Context) ([]*entity.Chart, error) {
+	charts, err := fillMasterCache(ctx, &u.cacheFiller, releasedCacheKey(repository.CHART_REDIS_KEY), u.redisMasterCacheRepo.GetReleasedCharts, func(ctx context.Context) ([]*entity.Chart, error) {
+		allCharts, err := u.listAllCharts(ctx)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		now := time.Now()
+		var nextRelease time.Time
+		charts := make([]*entity.Chart, 0, len(allCharts))
+		for _, chart := range allCharts {
+			if !IsReleased(chart.Song.ReleaseTime, now) {
+				nextRelease = earlierRelease(nextRelease, chart.Song.ReleaseTime)
+				continue
+			}
+			charts = append(charts, chart)
+		}
+		if err := u.redisMasterCacheRepo.SetReleasedCharts(ctx, charts, untilRelease(nextRelease, now)); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return charts, nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return charts, nil
+}
+
+// 公開前の曲は管理者以外の差分に含めないので、公開された時に更新として記録して差分に出るようにする
+// 公開日時は書き込みが無くても過ぎるので、定期的に呼ぶ
+func (u *masterUsecase) RecordSongReleases(ctx context.Context) (int, error) {
+	var count int
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		recordedAt, err := u.masterRepo.GetReleasesRecordedAtForUpdate(ctx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		now := time.Now()
+		songIDs, err := u.masterRepo.ListSongIDsReleasedBetween(ctx, recordedAt, now)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if len(songIDs) > 0 {
+			var logs []*entity.MasterChangeLog
+			for _, songID := range songIDs {
+				songLogs, err := u.songUpdateLogs(ctx, songID)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				logs = append(logs, songLogs...)
+			}
+			if err := u.recordMasterChanges(ctx, logs...); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+		if err := u.masterRepo.UpdateReleasesRecordedAt(ctx, now); err != nil {
+			return errors.WithStack(err)
+		}
+		count = len(songIDs)
+		return nil
+	}); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+func earlierRelease(current, releaseTime time.Time) time.Time {
+	if current.IsZero() || releaseTime.Before(current) {
+		return releaseTime
+	}
+	return current
+}
+
+// 次の公開までの時間 公開前の曲が無ければ0(期限なし)
+func untilRelease(nextRelease, now time.Time) time.Duration {
+	if nextRelease.IsZero() {
+		return 0
+	}
+	return nextRelease.Sub(now)
+}
+
+// 公開月は日本時間で区切る 夏時間が無いので固定のオフセットでよい
+var releaseLocation = time.FixedZone("JST", 9*60*60)
+
+func (u *masterUsecase) ListSongReleases(ctx context.Context, unitID int32, includeUnreleased bool) ([]*entity.SongReleaseMonth, error) {
+	if unitID != 0 {
+		exist, err := u.masterRepo.ExistsUnit(ctx, unitID)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if !exist {
+			return nil, errors.WithStack(repository.ErrNotFound)
+		}
+	}
+
+	songs, err := u.ListSongs(ctx, false, includeUnreleased)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	filtered := make([]*entity.Song, 0, len(songs))
+	for _, song := range songs {
+		if song.ReleaseTime.IsZero() {
+			continue
+		}
+		if unitID != 0 && !slices.ContainsFunc(song.Units, func(unit *entity.Unit) bool {
+			return unit != nil && unit.ID == unitID
+		}) {
+			continue
+		}
+		filtered = append(filtered, song)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if !filtered[i].ReleaseTime.Equal(filtered[j].ReleaseTime) {
+			return filtered[i].ReleaseTime.Before(filtered[j].ReleaseTime)
+		}
+		return filtered[i].ID < filtered[j].ID
+	})
+
+	months := []*entity.SongReleaseMonth{}
+	for _, song := range filtered {
+		t := song.ReleaseTime.In(releaseLocation)
+		year, month := int32(t.Year()), int32(t.Month())
+		if len(months) == 0 || months[len(months)-1].Year != year || months[len(months)-1].Month != month {
+			months = append(months, &entity.SongReleaseMonth{Year: year, Month: month})
+		}
+		last := months[len(months)-1]
+		last.Songs = append(last.Songs, song)
+	}
+
+	return months, nil
+}
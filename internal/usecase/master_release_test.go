@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsReleased(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		releaseTime time.Time
+		want        bool
+	}{
+		{name: "過去", releaseTime: now.Add(-time.Second), want: true},
+		{name: "ちょうど", releaseTime: now, want: true},
+		{name: "未来", releaseTime: now.Add(time.Second), want: false},
+		{name: "未設定は公開済み", releaseTime: time.Time{}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsReleased(tt.releaseTime, now); got != tt.want {
+				t.Errorf("IsReleased() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_earlierRelease(t *testing.T) {
+	base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		current     time.Time
+		releaseTime time.Time
+		want        time.Time
+	}{
+		{name: "まだ無い", current: time.Time{}, releaseTime: base, want: base},
+		{name: "早い方にする", current: base, releaseTime: base.Add(-time.Hour), want: base.Add(-time.Hour)},
+		{name: "遅ければそのまま", current: base, releaseTime: base.Add(time.Hour), want: base},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := earlierRelease(tt.current, tt.releaseTime); !got.Equal(tt.want) {
+				t.Errorf("earlierRelease() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_untilRelease(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		nextRelease time.Time
+		want        time.Duration
+	}{
+		{name: "公開前の曲が無ければ期限なし", nextRelease: time.Time{}, want: 0},
+		{name: "次の公開まで", nextRelease: now.Add(90 * time.Minute), want: 90 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := untilRelease(tt.nextRelease, now); got != tt.want {
+				t.Errorf("untilRelease() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
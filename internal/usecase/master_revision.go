@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/entity"
+	"github.com/Shakkuuu/sekai-songs-mylist/internal/domain/repository"
+	"github.com/cockroachdb/errors"
+)
+
+func masterChangeLog(kind string, id int32, op entity.MasterChangeOperation) *entity.MasterChangeLog {
+	return &entity.MasterChangeLog{Kind: kind, EntityID: id, Operation: op}
+}
+
+func masterChangeLogAt(revision int64, kind string, id int32, op entity.MasterChangeOperation) *entity.MasterChangeLog {
+	return &entity.MasterChangeLog{Revision: revision, Kind: kind, EntityID: id, Operation: op}
+}
+
+// 版数を進めて変更履歴を記録し、コミット後に変更を通知する
+// 書き込みと同じトランザクションの中で呼ぶ
+func (u *masterUsecase) recordMasterChanges(ctx context.Context, logs ...*entity.MasterChangeLog) error {
+	if _, err := u.masterRepo.RecordMasterChanges(ctx, logs); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := u.txManager.AfterCommit(ctx, func(ctx context.Context) error {
+		return u.redisMasterEventRepo.PublishMasterChanges(ctx, logs)
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// matchに該当する曲と、その譜面の更新履歴を作る
+// 曲と譜面はアーティストや歌手などを埋め込んで返すので、それらの変更でも更新扱いにする
+func (u *masterUsecase) songChangeLogs(ctx context.Context, match func(s *entity.Song) bool) ([]*entity.MasterChangeLog, error) {
+	songs, err := u.masterRepo.ListSongs(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var logs []*entity.MasterChangeLog
+	for _, song := range songs {
+		if match(song) {
+			logs = append(logs, masterChangeLog(entity.MasterKindSong, song.ID, entity.MasterChangeOperationUpdate))
+		}
+	}
+
+	charts, err := u.masterRepo.ListCharts(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, chart := range charts {
+		if match(&chart.Song) {
+			logs = append(logs, masterChangeLog(entity.MasterKindChart, chart.ID, entity.MasterChangeOperationUpdate))
+		}
+	}
+
+	return logs, nil
+}
+
+func (u *masterUsecase) GetMasterVersion(ctx context.Context) (int64, error) {
+	revision, err := u.masterRepo.GetMasterRevision(ctx)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return revision, nil
+}
+
+// sinceRevisionより後に変更されたマスタの現在の内容と、削除されたマスタのIDを返す
+// sinceRevisionが0の場合は全件を返す
+// 削除済み・公開前の曲と譜面はListSongs・ListChartsと同じく除き、変更履歴にあれば削除されたものとして返す
+// 公開前の曲は公開された時にRecordSongReleasesで更新として記録されるので、その時に差分に出る
+func (u *masterUsecase) GetMasterDelta(ctx context.Context, sinceRevision int64, includeDeleted, includeUnreleased bool) (*entity.MasterDelta, error) {
+	// 版数を先に読むことで、返した版数までの変更は必ず含まれるようにする
+	// その後の変更が含まれることはあるが、次回も同じものを受け取るだけなので問題ない
+	revision, err := u.masterRepo.GetMasterRevision(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if sinceRevision < 0 || sinceRevision > revision {
+		return nil, errors.WithStack(ErrInvalidArgument)
+	}
+
+	delta := &entity.MasterDelta{
+		Revision:         revision,
+		Artists:          []*entity.Artist{},
+		Singers:          []*entity.Singer{},
+		Units:            []*entity.Unit{},
+		Songs:            []*entity.Song{},
+		Charts:           []*entity.Chart{},
+		RemovedArtistIDs: []int32{},
+		RemovedSingerIDs: []int32{},
+		RemovedUnitIDs:   []int32{},
+		RemovedSongIDs:   []int32{},
+		RemovedChartIDs:  []int32{},
+	}
+
+	var changed map[string][]int32
+	changedSet := map[string]map[int32]bool{}
+	if sinceRevision > 0 {
+		logs, err := u.masterRepo.ListLatestMasterChangeLogsSince(ctx, sinceRevision)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		changed = map[string][]int32{}
+		for _, l := range logs {
+			changed[l.Kind] = append(changed[l.Kind], l.EntityID)
+			if changedSet[l.Kind] == nil {
+				changedSet[l.Kind] = map[int32]bool{}
+			}
+			changedSet[l.Kind][l.EntityID] = true
+		}
+	}
+	// changedがnilなら全件
+	include := func(kind string, id int32) bool {
+		return changed == nil || changedSet[kind][id]
+	}
+	// 変更履歴にあるのに見つからなかったものは削除されている
+	removed := func(kind string, found map[int32]bool) []int32 {
+		ids := []int32{}
+		for _, id := range changed[kind] {
+			if !found[id] {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+
+	artists, err := u.masterRepo.ListArtists(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	found := map[int32]bool{}
+	for _, a := range artists {
+		if include(entity.MasterKindArtist, a.ID) {
+			delta.Artists = append(delta.Artists, a)
+			found[a.ID] = true
+		}
+	}
+	delta.RemovedArtistIDs = removed(entity.MasterKindArtist, found)
+
+	singers, err := u.masterRepo.ListSingers(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	found = map[int32]bool{}
+	for _, s := range singers {
+		if include(entity.MasterKindSinger, s.ID) {
+			delta.Singers = append(delta.Singers, s)
+			found[s.ID] = true
+		}
+	}
+	delta.RemovedSingerIDs = removed(entity.MasterKindSinger, found)
+
+	units, err := u.masterRepo.ListUnits(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	found = map[int32]bool{}
+	for _, un := range units {
+		if include(entity.MasterKindUnit, un.ID) {
+			delta.Units = append(delta.Units, un)
+			found[un.ID] = true
+		}
+	}
+	delta.RemovedUnitIDs = removed(entity.MasterKindUnit, found)
+
+	now := time.Now()
+	visible := func(s *entity.Song) bool {
+		return (includeDeleted || !s.Deleted) && (includeUnreleased || IsReleased(s.ReleaseTime, now))
+	}
+
+	// 曲と譜面は件数が多いので、変更があったものだけ取得する
+	var songs []*entity.Song
+	if changed == nil {
+		songs, err = u.masterRepo.ListSongs(ctx)
+	} else {
+		songs, err = u.masterRepo.ListSongsByIDs(ctx, changed[entity.MasterKindSong])
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	found = map[int32]bool{}
+	for _, s := range songs {
+		if !visible(s) {
+			continue
+		}
+		delta.Songs = append(delta.Songs, s)
+		found[s.ID] = true
+	}
+	delta.RemovedSongIDs = removed(entity.MasterKindSong, found)
+
+	var charts []*entity.Chart
+	if changed == nil {
+		charts, err = u.masterRepo.ListCharts(ctx)
+	} else {
+		charts, err = u.masterRepo.ListChartsByIDs(ctx, changed[entity.MasterKindChart])
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	found = map[int32]bool{}
+	for _, c := range charts {
+		if !visible(&c.Song) {
+			continue
+		}
+		delta.Charts = append(delta.Charts, c)
+		found[c.ID] = true
+	}
+	delta.RemovedChartIDs = removed(entity.MasterKindChart, found)
+
+	return delta, nil
+}
+
+// マスタの変更通知を受け取る 同じ版数の変更はまとめて届く
+// 通知はキャッシュの更新前に届くことがあるので、受け取った側はGetMasterDeltaで内容を取り直す
+// ctxが終わるか、受け取りが追いつかなくなるとチャネルが閉じる
+// 削除済み・公開前の曲と譜面はGetMasterDeltaと同じく、includeDeleted・includeUnreleasedでなければ通知しない
+func (u *masterUsecase) WatchMaster(ctx context.Context, includeDeleted, includeUnreleased bool) <-chan []*entity.MasterChangeLog {
+	changes := u.redisMasterEventRepo.SubscribeMasterChanges(ctx)
+	if includeDeleted && includeUnreleased {
+		return changes
+	}
+
+	// 受け取りが遅いとchangesが溜まって閉じられ、こちらも閉じるので追いつかない時の扱いは変わらない
+	visible := make(chan []*entity.MasterChangeLog)
+	go func() {
+		defer close(visible)
+		for logs := range changes {
+			logs, err := u.visibleMasterChanges(ctx, logs, includeDeleted, includeUnreleased)
+			if err != nil {
+				// 閉じて再接続させ、GetMasterDeltaで取り直してもらう
+				log.Printf("%+v\n", errors.WithStack(err))
+				return
+			}
+			if len(logs) == 0 {
+				continue
+			}
+			select {
+			case visible <- logs:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return visible
+}
+
+// 見せない曲・譜面の変更を除く 削除済みにされたものは削除として通知し、受け取った側で消せるようにする
+// 通知は他の購読者と共有しているので書き換えずに作り直す
+func (u *masterUsecase) visibleMasterChanges(ctx context.Context, logs []*entity.MasterChangeLog, includeDeleted, includeUnreleased bool) ([]*entity.MasterChangeLog, error) {
+	now := time.Now()
+	visible := make([]*entity.MasterChangeLog, 0, len(logs))
+	for _, l := range logs {
+		if l.Operation == entity.MasterChangeOperationDelete {
+			visible = append(visible, l)
+			continue
+		}
+
+		var song *entity.Song
+		switch l.Kind {
+		case entity.MasterKindSong:
+			s, err := u.GetSongByID(ctx, l.EntityID)
+			if err != nil && !errors.Is(err, repository.ErrNotFound) {
+				return nil, errors.WithStack(err)
+			}
+			song = s
+		case entity.MasterKindChart:
+			c, err := u.GetChartByID(ctx, l.EntityID)
+			if err != nil && !errors.Is(err, repository.ErrNotFound) {
+				return nil, errors.WithStack(err)
+			}
+			if c != nil {
+				song = &c.Song
+			}
+		default:
+			visible = append(visible, l)
+			continue
+		}
+		// 通知の後に消されている 消した時の通知が続けて届く
+		if song == nil {
+			continue
+		}
+		if !includeUnreleased && !IsReleased(song.ReleaseTime, now) {
+			continue
+		}
+		if !includeDeleted && song.Deleted {
+			visible = append(visible, masterChangeLogAt(l.Revision, l.Kind, l.EntityID, entity.MasterChangeOperationDelete))
+			continue
+		}
+		visible = append(visible, l)
+	}
+
+	return visible, nil
+}
+
+// 曲と、その曲の譜面の更新を記録する
+func (u *masterUsecase) recordSongUpdate(ctx context.Context, songID int32) error {
+	logs, err := u.songUpdateLogs(ctx, songID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return u.recordMasterChanges(ctx, logs...)
+}
+
+// 曲と、その曲の譜面の更新履歴
+func (u *masterUsecase) songUpdateLogs(ctx context.Context, songID int32) ([]*entity.MasterChangeLog, error) {
+	logs := []*entity.MasterChangeLog{masterChangeLog(entity.MasterKindSong, songID, entity.MasterChangeOperationUpdate)}
+	chartIDs, err := u.masterRepo.ListChartIDsBySongID(ctx, songID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, chartID := range chartIDs {
+		logs = append(logs, masterChangeLog(entity.MasterKindChart, chartID, entity.MasterChangeOperationUpdate))
+	}
+
+	return logs, nil
+}
+
+func songHasArtist(s *entity.Song, artistID int32) bool {
+	for _, c := range s.Credits {
+		if c.Artist.ID == artistID {
+			return true
+		}
+	}
+	return false
+}
+
+func songHasSinger(s *entity.Song, singerID int32) bool {
+	for _, vp := range s.VocalPatterns {
+		for _, singer := range vp.Singers {
+			if singer.ID == singerID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func songHasUnit(s *entity.Song, unitID int32) bool {
+	for _, unit := range s.Units {
+		if unit.ID == unitID {
+			return true
+		}
+	}
+	return false
+}
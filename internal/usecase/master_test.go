@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_initialLevelEffectiveFrom(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		releaseTime time.Time
+		want        time.Time
+	}{
+		{name: "公開済みの曲は公開日時から", releaseTime: now.Add(-48 * time.Hour), want: now.Add(-48 * time.Hour)},
+		{name: "公開日時ちょうど", releaseTime: now, want: now},
+		{name: "公開前の曲は今から", releaseTime: now.Add(time.Hour), want: now},
+		{name: "公開日時が未設定なら今から", releaseTime: time.Time{}, want: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := initialLevelEffectiveFrom(tt.releaseTime, now); !got.Equal(tt.want) {
+				t.Errorf("initialLevelEffectiveFrom() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ var (
 	ErrMyListChartNotFound           = errors.New("this id mylistchart not found")
 	ErrMyListChartAttachmentNotFound = errors.New("this id mylistchartattachment not found")
 	ErrDuplicateMyListChart          = errors.New("this chart already exists")
+	ErrDeletedSongChart              = errors.New("this chart's song has been deleted")
 )
 
 type MyListUsecase interface {
@@ -44,13 +45,15 @@ type myListUsecase struct {
 	myListRepo           repository.MyListRepository
 	masterRepo           repository.MasterRepository
 	redisMasterCacheRepo repository.RedisMasterCacheRepository
+	txManager            repository.TxManager
 }
 
-func NewMyListUsecase(repo repository.MyListRepository, masterRepo repository.MasterRepository, redisMasterCacheRepo repository.RedisMasterCacheRepository) MyListUsecase {
+func NewMyListUsecase(repo repository.MyListRepository, masterRepo repository.MasterRepository, redisMasterCacheRepo repository.RedisMasterCacheRepository, txManager repository.TxManager) MyListUsecase {
 	return &myListUsecase{
 		myListRepo:           repo,
 		masterRepo:           masterRepo,
 		redisMasterCacheRepo: redisMasterCacheRepo,
+		txManager:            txManager,
 	}
 }
 
@@ -121,22 +124,28 @@ func (u *myListUsecase) DeleteMyList(ctx context.Context, id int32) error {
 		return errors.WithStack(ErrMyListNotFound)
 	}
 
-	myListCharts, err := u.myListRepo.ListMyListChartsByMyListID(ctx, id)
-	if err != nil {
-		return errors.WithStack(err)
-	}
+	if err := u.txManager.Do(ctx, func(ctx context.Context) error {
+		myListCharts, err := u.myListRepo.ListMyListChartsByMyListID(ctx, id)
+		if err != nil {
+			return errors.WithStack(err)
+		}
 
-	for _, myListChart := range myListCharts {
-		if err := u.myListRepo.DeleteMyListChartAttachmentByMyListChartID(ctx, myListChart.ID); err != nil {
+		for _, myListChart := range myListCharts {
+			if err := u.myListRepo.DeleteMyListChartAttachmentByMyListChartID(ctx, myListChart.ID); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		if err := u.myListRepo.DeleteMyListChartByMyListID(ctx, id); err != nil {
 			return errors.WithStack(err)
 		}
-	}
 
-	if err := u.myListRepo.DeleteMyListChartByMyListID(ctx, id); err != nil {
-		return errors.WithStack(err)
-	}
+		if err := u.myListRepo.DeleteMyList(ctx, id); err != nil {
+			return errors.WithStack(err)
+		}
 
-	if err := u.myListRepo.DeleteMyList(ctx, id); err != nil {
+		return nil
+	}); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -149,20 +158,14 @@ func (u *myListUsecase) GetMyListChartByID(ctx context.Context, id int32) (*enti
 		return nil, errors.WithStack(err)
 	}
 
-	chart, err := u.redisMasterCacheRepo.GetChartByID(ctx, myListChart.ChartID)
-	if errors.Is(err, redis.Nil) {
-		chart, err := u.masterRepo.GetChartByID(ctx, myListChart.ChartID)
-		if err != nil {
-			return nil, errors.WithStack(err)
-		}
-		if err := u.redisMasterCacheRepo.SetChart(ctx, myListChart.ChartID, chart); err != nil {
-			return nil, errors.WithStack(err)
-		}
-		myListChart.Chart = chart
-		return myListChart, nil
-	} else if err != nil {
+	charts, err := u.getChartsByIDs(ctx, []int32{myListChart.ChartID})
+	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	chart, ok := charts[myListChart.ChartID]
+	if !ok {
+		return nil, errors.WithStack(repository.ErrNotFound)
+	}
 	myListChart.Chart = chart
 
 	return myListChart, nil
@@ -177,25 +180,83 @@ func (u *myListUsecase) GetMyListChartsByMyListID(ctx context.Context, myListID
 		return []*entity.MyListChart{}, nil
 	}
 
-	for i := range len(myListCharts) {
-		chart, err := u.redisMasterCacheRepo.GetChartByID(ctx, myListCharts[i].ChartID)
-		if errors.Is(err, redis.Nil) {
-			chart, err := u.masterRepo.GetChartByID(ctx, myListCharts[i].ChartID)
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
-			if err := u.redisMasterCacheRepo.SetChart(ctx, myListCharts[i].ChartID, chart); err != nil {
-				return nil, errors.WithStack(err)
-			}
-			myListCharts[i].Chart = chart
+	chartIDs := make([]int32, len(myListCharts))
+	for i, myListChart := range myListCharts {
+		chartIDs[i] = myListChart.ChartID
+	}
+	charts, err := u.getChartsByIDs(ctx, chartIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// 公開前の曲の譜面はマスタの一覧と同じく無いものとして除く
+	released := make([]*entity.MyListChart, 0, len(myListCharts))
+	for _, myListChart := range myListCharts {
+		chart, ok := charts[myListChart.ChartID]
+		if !ok {
+			continue
+		}
+		myListChart.Chart = chart
+		released = append(released, myListChart)
+	}
+
+	return released, nil
+}
+
+// 譜面をまとめてキャッシュから取り、無いものはDBからまとめて取ってキャッシュに入れる
+// DBにも無い譜面があればErrNotFound 公開前の曲の譜面はmapに含めない
+func (u *myListUsecase) getChartsByIDs(ctx context.Context, ids []int32) (map[int32]*entity.Chart, error) {
+	seen := make(map[int32]struct{}, len(ids))
+	uniqueIDs := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		uniqueIDs = append(uniqueIDs, id)
+	}
+
+	cached, err := u.redisMasterCacheRepo.GetChartsByIDs(ctx, uniqueIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	charts := make(map[int32]*entity.Chart, len(uniqueIDs))
+	var missIDs []int32
+	for i, id := range uniqueIDs {
+		if cached[i] == nil {
+			missIDs = append(missIDs, id)
 			continue
-		} else if err != nil {
-			return nil, errors.WithStack(err)
 		}
-		myListCharts[i].Chart = chart
+		charts[id] = cached[i]
+	}
+	if len(missIDs) == 0 {
+		return releasedCharts(charts), nil
+	}
+
+	fetched, err := u.masterRepo.ListChartsByIDs(ctx, missIDs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(fetched) != len(missIDs) {
+		return nil, errors.WithStack(repository.ErrNotFound)
+	}
+	if err := u.redisMasterCacheRepo.SetChartsByID(ctx, fetched); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, chart := range fetched {
+		charts[chart.ID] = chart
 	}
 
-	return myListCharts, nil
+	return releasedCharts(charts), nil
+}
+
+func releasedCharts(charts map[int32]*entity.Chart) map[int32]*entity.Chart {
+	now := time.Now()
+	for id, chart := range charts {
+		if !IsReleased(chart.Song.ReleaseTime, now) {
+			delete(charts, id)
+		}
+	}
+	return charts
 }
 
 func (u *myListUsecase) AddMyListChart(ctx context.Context, myListID, chartID int32, clearType enums.ClearType, memo string) error {
@@ -207,6 +268,27 @@ func (u *myListUsecase) AddMyListChart(ctx context.Context, myListID, chartID in
 		return errors.WithStack(ErrDuplicateMyListChart)
 	}
 
+	// 削除済みの曲の譜面は新しく追加できない 追加済みのものはそのまま残す
+	chart, err := u.redisMasterCacheRepo.GetChartByID(ctx, chartID)
+	if errors.Is(err, redis.Nil) {
+		chart, err = u.masterRepo.GetChartByID(ctx, chartID)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := u.redisMasterCacheRepo.SetChart(ctx, chartID, chart); err != nil {
+			return errors.WithStack(err)
+		}
+	} else if err != nil {
+		return errors.WithStack(err)
+	}
+	if chart.Song.Deleted {
+		return errors.WithStack(ErrDeletedSongChart)
+	}
+	// 公開前の曲の譜面はGetChartと同じく無いものとして扱う
+	if !IsReleased(chart.Song.ReleaseTime, time.Now()) {
+		return errors.WithStack(repository.ErrNotFound)
+	}
+
 	now := time.Now()
 	createdAt := now
 	updatedAt := now
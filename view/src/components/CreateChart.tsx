@@ -13,6 +13,12 @@ export const CreateChart = () => {
   );
   const [level, setLevel] = useState<number>(0);
   const [chartViewLink, setChartViewLink] = useState<string>("");
+  // 以下は任意 0は未設定
+  const [noteCount, setNoteCount] = useState<number>(0);
+  const [bpmMin, setBpmMin] = useState<number>(0);
+  const [bpmMax, setBpmMax] = useState<number>(0);
+  const [durationSeconds, setDurationSeconds] = useState<number>(0);
+  const [constant, setConstant] = useState<number>(0);
 
   const handleSubmit = async (e: React.FormEvent) => {
     e.preventDefault();
@@ -23,6 +29,11 @@ export const CreateChart = () => {
         difficultyType,
         level,
         chartViewLink,
+        noteCount,
+        bpmMin,
+        bpmMax,
+        durationSeconds,
+        constant,
       });
 
       alert("Chart created successfully!");
@@ -30,6 +41,11 @@ export const CreateChart = () => {
       setDifficultyType(DifficultyType.UNSPECIFIED);
       setLevel(0);
       setChartViewLink("");
+      setNoteCount(0);
+      setBpmMin(0);
+      setBpmMax(0);
+      setDurationSeconds(0);
+      setConstant(0);
       window.location.reload();
     } catch (error) {
       if (
@@ -121,6 +137,53 @@ export const CreateChart = () => {
           />
         </label>
       </div>
+      <div>
+        <label>
+          NoteCount:
+          <input
+            type="number"
+            value={noteCount}
+            onChange={(e) => setNoteCount(Number(e.target.value))}
+          />
+        </label>
+      </div>
+      <div>
+        <label>
+          BPM:
+          <input
+            type="number"
+            value={bpmMin}
+            onChange={(e) => setBpmMin(Number(e.target.value))}
+          />
+          -
+          <input
+            type="number"
+            value={bpmMax}
+            onChange={(e) => setBpmMax(Number(e.target.value))}
+          />
+        </label>
+      </div>
+      <div>
+        <label>
+          Duration(秒):
+          <input
+            type="number"
+            value={durationSeconds}
+            onChange={(e) => setDurationSeconds(Number(e.target.value))}
+          />
+        </label>
+      </div>
+      <div>
+        <label>
+          Constant:
+          <input
+            type="number"
+            step="0.1"
+            value={constant}
+            onChange={(e) => setConstant(Number(e.target.value))}
+          />
+        </label>
+      </div>
       <button type="submit">Create</button>
     </form>
   );
@@ -2,7 +2,7 @@ import { useState } from "react";
 import { masterClient } from "../lib/grpcClient";
 import { Timestamp } from "@bufbuild/protobuf"; // Timestamp をインポート
 import { ConnectError } from "@bufbuild/connect"; // ConnectError をインポート
-import { MusicVideoType } from "../gen/enums/master_pb";
+import { CreditRole, MusicVideoType } from "../gen/enums/master_pb";
 import { useArtists, useUnits } from "../hooks/useMasterLists";
 import Select from "react-select";
 import { API_BASE_URL } from "../lib/constants";
@@ -13,9 +13,10 @@ export const CreateSong = () => {
 
   const [name, setName] = useState<string>("");
   const [kana, setKana] = useState<string>("");
-  const [lyricsId, setLyricsId] = useState<number>(0);
-  const [musicId, setMusicId] = useState<number>(0);
-  const [arrangementId, setArrangementId] = useState<number>(0);
+  // 同じ役割は選択した順にクレジットされる
+  const [lyricsIds, setLyricsIds] = useState<number[]>([]);
+  const [musicIds, setMusicIds] = useState<number[]>([]);
+  const [arrangementIds, setArrangementIds] = useState<number[]>([]);
   const [thumbnail, setThumbnail] = useState<string>("");
   const [thumbnailFile, setThumbnailFile] = useState<File | null>(null); // ファイルを保持
   const [originalVideo, setOriginalVideo] = useState<string>("");
@@ -26,6 +27,10 @@ export const CreateSong = () => {
   const [uploading, setUploading] = useState<boolean>(false);
 
   const unitOptions = units.map((u) => ({ value: u.id, label: u.name }));
+  const artistOptions = artists.map((a) => ({ value: a.id, label: a.name }));
+  // 選択した順に並べる
+  const selectedArtistOptions = (ids: number[]) =>
+    ids.flatMap((id) => artistOptions.filter((opt) => opt.value === id));
 
   const handleMusicVideoTypeChange = (type: MusicVideoType) => {
     setMusicVideoTypes((prev) =>
@@ -86,14 +91,34 @@ export const CreateSong = () => {
       return;
     }
 
+    if (
+      lyricsIds.length === 0 ||
+      musicIds.length === 0 ||
+      arrangementIds.length === 0
+    ) {
+      alert("作詞・作曲・編曲をそれぞれ1人以上選択してください");
+      return;
+    }
+
     console.log(Timestamp.fromDate(new Date(releaseTime)));
     try {
       await masterClient.createSong({
         name,
         kana,
-        lyricsId,
-        musicId,
-        arrangementId,
+        credits: [
+          ...lyricsIds.map((artistId) => ({
+            artistId,
+            role: CreditRole.LYRICS,
+          })),
+          ...musicIds.map((artistId) => ({
+            artistId,
+            role: CreditRole.MUSIC,
+          })),
+          ...arrangementIds.map((artistId) => ({
+            artistId,
+            role: CreditRole.ARRANGEMENT,
+          })),
+        ],
         thumbnail,
         originalVideo,
         releaseTime: Timestamp.fromDate(new Date(releaseTime)),
@@ -105,9 +130,9 @@ export const CreateSong = () => {
       alert("Song created successfully!");
       setName("");
       setKana("");
-      setLyricsId(0);
-      setMusicId(0);
-      setArrangementId(0);
+      setLyricsIds([]);
+      setMusicIds([]);
+      setArrangementIds([]);
       setThumbnail("");
       setThumbnailFile(null);
       setOriginalVideo("");
@@ -186,52 +211,34 @@ export const CreateSong = () => {
       <div>
         <label>
           Lyrics:
-          <select
-            value={lyricsId}
-            onChange={(e) => setLyricsId(Number(e.target.value))}
-            required
-          >
-            <option value={0}>選択してください</option>
-            {artists.map((artist) => (
-              <option key={artist.id} value={artist.id}>
-                {artist.name}
-              </option>
-            ))}
-          </select>
+          <Select
+            isMulti
+            options={artistOptions}
+            value={selectedArtistOptions(lyricsIds)}
+            onChange={(opts) => setLyricsIds(opts.map((opt) => opt.value))}
+          />
         </label>
       </div>
       <div>
         <label>
           Music:
-          <select
-            value={musicId}
-            onChange={(e) => setMusicId(Number(e.target.value))}
-            required
-          >
-            <option value={0}>選択してください</option>
-            {artists.map((artist) => (
-              <option key={artist.id} value={artist.id}>
-                {artist.name}
-              </option>
-            ))}
-          </select>
+          <Select
+            isMulti
+            options={artistOptions}
+            value={selectedArtistOptions(musicIds)}
+            onChange={(opts) => setMusicIds(opts.map((opt) => opt.value))}
+          />
         </label>
       </div>
       <div>
         <label>
           Arrangement:
-          <select
-            value={arrangementId}
-            onChange={(e) => setArrangementId(Number(e.target.value))}
-            required
-          >
-            <option value={0}>選択してください</option>
-            {artists.map((artist) => (
-              <option key={artist.id} value={artist.id}>
-                {artist.name}
-              </option>
-            ))}
-          </select>
+          <Select
+            isMulti
+            options={artistOptions}
+            value={selectedArtistOptions(arrangementIds)}
+            onChange={(opts) => setArrangementIds(opts.map((opt) => opt.value))}
+          />
         </label>
       </div>
       <div>
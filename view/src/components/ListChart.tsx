@@ -25,6 +25,31 @@ const ChartDetailModal = ({ chart, onClose }: ChartDetailModalProps) => {
           <p>
             <strong>レベル:</strong> {chart.level}
           </p>
+          {chart.constant > 0 && (
+            <p>
+              <strong>定数:</strong> {chart.constant}
+            </p>
+          )}
+          {chart.noteCount > 0 && (
+            <p>
+              <strong>ノーツ数:</strong> {chart.noteCount}
+            </p>
+          )}
+          {chart.bpmMax > 0 && (
+            <p>
+              <strong>BPM:</strong>{" "}
+              {chart.bpmMin === chart.bpmMax
+                ? chart.bpmMax
+                : `${chart.bpmMin}-${chart.bpmMax}`}
+            </p>
+          )}
+          {chart.durationSeconds > 0 && (
+            <p>
+              <strong>演奏時間:</strong>{" "}
+              {Math.floor(chart.durationSeconds / 60)}:
+              {String(chart.durationSeconds % 60).padStart(2, "0")}
+            </p>
+          )}
           <p>
             <strong>譜面ビュー:</strong>{" "}
             <a
@@ -91,3 +91,197 @@ proto3.util.setEnumType(MusicVideoType, "enums.MusicVideoType", [
   { no: 3, name: "MUSIC_VIDEO_TYPE_ORIGINAL" },
 ]);
 
+/**
+ * CreditRoles
+ *
+ * @generated from enum enums.CreditRole
+ */
+export enum CreditRole {
+  /**
+   * @generated from enum value: CREDIT_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CREDIT_ROLE_LYRICS = 1;
+   */
+  LYRICS = 1,
+
+  /**
+   * @generated from enum value: CREDIT_ROLE_MUSIC = 2;
+   */
+  MUSIC = 2,
+
+  /**
+   * @generated from enum value: CREDIT_ROLE_ARRANGEMENT = 3;
+   */
+  ARRANGEMENT = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(CreditRole)
+proto3.util.setEnumType(CreditRole, "enums.CreditRole", [
+  { no: 0, name: "CREDIT_ROLE_UNSPECIFIED" },
+  { no: 1, name: "CREDIT_ROLE_LYRICS" },
+  { no: 2, name: "CREDIT_ROLE_MUSIC" },
+  { no: 3, name: "CREDIT_ROLE_ARRANGEMENT" },
+]);
+
+/**
+ * MasterBundleFormats
+ *
+ * @generated from enum enums.MasterBundleFormat
+ */
+export enum MasterBundleFormat {
+  /**
+   * @generated from enum value: MASTER_BUNDLE_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MASTER_BUNDLE_FORMAT_JSON = 1;
+   */
+  JSON = 1,
+
+  /**
+   * @generated from enum value: MASTER_BUNDLE_FORMAT_CSV_ZIP = 2;
+   */
+  CSV_ZIP = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MasterBundleFormat)
+proto3.util.setEnumType(MasterBundleFormat, "enums.MasterBundleFormat", [
+  { no: 0, name: "MASTER_BUNDLE_FORMAT_UNSPECIFIED" },
+  { no: 1, name: "MASTER_BUNDLE_FORMAT_JSON" },
+  { no: 2, name: "MASTER_BUNDLE_FORMAT_CSV_ZIP" },
+]);
+
+/**
+ * MasterChangeOperations
+ *
+ * @generated from enum enums.MasterChangeOperation
+ */
+export enum MasterChangeOperation {
+  /**
+   * @generated from enum value: MASTER_CHANGE_OPERATION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MASTER_CHANGE_OPERATION_INSERT = 1;
+   */
+  INSERT = 1,
+
+  /**
+   * @generated from enum value: MASTER_CHANGE_OPERATION_UPDATE = 2;
+   */
+  UPDATE = 2,
+
+  /**
+   * @generated from enum value: MASTER_CHANGE_OPERATION_DELETE = 3;
+   */
+  DELETE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MasterChangeOperation)
+proto3.util.setEnumType(MasterChangeOperation, "enums.MasterChangeOperation", [
+  { no: 0, name: "MASTER_CHANGE_OPERATION_UNSPECIFIED" },
+  { no: 1, name: "MASTER_CHANGE_OPERATION_INSERT" },
+  { no: 2, name: "MASTER_CHANGE_OPERATION_UPDATE" },
+  { no: 3, name: "MASTER_CHANGE_OPERATION_DELETE" },
+]);
+
+/**
+ * MasterKinds
+ *
+ * @generated from enum enums.MasterKind
+ */
+export enum MasterKind {
+  /**
+   * @generated from enum value: MASTER_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MASTER_KIND_ARTIST = 1;
+   */
+  ARTIST = 1,
+
+  /**
+   * @generated from enum value: MASTER_KIND_SINGER = 2;
+   */
+  SINGER = 2,
+
+  /**
+   * @generated from enum value: MASTER_KIND_UNIT = 3;
+   */
+  UNIT = 3,
+
+  /**
+   * @generated from enum value: MASTER_KIND_SONG = 4;
+   */
+  SONG = 4,
+
+  /**
+   * @generated from enum value: MASTER_KIND_VOCAL_PATTERN = 5;
+   */
+  VOCAL_PATTERN = 5,
+
+  /**
+   * @generated from enum value: MASTER_KIND_CHART = 6;
+   */
+  CHART = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MasterKind)
+proto3.util.setEnumType(MasterKind, "enums.MasterKind", [
+  { no: 0, name: "MASTER_KIND_UNSPECIFIED" },
+  { no: 1, name: "MASTER_KIND_ARTIST" },
+  { no: 2, name: "MASTER_KIND_SINGER" },
+  { no: 3, name: "MASTER_KIND_UNIT" },
+  { no: 4, name: "MASTER_KIND_SONG" },
+  { no: 5, name: "MASTER_KIND_VOCAL_PATTERN" },
+  { no: 6, name: "MASTER_KIND_CHART" },
+]);
+
+/**
+ * ChartSortKeys
+ *
+ * @generated from enum enums.ChartSortKey
+ */
+export enum ChartSortKey {
+  /**
+   * @generated from enum value: CHART_SORT_KEY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHART_SORT_KEY_LEVEL = 1;
+   */
+  LEVEL = 1,
+
+  /**
+   * @generated from enum value: CHART_SORT_KEY_NOTE_COUNT = 2;
+   */
+  NOTE_COUNT = 2,
+
+  /**
+   * @generated from enum value: CHART_SORT_KEY_BPM = 3;
+   */
+  BPM = 3,
+
+  /**
+   * @generated from enum value: CHART_SORT_KEY_DURATION = 4;
+   */
+  DURATION = 4,
+
+  /**
+   * @generated from enum value: CHART_SORT_KEY_CONSTANT = 5;
+   */
+  CONSTANT = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ChartSortKey)
+proto3.util.setEnumType(ChartSortKey, "enums.ChartSortKey", [
+  { no: 0, name: "CHART_SORT_KEY_UNSPECIFIED" },
+  { no: 1, name: "CHART_SORT_KEY_LEVEL" },
+  { no: 2, name: "CHART_SORT_KEY_NOTE_COUNT" },
+  { no: 3, name: "CHART_SORT_KEY_BPM" },
+  { no: 4, name: "CHART_SORT_KEY_DURATION" },
+  { no: 5, name: "CHART_SORT_KEY_CONSTANT" },
+]);
+
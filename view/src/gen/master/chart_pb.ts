@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
 import { Song } from "./song_pb.js";
 import { DifficultyType } from "../enums/master_pb.js";
 
@@ -39,6 +39,35 @@ export class Chart extends Message<Chart> {
    */
   chartViewLink = "";
 
+  /**
+   * 以下は任意 0は未設定
+   *
+   * @generated from field: int32 note_count = 6;
+   */
+  noteCount = 0;
+
+  /**
+   * @generated from field: int32 bpm_min = 7;
+   */
+  bpmMin = 0;
+
+  /**
+   * @generated from field: int32 bpm_max = 8;
+   */
+  bpmMax = 0;
+
+  /**
+   * @generated from field: int32 duration_seconds = 9;
+   */
+  durationSeconds = 0;
+
+  /**
+   * 非公式の難易度定数
+   *
+   * @generated from field: double constant = 10;
+   */
+  constant = 0;
+
   constructor(data?: PartialMessage<Chart>) {
     super();
     proto3.util.initPartial(data, this);
@@ -52,6 +81,11 @@ export class Chart extends Message<Chart> {
     { no: 3, name: "difficulty_type", kind: "enum", T: proto3.getEnumType(DifficultyType) },
     { no: 4, name: "level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 5, name: "chart_view_link", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "note_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "bpm_min", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "bpm_max", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "constant", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Chart {
@@ -71,3 +105,55 @@ export class Chart extends Message<Chart> {
   }
 }
 
+/**
+ * ChartLevelHistories
+ * effective_fromからlevelが適用される
+ *
+ * @generated from message master.ChartLevelHistory
+ */
+export class ChartLevelHistory extends Message<ChartLevelHistory> {
+  /**
+   * @generated from field: int32 level = 1;
+   */
+  level = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp effective_from = 2;
+   */
+  effectiveFrom?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<ChartLevelHistory>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.ChartLevelHistory";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "effective_from", kind: "message", T: Timestamp },
+    { no: 3, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChartLevelHistory {
+    return new ChartLevelHistory().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChartLevelHistory {
+    return new ChartLevelHistory().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChartLevelHistory {
+    return new ChartLevelHistory().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChartLevelHistory | PlainMessage<ChartLevelHistory> | undefined, b: ChartLevelHistory | PlainMessage<ChartLevelHistory> | undefined): boolean {
+    return proto3.util.equals(ChartLevelHistory, a, b);
+  }
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateArtistRequest, CreateArtistResponse, CreateChartRequest, CreateChartResponse, CreateSingerRequest, CreateSingerResponse, CreateSongRequest, CreateSongResponse, CreateUnitRequest, CreateUnitResponse, CreateVocalPatternRequest, CreateVocalPatternResponse, GetArtistRequest, GetArtistResponse, GetArtistsRequest, GetArtistsResponse, GetChartRequest, GetChartResponse, GetChartsRequest, GetChartsResponse, GetSingerRequest, GetSingerResponse, GetSingersRequest, GetSingersResponse, GetSongRequest, GetSongResponse, GetSongsRequest, GetSongsResponse, GetUnitRequest, GetUnitResponse, GetUnitsRequest, GetUnitsResponse } from "./master_pb.js";
+import { CreateArtistRequest, CreateArtistResponse, CreateChartRequest, CreateChartResponse, CreateSingerRequest, CreateSingerResponse, CreateSongRequest, CreateSongResponse, CreateUnitRequest, CreateUnitResponse, CreateUnitSingerRequest, CreateUnitSingerResponse, CreateVocalPatternRequest, CreateVocalPatternResponse, DeleteArtistRequest, DeleteArtistResponse, DeleteChartRequest, DeleteChartResponse, DeleteSongRequest, DeleteSongResponse, DeleteUnitSingerRequest, DeleteUnitSingerResponse, DeleteVocalPatternRequest, DeleteVocalPatternResponse, ExportMasterRequest, ExportMasterResponse, GetArtistRequest, GetArtistResponse, GetArtistsRequest, GetArtistsResponse, GetChartHistoryRequest, GetChartHistoryResponse, GetChartRequest, GetChartResponse, GetChartsRequest, GetChartsResponse, GetMasterDeltaRequest, GetMasterDeltaResponse, GetMasterVersionRequest, GetMasterVersionResponse, GetSingerRequest, GetSingerResponse, GetSingersRequest, GetSingersResponse, GetSongRequest, GetSongResponse, GetSongsRequest, GetSongsResponse, GetUnitRequest, GetUnitResponse, GetUnitsRequest, GetUnitsResponse, GetVocalPatternRequest, GetVocalPatternResponse, GetVocalPatternsRequest, GetVocalPatternsResponse, ImportMasterRequest, ImportMasterResponse, ListSongReleasesRequest, ListSongReleasesResponse, MergeArtistsRequest, MergeArtistsResponse, SearchChartsRequest, SearchChartsResponse, SearchSongsRequest, SearchSongsResponse, UpdateArtistRequest, UpdateArtistResponse, UpdateChartRequest, UpdateChartResponse, UpdateSingerRequest, UpdateSingerResponse, UpdateSongRequest, UpdateSongResponse, UpdateUnitRequest, UpdateUnitResponse, UpdateUnitSingerRequest, UpdateUnitSingerResponse, UpdateVocalPatternRequest, UpdateVocalPatternResponse, WatchMasterRequest, WatchMasterResponse } from "./master_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -41,6 +41,33 @@ export const MasterService = {
       O: CreateArtistResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc master.MasterService.UpdateArtist
+     */
+    updateArtist: {
+      name: "UpdateArtist",
+      I: UpdateArtistRequest,
+      O: UpdateArtistResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.DeleteArtist
+     */
+    deleteArtist: {
+      name: "DeleteArtist",
+      I: DeleteArtistRequest,
+      O: DeleteArtistResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.MergeArtists
+     */
+    mergeArtists: {
+      name: "MergeArtists",
+      I: MergeArtistsRequest,
+      O: MergeArtistsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Singer
      *
@@ -70,6 +97,15 @@ export const MasterService = {
       O: CreateSingerResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc master.MasterService.UpdateSinger
+     */
+    updateSinger: {
+      name: "UpdateSinger",
+      I: UpdateSingerRequest,
+      O: UpdateSingerResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Unit
      *
@@ -99,11 +135,63 @@ export const MasterService = {
       O: CreateUnitResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc master.MasterService.UpdateUnit
+     */
+    updateUnit: {
+      name: "UpdateUnit",
+      I: UpdateUnitRequest,
+      O: UpdateUnitResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.CreateUnitSinger
+     */
+    createUnitSinger: {
+      name: "CreateUnitSinger",
+      I: CreateUnitSingerRequest,
+      O: CreateUnitSingerResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.UpdateUnitSinger
+     */
+    updateUnitSinger: {
+      name: "UpdateUnitSinger",
+      I: UpdateUnitSingerRequest,
+      O: UpdateUnitSingerResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.DeleteUnitSinger
+     */
+    deleteUnitSinger: {
+      name: "DeleteUnitSinger",
+      I: DeleteUnitSingerRequest,
+      O: DeleteUnitSingerResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * VocalPattern
-     * rpc GetVocalPatterns(GetVocalPatternsRequest) returns (GetVocalPatternsResponse);
-     * rpc GetVocalPattern(GetVocalPatternRequest) returns (GetVocalPatternResponse);
      *
+     * @generated from rpc master.MasterService.GetVocalPatterns
+     */
+    getVocalPatterns: {
+      name: "GetVocalPatterns",
+      I: GetVocalPatternsRequest,
+      O: GetVocalPatternsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.GetVocalPattern
+     */
+    getVocalPattern: {
+      name: "GetVocalPattern",
+      I: GetVocalPatternRequest,
+      O: GetVocalPatternResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
      * @generated from rpc master.MasterService.CreateVocalPattern
      */
     createVocalPattern: {
@@ -112,6 +200,24 @@ export const MasterService = {
       O: CreateVocalPatternResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc master.MasterService.UpdateVocalPattern
+     */
+    updateVocalPattern: {
+      name: "UpdateVocalPattern",
+      I: UpdateVocalPatternRequest,
+      O: UpdateVocalPatternResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.DeleteVocalPattern
+     */
+    deleteVocalPattern: {
+      name: "DeleteVocalPattern",
+      I: DeleteVocalPatternRequest,
+      O: DeleteVocalPatternResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Song
      *
@@ -141,6 +247,42 @@ export const MasterService = {
       O: CreateSongResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc master.MasterService.UpdateSong
+     */
+    updateSong: {
+      name: "UpdateSong",
+      I: UpdateSongRequest,
+      O: UpdateSongResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.DeleteSong
+     */
+    deleteSong: {
+      name: "DeleteSong",
+      I: DeleteSongRequest,
+      O: DeleteSongResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.SearchSongs
+     */
+    searchSongs: {
+      name: "SearchSongs",
+      I: SearchSongsRequest,
+      O: SearchSongsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.ListSongReleases
+     */
+    listSongReleases: {
+      name: "ListSongReleases",
+      I: ListSongReleasesRequest,
+      O: ListSongReleasesResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Chart
      *
@@ -170,6 +312,93 @@ export const MasterService = {
       O: CreateChartResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc master.MasterService.UpdateChart
+     */
+    updateChart: {
+      name: "UpdateChart",
+      I: UpdateChartRequest,
+      O: UpdateChartResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.DeleteChart
+     */
+    deleteChart: {
+      name: "DeleteChart",
+      I: DeleteChartRequest,
+      O: DeleteChartResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.SearchCharts
+     */
+    searchCharts: {
+      name: "SearchCharts",
+      I: SearchChartsRequest,
+      O: SearchChartsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.GetChartHistory
+     */
+    getChartHistory: {
+      name: "GetChartHistory",
+      I: GetChartHistoryRequest,
+      O: GetChartHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * MasterImport
+     *
+     * @generated from rpc master.MasterService.ImportMaster
+     */
+    importMaster: {
+      name: "ImportMaster",
+      I: ImportMasterRequest,
+      O: ImportMasterResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.ExportMaster
+     */
+    exportMaster: {
+      name: "ExportMaster",
+      I: ExportMasterRequest,
+      O: ExportMasterResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * MasterRevision
+     *
+     * @generated from rpc master.MasterService.GetMasterVersion
+     */
+    getMasterVersion: {
+      name: "GetMasterVersion",
+      I: GetMasterVersionRequest,
+      O: GetMasterVersionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc master.MasterService.GetMasterDelta
+     */
+    getMasterDelta: {
+      name: "GetMasterDelta",
+      I: GetMasterDeltaRequest,
+      O: GetMasterDeltaResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * マスタの変更を通知し続ける 受け取ったらGetMasterDeltaで内容を取り直す
+     *
+     * @generated from rpc master.MasterService.WatchMaster
+     */
+    watchMaster: {
+      name: "WatchMaster",
+      I: WatchMasterRequest,
+      O: WatchMasterResponse,
+      kind: MethodKind.ServerStreaming,
+    },
   }
 } as const;
 
@@ -4,13 +4,66 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+import { ChartSortKey, CreditRole, DifficultyType, MasterBundleFormat, MasterChangeOperation, MasterKind, MusicVideoType } from "../enums/master_pb.js";
 import { Artist } from "./artist_pb.js";
 import { Singer } from "./singer_pb.js";
 import { Unit } from "./unit_pb.js";
-import { Song } from "./song_pb.js";
-import { DifficultyType, MusicVideoType } from "../enums/master_pb.js";
-import { Chart } from "./chart_pb.js";
+import { VocalPattern } from "./vocal_pattern_pb.js";
+import { Song, SongReleaseMonth } from "./song_pb.js";
+import { Chart, ChartLevelHistory } from "./chart_pb.js";
+
+/**
+ * AlreadyExistsのエラーに付ける詳細 衝突した既存のマスタ
+ * 譜面のnameは"曲名/難易度"
+ *
+ * @generated from message master.MasterConflict
+ */
+export class MasterConflict extends Message<MasterConflict> {
+  /**
+   * @generated from field: enums.MasterKind kind = 1;
+   */
+  kind = MasterKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: int32 id = 2;
+   */
+  id = 0;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<MasterConflict>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.MasterConflict";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(MasterKind) },
+    { no: 2, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MasterConflict {
+    return new MasterConflict().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MasterConflict {
+    return new MasterConflict().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MasterConflict {
+    return new MasterConflict().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MasterConflict | PlainMessage<MasterConflict> | undefined, b: MasterConflict | PlainMessage<MasterConflict> | undefined): boolean {
+    return proto3.util.equals(MasterConflict, a, b);
+  }
+}
 
 /**
  * Artist
@@ -230,6 +283,230 @@ export class CreateArtistResponse extends Message<CreateArtistResponse> {
   }
 }
 
+/**
+ * @generated from message master.UpdateArtistRequest
+ */
+export class UpdateArtistRequest extends Message<UpdateArtistRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string kana = 3;
+   */
+  kana = "";
+
+  constructor(data?: PartialMessage<UpdateArtistRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UpdateArtistRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "kana", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateArtistRequest {
+    return new UpdateArtistRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateArtistRequest {
+    return new UpdateArtistRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateArtistRequest {
+    return new UpdateArtistRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateArtistRequest | PlainMessage<UpdateArtistRequest> | undefined, b: UpdateArtistRequest | PlainMessage<UpdateArtistRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateArtistRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.UpdateArtistResponse
+ */
+export class UpdateArtistResponse extends Message<UpdateArtistResponse> {
+  constructor(data?: PartialMessage<UpdateArtistResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UpdateArtistResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateArtistResponse {
+    return new UpdateArtistResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateArtistResponse {
+    return new UpdateArtistResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateArtistResponse {
+    return new UpdateArtistResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateArtistResponse | PlainMessage<UpdateArtistResponse> | undefined, b: UpdateArtistResponse | PlainMessage<UpdateArtistResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateArtistResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.DeleteArtistRequest
+ */
+export class DeleteArtistRequest extends Message<DeleteArtistRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  constructor(data?: PartialMessage<DeleteArtistRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.DeleteArtistRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteArtistRequest {
+    return new DeleteArtistRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteArtistRequest {
+    return new DeleteArtistRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteArtistRequest {
+    return new DeleteArtistRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteArtistRequest | PlainMessage<DeleteArtistRequest> | undefined, b: DeleteArtistRequest | PlainMessage<DeleteArtistRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteArtistRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.DeleteArtistResponse
+ */
+export class DeleteArtistResponse extends Message<DeleteArtistResponse> {
+  constructor(data?: PartialMessage<DeleteArtistResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.DeleteArtistResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteArtistResponse {
+    return new DeleteArtistResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteArtistResponse {
+    return new DeleteArtistResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteArtistResponse {
+    return new DeleteArtistResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteArtistResponse | PlainMessage<DeleteArtistResponse> | undefined, b: DeleteArtistResponse | PlainMessage<DeleteArtistResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteArtistResponse, a, b);
+  }
+}
+
+/**
+ * sourceの作詞・作曲・編曲をtargetに付け替えて、sourceを削除する
+ *
+ * @generated from message master.MergeArtistsRequest
+ */
+export class MergeArtistsRequest extends Message<MergeArtistsRequest> {
+  /**
+   * @generated from field: int32 source_artist_id = 1;
+   */
+  sourceArtistId = 0;
+
+  /**
+   * @generated from field: int32 target_artist_id = 2;
+   */
+  targetArtistId = 0;
+
+  constructor(data?: PartialMessage<MergeArtistsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.MergeArtistsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source_artist_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "target_artist_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MergeArtistsRequest {
+    return new MergeArtistsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MergeArtistsRequest {
+    return new MergeArtistsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MergeArtistsRequest {
+    return new MergeArtistsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MergeArtistsRequest | PlainMessage<MergeArtistsRequest> | undefined, b: MergeArtistsRequest | PlainMessage<MergeArtistsRequest> | undefined): boolean {
+    return proto3.util.equals(MergeArtistsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.MergeArtistsResponse
+ */
+export class MergeArtistsResponse extends Message<MergeArtistsResponse> {
+  constructor(data?: PartialMessage<MergeArtistsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.MergeArtistsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MergeArtistsResponse {
+    return new MergeArtistsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MergeArtistsResponse {
+    return new MergeArtistsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MergeArtistsResponse {
+    return new MergeArtistsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MergeArtistsResponse | PlainMessage<MergeArtistsResponse> | undefined, b: MergeArtistsResponse | PlainMessage<MergeArtistsResponse> | undefined): boolean {
+    return proto3.util.equals(MergeArtistsResponse, a, b);
+  }
+}
+
 /**
  * Singer
  *
@@ -442,6 +719,80 @@ export class CreateSingerResponse extends Message<CreateSingerResponse> {
   }
 }
 
+/**
+ * @generated from message master.UpdateSingerRequest
+ */
+export class UpdateSingerRequest extends Message<UpdateSingerRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<UpdateSingerRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UpdateSingerRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSingerRequest {
+    return new UpdateSingerRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSingerRequest {
+    return new UpdateSingerRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSingerRequest {
+    return new UpdateSingerRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSingerRequest | PlainMessage<UpdateSingerRequest> | undefined, b: UpdateSingerRequest | PlainMessage<UpdateSingerRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSingerRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.UpdateSingerResponse
+ */
+export class UpdateSingerResponse extends Message<UpdateSingerResponse> {
+  constructor(data?: PartialMessage<UpdateSingerResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UpdateSingerResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSingerResponse {
+    return new UpdateSingerResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSingerResponse {
+    return new UpdateSingerResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSingerResponse {
+    return new UpdateSingerResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSingerResponse | PlainMessage<UpdateSingerResponse> | undefined, b: UpdateSingerResponse | PlainMessage<UpdateSingerResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSingerResponse, a, b);
+  }
+}
+
 /**
  * Unit
  *
@@ -655,602 +1006,2795 @@ export class CreateUnitResponse extends Message<CreateUnitResponse> {
 }
 
 /**
- * VocalPattern
- * message GetVocalPatternsRequest {}
- * message GetVocalPatternsResponse {
- *   repeated master.VocalPattern vocal_patterns = 1;
- * }
- * message GetVocalPatternRequest {
- *   int32 id = 1;
- * }
- * message GetVocalPatternResponse {
- *   master.VocalPattern vocal_pattern = 1;
- * }
- *
- * @generated from message master.CreateVocalPatternRequest
+ * @generated from message master.UpdateUnitRequest
  */
-export class CreateVocalPatternRequest extends Message<CreateVocalPatternRequest> {
+export class UpdateUnitRequest extends Message<UpdateUnitRequest> {
   /**
-   * @generated from field: int32 song_id = 1;
+   * @generated from field: int32 id = 1;
    */
-  songId = 0;
+  id = 0;
 
   /**
    * @generated from field: string name = 2;
    */
   name = "";
 
-  /**
-   * @generated from field: repeated int32 singer_ids = 3;
-   */
-  singerIds: number[] = [];
-
-  /**
-   * @generated from field: repeated int32 singer_positions = 4;
-   */
-  singerPositions: number[] = [];
-
-  constructor(data?: PartialMessage<CreateVocalPatternRequest>) {
+  constructor(data?: PartialMessage<UpdateUnitRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.CreateVocalPatternRequest";
+  static readonly typeName = "master.UpdateUnitRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "song_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "singer_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
-    { no: 4, name: "singer_positions", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateVocalPatternRequest {
-    return new CreateVocalPatternRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUnitRequest {
+    return new UpdateUnitRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateVocalPatternRequest {
-    return new CreateVocalPatternRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUnitRequest {
+    return new UpdateUnitRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateVocalPatternRequest {
-    return new CreateVocalPatternRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUnitRequest {
+    return new UpdateUnitRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateVocalPatternRequest | PlainMessage<CreateVocalPatternRequest> | undefined, b: CreateVocalPatternRequest | PlainMessage<CreateVocalPatternRequest> | undefined): boolean {
-    return proto3.util.equals(CreateVocalPatternRequest, a, b);
+  static equals(a: UpdateUnitRequest | PlainMessage<UpdateUnitRequest> | undefined, b: UpdateUnitRequest | PlainMessage<UpdateUnitRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateUnitRequest, a, b);
   }
 }
 
 /**
- * @generated from message master.CreateVocalPatternResponse
+ * @generated from message master.UpdateUnitResponse
  */
-export class CreateVocalPatternResponse extends Message<CreateVocalPatternResponse> {
-  constructor(data?: PartialMessage<CreateVocalPatternResponse>) {
+export class UpdateUnitResponse extends Message<UpdateUnitResponse> {
+  constructor(data?: PartialMessage<UpdateUnitResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.CreateVocalPatternResponse";
+  static readonly typeName = "master.UpdateUnitResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateVocalPatternResponse {
-    return new CreateVocalPatternResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUnitResponse {
+    return new UpdateUnitResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateVocalPatternResponse {
-    return new CreateVocalPatternResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUnitResponse {
+    return new UpdateUnitResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateVocalPatternResponse {
-    return new CreateVocalPatternResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUnitResponse {
+    return new UpdateUnitResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateVocalPatternResponse | PlainMessage<CreateVocalPatternResponse> | undefined, b: CreateVocalPatternResponse | PlainMessage<CreateVocalPatternResponse> | undefined): boolean {
-    return proto3.util.equals(CreateVocalPatternResponse, a, b);
+  static equals(a: UpdateUnitResponse | PlainMessage<UpdateUnitResponse> | undefined, b: UpdateUnitResponse | PlainMessage<UpdateUnitResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateUnitResponse, a, b);
   }
 }
 
 /**
- * Song
+ * UnitSinger
  *
- * @generated from message master.GetSongsRequest
+ * @generated from message master.CreateUnitSingerRequest
  */
-export class GetSongsRequest extends Message<GetSongsRequest> {
-  constructor(data?: PartialMessage<GetSongsRequest>) {
+export class CreateUnitSingerRequest extends Message<CreateUnitSingerRequest> {
+  /**
+   * @generated from field: int32 unit_id = 1;
+   */
+  unitId = 0;
+
+  /**
+   * @generated from field: int32 singer_id = 2;
+   */
+  singerId = 0;
+
+  /**
+   * @generated from field: int32 position = 3;
+   */
+  position = 0;
+
+  constructor(data?: PartialMessage<CreateUnitSingerRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.GetSongsRequest";
+  static readonly typeName = "master.CreateUnitSingerRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "unit_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "singer_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "position", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSongsRequest {
-    return new GetSongsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUnitSingerRequest {
+    return new CreateUnitSingerRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSongsRequest {
-    return new GetSongsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUnitSingerRequest {
+    return new CreateUnitSingerRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSongsRequest {
-    return new GetSongsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUnitSingerRequest {
+    return new CreateUnitSingerRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSongsRequest | PlainMessage<GetSongsRequest> | undefined, b: GetSongsRequest | PlainMessage<GetSongsRequest> | undefined): boolean {
-    return proto3.util.equals(GetSongsRequest, a, b);
+  static equals(a: CreateUnitSingerRequest | PlainMessage<CreateUnitSingerRequest> | undefined, b: CreateUnitSingerRequest | PlainMessage<CreateUnitSingerRequest> | undefined): boolean {
+    return proto3.util.equals(CreateUnitSingerRequest, a, b);
   }
 }
 
 /**
- * @generated from message master.GetSongsResponse
+ * @generated from message master.CreateUnitSingerResponse
  */
-export class GetSongsResponse extends Message<GetSongsResponse> {
-  /**
-   * @generated from field: repeated master.Song songs = 1;
-   */
-  songs: Song[] = [];
-
-  constructor(data?: PartialMessage<GetSongsResponse>) {
+export class CreateUnitSingerResponse extends Message<CreateUnitSingerResponse> {
+  constructor(data?: PartialMessage<CreateUnitSingerResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.GetSongsResponse";
+  static readonly typeName = "master.CreateUnitSingerResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "songs", kind: "message", T: Song, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSongsResponse {
-    return new GetSongsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUnitSingerResponse {
+    return new CreateUnitSingerResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSongsResponse {
-    return new GetSongsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUnitSingerResponse {
+    return new CreateUnitSingerResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSongsResponse {
-    return new GetSongsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUnitSingerResponse {
+    return new CreateUnitSingerResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSongsResponse | PlainMessage<GetSongsResponse> | undefined, b: GetSongsResponse | PlainMessage<GetSongsResponse> | undefined): boolean {
-    return proto3.util.equals(GetSongsResponse, a, b);
+  static equals(a: CreateUnitSingerResponse | PlainMessage<CreateUnitSingerResponse> | undefined, b: CreateUnitSingerResponse | PlainMessage<CreateUnitSingerResponse> | undefined): boolean {
+    return proto3.util.equals(CreateUnitSingerResponse, a, b);
   }
 }
 
 /**
- * @generated from message master.GetSongRequest
+ * @generated from message master.UpdateUnitSingerRequest
  */
-export class GetSongRequest extends Message<GetSongRequest> {
+export class UpdateUnitSingerRequest extends Message<UpdateUnitSingerRequest> {
   /**
-   * @generated from field: int32 id = 1;
+   * @generated from field: int32 unit_id = 1;
    */
-  id = 0;
+  unitId = 0;
 
-  constructor(data?: PartialMessage<GetSongRequest>) {
+  /**
+   * @generated from field: int32 singer_id = 2;
+   */
+  singerId = 0;
+
+  /**
+   * @generated from field: int32 position = 3;
+   */
+  position = 0;
+
+  constructor(data?: PartialMessage<UpdateUnitSingerRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.GetSongRequest";
+  static readonly typeName = "master.UpdateUnitSingerRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "unit_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "singer_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "position", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSongRequest {
-    return new GetSongRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUnitSingerRequest {
+    return new UpdateUnitSingerRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSongRequest {
-    return new GetSongRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUnitSingerRequest {
+    return new UpdateUnitSingerRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSongRequest {
-    return new GetSongRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUnitSingerRequest {
+    return new UpdateUnitSingerRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSongRequest | PlainMessage<GetSongRequest> | undefined, b: GetSongRequest | PlainMessage<GetSongRequest> | undefined): boolean {
-    return proto3.util.equals(GetSongRequest, a, b);
+  static equals(a: UpdateUnitSingerRequest | PlainMessage<UpdateUnitSingerRequest> | undefined, b: UpdateUnitSingerRequest | PlainMessage<UpdateUnitSingerRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateUnitSingerRequest, a, b);
   }
 }
 
 /**
- * @generated from message master.GetSongResponse
+ * @generated from message master.UpdateUnitSingerResponse
  */
-export class GetSongResponse extends Message<GetSongResponse> {
-  /**
-   * @generated from field: master.Song song = 1;
-   */
-  song?: Song;
-
-  constructor(data?: PartialMessage<GetSongResponse>) {
+export class UpdateUnitSingerResponse extends Message<UpdateUnitSingerResponse> {
+  constructor(data?: PartialMessage<UpdateUnitSingerResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.GetSongResponse";
+  static readonly typeName = "master.UpdateUnitSingerResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "song", kind: "message", T: Song },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSongResponse {
-    return new GetSongResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateUnitSingerResponse {
+    return new UpdateUnitSingerResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSongResponse {
-    return new GetSongResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateUnitSingerResponse {
+    return new UpdateUnitSingerResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSongResponse {
-    return new GetSongResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateUnitSingerResponse {
+    return new UpdateUnitSingerResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSongResponse | PlainMessage<GetSongResponse> | undefined, b: GetSongResponse | PlainMessage<GetSongResponse> | undefined): boolean {
-    return proto3.util.equals(GetSongResponse, a, b);
+  static equals(a: UpdateUnitSingerResponse | PlainMessage<UpdateUnitSingerResponse> | undefined, b: UpdateUnitSingerResponse | PlainMessage<UpdateUnitSingerResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateUnitSingerResponse, a, b);
   }
 }
 
 /**
- * @generated from message master.CreateSongRequest
+ * @generated from message master.DeleteUnitSingerRequest
  */
-export class CreateSongRequest extends Message<CreateSongRequest> {
-  /**
-   * @generated from field: string name = 1;
-   */
-  name = "";
-
-  /**
-   * @generated from field: string kana = 2;
-   */
-  kana = "";
-
-  /**
-   * @generated from field: int32 lyrics_id = 3;
-   */
-  lyricsId = 0;
-
-  /**
-   * @generated from field: int32 music_id = 4;
-   */
-  musicId = 0;
-
-  /**
-   * @generated from field: int32 arrangement_id = 5;
-   */
-  arrangementId = 0;
-
-  /**
-   * @generated from field: string thumbnail = 6;
-   */
-  thumbnail = "";
-
-  /**
-   * @generated from field: string original_video = 7;
-   */
-  originalVideo = "";
-
-  /**
-   * @generated from field: google.protobuf.Timestamp release_time = 8;
-   */
-  releaseTime?: Timestamp;
-
-  /**
-   * @generated from field: bool deleted = 9;
-   */
-  deleted = false;
-
+export class DeleteUnitSingerRequest extends Message<DeleteUnitSingerRequest> {
   /**
-   * @generated from field: repeated int32 unit_ids = 10;
+   * @generated from field: int32 unit_id = 1;
    */
-  unitIds: number[] = [];
+  unitId = 0;
 
   /**
-   * @generated from field: repeated enums.MusicVideoType music_video_types = 11;
+   * @generated from field: int32 singer_id = 2;
    */
-  musicVideoTypes: MusicVideoType[] = [];
+  singerId = 0;
 
-  constructor(data?: PartialMessage<CreateSongRequest>) {
+  constructor(data?: PartialMessage<DeleteUnitSingerRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.CreateSongRequest";
+  static readonly typeName = "master.DeleteUnitSingerRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "kana", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "lyrics_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "music_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 5, name: "arrangement_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 6, name: "thumbnail", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "original_video", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 8, name: "release_time", kind: "message", T: Timestamp },
-    { no: 9, name: "deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 10, name: "unit_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
-    { no: 11, name: "music_video_types", kind: "enum", T: proto3.getEnumType(MusicVideoType), repeated: true },
+    { no: 1, name: "unit_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "singer_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSongRequest {
-    return new CreateSongRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteUnitSingerRequest {
+    return new DeleteUnitSingerRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSongRequest {
-    return new CreateSongRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteUnitSingerRequest {
+    return new DeleteUnitSingerRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSongRequest {
-    return new CreateSongRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteUnitSingerRequest {
+    return new DeleteUnitSingerRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSongRequest | PlainMessage<CreateSongRequest> | undefined, b: CreateSongRequest | PlainMessage<CreateSongRequest> | undefined): boolean {
-    return proto3.util.equals(CreateSongRequest, a, b);
+  static equals(a: DeleteUnitSingerRequest | PlainMessage<DeleteUnitSingerRequest> | undefined, b: DeleteUnitSingerRequest | PlainMessage<DeleteUnitSingerRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteUnitSingerRequest, a, b);
   }
 }
 
 /**
- * @generated from message master.CreateSongResponse
+ * @generated from message master.DeleteUnitSingerResponse
  */
-export class CreateSongResponse extends Message<CreateSongResponse> {
-  constructor(data?: PartialMessage<CreateSongResponse>) {
+export class DeleteUnitSingerResponse extends Message<DeleteUnitSingerResponse> {
+  constructor(data?: PartialMessage<DeleteUnitSingerResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.CreateSongResponse";
+  static readonly typeName = "master.DeleteUnitSingerResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSongResponse {
-    return new CreateSongResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteUnitSingerResponse {
+    return new DeleteUnitSingerResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSongResponse {
-    return new CreateSongResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteUnitSingerResponse {
+    return new DeleteUnitSingerResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSongResponse {
-    return new CreateSongResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteUnitSingerResponse {
+    return new DeleteUnitSingerResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSongResponse | PlainMessage<CreateSongResponse> | undefined, b: CreateSongResponse | PlainMessage<CreateSongResponse> | undefined): boolean {
-    return proto3.util.equals(CreateSongResponse, a, b);
+  static equals(a: DeleteUnitSingerResponse | PlainMessage<DeleteUnitSingerResponse> | undefined, b: DeleteUnitSingerResponse | PlainMessage<DeleteUnitSingerResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteUnitSingerResponse, a, b);
   }
 }
 
 /**
- * Chart
+ * VocalPattern
  *
- * @generated from message master.GetChartsRequest
+ * @generated from message master.GetVocalPatternsRequest
  */
-export class GetChartsRequest extends Message<GetChartsRequest> {
-  constructor(data?: PartialMessage<GetChartsRequest>) {
+export class GetVocalPatternsRequest extends Message<GetVocalPatternsRequest> {
+  /**
+   * 0の場合は全件
+   *
+   * @generated from field: int32 song_id = 1;
+   */
+  songId = 0;
+
+  constructor(data?: PartialMessage<GetVocalPatternsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.GetChartsRequest";
+  static readonly typeName = "master.GetVocalPatternsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "song_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartsRequest {
-    return new GetChartsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetVocalPatternsRequest {
+    return new GetVocalPatternsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartsRequest {
-    return new GetChartsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetVocalPatternsRequest {
+    return new GetVocalPatternsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartsRequest {
-    return new GetChartsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetVocalPatternsRequest {
+    return new GetVocalPatternsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetChartsRequest | PlainMessage<GetChartsRequest> | undefined, b: GetChartsRequest | PlainMessage<GetChartsRequest> | undefined): boolean {
-    return proto3.util.equals(GetChartsRequest, a, b);
+  static equals(a: GetVocalPatternsRequest | PlainMessage<GetVocalPatternsRequest> | undefined, b: GetVocalPatternsRequest | PlainMessage<GetVocalPatternsRequest> | undefined): boolean {
+    return proto3.util.equals(GetVocalPatternsRequest, a, b);
   }
 }
 
 /**
- * @generated from message master.GetChartsResponse
+ * @generated from message master.GetVocalPatternsResponse
  */
-export class GetChartsResponse extends Message<GetChartsResponse> {
+export class GetVocalPatternsResponse extends Message<GetVocalPatternsResponse> {
   /**
-   * @generated from field: repeated master.Chart charts = 1;
+   * @generated from field: repeated master.VocalPattern vocal_patterns = 1;
    */
-  charts: Chart[] = [];
+  vocalPatterns: VocalPattern[] = [];
 
-  constructor(data?: PartialMessage<GetChartsResponse>) {
+  constructor(data?: PartialMessage<GetVocalPatternsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.GetChartsResponse";
+  static readonly typeName = "master.GetVocalPatternsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "charts", kind: "message", T: Chart, repeated: true },
+    { no: 1, name: "vocal_patterns", kind: "message", T: VocalPattern, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartsResponse {
-    return new GetChartsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetVocalPatternsResponse {
+    return new GetVocalPatternsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartsResponse {
-    return new GetChartsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetVocalPatternsResponse {
+    return new GetVocalPatternsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartsResponse {
-    return new GetChartsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetVocalPatternsResponse {
+    return new GetVocalPatternsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetChartsResponse | PlainMessage<GetChartsResponse> | undefined, b: GetChartsResponse | PlainMessage<GetChartsResponse> | undefined): boolean {
-    return proto3.util.equals(GetChartsResponse, a, b);
+  static equals(a: GetVocalPatternsResponse | PlainMessage<GetVocalPatternsResponse> | undefined, b: GetVocalPatternsResponse | PlainMessage<GetVocalPatternsResponse> | undefined): boolean {
+    return proto3.util.equals(GetVocalPatternsResponse, a, b);
   }
 }
 
 /**
- * @generated from message master.GetChartRequest
+ * @generated from message master.GetVocalPatternRequest
  */
-export class GetChartRequest extends Message<GetChartRequest> {
+export class GetVocalPatternRequest extends Message<GetVocalPatternRequest> {
   /**
    * @generated from field: int32 id = 1;
    */
   id = 0;
 
-  constructor(data?: PartialMessage<GetChartRequest>) {
+  constructor(data?: PartialMessage<GetVocalPatternRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.GetChartRequest";
+  static readonly typeName = "master.GetVocalPatternRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartRequest {
-    return new GetChartRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetVocalPatternRequest {
+    return new GetVocalPatternRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartRequest {
-    return new GetChartRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetVocalPatternRequest {
+    return new GetVocalPatternRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartRequest {
-    return new GetChartRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetVocalPatternRequest {
+    return new GetVocalPatternRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetChartRequest | PlainMessage<GetChartRequest> | undefined, b: GetChartRequest | PlainMessage<GetChartRequest> | undefined): boolean {
-    return proto3.util.equals(GetChartRequest, a, b);
+  static equals(a: GetVocalPatternRequest | PlainMessage<GetVocalPatternRequest> | undefined, b: GetVocalPatternRequest | PlainMessage<GetVocalPatternRequest> | undefined): boolean {
+    return proto3.util.equals(GetVocalPatternRequest, a, b);
   }
 }
 
 /**
- * @generated from message master.GetChartResponse
+ * @generated from message master.GetVocalPatternResponse
  */
-export class GetChartResponse extends Message<GetChartResponse> {
+export class GetVocalPatternResponse extends Message<GetVocalPatternResponse> {
   /**
-   * @generated from field: master.Chart chart = 1;
+   * @generated from field: master.VocalPattern vocal_pattern = 1;
    */
-  chart?: Chart;
+  vocalPattern?: VocalPattern;
 
-  constructor(data?: PartialMessage<GetChartResponse>) {
+  constructor(data?: PartialMessage<GetVocalPatternResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.GetChartResponse";
+  static readonly typeName = "master.GetVocalPatternResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "chart", kind: "message", T: Chart },
+    { no: 1, name: "vocal_pattern", kind: "message", T: VocalPattern },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartResponse {
-    return new GetChartResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetVocalPatternResponse {
+    return new GetVocalPatternResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartResponse {
-    return new GetChartResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetVocalPatternResponse {
+    return new GetVocalPatternResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartResponse {
-    return new GetChartResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetVocalPatternResponse {
+    return new GetVocalPatternResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetChartResponse | PlainMessage<GetChartResponse> | undefined, b: GetChartResponse | PlainMessage<GetChartResponse> | undefined): boolean {
-    return proto3.util.equals(GetChartResponse, a, b);
+  static equals(a: GetVocalPatternResponse | PlainMessage<GetVocalPatternResponse> | undefined, b: GetVocalPatternResponse | PlainMessage<GetVocalPatternResponse> | undefined): boolean {
+    return proto3.util.equals(GetVocalPatternResponse, a, b);
   }
 }
 
 /**
- * @generated from message master.CreateChartRequest
+ * @generated from message master.CreateVocalPatternRequest
  */
-export class CreateChartRequest extends Message<CreateChartRequest> {
+export class CreateVocalPatternRequest extends Message<CreateVocalPatternRequest> {
   /**
    * @generated from field: int32 song_id = 1;
    */
   songId = 0;
 
   /**
-   * @generated from field: enums.DifficultyType difficulty_type = 2;
+   * @generated from field: string name = 2;
    */
-  difficultyType = DifficultyType.UNSPECIFIED;
+  name = "";
 
   /**
-   * @generated from field: int32 level = 3;
+   * @generated from field: repeated int32 singer_ids = 3;
    */
-  level = 0;
+  singerIds: number[] = [];
 
   /**
-   * @generated from field: string chart_view_link = 4;
+   * @generated from field: repeated int32 singer_positions = 4;
    */
-  chartViewLink = "";
+  singerPositions: number[] = [];
 
-  constructor(data?: PartialMessage<CreateChartRequest>) {
+  constructor(data?: PartialMessage<CreateVocalPatternRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.CreateChartRequest";
+  static readonly typeName = "master.CreateVocalPatternRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "song_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "difficulty_type", kind: "enum", T: proto3.getEnumType(DifficultyType) },
-    { no: 3, name: "level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "chart_view_link", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-  ]);
-
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "singer_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 4, name: "singer_positions", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateVocalPatternRequest {
+    return new CreateVocalPatternRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateVocalPatternRequest {
+    return new CreateVocalPatternRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateVocalPatternRequest {
+    return new CreateVocalPatternRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateVocalPatternRequest | PlainMessage<CreateVocalPatternRequest> | undefined, b: CreateVocalPatternRequest | PlainMessage<CreateVocalPatternRequest> | undefined): boolean {
+    return proto3.util.equals(CreateVocalPatternRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.CreateVocalPatternResponse
+ */
+export class CreateVocalPatternResponse extends Message<CreateVocalPatternResponse> {
+  constructor(data?: PartialMessage<CreateVocalPatternResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.CreateVocalPatternResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateVocalPatternResponse {
+    return new CreateVocalPatternResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateVocalPatternResponse {
+    return new CreateVocalPatternResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateVocalPatternResponse {
+    return new CreateVocalPatternResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateVocalPatternResponse | PlainMessage<CreateVocalPatternResponse> | undefined, b: CreateVocalPatternResponse | PlainMessage<CreateVocalPatternResponse> | undefined): boolean {
+    return proto3.util.equals(CreateVocalPatternResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.UpdateVocalPatternRequest
+ */
+export class UpdateVocalPatternRequest extends Message<UpdateVocalPatternRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: repeated int32 singer_ids = 3;
+   */
+  singerIds: number[] = [];
+
+  /**
+   * @generated from field: repeated int32 singer_positions = 4;
+   */
+  singerPositions: number[] = [];
+
+  constructor(data?: PartialMessage<UpdateVocalPatternRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UpdateVocalPatternRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "singer_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 4, name: "singer_positions", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateVocalPatternRequest {
+    return new UpdateVocalPatternRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateVocalPatternRequest {
+    return new UpdateVocalPatternRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateVocalPatternRequest {
+    return new UpdateVocalPatternRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateVocalPatternRequest | PlainMessage<UpdateVocalPatternRequest> | undefined, b: UpdateVocalPatternRequest | PlainMessage<UpdateVocalPatternRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateVocalPatternRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.UpdateVocalPatternResponse
+ */
+export class UpdateVocalPatternResponse extends Message<UpdateVocalPatternResponse> {
+  constructor(data?: PartialMessage<UpdateVocalPatternResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UpdateVocalPatternResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateVocalPatternResponse {
+    return new UpdateVocalPatternResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateVocalPatternResponse {
+    return new UpdateVocalPatternResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateVocalPatternResponse {
+    return new UpdateVocalPatternResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateVocalPatternResponse | PlainMessage<UpdateVocalPatternResponse> | undefined, b: UpdateVocalPatternResponse | PlainMessage<UpdateVocalPatternResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateVocalPatternResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.DeleteVocalPatternRequest
+ */
+export class DeleteVocalPatternRequest extends Message<DeleteVocalPatternRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  constructor(data?: PartialMessage<DeleteVocalPatternRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.DeleteVocalPatternRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteVocalPatternRequest {
+    return new DeleteVocalPatternRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteVocalPatternRequest {
+    return new DeleteVocalPatternRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteVocalPatternRequest {
+    return new DeleteVocalPatternRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteVocalPatternRequest | PlainMessage<DeleteVocalPatternRequest> | undefined, b: DeleteVocalPatternRequest | PlainMessage<DeleteVocalPatternRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteVocalPatternRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.DeleteVocalPatternResponse
+ */
+export class DeleteVocalPatternResponse extends Message<DeleteVocalPatternResponse> {
+  constructor(data?: PartialMessage<DeleteVocalPatternResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.DeleteVocalPatternResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteVocalPatternResponse {
+    return new DeleteVocalPatternResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteVocalPatternResponse {
+    return new DeleteVocalPatternResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteVocalPatternResponse {
+    return new DeleteVocalPatternResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteVocalPatternResponse | PlainMessage<DeleteVocalPatternResponse> | undefined, b: DeleteVocalPatternResponse | PlainMessage<DeleteVocalPatternResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteVocalPatternResponse, a, b);
+  }
+}
+
+/**
+ * Song
+ *
+ * @generated from message master.GetSongsRequest
+ */
+export class GetSongsRequest extends Message<GetSongsRequest> {
+  /**
+   * 削除済みの曲も含める 管理者のみ
+   *
+   * @generated from field: bool include_deleted = 1;
+   */
+  includeDeleted = false;
+
+  constructor(data?: PartialMessage<GetSongsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetSongsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "include_deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSongsRequest {
+    return new GetSongsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSongsRequest {
+    return new GetSongsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSongsRequest {
+    return new GetSongsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSongsRequest | PlainMessage<GetSongsRequest> | undefined, b: GetSongsRequest | PlainMessage<GetSongsRequest> | undefined): boolean {
+    return proto3.util.equals(GetSongsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetSongsResponse
+ */
+export class GetSongsResponse extends Message<GetSongsResponse> {
+  /**
+   * @generated from field: repeated master.Song songs = 1;
+   */
+  songs: Song[] = [];
+
+  constructor(data?: PartialMessage<GetSongsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetSongsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "songs", kind: "message", T: Song, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSongsResponse {
+    return new GetSongsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSongsResponse {
+    return new GetSongsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSongsResponse {
+    return new GetSongsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSongsResponse | PlainMessage<GetSongsResponse> | undefined, b: GetSongsResponse | PlainMessage<GetSongsResponse> | undefined): boolean {
+    return proto3.util.equals(GetSongsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetSongRequest
+ */
+export class GetSongRequest extends Message<GetSongRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  constructor(data?: PartialMessage<GetSongRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetSongRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSongRequest {
+    return new GetSongRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSongRequest {
+    return new GetSongRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSongRequest {
+    return new GetSongRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSongRequest | PlainMessage<GetSongRequest> | undefined, b: GetSongRequest | PlainMessage<GetSongRequest> | undefined): boolean {
+    return proto3.util.equals(GetSongRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetSongResponse
+ */
+export class GetSongResponse extends Message<GetSongResponse> {
+  /**
+   * @generated from field: master.Song song = 1;
+   */
+  song?: Song;
+
+  constructor(data?: PartialMessage<GetSongResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetSongResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "song", kind: "message", T: Song },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSongResponse {
+    return new GetSongResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSongResponse {
+    return new GetSongResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSongResponse {
+    return new GetSongResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSongResponse | PlainMessage<GetSongResponse> | undefined, b: GetSongResponse | PlainMessage<GetSongResponse> | undefined): boolean {
+    return proto3.util.equals(GetSongResponse, a, b);
+  }
+}
+
+/**
+ * 同じ役割のクレジットは並べた順になる 作詞・作曲・編曲はそれぞれ1人以上必要
+ *
+ * @generated from message master.SongCreditInput
+ */
+export class SongCreditInput extends Message<SongCreditInput> {
+  /**
+   * @generated from field: int32 artist_id = 1;
+   */
+  artistId = 0;
+
+  /**
+   * @generated from field: enums.CreditRole role = 2;
+   */
+  role = CreditRole.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<SongCreditInput>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.SongCreditInput";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "artist_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "role", kind: "enum", T: proto3.getEnumType(CreditRole) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SongCreditInput {
+    return new SongCreditInput().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SongCreditInput {
+    return new SongCreditInput().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SongCreditInput {
+    return new SongCreditInput().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SongCreditInput | PlainMessage<SongCreditInput> | undefined, b: SongCreditInput | PlainMessage<SongCreditInput> | undefined): boolean {
+    return proto3.util.equals(SongCreditInput, a, b);
+  }
+}
+
+/**
+ * @generated from message master.CreateSongRequest
+ */
+export class CreateSongRequest extends Message<CreateSongRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string kana = 2;
+   */
+  kana = "";
+
+  /**
+   * @generated from field: string thumbnail = 6;
+   */
+  thumbnail = "";
+
+  /**
+   * @generated from field: string original_video = 7;
+   */
+  originalVideo = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp release_time = 8;
+   */
+  releaseTime?: Timestamp;
+
+  /**
+   * @generated from field: bool deleted = 9;
+   */
+  deleted = false;
+
+  /**
+   * @generated from field: repeated int32 unit_ids = 10;
+   */
+  unitIds: number[] = [];
+
+  /**
+   * @generated from field: repeated enums.MusicVideoType music_video_types = 11;
+   */
+  musicVideoTypes: MusicVideoType[] = [];
+
+  /**
+   * @generated from field: repeated master.SongCreditInput credits = 12;
+   */
+  credits: SongCreditInput[] = [];
+
+  constructor(data?: PartialMessage<CreateSongRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.CreateSongRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kana", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "thumbnail", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "original_video", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "release_time", kind: "message", T: Timestamp },
+    { no: 9, name: "deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "unit_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 11, name: "music_video_types", kind: "enum", T: proto3.getEnumType(MusicVideoType), repeated: true },
+    { no: 12, name: "credits", kind: "message", T: SongCreditInput, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSongRequest {
+    return new CreateSongRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSongRequest {
+    return new CreateSongRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSongRequest {
+    return new CreateSongRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSongRequest | PlainMessage<CreateSongRequest> | undefined, b: CreateSongRequest | PlainMessage<CreateSongRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSongRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.CreateSongResponse
+ */
+export class CreateSongResponse extends Message<CreateSongResponse> {
+  constructor(data?: PartialMessage<CreateSongResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.CreateSongResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSongResponse {
+    return new CreateSongResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSongResponse {
+    return new CreateSongResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSongResponse {
+    return new CreateSongResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSongResponse | PlainMessage<CreateSongResponse> | undefined, b: CreateSongResponse | PlainMessage<CreateSongResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSongResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.UpdateSongRequest
+ */
+export class UpdateSongRequest extends Message<UpdateSongRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string kana = 3;
+   */
+  kana = "";
+
+  /**
+   * @generated from field: string thumbnail = 7;
+   */
+  thumbnail = "";
+
+  /**
+   * @generated from field: string original_video = 8;
+   */
+  originalVideo = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp release_time = 9;
+   */
+  releaseTime?: Timestamp;
+
+  /**
+   * @generated from field: bool deleted = 10;
+   */
+  deleted = false;
+
+  /**
+   * @generated from field: repeated int32 unit_ids = 11;
+   */
+  unitIds: number[] = [];
+
+  /**
+   * @generated from field: repeated enums.MusicVideoType music_video_types = 12;
+   */
+  musicVideoTypes: MusicVideoType[] = [];
+
+  /**
+   * @generated from field: repeated master.SongCreditInput credits = 13;
+   */
+  credits: SongCreditInput[] = [];
+
+  constructor(data?: PartialMessage<UpdateSongRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UpdateSongRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "kana", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "thumbnail", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "original_video", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "release_time", kind: "message", T: Timestamp },
+    { no: 10, name: "deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "unit_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 12, name: "music_video_types", kind: "enum", T: proto3.getEnumType(MusicVideoType), repeated: true },
+    { no: 13, name: "credits", kind: "message", T: SongCreditInput, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSongRequest {
+    return new UpdateSongRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSongRequest {
+    return new UpdateSongRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSongRequest {
+    return new UpdateSongRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSongRequest | PlainMessage<UpdateSongRequest> | undefined, b: UpdateSongRequest | PlainMessage<UpdateSongRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSongRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.UpdateSongResponse
+ */
+export class UpdateSongResponse extends Message<UpdateSongResponse> {
+  constructor(data?: PartialMessage<UpdateSongResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UpdateSongResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSongResponse {
+    return new UpdateSongResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSongResponse {
+    return new UpdateSongResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSongResponse {
+    return new UpdateSongResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSongResponse | PlainMessage<UpdateSongResponse> | undefined, b: UpdateSongResponse | PlainMessage<UpdateSongResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSongResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.DeleteSongRequest
+ */
+export class DeleteSongRequest extends Message<DeleteSongRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  constructor(data?: PartialMessage<DeleteSongRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.DeleteSongRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSongRequest {
+    return new DeleteSongRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSongRequest {
+    return new DeleteSongRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSongRequest {
+    return new DeleteSongRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSongRequest | PlainMessage<DeleteSongRequest> | undefined, b: DeleteSongRequest | PlainMessage<DeleteSongRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSongRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.DeleteSongResponse
+ */
+export class DeleteSongResponse extends Message<DeleteSongResponse> {
+  constructor(data?: PartialMessage<DeleteSongResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.DeleteSongResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSongResponse {
+    return new DeleteSongResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSongResponse {
+    return new DeleteSongResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSongResponse {
+    return new DeleteSongResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSongResponse | PlainMessage<DeleteSongResponse> | undefined, b: DeleteSongResponse | PlainMessage<DeleteSongResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteSongResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.SearchSongsRequest
+ */
+export class SearchSongsRequest extends Message<SearchSongsRequest> {
+  /**
+   * 曲名・読みの部分一致
+   *
+   * @generated from field: string query = 1;
+   */
+  query = "";
+
+  /**
+   * 0の項目は絞り込みに使わない 作詞・作曲・編曲はクレジットのいずれかに含まれる曲
+   *
+   * @generated from field: int32 lyrics_id = 2;
+   */
+  lyricsId = 0;
+
+  /**
+   * @generated from field: int32 music_id = 3;
+   */
+  musicId = 0;
+
+  /**
+   * @generated from field: int32 arrangement_id = 4;
+   */
+  arrangementId = 0;
+
+  /**
+   * @generated from field: int32 unit_id = 5;
+   */
+  unitId = 0;
+
+  /**
+   * @generated from field: int32 singer_id = 6;
+   */
+  singerId = 0;
+
+  /**
+   * @generated from field: enums.MusicVideoType music_video_type = 7;
+   */
+  musicVideoType = MusicVideoType.MUSIC_VIDEO_TYPE_UNSPECIFIED;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp release_from = 8;
+   */
+  releaseFrom?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp release_to = 9;
+   */
+  releaseTo?: Timestamp;
+
+  /**
+   * @generated from field: int32 page_size = 10;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 11;
+   */
+  pageToken = "";
+
+  /**
+   * 削除済みの曲も含める 管理者のみ
+   *
+   * @generated from field: bool include_deleted = 12;
+   */
+  includeDeleted = false;
+
+  constructor(data?: PartialMessage<SearchSongsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.SearchSongsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "lyrics_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "music_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "arrangement_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "unit_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "singer_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "music_video_type", kind: "enum", T: proto3.getEnumType(MusicVideoType) },
+    { no: 8, name: "release_from", kind: "message", T: Timestamp },
+    { no: 9, name: "release_to", kind: "message", T: Timestamp },
+    { no: 10, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "include_deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchSongsRequest {
+    return new SearchSongsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchSongsRequest {
+    return new SearchSongsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchSongsRequest {
+    return new SearchSongsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchSongsRequest | PlainMessage<SearchSongsRequest> | undefined, b: SearchSongsRequest | PlainMessage<SearchSongsRequest> | undefined): boolean {
+    return proto3.util.equals(SearchSongsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.SearchSongsResponse
+ */
+export class SearchSongsResponse extends Message<SearchSongsResponse> {
+  /**
+   * @generated from field: repeated master.Song songs = 1;
+   */
+  songs: Song[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<SearchSongsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.SearchSongsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "songs", kind: "message", T: Song, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchSongsResponse {
+    return new SearchSongsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchSongsResponse {
+    return new SearchSongsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchSongsResponse {
+    return new SearchSongsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchSongsResponse | PlainMessage<SearchSongsResponse> | undefined, b: SearchSongsResponse | PlainMessage<SearchSongsResponse> | undefined): boolean {
+    return proto3.util.equals(SearchSongsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.ListSongReleasesRequest
+ */
+export class ListSongReleasesRequest extends Message<ListSongReleasesRequest> {
+  /**
+   * 0なら絞り込まない
+   *
+   * @generated from field: int32 unit_id = 1;
+   */
+  unitId = 0;
+
+  constructor(data?: PartialMessage<ListSongReleasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.ListSongReleasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "unit_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSongReleasesRequest {
+    return new ListSongReleasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSongReleasesRequest {
+    return new ListSongReleasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSongReleasesRequest {
+    return new ListSongReleasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSongReleasesRequest | PlainMessage<ListSongReleasesRequest> | undefined, b: ListSongReleasesRequest | PlainMessage<ListSongReleasesRequest> | undefined): boolean {
+    return proto3.util.equals(ListSongReleasesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.ListSongReleasesResponse
+ */
+export class ListSongReleasesResponse extends Message<ListSongReleasesResponse> {
+  /**
+   * 古い月から順
+   *
+   * @generated from field: repeated master.SongReleaseMonth months = 1;
+   */
+  months: SongReleaseMonth[] = [];
+
+  constructor(data?: PartialMessage<ListSongReleasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.ListSongReleasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "months", kind: "message", T: SongReleaseMonth, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSongReleasesResponse {
+    return new ListSongReleasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSongReleasesResponse {
+    return new ListSongReleasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSongReleasesResponse {
+    return new ListSongReleasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSongReleasesResponse | PlainMessage<ListSongReleasesResponse> | undefined, b: ListSongReleasesResponse | PlainMessage<ListSongReleasesResponse> | undefined): boolean {
+    return proto3.util.equals(ListSongReleasesResponse, a, b);
+  }
+}
+
+/**
+ * Chart
+ *
+ * @generated from message master.GetChartsRequest
+ */
+export class GetChartsRequest extends Message<GetChartsRequest> {
+  /**
+   * 削除済みの曲の譜面も含める 管理者のみ
+   *
+   * @generated from field: bool include_deleted = 1;
+   */
+  includeDeleted = false;
+
+  /**
+   * 0の項目は絞り込みに使わない 絞り込む項目が未設定の譜面は除く
+   *
+   * @generated from field: int32 min_note_count = 2;
+   */
+  minNoteCount = 0;
+
+  /**
+   * @generated from field: int32 max_note_count = 3;
+   */
+  maxNoteCount = 0;
+
+  /**
+   * BPMの範囲が重なる譜面を返す
+   *
+   * @generated from field: int32 min_bpm = 4;
+   */
+  minBpm = 0;
+
+  /**
+   * @generated from field: int32 max_bpm = 5;
+   */
+  maxBpm = 0;
+
+  /**
+   * @generated from field: int32 min_duration_seconds = 6;
+   */
+  minDurationSeconds = 0;
+
+  /**
+   * @generated from field: int32 max_duration_seconds = 7;
+   */
+  maxDurationSeconds = 0;
+
+  /**
+   * @generated from field: double min_constant = 8;
+   */
+  minConstant = 0;
+
+  /**
+   * @generated from field: double max_constant = 9;
+   */
+  maxConstant = 0;
+
+  /**
+   * 未指定はID順 BPMはbpm_maxで並べる 並べる項目が未設定の譜面は最後にする
+   *
+   * @generated from field: enums.ChartSortKey sort_key = 10;
+   */
+  sortKey = ChartSortKey.UNSPECIFIED;
+
+  /**
+   * @generated from field: bool descending = 11;
+   */
+  descending = false;
+
+  constructor(data?: PartialMessage<GetChartsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetChartsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "include_deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "min_note_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "max_note_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "min_bpm", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "max_bpm", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "min_duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "max_duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "min_constant", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 9, name: "max_constant", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 10, name: "sort_key", kind: "enum", T: proto3.getEnumType(ChartSortKey) },
+    { no: 11, name: "descending", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartsRequest {
+    return new GetChartsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartsRequest {
+    return new GetChartsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartsRequest {
+    return new GetChartsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetChartsRequest | PlainMessage<GetChartsRequest> | undefined, b: GetChartsRequest | PlainMessage<GetChartsRequest> | undefined): boolean {
+    return proto3.util.equals(GetChartsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetChartsResponse
+ */
+export class GetChartsResponse extends Message<GetChartsResponse> {
+  /**
+   * @generated from field: repeated master.Chart charts = 1;
+   */
+  charts: Chart[] = [];
+
+  constructor(data?: PartialMessage<GetChartsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetChartsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "charts", kind: "message", T: Chart, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartsResponse {
+    return new GetChartsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartsResponse {
+    return new GetChartsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartsResponse {
+    return new GetChartsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetChartsResponse | PlainMessage<GetChartsResponse> | undefined, b: GetChartsResponse | PlainMessage<GetChartsResponse> | undefined): boolean {
+    return proto3.util.equals(GetChartsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetChartRequest
+ */
+export class GetChartRequest extends Message<GetChartRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  /**
+   * 指定した場合はlevelをその時点のものにする
+   *
+   * @generated from field: google.protobuf.Timestamp as_of = 2;
+   */
+  asOf?: Timestamp;
+
+  constructor(data?: PartialMessage<GetChartRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetChartRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "as_of", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartRequest {
+    return new GetChartRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartRequest {
+    return new GetChartRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartRequest {
+    return new GetChartRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetChartRequest | PlainMessage<GetChartRequest> | undefined, b: GetChartRequest | PlainMessage<GetChartRequest> | undefined): boolean {
+    return proto3.util.equals(GetChartRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetChartResponse
+ */
+export class GetChartResponse extends Message<GetChartResponse> {
+  /**
+   * @generated from field: master.Chart chart = 1;
+   */
+  chart?: Chart;
+
+  constructor(data?: PartialMessage<GetChartResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetChartResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "chart", kind: "message", T: Chart },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartResponse {
+    return new GetChartResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartResponse {
+    return new GetChartResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartResponse {
+    return new GetChartResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetChartResponse | PlainMessage<GetChartResponse> | undefined, b: GetChartResponse | PlainMessage<GetChartResponse> | undefined): boolean {
+    return proto3.util.equals(GetChartResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.CreateChartRequest
+ */
+export class CreateChartRequest extends Message<CreateChartRequest> {
+  /**
+   * @generated from field: int32 song_id = 1;
+   */
+  songId = 0;
+
+  /**
+   * @generated from field: enums.DifficultyType difficulty_type = 2;
+   */
+  difficultyType = DifficultyType.UNSPECIFIED;
+
+  /**
+   * @generated from field: int32 level = 3;
+   */
+  level = 0;
+
+  /**
+   * @generated from field: string chart_view_link = 4;
+   */
+  chartViewLink = "";
+
+  /**
+   * 以下は任意 0は未設定
+   *
+   * @generated from field: int32 note_count = 5;
+   */
+  noteCount = 0;
+
+  /**
+   * BPMが一定の場合はどちらか片方だけでもよい
+   *
+   * @generated from field: int32 bpm_min = 6;
+   */
+  bpmMin = 0;
+
+  /**
+   * @generated from field: int32 bpm_max = 7;
+   */
+  bpmMax = 0;
+
+  /**
+   * @generated from field: int32 duration_seconds = 8;
+   */
+  durationSeconds = 0;
+
+  /**
+   * @generated from field: double constant = 9;
+   */
+  constant = 0;
+
+  constructor(data?: PartialMessage<CreateChartRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.CreateChartRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "song_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "difficulty_type", kind: "enum", T: proto3.getEnumType(DifficultyType) },
+    { no: 3, name: "level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "chart_view_link", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "note_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "bpm_min", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "bpm_max", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "constant", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateChartRequest {
     return new CreateChartRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateChartRequest {
-    return new CreateChartRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateChartRequest {
+    return new CreateChartRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateChartRequest {
+    return new CreateChartRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateChartRequest | PlainMessage<CreateChartRequest> | undefined, b: CreateChartRequest | PlainMessage<CreateChartRequest> | undefined): boolean {
+    return proto3.util.equals(CreateChartRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.CreateChartResponse
+ */
+export class CreateChartResponse extends Message<CreateChartResponse> {
+  constructor(data?: PartialMessage<CreateChartResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.CreateChartResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateChartResponse {
+    return new CreateChartResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateChartResponse {
+    return new CreateChartResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateChartResponse {
+    return new CreateChartResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateChartResponse | PlainMessage<CreateChartResponse> | undefined, b: CreateChartResponse | PlainMessage<CreateChartResponse> | undefined): boolean {
+    return proto3.util.equals(CreateChartResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.UpdateChartRequest
+ */
+export class UpdateChartRequest extends Message<UpdateChartRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  /**
+   * @generated from field: int32 song_id = 2;
+   */
+  songId = 0;
+
+  /**
+   * @generated from field: enums.DifficultyType difficulty_type = 3;
+   */
+  difficultyType = DifficultyType.UNSPECIFIED;
+
+  /**
+   * @generated from field: int32 level = 4;
+   */
+  level = 0;
+
+  /**
+   * @generated from field: string chart_view_link = 5;
+   */
+  chartViewLink = "";
+
+  /**
+   * 以下は任意 0は未設定
+   *
+   * @generated from field: int32 note_count = 6;
+   */
+  noteCount = 0;
+
+  /**
+   * BPMが一定の場合はどちらか片方だけでもよい
+   *
+   * @generated from field: int32 bpm_min = 7;
+   */
+  bpmMin = 0;
+
+  /**
+   * @generated from field: int32 bpm_max = 8;
+   */
+  bpmMax = 0;
+
+  /**
+   * @generated from field: int32 duration_seconds = 9;
+   */
+  durationSeconds = 0;
+
+  /**
+   * @generated from field: double constant = 10;
+   */
+  constant = 0;
+
+  /**
+   * レベルの変更を適用する日時 未指定は現在時刻 未来の日時は指定できない
+   *
+   * @generated from field: google.protobuf.Timestamp level_effective_from = 11;
+   */
+  levelEffectiveFrom?: Timestamp;
+
+  constructor(data?: PartialMessage<UpdateChartRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UpdateChartRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "song_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "difficulty_type", kind: "enum", T: proto3.getEnumType(DifficultyType) },
+    { no: 4, name: "level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "chart_view_link", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "note_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "bpm_min", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "bpm_max", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "constant", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 11, name: "level_effective_from", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateChartRequest {
+    return new UpdateChartRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateChartRequest {
+    return new UpdateChartRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateChartRequest {
+    return new UpdateChartRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateChartRequest | PlainMessage<UpdateChartRequest> | undefined, b: UpdateChartRequest | PlainMessage<UpdateChartRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateChartRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.UpdateChartResponse
+ */
+export class UpdateChartResponse extends Message<UpdateChartResponse> {
+  constructor(data?: PartialMessage<UpdateChartResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UpdateChartResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateChartResponse {
+    return new UpdateChartResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateChartResponse {
+    return new UpdateChartResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateChartResponse {
+    return new UpdateChartResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateChartResponse | PlainMessage<UpdateChartResponse> | undefined, b: UpdateChartResponse | PlainMessage<UpdateChartResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateChartResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.DeleteChartRequest
+ */
+export class DeleteChartRequest extends Message<DeleteChartRequest> {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  constructor(data?: PartialMessage<DeleteChartRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.DeleteChartRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteChartRequest {
+    return new DeleteChartRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteChartRequest {
+    return new DeleteChartRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteChartRequest {
+    return new DeleteChartRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteChartRequest | PlainMessage<DeleteChartRequest> | undefined, b: DeleteChartRequest | PlainMessage<DeleteChartRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteChartRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.DeleteChartResponse
+ */
+export class DeleteChartResponse extends Message<DeleteChartResponse> {
+  constructor(data?: PartialMessage<DeleteChartResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.DeleteChartResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteChartResponse {
+    return new DeleteChartResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteChartResponse {
+    return new DeleteChartResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteChartResponse {
+    return new DeleteChartResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteChartResponse | PlainMessage<DeleteChartResponse> | undefined, b: DeleteChartResponse | PlainMessage<DeleteChartResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteChartResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetChartHistoryRequest
+ */
+export class GetChartHistoryRequest extends Message<GetChartHistoryRequest> {
+  /**
+   * @generated from field: int32 chart_id = 1;
+   */
+  chartId = 0;
+
+  constructor(data?: PartialMessage<GetChartHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetChartHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "chart_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartHistoryRequest {
+    return new GetChartHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartHistoryRequest {
+    return new GetChartHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartHistoryRequest {
+    return new GetChartHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetChartHistoryRequest | PlainMessage<GetChartHistoryRequest> | undefined, b: GetChartHistoryRequest | PlainMessage<GetChartHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(GetChartHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetChartHistoryResponse
+ */
+export class GetChartHistoryResponse extends Message<GetChartHistoryResponse> {
+  /**
+   * effective_fromの順
+   *
+   * @generated from field: repeated master.ChartLevelHistory histories = 1;
+   */
+  histories: ChartLevelHistory[] = [];
+
+  constructor(data?: PartialMessage<GetChartHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetChartHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "histories", kind: "message", T: ChartLevelHistory, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartHistoryResponse {
+    return new GetChartHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartHistoryResponse {
+    return new GetChartHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartHistoryResponse {
+    return new GetChartHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetChartHistoryResponse | PlainMessage<GetChartHistoryResponse> | undefined, b: GetChartHistoryResponse | PlainMessage<GetChartHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(GetChartHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.SearchChartsRequest
+ */
+export class SearchChartsRequest extends Message<SearchChartsRequest> {
+  /**
+   * 曲名・読みの部分一致
+   *
+   * @generated from field: string query = 1;
+   */
+  query = "";
+
+  /**
+   * 空・0の項目は絞り込みに使わない
+   *
+   * @generated from field: repeated enums.DifficultyType difficulty_types = 2;
+   */
+  difficultyTypes: DifficultyType[] = [];
+
+  /**
+   * @generated from field: int32 min_level = 3;
+   */
+  minLevel = 0;
+
+  /**
+   * @generated from field: int32 max_level = 4;
+   */
+  maxLevel = 0;
+
+  /**
+   * @generated from field: repeated int32 unit_ids = 5;
+   */
+  unitIds: number[] = [];
+
+  /**
+   * @generated from field: repeated int32 singer_ids = 6;
+   */
+  singerIds: number[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp release_from = 7;
+   */
+  releaseFrom?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp release_to = 8;
+   */
+  releaseTo?: Timestamp;
+
+  /**
+   * @generated from field: int32 page_size = 9;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 10;
+   */
+  pageToken = "";
+
+  /**
+   * 削除済みの曲の譜面も含める 管理者のみ
+   *
+   * @generated from field: bool include_deleted = 11;
+   */
+  includeDeleted = false;
+
+  constructor(data?: PartialMessage<SearchChartsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.SearchChartsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "difficulty_types", kind: "enum", T: proto3.getEnumType(DifficultyType), repeated: true },
+    { no: 3, name: "min_level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "max_level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "unit_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 6, name: "singer_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 7, name: "release_from", kind: "message", T: Timestamp },
+    { no: 8, name: "release_to", kind: "message", T: Timestamp },
+    { no: 9, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "include_deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchChartsRequest {
+    return new SearchChartsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchChartsRequest {
+    return new SearchChartsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchChartsRequest {
+    return new SearchChartsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchChartsRequest | PlainMessage<SearchChartsRequest> | undefined, b: SearchChartsRequest | PlainMessage<SearchChartsRequest> | undefined): boolean {
+    return proto3.util.equals(SearchChartsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.SearchChartsResponse
+ */
+export class SearchChartsResponse extends Message<SearchChartsResponse> {
+  /**
+   * @generated from field: repeated master.Chart charts = 1;
+   */
+  charts: Chart[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  /**
+   * @generated from field: int64 total_count = 3;
+   */
+  totalCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SearchChartsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.SearchChartsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "charts", kind: "message", T: Chart, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "total_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchChartsResponse {
+    return new SearchChartsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchChartsResponse {
+    return new SearchChartsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchChartsResponse {
+    return new SearchChartsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchChartsResponse | PlainMessage<SearchChartsResponse> | undefined, b: SearchChartsResponse | PlainMessage<SearchChartsResponse> | undefined): boolean {
+    return proto3.util.equals(SearchChartsResponse, a, b);
+  }
+}
+
+/**
+ * MasterImport
+ *
+ * @generated from message master.MasterChange
+ */
+export class MasterChange extends Message<MasterChange> {
+  /**
+   * artist, singer, unit, song, vocal_pattern, chart
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind = "";
+
+  /**
+   * 自然キー 曲に属するものは"曲名/名前"
+   *
+   * @generated from field: string key = 2;
+   */
+  key = "";
+
+  /**
+   * @generated from field: enums.MasterChangeOperation operation = 3;
+   */
+  operation = MasterChangeOperation.UNSPECIFIED;
+
+  /**
+   * 更新される項目
+   *
+   * @generated from field: repeated string fields = 4;
+   */
+  fields: string[] = [];
+
+  constructor(data?: PartialMessage<MasterChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.MasterChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "operation", kind: "enum", T: proto3.getEnumType(MasterChangeOperation) },
+    { no: 4, name: "fields", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MasterChange {
+    return new MasterChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MasterChange {
+    return new MasterChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MasterChange {
+    return new MasterChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MasterChange | PlainMessage<MasterChange> | undefined, b: MasterChange | PlainMessage<MasterChange> | undefined): boolean {
+    return proto3.util.equals(MasterChange, a, b);
+  }
+}
+
+/**
+ * @generated from message master.ImportMasterRequest
+ */
+export class ImportMasterRequest extends Message<ImportMasterRequest> {
+  /**
+   * @generated from field: enums.MasterBundleFormat format = 1;
+   */
+  format = MasterBundleFormat.UNSPECIFIED;
+
+  /**
+   * @generated from field: bytes data = 2;
+   */
+  data = new Uint8Array(0);
+
+  /**
+   * trueの場合は差分を返すだけで反映しない
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun = false;
+
+  constructor(data?: PartialMessage<ImportMasterRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.ImportMasterRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "format", kind: "enum", T: proto3.getEnumType(MasterBundleFormat) },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportMasterRequest {
+    return new ImportMasterRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportMasterRequest {
+    return new ImportMasterRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportMasterRequest {
+    return new ImportMasterRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportMasterRequest | PlainMessage<ImportMasterRequest> | undefined, b: ImportMasterRequest | PlainMessage<ImportMasterRequest> | undefined): boolean {
+    return proto3.util.equals(ImportMasterRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.ImportMasterResponse
+ */
+export class ImportMasterResponse extends Message<ImportMasterResponse> {
+  /**
+   * @generated from field: repeated master.MasterChange changes = 1;
+   */
+  changes: MasterChange[] = [];
+
+  /**
+   * @generated from field: bool applied = 2;
+   */
+  applied = false;
+
+  constructor(data?: PartialMessage<ImportMasterResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.ImportMasterResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changes", kind: "message", T: MasterChange, repeated: true },
+    { no: 2, name: "applied", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportMasterResponse {
+    return new ImportMasterResponse().fromBinary(bytes, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateChartRequest {
-    return new CreateChartRequest().fromJsonString(jsonString, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportMasterResponse {
+    return new ImportMasterResponse().fromJson(jsonValue, options);
   }
 
-  static equals(a: CreateChartRequest | PlainMessage<CreateChartRequest> | undefined, b: CreateChartRequest | PlainMessage<CreateChartRequest> | undefined): boolean {
-    return proto3.util.equals(CreateChartRequest, a, b);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportMasterResponse {
+    return new ImportMasterResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportMasterResponse | PlainMessage<ImportMasterResponse> | undefined, b: ImportMasterResponse | PlainMessage<ImportMasterResponse> | undefined): boolean {
+    return proto3.util.equals(ImportMasterResponse, a, b);
   }
 }
 
 /**
- * @generated from message master.CreateChartResponse
+ * @generated from message master.ExportMasterRequest
  */
-export class CreateChartResponse extends Message<CreateChartResponse> {
-  constructor(data?: PartialMessage<CreateChartResponse>) {
+export class ExportMasterRequest extends Message<ExportMasterRequest> {
+  constructor(data?: PartialMessage<ExportMasterRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "master.CreateChartResponse";
+  static readonly typeName = "master.ExportMasterRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateChartResponse {
-    return new CreateChartResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportMasterRequest {
+    return new ExportMasterRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateChartResponse {
-    return new CreateChartResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportMasterRequest {
+    return new ExportMasterRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateChartResponse {
-    return new CreateChartResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportMasterRequest {
+    return new ExportMasterRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateChartResponse | PlainMessage<CreateChartResponse> | undefined, b: CreateChartResponse | PlainMessage<CreateChartResponse> | undefined): boolean {
-    return proto3.util.equals(CreateChartResponse, a, b);
+  static equals(a: ExportMasterRequest | PlainMessage<ExportMasterRequest> | undefined, b: ExportMasterRequest | PlainMessage<ExportMasterRequest> | undefined): boolean {
+    return proto3.util.equals(ExportMasterRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.ExportMasterResponse
+ */
+export class ExportMasterResponse extends Message<ExportMasterResponse> {
+  /**
+   * JSON形式のバンドル
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<ExportMasterResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.ExportMasterResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportMasterResponse {
+    return new ExportMasterResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportMasterResponse {
+    return new ExportMasterResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportMasterResponse {
+    return new ExportMasterResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportMasterResponse | PlainMessage<ExportMasterResponse> | undefined, b: ExportMasterResponse | PlainMessage<ExportMasterResponse> | undefined): boolean {
+    return proto3.util.equals(ExportMasterResponse, a, b);
+  }
+}
+
+/**
+ * MasterRevision
+ *
+ * @generated from message master.GetMasterVersionRequest
+ */
+export class GetMasterVersionRequest extends Message<GetMasterVersionRequest> {
+  constructor(data?: PartialMessage<GetMasterVersionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetMasterVersionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMasterVersionRequest {
+    return new GetMasterVersionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMasterVersionRequest {
+    return new GetMasterVersionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMasterVersionRequest {
+    return new GetMasterVersionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMasterVersionRequest | PlainMessage<GetMasterVersionRequest> | undefined, b: GetMasterVersionRequest | PlainMessage<GetMasterVersionRequest> | undefined): boolean {
+    return proto3.util.equals(GetMasterVersionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetMasterVersionResponse
+ */
+export class GetMasterVersionResponse extends Message<GetMasterVersionResponse> {
+  /**
+   * マスタを書き換えるたびに増える版数
+   *
+   * @generated from field: int64 revision = 1;
+   */
+  revision = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetMasterVersionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetMasterVersionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMasterVersionResponse {
+    return new GetMasterVersionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMasterVersionResponse {
+    return new GetMasterVersionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMasterVersionResponse {
+    return new GetMasterVersionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMasterVersionResponse | PlainMessage<GetMasterVersionResponse> | undefined, b: GetMasterVersionResponse | PlainMessage<GetMasterVersionResponse> | undefined): boolean {
+    return proto3.util.equals(GetMasterVersionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetMasterDeltaRequest
+ */
+export class GetMasterDeltaRequest extends Message<GetMasterDeltaRequest> {
+  /**
+   * 0の場合は全件
+   *
+   * @generated from field: int64 since_revision = 1;
+   */
+  sinceRevision = protoInt64.zero;
+
+  /**
+   * 削除済みの曲・譜面も含める 管理者のみ
+   *
+   * @generated from field: bool include_deleted = 2;
+   */
+  includeDeleted = false;
+
+  constructor(data?: PartialMessage<GetMasterDeltaRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetMasterDeltaRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "since_revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "include_deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMasterDeltaRequest {
+    return new GetMasterDeltaRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMasterDeltaRequest {
+    return new GetMasterDeltaRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMasterDeltaRequest {
+    return new GetMasterDeltaRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMasterDeltaRequest | PlainMessage<GetMasterDeltaRequest> | undefined, b: GetMasterDeltaRequest | PlainMessage<GetMasterDeltaRequest> | undefined): boolean {
+    return proto3.util.equals(GetMasterDeltaRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.GetMasterDeltaResponse
+ */
+export class GetMasterDeltaResponse extends Message<GetMasterDeltaResponse> {
+  /**
+   * @generated from field: int64 revision = 1;
+   */
+  revision = protoInt64.zero;
+
+  /**
+   * 追加・更新されたマスタ
+   *
+   * @generated from field: repeated master.Artist artists = 2;
+   */
+  artists: Artist[] = [];
+
+  /**
+   * @generated from field: repeated master.Singer singers = 3;
+   */
+  singers: Singer[] = [];
+
+  /**
+   * @generated from field: repeated master.Unit units = 4;
+   */
+  units: Unit[] = [];
+
+  /**
+   * @generated from field: repeated master.Song songs = 5;
+   */
+  songs: Song[] = [];
+
+  /**
+   * @generated from field: repeated master.Chart charts = 6;
+   */
+  charts: Chart[] = [];
+
+  /**
+   * 削除されたマスタ
+   *
+   * @generated from field: repeated int32 removed_artist_ids = 7;
+   */
+  removedArtistIds: number[] = [];
+
+  /**
+   * @generated from field: repeated int32 removed_singer_ids = 8;
+   */
+  removedSingerIds: number[] = [];
+
+  /**
+   * @generated from field: repeated int32 removed_unit_ids = 9;
+   */
+  removedUnitIds: number[] = [];
+
+  /**
+   * @generated from field: repeated int32 removed_song_ids = 10;
+   */
+  removedSongIds: number[] = [];
+
+  /**
+   * @generated from field: repeated int32 removed_chart_ids = 11;
+   */
+  removedChartIds: number[] = [];
+
+  constructor(data?: PartialMessage<GetMasterDeltaResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.GetMasterDeltaResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "artists", kind: "message", T: Artist, repeated: true },
+    { no: 3, name: "singers", kind: "message", T: Singer, repeated: true },
+    { no: 4, name: "units", kind: "message", T: Unit, repeated: true },
+    { no: 5, name: "songs", kind: "message", T: Song, repeated: true },
+    { no: 6, name: "charts", kind: "message", T: Chart, repeated: true },
+    { no: 7, name: "removed_artist_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 8, name: "removed_singer_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 9, name: "removed_unit_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 10, name: "removed_song_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 11, name: "removed_chart_ids", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMasterDeltaResponse {
+    return new GetMasterDeltaResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMasterDeltaResponse {
+    return new GetMasterDeltaResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMasterDeltaResponse {
+    return new GetMasterDeltaResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMasterDeltaResponse | PlainMessage<GetMasterDeltaResponse> | undefined, b: GetMasterDeltaResponse | PlainMessage<GetMasterDeltaResponse> | undefined): boolean {
+    return proto3.util.equals(GetMasterDeltaResponse, a, b);
+  }
+}
+
+/**
+ * MasterEvent
+ *
+ * @generated from message master.MasterEvent
+ */
+export class MasterEvent extends Message<MasterEvent> {
+  /**
+   * @generated from field: enums.MasterKind kind = 1;
+   */
+  kind = MasterKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: int32 id = 2;
+   */
+  id = 0;
+
+  /**
+   * @generated from field: enums.MasterChangeOperation operation = 3;
+   */
+  operation = MasterChangeOperation.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<MasterEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.MasterEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(MasterKind) },
+    { no: 2, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "operation", kind: "enum", T: proto3.getEnumType(MasterChangeOperation) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MasterEvent {
+    return new MasterEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MasterEvent {
+    return new MasterEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MasterEvent {
+    return new MasterEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MasterEvent | PlainMessage<MasterEvent> | undefined, b: MasterEvent | PlainMessage<MasterEvent> | undefined): boolean {
+    return proto3.util.equals(MasterEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message master.WatchMasterRequest
+ */
+export class WatchMasterRequest extends Message<WatchMasterRequest> {
+  /**
+   * 削除済みの曲・譜面の変更も送る 管理者のみ
+   *
+   * @generated from field: bool include_deleted = 1;
+   */
+  includeDeleted = false;
+
+  constructor(data?: PartialMessage<WatchMasterRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.WatchMasterRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "include_deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchMasterRequest {
+    return new WatchMasterRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchMasterRequest {
+    return new WatchMasterRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchMasterRequest {
+    return new WatchMasterRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchMasterRequest | PlainMessage<WatchMasterRequest> | undefined, b: WatchMasterRequest | PlainMessage<WatchMasterRequest> | undefined): boolean {
+    return proto3.util.equals(WatchMasterRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message master.WatchMasterResponse
+ */
+export class WatchMasterResponse extends Message<WatchMasterResponse> {
+  /**
+   * この変更で進んだ後の版数
+   *
+   * @generated from field: int64 revision = 1;
+   */
+  revision = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated master.MasterEvent events = 2;
+   */
+  events: MasterEvent[] = [];
+
+  constructor(data?: PartialMessage<WatchMasterResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.WatchMasterResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "events", kind: "message", T: MasterEvent, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchMasterResponse {
+    return new WatchMasterResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchMasterResponse {
+    return new WatchMasterResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchMasterResponse {
+    return new WatchMasterResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchMasterResponse | PlainMessage<WatchMasterResponse> | undefined, b: WatchMasterResponse | PlainMessage<WatchMasterResponse> | undefined): boolean {
+    return proto3.util.equals(WatchMasterResponse, a, b);
   }
 }
 
@@ -5,6 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import { Unit } from "./unit_pb.js";
 
 /**
  * Singers
@@ -22,6 +23,13 @@ export class Singer extends Message<Singer> {
    */
   name = "";
 
+  /**
+   * 所属しているユニット membersは含まない ボーカルパターンに含まれる場合は空
+   *
+   * @generated from field: repeated master.Unit units = 3;
+   */
+  units: Unit[] = [];
+
   constructor(data?: PartialMessage<Singer>) {
     super();
     proto3.util.initPartial(data, this);
@@ -32,6 +40,7 @@ export class Singer extends Message<Singer> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "units", kind: "message", T: Unit, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Singer {
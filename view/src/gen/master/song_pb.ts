@@ -8,7 +8,7 @@ import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
 import { Artist } from "./artist_pb.js";
 import { VocalPattern } from "./vocal_pattern_pb.js";
 import { Unit } from "./unit_pb.js";
-import { MusicVideoType } from "../enums/master_pb.js";
+import { CreditRole, MusicVideoType } from "../enums/master_pb.js";
 
 /**
  * Songs
@@ -32,6 +32,8 @@ export class Song extends Message<Song> {
   kana = "";
 
   /**
+   * 各役割の先頭のクレジット 全員分はcredits
+   *
    * @generated from field: master.Artist lyrics = 4;
    */
   lyrics?: Artist;
@@ -81,6 +83,13 @@ export class Song extends Message<Song> {
    */
   musicVideoTypes: MusicVideoType[] = [];
 
+  /**
+   * 役割ごとにpositionの順
+   *
+   * @generated from field: repeated master.SongCredit credits = 14;
+   */
+  credits: SongCredit[] = [];
+
   constructor(data?: PartialMessage<Song>) {
     super();
     proto3.util.initPartial(data, this);
@@ -102,6 +111,7 @@ export class Song extends Message<Song> {
     { no: 11, name: "vocal_patterns", kind: "message", T: VocalPattern, repeated: true },
     { no: 12, name: "units", kind: "message", T: Unit, repeated: true },
     { no: 13, name: "music_video_types", kind: "enum", T: proto3.getEnumType(MusicVideoType), repeated: true },
+    { no: 14, name: "credits", kind: "message", T: SongCredit, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Song {
@@ -121,3 +131,108 @@ export class Song extends Message<Song> {
   }
 }
 
+/**
+ * SongReleaseMonths
+ * 公開月(日本時間)ごとの曲 公開日時の順
+ *
+ * @generated from message master.SongReleaseMonth
+ */
+export class SongReleaseMonth extends Message<SongReleaseMonth> {
+  /**
+   * @generated from field: int32 year = 1;
+   */
+  year = 0;
+
+  /**
+   * @generated from field: int32 month = 2;
+   */
+  month = 0;
+
+  /**
+   * @generated from field: repeated master.Song songs = 3;
+   */
+  songs: Song[] = [];
+
+  constructor(data?: PartialMessage<SongReleaseMonth>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.SongReleaseMonth";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "year", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "month", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "songs", kind: "message", T: Song, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SongReleaseMonth {
+    return new SongReleaseMonth().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SongReleaseMonth {
+    return new SongReleaseMonth().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SongReleaseMonth {
+    return new SongReleaseMonth().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SongReleaseMonth | PlainMessage<SongReleaseMonth> | undefined, b: SongReleaseMonth | PlainMessage<SongReleaseMonth> | undefined): boolean {
+    return proto3.util.equals(SongReleaseMonth, a, b);
+  }
+}
+
+/**
+ * SongCredits
+ *
+ * @generated from message master.SongCredit
+ */
+export class SongCredit extends Message<SongCredit> {
+  /**
+   * @generated from field: master.Artist artist = 1;
+   */
+  artist?: Artist;
+
+  /**
+   * @generated from field: enums.CreditRole role = 2;
+   */
+  role = CreditRole.UNSPECIFIED;
+
+  /**
+   * 同じ役割の中での順番 1から
+   *
+   * @generated from field: int32 position = 3;
+   */
+  position = 0;
+
+  constructor(data?: PartialMessage<SongCredit>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.SongCredit";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "artist", kind: "message", T: Artist },
+    { no: 2, name: "role", kind: "enum", T: proto3.getEnumType(CreditRole) },
+    { no: 3, name: "position", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SongCredit {
+    return new SongCredit().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SongCredit {
+    return new SongCredit().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SongCredit {
+    return new SongCredit().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SongCredit | PlainMessage<SongCredit> | undefined, b: SongCredit | PlainMessage<SongCredit> | undefined): boolean {
+    return proto3.util.equals(SongCredit, a, b);
+  }
+}
+
@@ -22,6 +22,13 @@ export class Unit extends Message<Unit> {
    */
   name = "";
 
+  /**
+   * 所属している歌手 並び順 曲に含まれる場合は空
+   *
+   * @generated from field: repeated master.UnitMember members = 3;
+   */
+  members: UnitMember[] = [];
+
   constructor(data?: PartialMessage<Unit>) {
     super();
     proto3.util.initPartial(data, this);
@@ -32,6 +39,7 @@ export class Unit extends Message<Unit> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "members", kind: "message", T: UnitMember, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Unit {
@@ -51,3 +59,56 @@ export class Unit extends Message<Unit> {
   }
 }
 
+/**
+ * UnitMembers
+ *
+ * @generated from message master.UnitMember
+ */
+export class UnitMember extends Message<UnitMember> {
+  /**
+   * 歌手のID
+   *
+   * @generated from field: int32 id = 1;
+   */
+  id = 0;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: int32 position = 3;
+   */
+  position = 0;
+
+  constructor(data?: PartialMessage<UnitMember>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "master.UnitMember";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "position", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnitMember {
+    return new UnitMember().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnitMember {
+    return new UnitMember().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnitMember {
+    return new UnitMember().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnitMember | PlainMessage<UnitMember> | undefined, b: UnitMember | PlainMessage<UnitMember> | undefined): boolean {
+    return proto3.util.equals(UnitMember, a, b);
+  }
+}
+
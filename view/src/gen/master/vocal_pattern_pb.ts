@@ -28,6 +28,11 @@ export class VocalPattern extends Message<VocalPattern> {
    */
   singers: Singer[] = [];
 
+  /**
+   * @generated from field: int32 song_id = 4;
+   */
+  songId = 0;
+
   constructor(data?: PartialMessage<VocalPattern>) {
     super();
     proto3.util.initPartial(data, this);
@@ -39,6 +44,7 @@ export class VocalPattern extends Message<VocalPattern> {
     { no: 1, name: "id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "singers", kind: "message", T: Singer, repeated: true },
+    { no: 4, name: "song_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VocalPattern {
@@ -118,6 +118,13 @@ export class MyListChart extends Message<MyListChart> {
    */
   updatedAt?: Timestamp;
 
+  /**
+   * 譜面の曲が削除済み
+   *
+   * @generated from field: bool song_deleted = 8;
+   */
+  songDeleted = false;
+
   constructor(data?: PartialMessage<MyListChart>) {
     super();
     proto3.util.initPartial(data, this);
@@ -133,6 +140,7 @@ export class MyListChart extends Message<MyListChart> {
     { no: 5, name: "memo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "created_at", kind: "message", T: Timestamp },
     { no: 7, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 8, name: "song_deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MyListChart {
@@ -35,6 +35,16 @@ const authMiddleware: Interceptor = (next) => async (req) => {
   }
 };
 
+// ログインしていればトークンを付けるだけのミドルウェア
+// masterは未ログインでも使えるが、管理者向けの操作ではユーザーを判別する
+const optionalAuthMiddleware: Interceptor = (next) => async (req) => {
+  const token: string | null = localStorage.getItem("token");
+  if (token) {
+    req.header.set("Authorization", `Bearer ${token}`);
+  }
+  return await next(req);
+};
+
 const defaultTransport = createConnectTransport({
   baseUrl: API_BASE_URL,
 });
@@ -44,7 +54,12 @@ const authTransport = createConnectTransport({
   interceptors: [authMiddleware],
 });
 
-export const masterClient = createPromiseClient(MasterService, defaultTransport);
+const optionalAuthTransport = createConnectTransport({
+  baseUrl: API_BASE_URL,
+  interceptors: [optionalAuthMiddleware],
+});
+
+export const masterClient = createPromiseClient(MasterService, optionalAuthTransport);
 export const authClient = createPromiseClient(AuthService, defaultTransport);
 export const userClient = createPromiseClient(UserService, authTransport);
 export const myListClient = createPromiseClient(MyListService, authTransport);